@@ -3,7 +3,9 @@ package asciichgolangpublic
 import (
 	"context"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
@@ -11,6 +13,7 @@ import (
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/commandexecutorgitoo"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitgeneric"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
@@ -2031,3 +2034,300 @@ func Test_CheckExists(t *testing.T) {
 		)
 	}
 }
+
+func TestGitRepository_ListCommitLogEntries(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"localGitRepository"},
+		{"localCommandExecutorRepository"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				gitRepo := getGitRepositoryToTest(tt.implementationName)
+				defer gitRepo.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				initialCommitHash, err := gitRepo.GetCurrentCommitHash(ctx)
+				require.NoError(t, err)
+
+				_, err = gitRepo.CreateSubDirectory(ctx, "docs", &filesoptions.CreateOptions{})
+				require.NoError(t, err)
+
+				for _, name := range []string{"a.txt", "docs/b.txt"} {
+					_, err = gitRepo.WriteStringToFile(ctx, name, "content of "+name+"\n", &filesoptions.WriteOptions{})
+					require.NoError(t, err)
+
+					err = gitRepo.AddFileByPath(ctx, name)
+					require.NoError(t, err)
+
+					_, err = gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{Message: "add " + name + "\n\nwith body"})
+					require.NoError(t, err)
+				}
+
+				t.Run("all", func(t *testing.T) {
+					entries, err := gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{})
+					require.NoError(t, err)
+					require.Len(t, entries, 3)
+					require.EqualValues(t, "add docs/b.txt", entries[0].GetSubject())
+					require.EqualValues(t, "add docs/b.txt\n\nwith body", entries[0].Message)
+					require.EqualValues(t, "add a.txt", entries[1].GetSubject())
+					require.EqualValues(t, initialCommitHash, entries[2].Hash)
+					require.EqualValues(t, []string{entries[1].Hash}, entries[0].ParentHashes)
+					require.Len(t, entries[2].ParentHashes, 0)
+					require.EqualValues(t, gitgeneric.GitRepositryDefaultAuthorEmail(), entries[0].AuthorEmail)
+					require.False(t, entries[0].AuthorTime.IsZero())
+				})
+
+				t.Run("max count", func(t *testing.T) {
+					entries, err := gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{MaxCount: 1})
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					require.EqualValues(t, "add docs/b.txt", entries[0].GetSubject())
+				})
+
+				t.Run("path", func(t *testing.T) {
+					entries, err := gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{Path: "docs"})
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					require.EqualValues(t, "add docs/b.txt", entries[0].GetSubject())
+				})
+
+				t.Run("ref range", func(t *testing.T) {
+					entries, err := gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{FromRef: initialCommitHash, ToRef: "HEAD~1"})
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					require.EqualValues(t, "add a.txt", entries[0].GetSubject())
+				})
+
+				t.Run("author", func(t *testing.T) {
+					entries, err := gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{Author: gitgeneric.GitRepositryDefaultAuthorEmail()})
+					require.NoError(t, err)
+					require.Len(t, entries, 3)
+
+					entries, err = gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{Author: "unknown author"})
+					require.NoError(t, err)
+					require.Len(t, entries, 0)
+				})
+
+				t.Run("since and until", func(t *testing.T) {
+					future := time.Now().Add(time.Hour)
+					entries, err := gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{Since: &future})
+					require.NoError(t, err)
+					require.Len(t, entries, 0)
+
+					past := time.Now().Add(-time.Hour)
+					entries, err = gitRepo.ListCommitLogEntries(ctx, &gitparameteroptions.GitLogOptions{Since: &past, Until: &future})
+					require.NoError(t, err)
+					require.Len(t, entries, 3)
+				})
+			},
+		)
+	}
+}
+
+func TestGitRepository_GetDiff(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"localGitRepository"},
+		{"localCommandExecutorRepository"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				gitRepo := getGitRepositoryToTest(tt.implementationName)
+				defer gitRepo.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				_, err := gitRepo.WriteStringToFile(ctx, "a.txt", "line1\nline2\nline3\n", &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+				_, err = gitRepo.WriteStringToFile(ctx, "b.txt", "to be deleted\n", &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+				err = gitRepo.AddFilesByPath(ctx, []string{"a.txt", "b.txt"})
+				require.NoError(t, err)
+				firstCommit, err := gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{Message: "first"})
+				require.NoError(t, err)
+				firstCommitHash, err := firstCommit.GetHash(ctx)
+				require.NoError(t, err)
+
+				_, err = gitRepo.WriteStringToFile(ctx, "a.txt", "line1\nchanged\nline3\nline4\n", &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+				_, err = gitRepo.WriteStringToFile(ctx, "c.txt", "new\n", &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+				err = gitRepo.AddFileByPath(ctx, "c.txt")
+				require.NoError(t, err)
+				_, err = gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{Message: "second", CommitAllChanges: true})
+				require.NoError(t, err)
+
+				bFile, err := gitRepo.GetFileByPath("b.txt")
+				require.NoError(t, err)
+				err = bFile.Delete(ctx, &filesoptions.DeleteOptions{})
+				require.NoError(t, err)
+				secondCommit, err := gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{Message: "third", CommitAllChanges: true})
+				require.NoError(t, err)
+				secondCommitHash, err := secondCommit.GetHash(ctx)
+				require.NoError(t, err)
+
+				diff, err := gitRepo.GetDiff(ctx, &gitparameteroptions.GitDiffOptions{FromRef: firstCommitHash})
+				require.NoError(t, err)
+				require.EqualValues(t, firstCommitHash, diff.FromHash)
+				require.EqualValues(t, secondCommitHash, diff.ToHash)
+				require.EqualValues(t, []string{"a.txt", "b.txt", "c.txt"}, diff.GetChangedFilePaths())
+
+				aDiff := diff.GetFileDiffByPath("a.txt")
+				require.EqualValues(t, 2, aDiff.Additions)
+				require.EqualValues(t, 1, aDiff.Deletions)
+				require.Len(t, aDiff.Hunks, 1)
+				require.EqualValues(t, []string{" line1", "-line2", "+changed", " line3", "+line4"}, aDiff.Hunks[0].Lines)
+
+				require.True(t, diff.GetFileDiffByPath("b.txt").IsDeleted())
+				require.True(t, diff.GetFileDiffByPath("c.txt").IsAdded())
+				require.EqualValues(t, 3, diff.GetTotalAdditions())
+				require.EqualValues(t, 2, diff.GetTotalDeletions())
+
+				diff, err = gitRepo.GetDiff(ctx, &gitparameteroptions.GitDiffOptions{FromRef: firstCommitHash, ToRef: "HEAD~1", Paths: []string{"c.txt"}})
+				require.NoError(t, err)
+				require.EqualValues(t, []string{"c.txt"}, diff.GetChangedFilePaths())
+
+				diff, err = gitRepo.GetDiff(ctx, &gitparameteroptions.GitDiffOptions{FromRef: "HEAD"})
+				require.NoError(t, err)
+				require.True(t, diff.IsEmpty())
+			},
+		)
+	}
+}
+
+func TestGitRepository_GetBlame(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"localGitRepository"},
+		{"localCommandExecutorRepository"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				gitRepo := getGitRepositoryToTest(tt.implementationName)
+				defer gitRepo.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				_, err := gitRepo.WriteStringToFile(ctx, "a.txt", "line1\nline2\n", &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+				err = gitRepo.AddFileByPath(ctx, "a.txt")
+				require.NoError(t, err)
+				firstCommit, err := gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{Message: "first"})
+				require.NoError(t, err)
+				firstCommitHash, err := firstCommit.GetHash(ctx)
+				require.NoError(t, err)
+
+				_, err = gitRepo.WriteStringToFile(ctx, "a.txt", "line1\nchanged\nline3\n", &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+				secondCommit, err := gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{Message: "second", CommitAllChanges: true})
+				require.NoError(t, err)
+				secondCommitHash, err := secondCommit.GetHash(ctx)
+				require.NoError(t, err)
+
+				blameLines, err := gitRepo.GetBlame(ctx, &gitparameteroptions.GitBlameOptions{Path: "a.txt"})
+				require.NoError(t, err)
+				require.Len(t, blameLines, 3)
+
+				require.EqualValues(t, 1, blameLines[0].LineNumber)
+				require.EqualValues(t, "line1", blameLines[0].Content)
+				require.EqualValues(t, firstCommitHash, blameLines[0].Hash)
+				require.EqualValues(t, gitgeneric.GitRepositryDefaultAuthorEmail(), blameLines[0].AuthorEmail)
+
+				require.EqualValues(t, "changed", blameLines[1].Content)
+				require.EqualValues(t, secondCommitHash, blameLines[1].Hash)
+				require.EqualValues(t, 3, blameLines[2].LineNumber)
+				require.EqualValues(t, secondCommitHash, blameLines[2].Hash)
+
+				blameLines, err = gitRepo.GetBlame(ctx, &gitparameteroptions.GitBlameOptions{Path: "a.txt", Ref: firstCommitHash})
+				require.NoError(t, err)
+				require.Len(t, blameLines, 2)
+				require.EqualValues(t, "line2", blameLines[1].Content)
+			},
+		)
+	}
+}
+
+func TestGitRepository_CreateConventionalCommitsRelease(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"localGitRepository"},
+		{"localCommandExecutorRepository"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				gitRepo := getGitRepositoryToTest(tt.implementationName)
+				defer gitRepo.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				commit := func(message string) {
+					_, err := gitRepo.Commit(ctx, &gitparameteroptions.GitCommitOptions{AllowEmpty: true, Message: message})
+					require.NoError(t, err)
+				}
+
+				releaseOptions := &gitparameteroptions.GitConventionalCommitsReleaseOptions{UpdateChangelogFile: true}
+
+				// Only the non conventional initial commit exists:
+				releasePlan, err := gitRepo.CreateConventionalCommitsRelease(ctx, releaseOptions)
+				require.NoError(t, err)
+				require.False(t, releasePlan.IsReleaseNeeded())
+
+				commit("feat: first feature")
+				releasePlan, err = gitRepo.CreateConventionalCommitsRelease(ctx, releaseOptions)
+				require.NoError(t, err)
+				require.EqualValues(t, "v0.1.0", releasePlan.GetNextVersionString())
+				require.Nil(t, releasePlan.PreviousVersion)
+
+				commit("fix(core): first fix")
+				commit("docs: update docs")
+				releasePlan, err = gitRepo.GetConventionalCommitsReleasePlan(ctx, releaseOptions)
+				require.NoError(t, err)
+				require.EqualValues(t, "v0.1.1", releasePlan.GetNextVersionString())
+				require.EqualValues(t, "v0.1.0", releasePlan.PreviousVersion.String())
+				require.Len(t, releasePlan.Commits, 2)
+
+				releasePlan, err = gitRepo.CreateConventionalCommitsRelease(ctx, releaseOptions)
+				require.NoError(t, err)
+				require.EqualValues(t, "v0.1.1", releasePlan.GetNextVersionString())
+
+				latestVersion, err := gitRepo.GetLatestTagVersionAsString(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, "v0.1.1", latestVersion)
+
+				// The changelog commit itself does not trigger another release:
+				releasePlan, err = gitRepo.CreateConventionalCommitsRelease(ctx, releaseOptions)
+				require.NoError(t, err)
+				require.False(t, releasePlan.IsReleaseNeeded())
+
+				changelogFile, err := gitRepo.GetFileByPath("CHANGELOG.md")
+				require.NoError(t, err)
+				changelog, err := changelogFile.ReadAsString(ctx)
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(changelog, "# Changelog\n\n## v0.1.1 ("))
+				require.Contains(t, changelog, "* **core:** first fix (")
+				require.Contains(t, changelog, "## v0.1.0 (")
+				require.Contains(t, changelog, "* first feature (")
+
+				require.EqualValues(t, "chore(release): v0.1.1", mustutils.Must(gitRepo.GetCurrentCommitMessage(ctx)))
+			},
+		)
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"time"
 
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/conventionalcommits"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
 	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions/authenticationoptions"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
@@ -999,6 +1003,41 @@ func (g *GitlabProject) GetVersionTags(ctx context.Context) (versionTags []*Gitl
 	return versionTags, nil
 }
 
+// GetVersionTagName returns the name of the version tag representing 'version', e.g. "v1.2.3" or "1.2.3".
+func (g *GitlabProject) GetVersionTagName(ctx context.Context, version versionutils.Version) (tagName string, err error) {
+	if version == nil {
+		return "", tracederrors.TracedErrorNil("version")
+	}
+
+	versionTags, err := g.GetVersionTags(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, tag := range versionTags {
+		tagName, err := tag.GetName()
+		if err != nil {
+			return "", err
+		}
+
+		tagVersion, err := versionutils.NewFromString(tagName)
+		if err != nil {
+			return "", err
+		}
+
+		if tagVersion.Equals(version) {
+			return tagName, nil
+		}
+	}
+
+	versionString, err := version.GetAsString()
+	if err != nil {
+		return "", err
+	}
+
+	return "", tracederrors.TracedErrorf("No version tag for version '%s' found.", versionString)
+}
+
 func (g *GitlabProject) GetVersions(ctx context.Context) (versions []versionutils.Version, err error) {
 	versionTags, err := g.GetVersionTags(ctx)
 	if err != nil {
@@ -1417,3 +1456,135 @@ func (p *GitlabProject) SetGitlab(gitlab *GitlabInstance) (err error) {
 
 	return nil
 }
+
+func (g *GitlabProject) ListCommitLogEntries(ctx context.Context, options *gitparameteroptions.GitLogOptions) (logEntries []*gitimplementationindependend.CommitLogEntry, err error) {
+	projectCommits, err := g.GetProjectCommits()
+	if err != nil {
+		return nil, err
+	}
+
+	return projectCommits.ListCommitLogEntries(ctx, options)
+}
+
+// GetConventionalCommitsReleasePlan evaluates the next semantic version based on the conventional commit messages in the default branch since the latest version tag.
+func (g *GitlabProject) GetConventionalCommitsReleasePlan(ctx context.Context, options *gitparameteroptions.GitConventionalCommitsReleaseOptions) (releasePlan *conventionalcommits.ReleasePlan, err error) {
+	if options == nil {
+		options = &gitparameteroptions.GitConventionalCommitsReleaseOptions{}
+	}
+
+	semanticVersions, err := g.GetSemanticVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var latestVersion versionutils.Version
+	latestVersionTagName := ""
+	if len(semanticVersions) > 0 {
+		latestVersion, err = versionutils.GetLatestVersionFromSlice(semanticVersions)
+		if err != nil {
+			return nil, err
+		}
+
+		latestVersionTagName, err = g.GetVersionTagName(ctx, latestVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logEntries, err := g.ListCommitLogEntries(
+		ctx,
+		&gitparameteroptions.GitLogOptions{
+			FromRef: latestVersionTagName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	releasePlan, err = conventionalcommits.CreateReleasePlan(latestVersion, logEntries, options.InitialVersion, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	projectUrl, err := g.GetProjectUrl(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if releasePlan.IsReleaseNeeded() {
+		logging.LogInfoByCtxf(ctx, "Next version of gitlab project '%s' is '%s' (%s release based on %d conventional commits).", projectUrl, releasePlan.NextVersion, releasePlan.BumpType, len(releasePlan.Commits))
+	} else {
+		logging.LogInfoByCtxf(ctx, "No release needed for gitlab project '%s' since none of the %d commits since the latest version requires one.", projectUrl, len(logEntries))
+	}
+
+	return releasePlan, nil
+}
+
+// CreateConventionalCommitsRelease creates a gitlab release of the latest commit in the default branch using the next semantic version evaluated from the conventional commits.
+// The generated changelog section is used as release description.
+//
+// If requested by 'options' the changelog section is also added to the changelog file in the default branch before the release is created.
+// Since all changes are done using the gitlab API 'options.Push' is ignored.
+func (g *GitlabProject) CreateConventionalCommitsRelease(ctx context.Context, options *gitparameteroptions.GitConventionalCommitsReleaseOptions) (releasePlan *conventionalcommits.ReleasePlan, err error) {
+	if options == nil {
+		options = &gitparameteroptions.GitConventionalCommitsReleaseOptions{}
+	}
+
+	releasePlan, err = g.GetConventionalCommitsReleasePlan(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if !releasePlan.IsReleaseNeeded() {
+		return releasePlan, nil
+	}
+
+	nextVersion := releasePlan.GetNextVersionString()
+
+	if options.UpdateChangelogFile {
+		changelogFilePath := options.GetChangelogFilePathOrDefault()
+
+		changelogFile, err := g.GetFileInDefaultBranch(ctx, changelogFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		changelog := ""
+		exists, err := changelogFile.Exists(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			changelog, err = changelogFile.GetContentAsString(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = g.WriteFileContentInDefaultBranch(
+			ctx,
+			&GitlabWriteFileOptions{
+				Path:          changelogFilePath,
+				Content:       []byte(conventionalcommits.PrependChangelogSection(changelog, releasePlan.ChangelogSection)),
+				CommitMessage: "chore(release): " + nextVersion,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = g.CreateReleaseFromLatestCommitInDefaultBranch(
+		ctx,
+		&GitlabCreateReleaseOptions{
+			Name:        nextVersion,
+			Description: releasePlan.ChangelogSection,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return releasePlan, nil
+}
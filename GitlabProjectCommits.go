@@ -2,7 +2,11 @@ package asciichgolangpublic
 
 import (
 	"context"
+	"strings"
 
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
@@ -86,6 +90,111 @@ func (g *GitlabProjectCommits) GetNativeGitlabClient() (nativeClient *gitlab.Cli
 	return nativeClient, nil
 }
 
+// ListCommitLogEntries lists the commits using the gitlab API. The newest commit is returned first.
+//
+// If options.ToRef is not set the default branch is used.
+func (g *GitlabProjectCommits) ListCommitLogEntries(ctx context.Context, options *gitparameteroptions.GitLogOptions) (logEntries []*gitimplementationindependend.CommitLogEntry, err error) {
+	if options == nil {
+		options = &gitparameteroptions.GitLogOptions{}
+	}
+
+	project, err := g.GetGitlabProject()
+	if err != nil {
+		return nil, err
+	}
+
+	nativeCommitsService, err := g.GetNativeCommitsService()
+	if err != nil {
+		return nil, err
+	}
+
+	projectId, err := project.GetId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refName := options.ToRef
+	if refName == "" {
+		refName, err = project.GetDefaultBranchName(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if options.IsFromRefSet() {
+		refName = options.FromRef + ".." + refName
+	}
+
+	listOptions := &gitlab.ListCommitsOptions{
+		RefName: &refName,
+		Since:   options.Since,
+		Until:   options.Until,
+	}
+
+	if options.Path != "" {
+		listOptions.Path = &options.Path
+	}
+
+	if options.Author != "" {
+		listOptions.Author = &options.Author
+	}
+
+	logEntries = []*gitimplementationindependend.CommitLogEntry{}
+	pageNumber := 1
+	for {
+		listOptions.Page = pageNumber
+
+		nativeCommits, response, err := nativeCommitsService.ListCommits(projectId, listOptions)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Unable to list gitlab commits of '%s': '%w'", refName, err)
+		}
+
+		for _, nativeCommit := range nativeCommits {
+			logEntries = append(logEntries, getCommitLogEntryFromNativeCommit(nativeCommit))
+
+			if options.MaxCount > 0 && len(logEntries) >= options.MaxCount {
+				return logEntries, nil
+			}
+		}
+
+		if response.NextPage <= 0 {
+			break
+		}
+
+		pageNumber = response.NextPage
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collected '%d' commit log entries of '%s' in gitlab project '%d'.", len(logEntries), refName, projectId)
+
+	return logEntries, nil
+}
+
+func getCommitLogEntryFromNativeCommit(nativeCommit *gitlab.Commit) *gitimplementationindependend.CommitLogEntry {
+	logEntry := &gitimplementationindependend.CommitLogEntry{
+		Hash:           nativeCommit.ID,
+		ParentHashes:   nativeCommit.ParentIDs,
+		AuthorName:     nativeCommit.AuthorName,
+		AuthorEmail:    nativeCommit.AuthorEmail,
+		CommitterName:  nativeCommit.CommitterName,
+		CommitterEmail: nativeCommit.CommitterEmail,
+		Message:        strings.TrimRight(nativeCommit.Message, "\n"),
+	}
+
+	if nativeCommit.AuthoredDate != nil {
+		logEntry.AuthorTime = *nativeCommit.AuthoredDate
+	}
+
+	if nativeCommit.CommittedDate != nil {
+		logEntry.CommitTime = *nativeCommit.CommittedDate
+	}
+
+	if logEntry.ParentHashes == nil {
+		logEntry.ParentHashes = []string{}
+	}
+
+	return logEntry
+}
+
 func (g *GitlabProjectCommits) SetGitlabProject(gitlabProject *GitlabProject) (err error) {
 	if gitlabProject == nil {
 		return tracederrors.TracedErrorf("gitlabProject is nil")
@@ -23,8 +23,10 @@ import (
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitgeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/nativegit"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
 	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/shellutils/shelllinehandler"
@@ -702,6 +704,15 @@ func (l *LocalGitRepository) GetAuthorStringByCommitHash(hash string) (authorStr
 	return authorString, nil
 }
 
+func (l *LocalGitRepository) GetBlame(ctx context.Context, options *gitparameteroptions.GitBlameOptions) (blameLines []*gitimplementationindependend.BlameLine, err error) {
+	goGitRepo, err := l.GetAsGoGitRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativegit.GetBlame(ctx, goGitRepo, options)
+}
+
 func (l *LocalGitRepository) GetCommitAgeDurationByCommitHash(hash string) (ageDuration *time.Duration, err error) {
 	if hash == "" {
 		return nil, tracederrors.TracedErrorEmptyString("hash")
@@ -972,6 +983,15 @@ func (l *LocalGitRepository) GetCurrentCommitHashAsBytes(ctx context.Context) (h
 	return stringsutils.HexStringToBytes(currentHash)
 }
 
+func (l *LocalGitRepository) GetDiff(ctx context.Context, options *gitparameteroptions.GitDiffOptions) (diff *gitimplementationindependend.Diff, err error) {
+	goGitRepo, err := l.GetAsGoGitRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativegit.GetDiff(ctx, goGitRepo, options)
+}
+
 func (l *LocalGitRepository) GetDirectoryByPath(ctx context.Context, pathToSubDir ...string) (subDir filesinterfaces.Directory, err error) {
 	if len(pathToSubDir) <= 0 {
 		return nil, tracederrors.TracedError("pathToSubdir has no elements")
@@ -1562,6 +1582,15 @@ func (l *LocalGitRepository) ListBranchNames(ctx context.Context) (branchNames [
 	return branchNames, nil
 }
 
+func (l *LocalGitRepository) ListCommitLogEntries(ctx context.Context, options *gitparameteroptions.GitLogOptions) (logEntries []*gitimplementationindependend.CommitLogEntry, err error) {
+	goGitRepo, err := l.GetAsGoGitRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativegit.ListCommitLogEntries(ctx, goGitRepo, options)
+}
+
 func (l *LocalGitRepository) ListTagNames(ctx context.Context) (tagNames []string, err error) {
 	nativeRepo, err := l.GetAsGoGitRepository()
 	if err != nil {
@@ -0,0 +1,76 @@
+package archiveutils_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils"
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefilesoo"
+)
+
+// ExampleExtractToDirectory demonstrates how to create and extract archives.
+//
+// The archive format is detected automatically from the magic bytes, so release artifacts
+// can be extracted without knowing if they are zip, tar.gz, tar.xz, tar.zst or tar.bz2 files.
+func ExampleExtractToDirectory() {
+	ctx := contextutils.ContextSilent()
+
+	tmpDir, err := os.MkdirTemp("", "archiveutils-example-*")
+	if err != nil {
+		fmt.Printf("Failed to create temp dir: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Prepare a directory with a file to archive:
+	sourceDirectory, err := nativefilesoo.NewDirectoryByPath(filepath.Join(tmpDir, "source"))
+	if err != nil {
+		fmt.Printf("Failed to get source directory: %v\n", err)
+		return
+	}
+
+	_, err = sourceDirectory.WriteStringToFile(ctx, "README.md", "hello world\n", nil)
+	if err != nil {
+		fmt.Printf("Failed to write file: %v\n", err)
+		return
+	}
+
+	// Create the archive. The format is taken from the file name:
+	archiveFile, err := nativefilesoo.NewFileByPath(filepath.Join(tmpDir, "release.tar.zst"))
+	if err != nil {
+		fmt.Printf("Failed to get archive file: %v\n", err)
+		return
+	}
+
+	err = archiveutils.CreateFromDirectory(ctx, sourceDirectory, archiveFile, &archiveoptions.CreateOptions{PrefixDirectory: "myapp"})
+	if err != nil {
+		fmt.Printf("Failed to create archive: %v\n", err)
+		return
+	}
+
+	// Extract the archive while removing the "myapp" prefix directory:
+	destDirectory, err := nativefilesoo.NewDirectoryByPath(filepath.Join(tmpDir, "extracted"))
+	if err != nil {
+		fmt.Printf("Failed to get destination directory: %v\n", err)
+		return
+	}
+
+	err = archiveutils.ExtractToDirectory(ctx, archiveFile, destDirectory, &archiveoptions.ExtractOptions{StripComponents: 1})
+	if err != nil {
+		fmt.Printf("Failed to extract archive: %v\n", err)
+		return
+	}
+
+	content, err := destDirectory.ReadFileInDirectoryAsString(ctx, "README.md")
+	if err != nil {
+		fmt.Printf("Failed to read extracted file: %v\n", err)
+		return
+	}
+
+	fmt.Print(content)
+
+	// Output: hello world
+}
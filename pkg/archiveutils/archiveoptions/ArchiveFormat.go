@@ -0,0 +1,103 @@
+package archiveoptions
+
+import (
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type ArchiveFormat string
+
+const (
+	FormatZip    ArchiveFormat = "zip"
+	FormatTar    ArchiveFormat = "tar"
+	FormatTarGz  ArchiveFormat = "tar.gz"
+	FormatTarXz  ArchiveFormat = "tar.xz"
+	FormatTarZst ArchiveFormat = "tar.zst"
+
+	// tar.bz2 is read only since the go standard library does not provide a bzip2 compressor.
+	FormatTarBz2 ArchiveFormat = "tar.bz2"
+)
+
+// Returns all supported archive formats.
+func GetArchiveFormats() []ArchiveFormat {
+	return []ArchiveFormat{FormatZip, FormatTar, FormatTarGz, FormatTarXz, FormatTarZst, FormatTarBz2}
+}
+
+// Get the archive format based on the file name extension, e.g. "release.tar.gz" returns FormatTarGz.
+func GetArchiveFormatFromFileName(fileName string) (ArchiveFormat, error) {
+	if fileName == "" {
+		return "", tracederrors.TracedErrorEmptyString("fileName")
+	}
+
+	lower := strings.ToLower(fileName)
+
+	suffixes := []struct {
+		suffix string
+		format ArchiveFormat
+	}{
+		{".zip", FormatZip},
+		{".tar.gz", FormatTarGz},
+		{".tgz", FormatTarGz},
+		{".tar.xz", FormatTarXz},
+		{".txz", FormatTarXz},
+		{".tar.zst", FormatTarZst},
+		{".tzst", FormatTarZst},
+		{".tar.bz2", FormatTarBz2},
+		{".tbz2", FormatTarBz2},
+		{".tar", FormatTar},
+	}
+
+	for _, s := range suffixes {
+		if strings.HasSuffix(lower, s.suffix) {
+			return s.format, nil
+		}
+	}
+
+	return "", tracederrors.TracedErrorf("Unable to get archive format from file name '%s'", fileName)
+}
+
+// Returns the archive format of a tar archive compressed using 'compressionFormat'.
+func GetTarArchiveFormatByCompressionFormat(compressionFormat compressionoptions.CompressionFormat) (ArchiveFormat, error) {
+	switch compressionFormat {
+	case compressionoptions.FormatGzip:
+		return FormatTarGz, nil
+	case compressionoptions.FormatXz:
+		return FormatTarXz, nil
+	case compressionoptions.FormatZstd:
+		return FormatTarZst, nil
+	case compressionoptions.FormatBzip2:
+		return FormatTarBz2, nil
+	}
+
+	return "", tracederrors.TracedErrorf("No tar archive format known for compression format '%s'", compressionFormat)
+}
+
+// Returns the compression format used by a compressed tar archive format.
+func (a ArchiveFormat) GetCompressionFormat() (compressionoptions.CompressionFormat, error) {
+	switch a {
+	case FormatTarGz:
+		return compressionoptions.FormatGzip, nil
+	case FormatTarXz:
+		return compressionoptions.FormatXz, nil
+	case FormatTarZst:
+		return compressionoptions.FormatZstd, nil
+	case FormatTarBz2:
+		return compressionoptions.FormatBzip2, nil
+	}
+
+	return "", tracederrors.TracedErrorf("Archive format '%s' is not a compressed tar archive", a)
+}
+
+func (a ArchiveFormat) IsTar() bool {
+	return strings.HasPrefix(string(a), string(FormatTar))
+}
+
+func (a ArchiveFormat) IsWriteable() bool {
+	return a != FormatTarBz2
+}
+
+func (a ArchiveFormat) String() string {
+	return string(a)
+}
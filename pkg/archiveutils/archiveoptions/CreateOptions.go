@@ -0,0 +1,10 @@
+package archiveoptions
+
+type CreateOptions struct {
+	// Format of the archive to create.
+	// If not set the format is detected by the file name of the archive, e.g. "release.tar.gz".
+	Format ArchiveFormat
+
+	// If set all entries are added below this directory in the archive, e.g. "myapp-v1.0.0".
+	PrefixDirectory string
+}
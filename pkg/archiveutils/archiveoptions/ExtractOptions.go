@@ -0,0 +1,14 @@
+package archiveoptions
+
+type ExtractOptions struct {
+	// Overwrite already existing files in the destination directory.
+	// If false an error is returned if a file to extract already exists.
+	Overwrite bool
+
+	// Remove the given number of leading path elements from the entry names.
+	// Works like `tar --strip-components`.
+	StripComponents int
+
+	// Use sudo to write the extracted files and directories.
+	UseSudo bool
+}
@@ -0,0 +1,226 @@
+package archiveutils_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils"
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefilesoo"
+	"github.com/stretchr/testify/require"
+)
+
+func getCtx() context.Context {
+	return contextutils.ContextVerbose()
+}
+
+func getDirectory(t *testing.T, path string) filesinterfaces.Directory {
+	directory, err := nativefilesoo.NewDirectoryByPath(path)
+	require.NoError(t, err)
+	return directory
+}
+
+func getFile(t *testing.T, path string) filesinterfaces.File {
+	file, err := nativefilesoo.NewFileByPath(path)
+	require.NoError(t, err)
+	return file
+}
+
+func createSourceDirectory(t *testing.T) filesinterfaces.Directory {
+	sourcePath := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(sourcePath, "hello.txt"), []byte("hello world\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(sourcePath, "sub", "empty"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourcePath, "sub", "script.sh"), []byte("#!/bin/bash\necho hi\n"), 0755))
+
+	return getDirectory(t, sourcePath)
+}
+
+func getWriteableFormats() []archiveoptions.ArchiveFormat {
+	ret := []archiveoptions.ArchiveFormat{}
+	for _, f := range archiveoptions.GetArchiveFormats() {
+		if f.IsWriteable() {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+func Test_CreateListAndExtract(t *testing.T) {
+	for _, format := range getWriteableFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			ctx := getCtx()
+
+			archiveFile := getFile(t, filepath.Join(t.TempDir(), "archive."+format.String()))
+
+			err := archiveutils.CreateFromDirectory(ctx, createSourceDirectory(t), archiveFile, nil)
+			require.NoError(t, err)
+
+			t.Run("detect format", func(t *testing.T) {
+				detected, err := archiveutils.DetectFormat(ctx, archiveFile)
+				require.NoError(t, err)
+				require.EqualValues(t, format, detected)
+			})
+
+			t.Run("list", func(t *testing.T) {
+				names, err := archiveutils.ListEntryNames(ctx, archiveFile)
+				require.NoError(t, err)
+				require.EqualValues(t, []string{"hello.txt", "sub", "sub/empty", "sub/script.sh"}, names)
+			})
+
+			t.Run("extract", func(t *testing.T) {
+				destPath := filepath.Join(t.TempDir(), "extracted")
+
+				err := archiveutils.ExtractToDirectory(ctx, archiveFile, getDirectory(t, destPath), nil)
+				require.NoError(t, err)
+
+				content, err := os.ReadFile(filepath.Join(destPath, "hello.txt"))
+				require.NoError(t, err)
+				require.EqualValues(t, "hello world\n", string(content))
+
+				stat, err := os.Stat(filepath.Join(destPath, "sub", "script.sh"))
+				require.NoError(t, err)
+				require.EqualValues(t, os.FileMode(0755), stat.Mode().Perm())
+
+				require.DirExists(t, filepath.Join(destPath, "sub", "empty"))
+			})
+
+			t.Run("extract existing fails without overwrite", func(t *testing.T) {
+				destPath := t.TempDir()
+
+				err := archiveutils.ExtractToDirectory(ctx, archiveFile, getDirectory(t, destPath), nil)
+				require.NoError(t, err)
+
+				err = archiveutils.ExtractToDirectory(ctx, archiveFile, getDirectory(t, destPath), nil)
+				require.Error(t, err)
+
+				err = archiveutils.ExtractToDirectory(ctx, archiveFile, getDirectory(t, destPath), &archiveoptions.ExtractOptions{Overwrite: true})
+				require.NoError(t, err)
+			})
+
+			t.Run("add and delete entries", func(t *testing.T) {
+				err := archiveutils.AddBytesToArchive(ctx, archiveFile, "added/new.txt", []byte("new content"))
+				require.NoError(t, err)
+
+				err = archiveutils.DeleteEntriesFromArchive(ctx, archiveFile, []string{"sub"})
+				require.NoError(t, err)
+
+				names, err := archiveutils.ListEntryNames(ctx, archiveFile)
+				require.NoError(t, err)
+				require.EqualValues(t, []string{"added/new.txt", "hello.txt"}, names)
+
+				detected, err := archiveutils.DetectFormat(ctx, archiveFile)
+				require.NoError(t, err)
+				require.EqualValues(t, format, detected)
+			})
+		})
+	}
+}
+
+func Test_CreateFromDirectory_PrefixDirectoryAndStripComponents(t *testing.T) {
+	ctx := getCtx()
+
+	archiveFile := getFile(t, filepath.Join(t.TempDir(), "release.tar.gz"))
+
+	err := archiveutils.CreateFromDirectory(ctx, createSourceDirectory(t), archiveFile, &archiveoptions.CreateOptions{PrefixDirectory: "myapp-v1.0.0"})
+	require.NoError(t, err)
+
+	names, err := archiveutils.ListEntryNames(ctx, archiveFile)
+	require.NoError(t, err)
+	require.Contains(t, names, "myapp-v1.0.0/hello.txt")
+
+	destPath := t.TempDir()
+	err = archiveutils.ExtractToDirectory(ctx, archiveFile, getDirectory(t, destPath), &archiveoptions.ExtractOptions{StripComponents: 1})
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(destPath, "hello.txt"))
+	require.FileExists(t, filepath.Join(destPath, "sub", "script.sh"))
+}
+
+func Test_ReadTarBz2(t *testing.T) {
+	ctx := getCtx()
+
+	archiveFile := getFile(t, "testdata/example.tar.bz2")
+
+	t.Run("list", func(t *testing.T) {
+		names, err := archiveutils.ListEntryNames(ctx, archiveFile)
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"hello.txt", "sub", "sub/nested.txt"}, names)
+	})
+
+	t.Run("extract", func(t *testing.T) {
+		destPath := t.TempDir()
+		err := archiveutils.ExtractToDirectory(ctx, archiveFile, getDirectory(t, destPath), nil)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(destPath, "sub", "nested.txt"))
+		require.NoError(t, err)
+		require.EqualValues(t, "nested\n", string(content))
+	})
+
+	t.Run("modify is not supported", func(t *testing.T) {
+		err := archiveutils.AddBytesToArchive(ctx, archiveFile, "new.txt", []byte("abc"))
+		require.ErrorIs(t, err, archiveutils.ErrArchiveFormatReadOnly)
+	})
+}
+
+func Test_ExtractToDirectory_PathTraversal(t *testing.T) {
+	for _, name := range []string{"../evil.txt", "a/../../evil.txt", "/etc/evil.txt"} {
+		t.Run(name, func(t *testing.T) {
+			ctx := getCtx()
+
+			var buf bytes.Buffer
+			tarWriter := tar.NewWriter(&buf)
+			require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: "harmless.txt", Mode: 0644, Size: 1, Typeflag: tar.TypeReg}))
+			_, err := tarWriter.Write([]byte("a"))
+			require.NoError(t, err)
+			require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: 4, Typeflag: tar.TypeReg}))
+			_, err = tarWriter.Write([]byte("evil"))
+			require.NoError(t, err)
+			require.NoError(t, tarWriter.Close())
+
+			tempDir := t.TempDir()
+			archivePath := filepath.Join(tempDir, "evil.tar")
+			require.NoError(t, os.WriteFile(archivePath, buf.Bytes(), 0644))
+
+			destPath := filepath.Join(tempDir, "dest", "extracted")
+			err = archiveutils.ExtractToDirectory(ctx, getFile(t, archivePath), getDirectory(t, destPath), nil)
+			require.ErrorIs(t, err, archiveutils.ErrUnsafeEntryPath)
+
+			// Validation happens before anything is written:
+			require.NoFileExists(t, filepath.Join(destPath, "harmless.txt"))
+			require.NoFileExists(t, filepath.Join(tempDir, "dest", "evil.txt"))
+		})
+	}
+}
+
+func Test_DetectFormatFromBytes(t *testing.T) {
+	tests := []struct {
+		header   []byte
+		expected archiveoptions.ArchiveFormat
+	}{
+		{[]byte{'P', 'K', 0x03, 0x04, 0x00}, archiveoptions.FormatZip},
+		{[]byte{0x1f, 0x8b, 0x08}, archiveoptions.FormatTarGz},
+		{[]byte{0xfd, '7', 'z', 'X', 'Z', 0x00}, archiveoptions.FormatTarXz},
+		{[]byte{0x28, 0xb5, 0x2f, 0xfd}, archiveoptions.FormatTarZst},
+		{[]byte("BZh91AY"), archiveoptions.FormatTarBz2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			detected, err := archiveutils.DetectFormatFromBytes(tt.header)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expected, detected)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := archiveutils.DetectFormatFromBytes([]byte("hello world"))
+		require.ErrorIs(t, err, archiveutils.ErrUnknownArchiveFormat)
+	})
+}
@@ -0,0 +1,53 @@
+package archiveutils
+
+import (
+	"io"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Returns a reader providing the plain tar stream of a (compressed) tar archive.
+func getTarStreamReader(format archiveoptions.ArchiveFormat, reader io.Reader) (io.ReadCloser, error) {
+	if reader == nil {
+		return nil, tracederrors.TracedErrorNil("reader")
+	}
+
+	if format == archiveoptions.FormatTar {
+		return io.NopCloser(reader), nil
+	}
+
+	compressionFormat, err := format.GetCompressionFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	return compressionutils.NewDecompressReader(compressionFormat, reader)
+}
+
+// Returns a writer compressing the written plain tar stream as defined by 'format'.
+func getTarStreamWriter(format archiveoptions.ArchiveFormat, writer io.Writer) (io.WriteCloser, error) {
+	if writer == nil {
+		return nil, tracederrors.TracedErrorNil("writer")
+	}
+
+	if format == archiveoptions.FormatTar {
+		return nopWriteCloser{writer}, nil
+	}
+
+	compressionFormat, err := format.GetCompressionFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	return compressionutils.NewCompressWriter(compressionFormat, writer)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
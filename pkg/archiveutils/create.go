@@ -0,0 +1,192 @@
+package archiveutils
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// CreateFromDirectory creates 'archiveFile' containing all files and sub directories of 'sourceDirectory'.
+//
+// Entries are stored relative to 'sourceDirectory'. An already existing 'archiveFile' is overwritten.
+func CreateFromDirectory(ctx context.Context, sourceDirectory filesinterfaces.Directory, archiveFile filesinterfaces.File, options *archiveoptions.CreateOptions) error {
+	if sourceDirectory == nil {
+		return tracederrors.TracedErrorNil("sourceDirectory")
+	}
+
+	if archiveFile == nil {
+		return tracederrors.TracedErrorNil("archiveFile")
+	}
+
+	if options == nil {
+		options = &archiveoptions.CreateOptions{}
+	}
+
+	format, err := getFormatToCreate(archiveFile, options)
+	if err != nil {
+		return err
+	}
+
+	sourcePath, hostDescription, err := sourceDirectory.GetPathAndHostDescription()
+	if err != nil {
+		return err
+	}
+
+	archivePath, err := archiveFile.GetPath()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create %s archive '%s' from directory '%s' on host '%s' started.", format, archivePath, sourcePath, hostDescription)
+
+	subDirectories, err := sourceDirectory.ListSubDirectories(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.ListDirectoryOptions{Recursive: true},
+	)
+	if err != nil {
+		return err
+	}
+
+	files, err := sourceDirectory.ListFiles(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.ListFileOptions{AllowEmptyListIfNoFileIsFound: true},
+	)
+	if err != nil {
+		return err
+	}
+
+	type toAdd struct {
+		name      string
+		directory filesinterfaces.Directory
+		file      filesinterfaces.File
+	}
+
+	entries := []toAdd{}
+	for _, d := range subDirectories {
+		dirPath, err := d.GetPath()
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, toAdd{name: strings.TrimPrefix(dirPath, sourcePath+"/"), directory: d})
+	}
+
+	for _, f := range files {
+		filePath, err := f.GetPath()
+		if err != nil {
+			return err
+		}
+
+		if filePath == archivePath {
+			// Do not add the archive to itself if it is created inside the source directory.
+			continue
+		}
+
+		entries = append(entries, toAdd{name: strings.TrimPrefix(filePath, sourcePath+"/"), file: f})
+	}
+
+	sort.Slice(entries, func(i int, j int) bool { return entries[i].name < entries[j].name })
+
+	writeCloser, err := archiveFile.OpenAsWriteCloser(ctx, &filesoptions.WriteOptions{})
+	if err != nil {
+		return err
+	}
+	defer writeCloser.Close()
+
+	writer, err := newArchiveWriter(format, writeCloser)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if options.PrefixDirectory != "" {
+		err = writer.WriteEntry(&ArchiveEntry{Name: options.PrefixDirectory, IsDir: true, Mode: 0755, ModTime: now}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, e := range entries {
+		entry := &ArchiveEntry{
+			Name:    path.Join(options.PrefixDirectory, e.name),
+			ModTime: now,
+		}
+
+		if e.directory != nil {
+			entry.IsDir = true
+			entry.Mode = 0755
+
+			err = writer.WriteEntry(entry, nil)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		entry.Size, err = e.file.GetSizeBytes(ctx)
+		if err != nil {
+			return err
+		}
+
+		permissions, err := e.file.GetAccessPermissions()
+		if err != nil {
+			return err
+		}
+		entry.Mode = os.FileMode(permissions).Perm()
+
+		err = func() error {
+			content, err := e.file.OpenAsReadCloser(ctx)
+			if err != nil {
+				return err
+			}
+			defer content.Close()
+
+			return writer.WriteEntry(entry, content)
+		}()
+		if err != nil {
+			return err
+		}
+
+		logging.LogInfoByCtxf(ctx, "Added '%s' to archive '%s'.", entry.Name, archivePath)
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	err = writeCloser.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close archive '%s': %w", archivePath, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Created %s archive '%s' containing '%d' entries from directory '%s' on host '%s'.", format, archivePath, len(entries), sourcePath, hostDescription)
+
+	return nil
+}
+
+func getFormatToCreate(archiveFile filesinterfaces.File, options *archiveoptions.CreateOptions) (archiveoptions.ArchiveFormat, error) {
+	if options.Format != "" {
+		return options.Format, nil
+	}
+
+	baseName, err := archiveFile.GetBaseName()
+	if err != nil {
+		return "", err
+	}
+
+	return archiveoptions.GetArchiveFormatFromFileName(baseName)
+}
@@ -0,0 +1,24 @@
+package archiveutils
+
+import (
+	"os"
+	"time"
+)
+
+// An ArchiveEntry describes a single file or directory stored in an archive.
+type ArchiveEntry struct {
+	// Name of the entry inside the archive. Directories do not have a trailing slash.
+	Name    string
+	Size    int64
+	Mode    os.FileMode
+	ModTime time.Time
+	IsDir   bool
+
+	// Symbolic links are listed but neither created on extraction nor followed.
+	IsSymlink  bool
+	LinkTarget string
+}
+
+func (a *ArchiveEntry) IsRegularFile() bool {
+	return !a.IsDir && !a.IsSymlink
+}
@@ -0,0 +1,7 @@
+package archiveutils
+
+import "errors"
+
+var ErrUnknownArchiveFormat = errors.New("unknown archive format")
+var ErrArchiveFormatReadOnly = errors.New("archive format is read only")
+var ErrUnsafeEntryPath = errors.New("unsafe entry path in archive")
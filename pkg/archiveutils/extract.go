@@ -0,0 +1,197 @@
+package archiveutils
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/osutils/unixfilepermissionsutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// ExtractToDirectory extracts all entries of 'archiveFile' into 'destDirectory'.
+//
+// The archive format is detected automatically using the magic bytes.
+// Entries with absolute paths or paths pointing outside of 'destDirectory' (e.g. "../../etc/passwd") are rejected
+// with ErrUnsafeEntryPath before anything is written. Symbolic links are skipped.
+func ExtractToDirectory(ctx context.Context, archiveFile filesinterfaces.File, destDirectory filesinterfaces.Directory, options *archiveoptions.ExtractOptions) error {
+	if archiveFile == nil {
+		return tracederrors.TracedErrorNil("archiveFile")
+	}
+
+	if destDirectory == nil {
+		return tracederrors.TracedErrorNil("destDirectory")
+	}
+
+	if options == nil {
+		options = &archiveoptions.ExtractOptions{}
+	}
+
+	if options.StripComponents < 0 {
+		return tracederrors.TracedErrorf("Invalid StripComponents '%d'", options.StripComponents)
+	}
+
+	destPath, hostDescription, err := destDirectory.GetPathAndHostDescription()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Extract archive '%s' to directory '%s' on host '%s' started.", archiveFile, destPath, hostDescription)
+
+	// Validate all entries first to not leave a partially extracted archive behind:
+	entries, err := ListEntries(contextutils.WithSilent(ctx), archiveFile)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		_, err = GetSafeEntryPath(entry.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = destDirectory.Create(ctx, &filesoptions.CreateOptions{UseSudo: options.UseSudo})
+	if err != nil {
+		return err
+	}
+
+	nExtracted := 0
+	_, err = walkArchive(contextutils.WithSilent(ctx), archiveFile, func(entry *ArchiveEntry, content io.Reader) error {
+		safePath, err := GetSafeEntryPath(entry.Name)
+		if err != nil {
+			return err
+		}
+
+		safePath = stripComponents(safePath, options.StripComponents)
+		if safePath == "" {
+			return nil
+		}
+
+		if entry.IsSymlink {
+			logging.LogWarnByCtxf(ctx, "Skip extraction of symlink '%s' -> '%s'.", entry.Name, entry.LinkTarget)
+			return nil
+		}
+
+		if entry.IsDir {
+			subDirectory, err := destDirectory.GetSubDirectory(ctx, safePath)
+			if err != nil {
+				return err
+			}
+
+			return subDirectory.Create(contextutils.WithSilent(ctx), &filesoptions.CreateOptions{UseSudo: options.UseSudo})
+		}
+
+		err = extractFile(ctx, destDirectory, safePath, entry, content, options)
+		if err != nil {
+			return err
+		}
+
+		nExtracted++
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Extracted '%d' files of archive '%s' to directory '%s' on host '%s'.", nExtracted, archiveFile, destPath, hostDescription)
+
+	return nil
+}
+
+func extractFile(ctx context.Context, destDirectory filesinterfaces.Directory, safePath string, entry *ArchiveEntry, content io.Reader, options *archiveoptions.ExtractOptions) error {
+	destFile, err := destDirectory.GetFileInDirectory(safePath)
+	if err != nil {
+		return err
+	}
+
+	exists, err := destFile.Exists(contextutils.WithSilent(ctx))
+	if err != nil {
+		return err
+	}
+
+	if exists && !options.Overwrite {
+		return tracederrors.TracedErrorf("File '%s' to extract already exists in '%s'. Use Overwrite option to replace it.", safePath, destFile)
+	}
+
+	err = destFile.CreateParentDirectory(contextutils.WithSilent(ctx))
+	if err != nil {
+		return err
+	}
+
+	writeCloser, err := destFile.OpenAsWriteCloser(ctx, &filesoptions.WriteOptions{UseSudo: options.UseSudo})
+	if err != nil {
+		return err
+	}
+	defer writeCloser.Close()
+
+	_, err = io.Copy(writeCloser, content)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to extract '%s' to '%s': %w", entry.Name, destFile, err)
+	}
+
+	err = writeCloser.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close '%s' after extraction: %w", destFile, err)
+	}
+
+	permissionsString, err := unixfilepermissionsutils.GetPermissionString(int(entry.Mode.Perm()))
+	if err != nil {
+		return err
+	}
+
+	err = destFile.Chmod(contextutils.WithSilent(ctx), &filesoptions.ChmodOptions{PermissionsString: permissionsString, UseSudo: options.UseSudo})
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Extracted '%s' to '%s'.", entry.Name, destFile)
+
+	return nil
+}
+
+// GetSafeEntryPath returns the cleaned relative path of an archive entry.
+//
+// An error wrapping ErrUnsafeEntryPath is returned for absolute paths and paths leaving the extraction directory.
+func GetSafeEntryPath(entryName string) (string, error) {
+	if entryName == "" {
+		return "", tracederrors.TracedErrorEmptyString("entryName")
+	}
+
+	normalized := strings.ReplaceAll(entryName, "\\", "/")
+
+	if strings.HasPrefix(normalized, "/") || (len(normalized) >= 2 && normalized[1] == ':') {
+		return "", tracederrors.TracedErrorf("%w: absolute path '%s'", ErrUnsafeEntryPath, entryName)
+	}
+
+	cleaned := path.Clean(normalized)
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", tracederrors.TracedErrorf("%w: '%s' points outside of the extraction directory", ErrUnsafeEntryPath, entryName)
+	}
+
+	if cleaned == "." {
+		return "", nil
+	}
+
+	return cleaned, nil
+}
+
+func stripComponents(entryPath string, nComponents int) string {
+	if nComponents <= 0 {
+		return entryPath
+	}
+
+	parts := strings.Split(entryPath, "/")
+	if len(parts) <= nComponents {
+		return ""
+	}
+
+	return strings.Join(parts[nComponents:], "/")
+}
@@ -0,0 +1,82 @@
+package archiveutils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Number of bytes needed to detect all supported archive formats.
+// The "ustar" magic of a plain tar archive is located at offset 257.
+const nBytesToDetectFormat = 262
+
+var magicZip = []byte{'P', 'K', 0x03, 0x04}
+var magicZipEmpty = []byte{'P', 'K', 0x05, 0x06}
+var magicTar = []byte("ustar")
+
+// DetectFormatFromBytes detects the archive format using the magic bytes at the beginning of an archive.
+//
+// Compressed streams (gzip, xz, zstd and bzip2) are expected to contain a tar archive.
+func DetectFormatFromBytes(header []byte) (archiveoptions.ArchiveFormat, error) {
+	if header == nil {
+		return "", tracederrors.TracedErrorNil("header")
+	}
+
+	switch {
+	case bytes.HasPrefix(header, magicZip), bytes.HasPrefix(header, magicZipEmpty):
+		return archiveoptions.FormatZip, nil
+	case compressionutils.IsCompressed(header):
+		compressionFormat, err := compressionutils.DetectFormatFromBytes(header)
+		if err != nil {
+			return "", err
+		}
+
+		return archiveoptions.GetTarArchiveFormatByCompressionFormat(compressionFormat)
+	case len(header) >= 262 && bytes.Equal(header[257:262], magicTar):
+		return archiveoptions.FormatTar, nil
+	}
+
+	return "", tracederrors.TracedErrorf("%w: unable to detect archive format from magic bytes", ErrUnknownArchiveFormat)
+}
+
+// DetectFormat detects the format of 'archiveFile' by reading its magic bytes.
+// The file name is not taken into account.
+func DetectFormat(ctx context.Context, archiveFile filesinterfaces.File) (archiveoptions.ArchiveFormat, error) {
+	if archiveFile == nil {
+		return "", tracederrors.TracedErrorNil("archiveFile")
+	}
+
+	readCloser, err := archiveFile.OpenAsReadCloser(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer readCloser.Close()
+
+	format, _, err := detectFormatFromReader(bufio.NewReader(readCloser))
+	if err != nil {
+		return "", err
+	}
+
+	return format, nil
+}
+
+func detectFormatFromReader(reader *bufio.Reader) (archiveoptions.ArchiveFormat, *bufio.Reader, error) {
+	if reader == nil {
+		return "", nil, tracederrors.TracedErrorNil("reader")
+	}
+
+	// Peek returns less bytes and an error if the archive is shorter. This is fine for all formats except tar.
+	header, _ := reader.Peek(nBytesToDetectFormat)
+
+	format, err := DetectFormatFromBytes(header)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return format, reader, nil
+}
@@ -0,0 +1,45 @@
+package archiveutils
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+)
+
+// ListEntries returns all entries of 'archiveFile' in the order they are stored in the archive.
+// The archive format is detected automatically using the magic bytes.
+func ListEntries(ctx context.Context, archiveFile filesinterfaces.File) ([]*ArchiveEntry, error) {
+	ret := []*ArchiveEntry{}
+
+	format, err := walkArchive(ctx, archiveFile, func(entry *ArchiveEntry, content io.Reader) error {
+		ret = append(ret, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' entries in %s archive '%s'.", len(ret), format, archiveFile)
+
+	return ret, nil
+}
+
+// ListEntryNames returns the sorted names of all entries in 'archiveFile'.
+func ListEntryNames(ctx context.Context, archiveFile filesinterfaces.File) ([]string, error) {
+	entries, err := ListEntries(ctx, archiveFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ret = append(ret, e.Name)
+	}
+
+	sort.Strings(ret)
+
+	return ret, nil
+}
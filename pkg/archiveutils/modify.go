@@ -0,0 +1,228 @@
+package archiveutils
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// AddBytesToArchive adds a file named 'nameInArchive' with the given 'content' to 'archiveFile'.
+// An already existing entry with the same name is replaced.
+func AddBytesToArchive(ctx context.Context, archiveFile filesinterfaces.File, nameInArchive string, content []byte) error {
+	if content == nil {
+		return tracederrors.TracedErrorNil("content")
+	}
+
+	safeName, err := GetSafeEntryPath(nameInArchive)
+	if err != nil {
+		return err
+	}
+
+	entry := &ArchiveEntry{
+		Name:    safeName,
+		Size:    int64(len(content)),
+		Mode:    0644,
+		ModTime: time.Now(),
+	}
+
+	err = rewriteArchive(
+		ctx,
+		archiveFile,
+		func(existing *ArchiveEntry) bool { return existing.Name != safeName },
+		func(writer archiveWriter) error { return writer.WriteEntry(entry, bytes.NewReader(content)) },
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Added '%s' to archive '%s'.", safeName, archiveFile)
+
+	return nil
+}
+
+// AddFileToArchive adds 'fileToAdd' as 'nameInArchive' to 'archiveFile'.
+// An already existing entry with the same name is replaced.
+func AddFileToArchive(ctx context.Context, archiveFile filesinterfaces.File, fileToAdd filesinterfaces.File, nameInArchive string) error {
+	if fileToAdd == nil {
+		return tracederrors.TracedErrorNil("fileToAdd")
+	}
+
+	safeName, err := GetSafeEntryPath(nameInArchive)
+	if err != nil {
+		return err
+	}
+
+	size, err := fileToAdd.GetSizeBytes(ctx)
+	if err != nil {
+		return err
+	}
+
+	permissions, err := fileToAdd.GetAccessPermissions()
+	if err != nil {
+		return err
+	}
+
+	entry := &ArchiveEntry{
+		Name:    safeName,
+		Size:    size,
+		Mode:    os.FileMode(permissions).Perm(),
+		ModTime: time.Now(),
+	}
+
+	err = rewriteArchive(
+		ctx,
+		archiveFile,
+		func(existing *ArchiveEntry) bool { return existing.Name != safeName },
+		func(writer archiveWriter) error {
+			content, err := fileToAdd.OpenAsReadCloser(ctx)
+			if err != nil {
+				return err
+			}
+			defer content.Close()
+
+			return writer.WriteEntry(entry, content)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Added '%s' as '%s' to archive '%s'.", fileToAdd, safeName, archiveFile)
+
+	return nil
+}
+
+// DeleteEntriesFromArchive removes the entries with the given names from 'archiveFile'.
+// Deleting a directory removes all entries below it as well. Names not present in the archive are ignored.
+func DeleteEntriesFromArchive(ctx context.Context, archiveFile filesinterfaces.File, namesToDelete []string) error {
+	if len(namesToDelete) <= 0 {
+		return tracederrors.TracedError("namesToDelete has no elements")
+	}
+
+	toDelete := []string{}
+	for _, name := range namesToDelete {
+		normalized := normalizeEntryName(name)
+		if normalized == "" {
+			return tracederrors.TracedErrorf("Invalid name to delete: '%s'", name)
+		}
+
+		toDelete = append(toDelete, normalized)
+	}
+
+	nDeleted := 0
+	err := rewriteArchive(
+		ctx,
+		archiveFile,
+		func(existing *ArchiveEntry) bool {
+			for _, d := range toDelete {
+				if existing.Name == d || strings.HasPrefix(existing.Name, d+"/") {
+					nDeleted++
+					return false
+				}
+			}
+			return true
+		},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	if nDeleted > 0 {
+		logging.LogChangedByCtxf(ctx, "Deleted '%d' entries from archive '%s'.", nDeleted, archiveFile)
+	} else {
+		logging.LogInfoByCtxf(ctx, "No entries to delete found in archive '%s'.", archiveFile)
+	}
+
+	return nil
+}
+
+// Writes all entries of 'archiveFile' for which 'keepFunc' returns true into a new archive of the same format.
+// Afterwards 'addFunc' can write additional entries. The result replaces the content of 'archiveFile'.
+//
+// The new archive is assembled in a local temporary file since reading and writing the same file at once is not possible.
+func rewriteArchive(ctx context.Context, archiveFile filesinterfaces.File, keepFunc func(entry *ArchiveEntry) bool, addFunc func(writer archiveWriter) error) error {
+	if archiveFile == nil {
+		return tracederrors.TracedErrorNil("archiveFile")
+	}
+
+	if keepFunc == nil {
+		return tracederrors.TracedErrorNil("keepFunc")
+	}
+
+	format, err := DetectFormat(ctx, archiveFile)
+	if err != nil {
+		return err
+	}
+
+	if !format.IsWriteable() {
+		return tracederrors.TracedErrorf("%w: unable to modify '%s' archive '%s'", ErrArchiveFormatReadOnly, format, archiveFile)
+	}
+
+	tempFile, err := os.CreateTemp("", "archive-rewrite-*."+format.String())
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to create temporary file to rewrite archive: %w", err)
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+
+	writer, err := newArchiveWriter(format, tempFile)
+	if err != nil {
+		return err
+	}
+
+	_, err = walkArchive(contextutils.WithSilent(ctx), archiveFile, func(entry *ArchiveEntry, content io.Reader) error {
+		if !keepFunc(entry) {
+			return nil
+		}
+
+		return writer.WriteEntry(entry, content)
+	})
+	if err != nil {
+		return err
+	}
+
+	if addFunc != nil {
+		err = addFunc(writer)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to seek to start of rewritten archive: %w", err)
+	}
+
+	writeCloser, err := archiveFile.OpenAsWriteCloser(ctx, &filesoptions.WriteOptions{})
+	if err != nil {
+		return err
+	}
+	defer writeCloser.Close()
+
+	_, err = io.Copy(writeCloser, tempFile)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to write rewritten archive to '%s': %w", archiveFile, err)
+	}
+
+	err = writeCloser.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close '%s': %w", archiveFile, err)
+	}
+
+	return nil
+}
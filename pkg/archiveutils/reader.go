@@ -0,0 +1,216 @@
+package archiveutils
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Is called for every entry in an archive.
+// For regular files 'content' provides the file content and is only valid until the function returns.
+type walkArchiveFunc func(entry *ArchiveEntry, content io.Reader) error
+
+// Calls 'walkFunc' for every entry in 'archiveFile' in the order they are stored in the archive.
+func walkArchive(ctx context.Context, archiveFile filesinterfaces.File, walkFunc walkArchiveFunc) (archiveoptions.ArchiveFormat, error) {
+	if archiveFile == nil {
+		return "", tracederrors.TracedErrorNil("archiveFile")
+	}
+
+	if walkFunc == nil {
+		return "", tracederrors.TracedErrorNil("walkFunc")
+	}
+
+	readCloser, err := archiveFile.OpenAsReadCloser(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer readCloser.Close()
+
+	format, reader, err := detectFormatFromReader(bufio.NewReader(readCloser))
+	if err != nil {
+		return "", err
+	}
+
+	if format == archiveoptions.FormatZip {
+		err = walkZipArchive(ctx, archiveFile, walkFunc)
+	} else {
+		err = walkTarArchive(format, reader, walkFunc)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return format, nil
+}
+
+func walkTarArchive(format archiveoptions.ArchiveFormat, reader io.Reader, walkFunc walkArchiveFunc) error {
+	tarStream, err := getTarStreamReader(format, reader)
+	if err != nil {
+		return err
+	}
+	defer tarStream.Close()
+
+	tarReader := tar.NewReader(tarStream)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return tracederrors.TracedErrorf("Failed to read next header of %s archive: %w", format, err)
+		}
+
+		entry := &ArchiveEntry{
+			Name:       normalizeEntryName(header.Name),
+			Size:       header.Size,
+			Mode:       os.FileMode(header.Mode).Perm(),
+			ModTime:    header.ModTime,
+			IsDir:      header.Typeflag == tar.TypeDir,
+			IsSymlink:  header.Typeflag == tar.TypeSymlink || header.Typeflag == tar.TypeLink,
+			LinkTarget: header.Linkname,
+		}
+
+		if entry.Name == "" {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeReg, tar.TypeDir, tar.TypeSymlink, tar.TypeLink:
+		default:
+			// Skip pax headers, devices, fifos and other special entries.
+			continue
+		}
+
+		err = walkFunc(entry, tarReader)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkZipArchive(ctx context.Context, archiveFile filesinterfaces.File, walkFunc walkArchiveFunc) error {
+	zipReader, closeFunc, err := openZipReader(ctx, archiveFile)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	for _, zipFile := range zipReader.File {
+		mode := zipFile.Mode()
+
+		entry := &ArchiveEntry{
+			Name:      normalizeEntryName(zipFile.Name),
+			Size:      int64(zipFile.UncompressedSize64),
+			Mode:      mode.Perm(),
+			ModTime:   zipFile.Modified,
+			IsDir:     mode.IsDir(),
+			IsSymlink: mode&os.ModeSymlink != 0,
+		}
+
+		if entry.Name == "" {
+			continue
+		}
+
+		err = func() error {
+			if entry.IsDir {
+				return walkFunc(entry, nil)
+			}
+
+			content, err := zipFile.Open()
+			if err != nil {
+				return tracederrors.TracedErrorf("Failed to open '%s' in zip archive: %w", zipFile.Name, err)
+			}
+			defer content.Close()
+
+			if entry.IsSymlink {
+				target, err := io.ReadAll(content)
+				if err != nil {
+					return tracederrors.TracedErrorf("Failed to read symlink target of '%s' in zip archive: %w", zipFile.Name, err)
+				}
+
+				entry.LinkTarget = string(target)
+
+				return walkFunc(entry, nil)
+			}
+
+			return walkFunc(entry, content)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Zip archives require random access. Local files are opened directly, remote files are read into memory.
+func openZipReader(ctx context.Context, archiveFile filesinterfaces.File) (*zip.Reader, func(), error) {
+	isLocalFile, err := archiveFile.IsLocalFile(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if isLocalFile {
+		localPath, err := archiveFile.GetLocalPath()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		osFile, err := os.Open(localPath)
+		if err != nil {
+			return nil, nil, tracederrors.TracedErrorf("Failed to open zip archive '%s': %w", localPath, err)
+		}
+
+		stat, err := osFile.Stat()
+		if err != nil {
+			osFile.Close()
+			return nil, nil, tracederrors.TracedErrorf("Failed to stat zip archive '%s': %w", localPath, err)
+		}
+
+		zipReader, err := zip.NewReader(osFile, stat.Size())
+		if err != nil {
+			osFile.Close()
+			return nil, nil, tracederrors.TracedErrorf("Failed to read zip archive '%s': %w", localPath, err)
+		}
+
+		return zipReader, func() { osFile.Close() }, nil
+	}
+
+	content, err := archiveFile.ReadAsBytes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, nil, tracederrors.TracedErrorf("Failed to read zip archive: %w", err)
+	}
+
+	return zipReader, func() {}, nil
+}
+
+func normalizeEntryName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.TrimPrefix(name, "./")
+	name = strings.TrimSuffix(name, "/")
+
+	if name == "" || name == "." {
+		return ""
+	}
+
+	return path.Clean(name)
+}
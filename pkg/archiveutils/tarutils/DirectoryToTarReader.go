@@ -0,0 +1,119 @@
+package tarutils
+
+import (
+	"archive/tar"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/tarutils/tarparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// DirectoryToTarReader returns a tar stream containing the given directory recursively.
+//
+// The archive is written by a goroutine while the returned reader is consumed, so the directory is never held in memory as a whole.
+// Errors while walking the directory are returned by Read.
+// The caller must close the returned reader, also if it is not read until the end.
+func DirectoryToTarReader(localDirPath string, options *tarparameteroptions.DirectoryToTarOptions) (io.ReadCloser, error) {
+	if localDirPath == "" {
+		return nil, tracederrors.TracedErrorEmptyString("localDirPath")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	dirInfo, err := os.Stat(localDirPath)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("failed to get directory info for '%s': %w", localDirPath, err)
+	}
+
+	if !dirInfo.IsDir() {
+		return nil, tracederrors.TracedErrorf("'%s' is not a directory", localDirPath)
+	}
+
+	pathPrefix := options.PathPrefix
+
+	pipeReader, pipeWriter := io.Pipe()
+
+	go func() {
+		pipeWriter.CloseWithError(writeDirectoryAsTar(pipeWriter, localDirPath, pathPrefix))
+	}()
+
+	return pipeReader, nil
+}
+
+func writeDirectoryAsTar(w io.Writer, localDirPath string, pathPrefix string) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.WalkDir(localDirPath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(localDirPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		name := path.Join(pathPrefix, filepath.ToSlash(relativePath))
+		if name == "." {
+			// The root of the archive itself has no entry.
+			return nil
+		}
+
+		fileInfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		linkTarget := ""
+		if fileInfo.Mode()&fs.ModeSymlink != 0 {
+			linkTarget, err = os.Readlink(filePath)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(fileInfo, linkTarget)
+		if err != nil {
+			return err
+		}
+
+		header.Name = name
+		if fileInfo.IsDir() {
+			header.Name += "/"
+		}
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !fileInfo.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to add directory '%s' to tar: %w", localDirPath, err)
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to close tar writer: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package tarutils_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/tarutils"
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/tarutils/tarparameteroptions"
+)
+
+func createTestDirectory(t *testing.T) string {
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "a.txt"), []byte("a"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "sub", "subsub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "sub", "subsub", "b.sh"), []byte("b"), 0755))
+	require.NoError(t, os.Symlink("a.txt", filepath.Join(srcDir, "link")))
+
+	return srcDir
+}
+
+func TestDirectoryToTarReader(t *testing.T) {
+	t.Run("without prefix", func(t *testing.T) {
+		tarReader, err := tarutils.DirectoryToTarReader(createTestDirectory(t), &tarparameteroptions.DirectoryToTarOptions{})
+		require.NoError(t, err)
+		defer tarReader.Close()
+
+		names := []string{}
+		tr := tar.NewReader(tarReader)
+		for {
+			header, err := tr.Next()
+			if err != nil {
+				break
+			}
+			names = append(names, header.Name)
+		}
+
+		require.EqualValues(t, []string{"a.txt", "link", "sub/", "sub/subsub/", "sub/subsub/b.sh"}, names)
+	})
+
+	t.Run("with prefix", func(t *testing.T) {
+		tarReader, err := tarutils.DirectoryToTarReader(createTestDirectory(t), &tarparameteroptions.DirectoryToTarOptions{PathPrefix: "dest"})
+		require.NoError(t, err)
+		defer tarReader.Close()
+
+		// The prefix directory itself is added as first entry:
+		tr := tar.NewReader(tarReader)
+		header, err := tr.Next()
+		require.NoError(t, err)
+		require.EqualValues(t, "dest/", header.Name)
+
+		header, err = tr.Next()
+		require.NoError(t, err)
+		require.EqualValues(t, "dest/a.txt", header.Name)
+	})
+
+	t.Run("close before end of stream", func(t *testing.T) {
+		tarReader, err := tarutils.DirectoryToTarReader(createTestDirectory(t), &tarparameteroptions.DirectoryToTarOptions{})
+		require.NoError(t, err)
+
+		// Closing the reader early stops the writing goroutine:
+		_, err = tar.NewReader(tarReader).Next()
+		require.NoError(t, err)
+		require.NoError(t, tarReader.Close())
+
+		_, err = tarReader.Read(make([]byte, 1))
+		require.ErrorIs(t, err, io.ErrClosedPipe)
+	})
+
+	t.Run("not a directory", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(filePath, []byte("x"), 0644))
+
+		_, err := tarutils.DirectoryToTarReader(filePath, &tarparameteroptions.DirectoryToTarOptions{})
+		require.Error(t, err)
+	})
+}
+
+func TestExtractTarReaderToDirectory(t *testing.T) {
+	t.Run("roundtrip", func(t *testing.T) {
+		tarReader, err := tarutils.DirectoryToTarReader(createTestDirectory(t), &tarparameteroptions.DirectoryToTarOptions{PathPrefix: "prefix"})
+		require.NoError(t, err)
+		defer tarReader.Close()
+
+		destDir := filepath.Join(t.TempDir(), "not", "existing")
+		err = tarutils.ExtractTarReaderToDirectory(getCtx(), tarReader, destDir, &tarparameteroptions.ExtractOptions{StripComponents: 1})
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(destDir, "a.txt"))
+		require.NoError(t, err)
+		require.EqualValues(t, "a", string(content))
+
+		fileInfo, err := os.Stat(filepath.Join(destDir, "sub", "subsub", "b.sh"))
+		require.NoError(t, err)
+		require.EqualValues(t, os.FileMode(0755), fileInfo.Mode().Perm())
+
+		// Symlinks are skipped:
+		_, err = os.Lstat(filepath.Join(destDir, "link"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("reject path traversal", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, tarutils.WriteFileContentBytesIntoWriter(&buf, "../evil.txt", []byte("evil")))
+
+		err := tarutils.ExtractTarReaderToDirectory(getCtx(), &buf, t.TempDir(), &tarparameteroptions.ExtractOptions{})
+		require.Error(t, err)
+	})
+
+	t.Run("malicious links do not write outside", func(t *testing.T) {
+		outsideDir := t.TempDir()
+		destDir := t.TempDir()
+
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: outsideDir}))
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "hardlink", Typeflag: tar.TypeLink, Linkname: filepath.Join(outsideDir, "target.txt")}))
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "link/evil.txt", Typeflag: tar.TypeReg, Mode: 0644, Size: 5}))
+		_, err := tw.Write([]byte("pwned"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+
+		err = tarutils.ExtractTarReaderToDirectory(getCtx(), &buf, destDir, &tarparameteroptions.ExtractOptions{})
+		require.NoError(t, err)
+
+		entries, err := os.ReadDir(outsideDir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		// The links are skipped so "link" is extracted as a regular directory:
+		fileInfo, err := os.Lstat(filepath.Join(destDir, "link"))
+		require.NoError(t, err)
+		require.True(t, fileInfo.IsDir())
+
+		_, err = os.Lstat(filepath.Join(destDir, "hardlink"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("refuse to write through existing symlink", func(t *testing.T) {
+		outsideDir := t.TempDir()
+		destDir := t.TempDir()
+		require.NoError(t, os.Symlink(outsideDir, filepath.Join(destDir, "existing")))
+
+		var buf bytes.Buffer
+		require.NoError(t, tarutils.WriteFileContentBytesIntoWriter(&buf, "existing/evil.txt", []byte("pwned")))
+
+		err := tarutils.ExtractTarReaderToDirectory(getCtx(), &buf, destDir, &tarparameteroptions.ExtractOptions{})
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(outsideDir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+}
+
+func TestExtractSingleFileFromTarReader(t *testing.T) {
+	t.Run("single file", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, tarutils.WriteFileContentBytesIntoWriter(&buf, "hello.txt", []byte("hello")))
+
+		destPath := filepath.Join(t.TempDir(), "sub", "out.txt")
+		require.NoError(t, tarutils.ExtractSingleFileFromTarReader(getCtx(), &buf, destPath))
+
+		content, err := os.ReadFile(destPath)
+		require.NoError(t, err)
+		require.EqualValues(t, "hello", string(content))
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		tarReader, err := tarutils.DirectoryToTarReader(createTestDirectory(t), &tarparameteroptions.DirectoryToTarOptions{PathPrefix: "dir"})
+		require.NoError(t, err)
+		defer tarReader.Close()
+
+		err = tarutils.ExtractSingleFileFromTarReader(getCtx(), tarReader, filepath.Join(t.TempDir(), "out"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,202 @@
+package tarutils
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/tarutils/tarparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Returns the entry name with the leading path components removed or an empty string if nothing is left.
+func stripComponents(name string, stripComponents int) string {
+	parts := strings.Split(strings.Trim(filepath.ToSlash(name), "/"), "/")
+	if len(parts) <= stripComponents {
+		return ""
+	}
+
+	return filepath.Join(parts[stripComponents:]...)
+}
+
+// ExtractTarReaderToDirectory extracts all directories and regular files of the tar stream into destDir.
+// destDir is created if it does not exist. Entries pointing outside of destDir are rejected.
+//
+// Symlinks and hardlinks are skipped since their targets are controlled by the archive and could be used to write outside of destDir.
+// For the same reason nothing is written through symlinks already existing in destDir.
+func ExtractTarReaderToDirectory(ctx context.Context, reader io.Reader, destDir string, options *tarparameteroptions.ExtractOptions) error {
+	if reader == nil {
+		return tracederrors.TracedErrorNil("reader")
+	}
+
+	if destDir == "" {
+		return tracederrors.TracedErrorEmptyString("destDir")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	destDir, err := filepath.Abs(destDir)
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to get absolute path of '%s': %w", destDir, err)
+	}
+
+	err = os.MkdirAll(destDir, 0755)
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to create destination directory '%s': %w", destDir, err)
+	}
+
+	tr := tar.NewReader(reader)
+	nExtracted := 0
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return tracederrors.TracedErrorf("failed to read tar stream: %w", err)
+		}
+
+		name := stripComponents(header.Name, options.StripComponents)
+		if name == "" {
+			continue
+		}
+
+		targetPath := filepath.Join(destDir, name)
+		if targetPath != destDir && !strings.HasPrefix(targetPath, destDir+string(os.PathSeparator)) {
+			return tracederrors.TracedErrorf("tar entry '%s' points outside of destination directory '%s'", header.Name, destDir)
+		}
+
+		if header.Typeflag == tar.TypeSymlink || header.Typeflag == tar.TypeLink {
+			logging.LogWarnByCtxf(ctx, "Skip extraction of link '%s' -> '%s'.", header.Name, header.Linkname)
+			continue
+		}
+
+		err = checkNoSymlinkInPath(destDir, targetPath)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(targetPath, header.FileInfo().Mode().Perm())
+			if err != nil {
+				return tracederrors.TracedErrorf("failed to create directory '%s': %w", targetPath, err)
+			}
+		case tar.TypeReg:
+			err = writeTarEntryToFile(tr, targetPath, header)
+			if err != nil {
+				return err
+			}
+		default:
+			logging.LogInfoByCtxf(ctx, "Skip unsupported tar entry '%s' of type '%c'.", header.Name, header.Typeflag)
+			continue
+		}
+
+		nExtracted++
+	}
+
+	logging.LogInfoByCtxf(ctx, "Extracted '%d' entries from tar stream into '%s'.", nExtracted, destDir)
+
+	return nil
+}
+
+// Returns an error if any existing path component below destDir up to and including targetPath is a symlink.
+// Writing through such a symlink could modify files outside of destDir.
+func checkNoSymlinkInPath(destDir string, targetPath string) error {
+	relativePath, err := filepath.Rel(destDir, targetPath)
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to get path of '%s' relative to '%s': %w", targetPath, destDir, err)
+	}
+
+	if relativePath == "." {
+		return nil
+	}
+
+	currentPath := destDir
+	for _, part := range strings.Split(relativePath, string(os.PathSeparator)) {
+		currentPath = filepath.Join(currentPath, part)
+
+		fileInfo, err := os.Lstat(currentPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Nothing below a not existing path can be a symlink:
+				return nil
+			}
+
+			return tracederrors.TracedErrorf("failed to lstat '%s': %w", currentPath, err)
+		}
+
+		if fileInfo.Mode()&os.ModeSymlink != 0 {
+			return tracederrors.TracedErrorf("refuse to extract '%s' since '%s' is a symlink", targetPath, currentPath)
+		}
+	}
+
+	return nil
+}
+
+// ExtractSingleFileFromTarReader writes the content of the only regular file in the tar stream to destPath.
+// Returns an error if the stream contains no or more than one entry.
+func ExtractSingleFileFromTarReader(ctx context.Context, reader io.Reader, destPath string) error {
+	if reader == nil {
+		return tracederrors.TracedErrorNil("reader")
+	}
+
+	if destPath == "" {
+		return tracederrors.TracedErrorEmptyString("destPath")
+	}
+
+	tr := tar.NewReader(reader)
+
+	header, err := tr.Next()
+	if errors.Is(err, io.EOF) {
+		return tracederrors.TracedError("tar stream is empty")
+	}
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to read tar stream: %w", err)
+	}
+
+	if header.Typeflag != tar.TypeReg {
+		return tracederrors.TracedErrorf("tar entry '%s' is not a regular file", header.Name)
+	}
+
+	err = writeTarEntryToFile(tr, destPath, header)
+	if err != nil {
+		return err
+	}
+
+	_, err = tr.Next()
+	if !errors.Is(err, io.EOF) {
+		return tracederrors.TracedErrorf("tar stream contains more than the file '%s'", header.Name)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Extracted '%s' from tar stream as '%s'.", header.Name, destPath)
+
+	return nil
+}
+
+func writeTarEntryToFile(tr *tar.Reader, destPath string, header *tar.Header) error {
+	err := os.MkdirAll(filepath.Dir(destPath), 0755)
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to create directory '%s': %w", filepath.Dir(destPath), err)
+	}
+
+	file, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, header.FileInfo().Mode().Perm())
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to create file '%s': %w", destPath, err)
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, tr)
+	if err != nil {
+		return tracederrors.TracedErrorf("failed to write file '%s': %w", destPath, err)
+	}
+
+	return nil
+}
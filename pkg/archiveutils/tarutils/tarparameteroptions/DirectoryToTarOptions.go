@@ -0,0 +1,7 @@
+package tarparameteroptions
+
+type DirectoryToTarOptions struct {
+	// If set all entries are stored below this directory name inside the archive.
+	// Otherwise the content of the directory is stored at the root of the archive.
+	PathPrefix string
+}
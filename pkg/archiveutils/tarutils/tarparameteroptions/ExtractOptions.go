@@ -0,0 +1,6 @@
+package tarparameteroptions
+
+type ExtractOptions struct {
+	// Number of leading path components to remove from the entry names like 'tar --strip-components'.
+	StripComponents int
+}
@@ -0,0 +1,159 @@
+package archiveutils
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/archiveoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// archiveWriter hides the differences between writing tar and zip archives.
+type archiveWriter interface {
+	WriteEntry(entry *ArchiveEntry, content io.Reader) error
+	Close() error
+}
+
+func newArchiveWriter(format archiveoptions.ArchiveFormat, writer io.Writer) (archiveWriter, error) {
+	if writer == nil {
+		return nil, tracederrors.TracedErrorNil("writer")
+	}
+
+	if !format.IsWriteable() {
+		return nil, tracederrors.TracedErrorf("%w: '%s'", ErrArchiveFormatReadOnly, format)
+	}
+
+	if format == archiveoptions.FormatZip {
+		return &zipArchiveWriter{zipWriter: zip.NewWriter(writer)}, nil
+	}
+
+	compressor, err := getTarStreamWriter(format, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tarArchiveWriter{
+		compressor: compressor,
+		tarWriter:  tar.NewWriter(compressor),
+	}, nil
+}
+
+type tarArchiveWriter struct {
+	compressor io.WriteCloser
+	tarWriter  *tar.Writer
+}
+
+func (t *tarArchiveWriter) WriteEntry(entry *ArchiveEntry, content io.Reader) error {
+	if entry == nil {
+		return tracederrors.TracedErrorNil("entry")
+	}
+
+	header := &tar.Header{
+		Name:    entry.Name,
+		Mode:    int64(entry.Mode.Perm()),
+		ModTime: entry.ModTime,
+	}
+
+	switch {
+	case entry.IsDir:
+		header.Typeflag = tar.TypeDir
+		header.Name += "/"
+	case entry.IsSymlink:
+		header.Typeflag = tar.TypeSymlink
+		header.Linkname = entry.LinkTarget
+	default:
+		header.Typeflag = tar.TypeReg
+		header.Size = entry.Size
+	}
+
+	err := t.tarWriter.WriteHeader(header)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to write tar header for '%s': %w", entry.Name, err)
+	}
+
+	if entry.IsRegularFile() {
+		if content == nil {
+			return tracederrors.TracedErrorNil("content")
+		}
+
+		_, err = io.Copy(t.tarWriter, content)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write '%s' into tar archive: %w", entry.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (t *tarArchiveWriter) Close() error {
+	err := t.tarWriter.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close tar writer: %w", err)
+	}
+
+	err = t.compressor.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close compressor: %w", err)
+	}
+
+	return nil
+}
+
+type zipArchiveWriter struct {
+	zipWriter *zip.Writer
+}
+
+func (z *zipArchiveWriter) WriteEntry(entry *ArchiveEntry, content io.Reader) error {
+	if entry == nil {
+		return tracederrors.TracedErrorNil("entry")
+	}
+
+	header := &zip.FileHeader{
+		Name:     entry.Name,
+		Method:   zip.Deflate,
+		Modified: entry.ModTime,
+	}
+
+	switch {
+	case entry.IsDir:
+		header.Name += "/"
+		header.Method = zip.Store
+		header.SetMode(os.ModeDir | entry.Mode.Perm())
+	case entry.IsSymlink:
+		header.SetMode(os.ModeSymlink | entry.Mode.Perm())
+	default:
+		header.SetMode(entry.Mode.Perm())
+	}
+
+	writer, err := z.zipWriter.CreateHeader(header)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to write zip header for '%s': %w", entry.Name, err)
+	}
+
+	switch {
+	case entry.IsSymlink:
+		_, err = writer.Write([]byte(entry.LinkTarget))
+	case entry.IsRegularFile():
+		if content == nil {
+			return tracederrors.TracedErrorNil("content")
+		}
+
+		_, err = io.Copy(writer, content)
+	}
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to write '%s' into zip archive: %w", entry.Name, err)
+	}
+
+	return nil
+}
+
+func (z *zipArchiveWriter) Close() error {
+	err := z.zipWriter.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close zip writer: %w", err)
+	}
+
+	return nil
+}
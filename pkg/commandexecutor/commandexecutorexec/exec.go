@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorgeneric"
@@ -298,6 +299,7 @@ func RunCommandAndGetStdoutAsIoReadCloser(ctx context.Context, options *paramete
 
 	var waitOnce sync.Once
 	var cmdErr error
+	var eofReached atomic.Bool
 
 	waitForCmd := func() error {
 		waitOnce.Do(func() {
@@ -308,6 +310,14 @@ func RunCommandAndGetStdoutAsIoReadCloser(ctx context.Context, options *paramete
 
 	ret := &ioutils.ReadCloser{
 		CloseFunc: func() error {
+			if options.KillOnStdoutClose && !eofReached.Load() {
+				// Nobody reads the output anymore. Stop the command as it might run forever, e.g. when following logs.
+				err := cmd.Process.Kill()
+				if err != nil && !errors.Is(err, os.ErrProcessDone) {
+					return tracederrors.TracedErrorf("Failed to kill command '%s' on close: %w", fullCommandJoined, err)
+				}
+			}
+
 			// Ensure Wait is called on close (if not already via EOF).
 			waitForCmd()
 			return nil
@@ -315,6 +325,8 @@ func RunCommandAndGetStdoutAsIoReadCloser(ctx context.Context, options *paramete
 		ReadFunc: func(p []byte) (n int, err error) {
 			n, err = stdout.Read(p)
 			if err == io.EOF {
+				eofReached.Store(true)
+
 				// All reads are done, NOW it's safe to call Wait.
 				cmdErr := waitForCmd()
 				if cmdErr != nil {
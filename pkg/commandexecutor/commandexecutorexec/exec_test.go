@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -224,6 +225,89 @@ func Test_RunCommandAndGetStdoutAsIoReadCloser_failingCommand(t *testing.T) {
 	}
 }
 
+func Test_RunCommandAndGetStdoutAsIoReadCloser_close(t *testing.T) {
+	t.Run("close after EOF reports exit status", func(t *testing.T) {
+		ctx := getCtx()
+
+		readCloser, err := commandexecutorexec.RunCommandAndGetStdoutAsIoReadCloser(
+			ctx,
+			&parameteroptions.RunCommandOptions{
+				Command: []string{"bash", "-c", "echo partial output && exit 3"},
+			},
+		)
+		require.NoError(t, err)
+
+		got, err := io.ReadAll(readCloser)
+		require.ErrorContains(t, err, "exit status 3")
+		require.EqualValues(t, "partial output\n", string(got))
+
+		require.NoError(t, readCloser.Close())
+	})
+
+	t.Run("close before EOF waits for the command by default", func(t *testing.T) {
+		ctx := getCtx()
+		markerPath := filepath.Join(t.TempDir(), "marker")
+
+		readCloser, err := commandexecutorexec.RunCommandAndGetStdoutAsIoReadCloser(
+			ctx,
+			&parameteroptions.RunCommandOptions{
+				Command: []string{"bash", "-c", "sleep 1 && touch " + markerPath},
+			},
+		)
+		require.NoError(t, err)
+		require.NoError(t, readCloser.Close())
+
+		// The command was not killed but finished:
+		require.FileExists(t, markerPath)
+	})
+
+	t.Run("close before EOF kills the command if KillOnStdoutClose is set", func(t *testing.T) {
+		tests := []struct {
+			command   []string
+			readBytes int
+		}{
+			{[]string{"sleep", "60"}, 0},
+			{[]string{"yes"}, 16},
+		}
+
+		for _, tt := range tests {
+			t.Run(
+				testutils.MustFormatAsTestname(tt),
+				func(t *testing.T) {
+					ctx := getCtx()
+
+					readCloser, err := commandexecutorexec.RunCommandAndGetStdoutAsIoReadCloser(
+						ctx,
+						&parameteroptions.RunCommandOptions{
+							Command:           tt.command,
+							KillOnStdoutClose: true,
+						},
+					)
+					require.NoError(t, err)
+
+					if tt.readBytes > 0 {
+						buf := make([]byte, tt.readBytes)
+						_, err = io.ReadFull(readCloser, buf)
+						require.NoError(t, err)
+					}
+
+					closed := make(chan error, 1)
+					go func() {
+						closed <- readCloser.Close()
+					}()
+
+					select {
+					case err := <-closed:
+						require.NoError(t, err)
+					case <-time.After(10 * time.Second):
+						require.Fail(t, "Close did not return. The command was not killed.")
+					}
+				},
+			)
+		}
+	})
+}
+
 func Test_RunCommandAndGetStdinAsIoWriteCloser(t *testing.T) {
 	tests := []struct {
 		input string
@@ -0,0 +1,45 @@
+package compressionutils_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+)
+
+// ExampleCompress demonstrates how to compress and decompress streamed data.
+//
+// The compression format is detected automatically on decompression.
+func ExampleCompress() {
+	ctx := contextutils.ContextSilent()
+
+	var compressed bytes.Buffer
+	_, err := compressionutils.Compress(ctx, compressionoptions.FormatZstd, strings.NewReader("hello world\n"), &compressed)
+	if err != nil {
+		fmt.Printf("Failed to compress: %v\n", err)
+		return
+	}
+
+	format, err := compressionutils.DetectFormatFromBytes(compressed.Bytes())
+	if err != nil {
+		fmt.Printf("Failed to detect format: %v\n", err)
+		return
+	}
+	fmt.Println(format)
+
+	var decompressed bytes.Buffer
+	_, err = compressionutils.Decompress(ctx, &compressed, &decompressed)
+	if err != nil {
+		fmt.Printf("Failed to decompress: %v\n", err)
+		return
+	}
+
+	fmt.Print(decompressed.String())
+
+	// Output:
+	// zstd
+	// hello world
+}
@@ -0,0 +1,78 @@
+package compressionoptions
+
+import (
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CompressionFormat string
+
+const (
+	FormatGzip CompressionFormat = "gzip"
+	FormatXz   CompressionFormat = "xz"
+	FormatZstd CompressionFormat = "zstd"
+
+	// bzip2 can only be decompressed since the go standard library does not provide a bzip2 compressor.
+	FormatBzip2 CompressionFormat = "bzip2"
+)
+
+// Returns all supported compression formats.
+func GetCompressionFormats() []CompressionFormat {
+	return []CompressionFormat{FormatGzip, FormatXz, FormatZstd, FormatBzip2}
+}
+
+// Get the compression format based on the file name extension, e.g. "syslog.gz" returns FormatGzip.
+func GetCompressionFormatFromFileName(fileName string) (CompressionFormat, error) {
+	if fileName == "" {
+		return "", tracederrors.TracedErrorEmptyString("fileName")
+	}
+
+	lower := strings.ToLower(fileName)
+
+	suffixes := []struct {
+		suffix string
+		format CompressionFormat
+	}{
+		{".gz", FormatGzip},
+		{".tgz", FormatGzip},
+		{".xz", FormatXz},
+		{".txz", FormatXz},
+		{".zst", FormatZstd},
+		{".tzst", FormatZstd},
+		{".bz2", FormatBzip2},
+		{".tbz2", FormatBzip2},
+	}
+
+	for _, s := range suffixes {
+		if strings.HasSuffix(lower, s.suffix) {
+			return s.format, nil
+		}
+	}
+
+	return "", tracederrors.TracedErrorf("Unable to get compression format from file name '%s'", fileName)
+}
+
+// Returns the usual file name extension including the leading dot, e.g. ".gz" for FormatGzip.
+func (c CompressionFormat) GetFileExtension() (string, error) {
+	switch c {
+	case FormatGzip:
+		return ".gz", nil
+	case FormatXz:
+		return ".xz", nil
+	case FormatZstd:
+		return ".zst", nil
+	case FormatBzip2:
+		return ".bz2", nil
+	}
+
+	return "", tracederrors.TracedErrorf("Unknown compression format '%s'", c)
+}
+
+func (c CompressionFormat) IsCompressionSupported() bool {
+	return c != FormatBzip2
+}
+
+func (c CompressionFormat) String() string {
+	return string(c)
+}
@@ -0,0 +1,61 @@
+package compressionutils
+
+import (
+	"context"
+	"io"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Compress reads all data from 'src' and writes it 'format' compressed to 'dst'.
+// The data is streamed, so it is not loaded into memory at once.
+func Compress(ctx context.Context, format compressionoptions.CompressionFormat, src io.Reader, dst io.Writer) (int64, error) {
+	if src == nil {
+		return 0, tracederrors.TracedErrorNil("src")
+	}
+
+	compressWriter, err := NewCompressWriter(format, dst)
+	if err != nil {
+		return 0, err
+	}
+	defer compressWriter.Close()
+
+	written, err := io.Copy(compressWriter, src)
+	if err != nil {
+		return 0, tracederrors.TracedErrorf("Failed to compress using %s: %w", format, err)
+	}
+
+	err = compressWriter.Close()
+	if err != nil {
+		return 0, tracederrors.TracedErrorf("Failed to finalize %s compression: %w", format, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Compressed '%d' bytes using %s.", written, format)
+
+	return written, nil
+}
+
+// Decompress detects the compression format of 'src' and writes the decompressed data to 'dst'.
+// The data is streamed, so it is not loaded into memory at once.
+func Decompress(ctx context.Context, src io.Reader, dst io.Writer) (int64, error) {
+	if dst == nil {
+		return 0, tracederrors.TracedErrorNil("dst")
+	}
+
+	decompressReader, format, err := NewAutoDecompressReader(src)
+	if err != nil {
+		return 0, err
+	}
+	defer decompressReader.Close()
+
+	written, err := io.Copy(dst, decompressReader)
+	if err != nil {
+		return 0, tracederrors.TracedErrorf("Failed to decompress %s data: %w", format, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Decompressed '%d' bytes of %s data.", written, format)
+
+	return written, nil
+}
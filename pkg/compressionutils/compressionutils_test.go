@@ -0,0 +1,93 @@
+package compressionutils_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/stretchr/testify/require"
+)
+
+func getCtx() context.Context {
+	return contextutils.ContextVerbose()
+}
+
+func Test_CompressAndDecompress(t *testing.T) {
+	content := strings.Repeat("log line\n", 10000)
+
+	for _, format := range compressionoptions.GetCompressionFormats() {
+		t.Run(format.String(), func(t *testing.T) {
+			ctx := getCtx()
+
+			var compressed bytes.Buffer
+			_, err := compressionutils.Compress(ctx, format, strings.NewReader(content), &compressed)
+			if !format.IsCompressionSupported() {
+				require.ErrorIs(t, err, compressionutils.ErrCompressionNotSupported)
+				return
+			}
+			require.NoError(t, err)
+			require.Less(t, compressed.Len(), len(content))
+
+			detected, err := compressionutils.DetectFormatFromBytes(compressed.Bytes())
+			require.NoError(t, err)
+			require.EqualValues(t, format, detected)
+
+			var decompressed bytes.Buffer
+			written, err := compressionutils.Decompress(ctx, &compressed, &decompressed)
+			require.NoError(t, err)
+			require.EqualValues(t, len(content), written)
+			require.EqualValues(t, content, decompressed.String())
+		})
+	}
+}
+
+func Test_DecompressBzip2(t *testing.T) {
+	// "hello\n" compressed using `bzip2`:
+	compressed := []byte{
+		0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xc1, 0xc0, 0x80, 0xe2, 0x00, 0x00,
+		0x01, 0x41, 0x00, 0x00, 0x10, 0x02, 0x44, 0xa0, 0x00, 0x30, 0xcd, 0x00, 0xc3, 0x46, 0x29, 0x97,
+		0x17, 0x72, 0x45, 0x38, 0x50, 0x90, 0xc1, 0xc0, 0x80, 0xe2,
+	}
+
+	var decompressed bytes.Buffer
+	_, err := compressionutils.Decompress(getCtx(), bytes.NewReader(compressed), &decompressed)
+	require.NoError(t, err)
+	require.EqualValues(t, "hello\n", decompressed.String())
+}
+
+func Test_DetectFormatFromBytes(t *testing.T) {
+	t.Run("uncompressed", func(t *testing.T) {
+		_, err := compressionutils.DetectFormatFromBytes([]byte("plain text"))
+		require.ErrorIs(t, err, compressionutils.ErrUnknownCompressionFormat)
+		require.False(t, compressionutils.IsCompressed([]byte("plain text")))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := compressionutils.DetectFormatFromBytes([]byte{})
+		require.ErrorIs(t, err, compressionutils.ErrUnknownCompressionFormat)
+	})
+}
+
+func Test_GetCompressionFormatFromFileName(t *testing.T) {
+	tests := []struct {
+		fileName string
+		expected compressionoptions.CompressionFormat
+	}{
+		{"syslog.1.gz", compressionoptions.FormatGzip},
+		{"image.raw.xz", compressionoptions.FormatXz},
+		{"backup.tar.zst", compressionoptions.FormatZstd},
+		{"old.tar.bz2", compressionoptions.FormatBzip2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			format, err := compressionoptions.GetCompressionFormatFromFileName(tt.fileName)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expected, format)
+		})
+	}
+}
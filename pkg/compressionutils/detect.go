@@ -0,0 +1,71 @@
+package compressionutils
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Number of bytes needed to detect all supported compression formats.
+const NBytesToDetectFormat = 6
+
+var magicGzip = []byte{0x1f, 0x8b}
+var magicXz = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+var magicZstd = []byte{0x28, 0xb5, 0x2f, 0xfd}
+var magicBzip2 = []byte{'B', 'Z', 'h'}
+
+// DetectFormatFromBytes detects the compression format using the magic bytes at the beginning of 'header'.
+//
+// An error wrapping ErrUnknownCompressionFormat is returned for uncompressed data.
+func DetectFormatFromBytes(header []byte) (compressionoptions.CompressionFormat, error) {
+	if header == nil {
+		return "", tracederrors.TracedErrorNil("header")
+	}
+
+	switch {
+	case bytes.HasPrefix(header, magicGzip):
+		return compressionoptions.FormatGzip, nil
+	case bytes.HasPrefix(header, magicXz):
+		return compressionoptions.FormatXz, nil
+	case bytes.HasPrefix(header, magicZstd):
+		return compressionoptions.FormatZstd, nil
+	case bytes.HasPrefix(header, magicBzip2):
+		return compressionoptions.FormatBzip2, nil
+	}
+
+	return "", tracederrors.TracedErrorf("%w: unable to detect compression format from magic bytes", ErrUnknownCompressionFormat)
+}
+
+// IsCompressed returns true if 'header' starts with the magic bytes of a supported compression format.
+func IsCompressed(header []byte) bool {
+	_, err := DetectFormatFromBytes(header)
+	return err == nil
+}
+
+// DetectFormatFromReader detects the compression format of the stream provided by 'reader'.
+//
+// Since the magic bytes are consumed from 'reader' the returned reader must be used to read the whole stream afterwards.
+func DetectFormatFromReader(reader io.Reader) (compressionoptions.CompressionFormat, io.Reader, error) {
+	if reader == nil {
+		return "", nil, tracederrors.TracedErrorNil("reader")
+	}
+
+	bufferedReader := bufio.NewReader(reader)
+
+	// Peek returns less bytes together with an error for short streams which is fine to detect the format.
+	header, err := bufferedReader.Peek(NBytesToDetectFormat)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", nil, tracederrors.TracedErrorf("Failed to read magic bytes: %w", err)
+	}
+
+	format, err := DetectFormatFromBytes(header)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return format, bufferedReader, nil
+}
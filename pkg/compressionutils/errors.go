@@ -0,0 +1,6 @@
+package compressionutils
+
+import "errors"
+
+var ErrUnknownCompressionFormat = errors.New("unknown compression format")
+var ErrCompressionNotSupported = errors.New("compression not supported")
@@ -0,0 +1,91 @@
+package compressionutils
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// NewCompressWriter returns a writer compressing all data written to it into 'writer' using 'format'.
+//
+// Close must be called to flush the remaining compressed data. Closing does not close 'writer'.
+func NewCompressWriter(format compressionoptions.CompressionFormat, writer io.Writer) (io.WriteCloser, error) {
+	if writer == nil {
+		return nil, tracederrors.TracedErrorNil("writer")
+	}
+
+	switch format {
+	case compressionoptions.FormatGzip:
+		return gzip.NewWriter(writer), nil
+	case compressionoptions.FormatXz:
+		xzWriter, err := xz.NewWriter(writer)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to create xz writer: %w", err)
+		}
+		return xzWriter, nil
+	case compressionoptions.FormatZstd:
+		zstdWriter, err := zstd.NewWriter(writer)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to create zstd writer: %w", err)
+		}
+		return zstdWriter, nil
+	case compressionoptions.FormatBzip2:
+		return nil, tracederrors.TracedErrorf("%w: '%s' can only be decompressed", ErrCompressionNotSupported, format)
+	}
+
+	return nil, tracederrors.TracedErrorf("%w: '%s'", ErrUnknownCompressionFormat, format)
+}
+
+// NewDecompressReader returns a reader providing the decompressed data of the 'format' compressed stream in 'reader'.
+//
+// Closing the returned reader does not close 'reader'.
+func NewDecompressReader(format compressionoptions.CompressionFormat, reader io.Reader) (io.ReadCloser, error) {
+	if reader == nil {
+		return nil, tracederrors.TracedErrorNil("reader")
+	}
+
+	switch format {
+	case compressionoptions.FormatGzip:
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to create gzip reader: %w", err)
+		}
+		return gzipReader, nil
+	case compressionoptions.FormatXz:
+		xzReader, err := xz.NewReader(reader)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to create xz reader: %w", err)
+		}
+		return io.NopCloser(xzReader), nil
+	case compressionoptions.FormatZstd:
+		zstdReader, err := zstd.NewReader(reader)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to create zstd reader: %w", err)
+		}
+		return zstdReader.IOReadCloser(), nil
+	case compressionoptions.FormatBzip2:
+		return io.NopCloser(bzip2.NewReader(reader)), nil
+	}
+
+	return nil, tracederrors.TracedErrorf("%w: '%s'", ErrUnknownCompressionFormat, format)
+}
+
+// NewAutoDecompressReader detects the compression format of 'reader' and returns a reader providing the decompressed data.
+func NewAutoDecompressReader(reader io.Reader) (io.ReadCloser, compressionoptions.CompressionFormat, error) {
+	format, reader, err := DetectFormatFromReader(reader)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret, err := NewDecompressReader(format, reader)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return ret, format, nil
+}
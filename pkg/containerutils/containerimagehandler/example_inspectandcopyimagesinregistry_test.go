@@ -0,0 +1,57 @@
+package containerimagehandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerimagehandler"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+)
+
+// This example lists the tags of a repository, inspects an image and copies it to another registry and into an OCI layout directory.
+//
+// An in-process registry is used as local stand-in for a real registry.
+func Test_InspectAndCopyImagesInRegistry(t *testing.T) {
+	// enable verbose output:
+	ctx := contextutils.ContextVerbose()
+
+	// -----
+	// Prepare test environment start ...
+	sourceRegistry := startTestRegistry(t)
+	destinationRegistry := startTestRegistry(t)
+	pushRandomImage(t, sourceRegistry+"/example/app:1.0.0", "amd64", map[string]string{"org.opencontainers.image.version": "1.0.0"})
+	// ... prepare test environment finished.
+	// -----
+
+	options := &containeroptions.RegistryOptions{}
+
+	// List the available tags:
+	tags, err := containerimagehandler.ListTags(ctx, sourceRegistry+"/example/app", options)
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"1.0.0"}, tags)
+
+	// Inspect labels, platform and layers of the image:
+	info, err := containerimagehandler.InspectImage(ctx, sourceRegistry+"/example/app:1.0.0", options)
+	require.NoError(t, err)
+	require.EqualValues(t, "1.0.0", info.Labels["org.opencontainers.image.version"])
+	require.EqualValues(t, "linux/amd64", info.GetPlatform())
+	require.Len(t, info.Layers, 2)
+
+	// Copy the image to another registry:
+	err = containerimagehandler.CopyImage(ctx, sourceRegistry+"/example/app:1.0.0", destinationRegistry+"/mirror/app:1.0.0", options)
+	require.NoError(t, err)
+
+	// Copy the image into an OCI layout directory, e.g. to transfer it into an air gapped environment:
+	layoutPath := t.TempDir()
+	err = containerimagehandler.CopyImageToOciLayout(ctx, sourceRegistry+"/example/app:1.0.0", layoutPath, "1.0.0", options)
+	require.NoError(t, err)
+
+	// ... and push it from there again:
+	err = containerimagehandler.CopyImageFromOciLayout(ctx, layoutPath, "1.0.0", destinationRegistry+"/airgapped/app:1.0.0", options)
+	require.NoError(t, err)
+
+	// Delete the tag in the source registry:
+	err = containerimagehandler.DeleteTag(ctx, sourceRegistry+"/example/app:1.0.0", options)
+	require.NoError(t, err)
+}
@@ -0,0 +1,70 @@
+package containerimagehandler
+
+import (
+	"time"
+)
+
+// Describes a single layer of a container image.
+type ImageLayerInfo struct {
+	Digest    string
+	MediaType string
+	Size      int64
+}
+
+// Manifest and config information of a container image as stored in a registry.
+type ImageInfo struct {
+	// The reference used to inspect the image:
+	Reference string
+
+	// Digest and media type of the image manifest:
+	Digest    string
+	MediaType string
+
+	// Digest of the image config blob:
+	ConfigDigest string
+
+	Architecture string
+	OS           string
+	Variant      string
+	Created      time.Time
+	Labels       map[string]string
+
+	Layers []*ImageLayerInfo
+}
+
+// Returns the sum of all (compressed) layer sizes.
+func (i *ImageInfo) GetLayersSize() int64 {
+	var size int64
+	for _, layer := range i.Layers {
+		size += layer.Size
+	}
+
+	return size
+}
+
+func (i *ImageInfo) GetLayerDigests() []string {
+	digests := []string{}
+	for _, layer := range i.Layers {
+		digests = append(digests, layer.Digest)
+	}
+
+	return digests
+}
+
+// Returns the platform as "os/architecture[/variant]".
+func (i *ImageInfo) GetPlatform() string {
+	platform := i.OS + "/" + i.Architecture
+	if i.Variant != "" {
+		platform += "/" + i.Variant
+	}
+
+	return platform
+}
+
+// Describes one platform specific image manifest referenced by a multi-arch image index.
+type ImagePlatformManifest struct {
+	Platform  string
+	Digest    string
+	MediaType string
+	Size      int64
+}
@@ -0,0 +1,231 @@
+package containerimagehandler
+
+import (
+	"context"
+	"os"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/match"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Annotation used in the index.json of an OCI layout directory to name the stored images.
+const OciLayoutRefNameAnnotation = "org.opencontainers.image.ref.name"
+
+// Opens the OCI layout directory at layoutPath or initializes an empty one if it does not exist yet.
+func getOrCreateOciLayout(ctx context.Context, layoutPath string) (layout.Path, error) {
+	_, err := os.Stat(layoutPath + "/index.json")
+	if err == nil {
+		ociLayout, err := layout.FromPath(layoutPath)
+		if err != nil {
+			return "", tracederrors.TracedErrorf("Failed to open OCI layout '%s': %w", layoutPath, err)
+		}
+
+		return ociLayout, nil
+	}
+
+	ociLayout, err := layout.Write(layoutPath, empty.Index)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to create OCI layout '%s': %w", layoutPath, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Created OCI layout '%s'.", layoutPath)
+
+	return ociLayout, nil
+}
+
+// Lists the ref names (org.opencontainers.image.ref.name annotations) of all images stored in the OCI layout directory.
+func ListOciLayoutRefNames(ctx context.Context, layoutPath string) ([]string, error) {
+	if layoutPath == "" {
+		return nil, tracederrors.TracedErrorEmptyString("layoutPath")
+	}
+
+	indexManifest, err := getOciLayoutIndexManifest(layoutPath)
+	if err != nil {
+		return nil, err
+	}
+
+	refNames := []string{}
+	for _, manifest := range indexManifest.Manifests {
+		refName := manifest.Annotations[OciLayoutRefNameAnnotation]
+		if refName != "" {
+			refNames = append(refNames, refName)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' ref names in OCI layout '%s'.", len(refNames), layoutPath)
+
+	return refNames, nil
+}
+
+func getOciLayoutIndexManifest(layoutPath string) (*v1.IndexManifest, error) {
+	index, err := layout.ImageIndexFromPath(layoutPath)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to read OCI layout '%s': %w", layoutPath, err)
+	}
+
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to read index manifest of OCI layout '%s': %w", layoutPath, err)
+	}
+
+	return indexManifest, nil
+}
+
+// Copies an image from a registry into the OCI layout directory at layoutPath.
+// The image is stored under refName; an image already stored under the same refName is replaced.
+// Multi-arch image indexes are copied including all referenced platform images.
+func CopyImageToOciLayout(ctx context.Context, sourceReference string, layoutPath string, refName string, options *containeroptions.RegistryOptions) error {
+	if layoutPath == "" {
+		return tracederrors.TracedErrorEmptyString("layoutPath")
+	}
+
+	if refName == "" {
+		return tracederrors.TracedErrorEmptyString("refName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	src, err := parseReference(sourceReference, options)
+	if err != nil {
+		return err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Copy image '%s' to OCI layout '%s' as '%s' started.", sourceReference, layoutPath, refName)
+
+	descriptor, err := remote.Get(src, remoteOptions...)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to get descriptor of '%s': %w", sourceReference, err)
+	}
+
+	ociLayout, err := getOrCreateOciLayout(ctx, layoutPath)
+	if err != nil {
+		return err
+	}
+
+	matcher := match.Annotation(OciLayoutRefNameAnnotation, refName)
+	annotations := layout.WithAnnotations(map[string]string{OciLayoutRefNameAnnotation: refName})
+
+	if descriptor.MediaType.IsIndex() {
+		index, err := descriptor.ImageIndex()
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to get image index of '%s': %w", sourceReference, err)
+		}
+
+		err = ociLayout.ReplaceIndex(index, matcher, annotations)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write image index '%s' to OCI layout '%s': %w", sourceReference, layoutPath, err)
+		}
+	} else {
+		image, err := descriptor.Image()
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to get image '%s': %w", sourceReference, err)
+		}
+
+		err = ociLayout.ReplaceImage(image, matcher, annotations)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write image '%s' to OCI layout '%s': %w", sourceReference, layoutPath, err)
+		}
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied image '%s' to OCI layout '%s' as '%s'.", sourceReference, layoutPath, refName)
+
+	logging.LogInfoByCtxf(ctx, "Copy image '%s' to OCI layout '%s' as '%s' finished.", sourceReference, layoutPath, refName)
+
+	return nil
+}
+
+// Pushes the image stored as refName in the OCI layout directory at layoutPath to destinationReference.
+// If refName is empty the layout has to contain exactly one image.
+func CopyImageFromOciLayout(ctx context.Context, layoutPath string, refName string, destinationReference string, options *containeroptions.RegistryOptions) error {
+	if layoutPath == "" {
+		return tracederrors.TracedErrorEmptyString("layoutPath")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	dst, err := parseReference(destinationReference, options)
+	if err != nil {
+		return err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Copy image '%s' from OCI layout '%s' to '%s' started.", refName, layoutPath, destinationReference)
+
+	index, err := layout.ImageIndexFromPath(layoutPath)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to read OCI layout '%s': %w", layoutPath, err)
+	}
+
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to read index manifest of OCI layout '%s': %w", layoutPath, err)
+	}
+
+	var toCopy *v1.Descriptor
+	if refName == "" {
+		if len(indexManifest.Manifests) != 1 {
+			return tracederrors.TracedErrorf("Unable to select image in OCI layout '%s' without refName: Layout contains '%d' images.", layoutPath, len(indexManifest.Manifests))
+		}
+
+		toCopy = &indexManifest.Manifests[0]
+	} else {
+		for i, manifest := range indexManifest.Manifests {
+			if manifest.Annotations[OciLayoutRefNameAnnotation] == refName {
+				toCopy = &indexManifest.Manifests[i]
+				break
+			}
+		}
+
+		if toCopy == nil {
+			return tracederrors.TracedErrorf("No image with ref name '%s' found in OCI layout '%s'.", refName, layoutPath)
+		}
+	}
+
+	if toCopy.MediaType.IsIndex() {
+		imageIndex, err := index.ImageIndex(toCopy.Digest)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to load image index '%s' from OCI layout '%s': %w", toCopy.Digest, layoutPath, err)
+		}
+
+		err = remote.WriteIndex(dst, imageIndex, remoteOptions...)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write image index '%s': %w", destinationReference, err)
+		}
+	} else {
+		image, err := index.Image(toCopy.Digest)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to load image '%s' from OCI layout '%s': %w", toCopy.Digest, layoutPath, err)
+		}
+
+		err = remote.Write(dst, image, remoteOptions...)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write image '%s': %w", destinationReference, err)
+		}
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied image '%s' from OCI layout '%s' to '%s'.", refName, layoutPath, destinationReference)
+
+	logging.LogInfoByCtxf(ctx, "Copy image '%s' from OCI layout '%s' to '%s' finished.", refName, layoutPath, destinationReference)
+
+	return nil
+}
@@ -0,0 +1,437 @@
+package containerimagehandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Credentials are taken from the docker config (~/.docker/config.json) if available, anonymous access otherwise.
+func getRemoteOptions(ctx context.Context, options *containeroptions.RegistryOptions) ([]remote.Option, error) {
+	platform, err := options.GetPlatform()
+	if err != nil {
+		return nil, err
+	}
+
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(authn.DefaultKeychain),
+		remote.WithPlatform(*platform),
+	}, nil
+}
+
+func getNameOptions(options *containeroptions.RegistryOptions) []name.Option {
+	if options.Insecure {
+		return []name.Option{name.Insecure}
+	}
+
+	return []name.Option{}
+}
+
+func parseReference(imageReference string, options *containeroptions.RegistryOptions) (name.Reference, error) {
+	if imageReference == "" {
+		return nil, tracederrors.TracedErrorEmptyString("imageReference")
+	}
+
+	ref, err := name.ParseReference(imageReference, getNameOptions(options)...)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse image reference '%s': %w", imageReference, err)
+	}
+
+	return ref, nil
+}
+
+// Lists all tags of the given repository, e.g. "registry.example.com/my/image".
+func ListTags(ctx context.Context, repositoryName string, options *containeroptions.RegistryOptions) ([]string, error) {
+	if repositoryName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("repositoryName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	repository, err := name.NewRepository(repositoryName, getNameOptions(options)...)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse repository name '%s': %w", repositoryName, err)
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := remote.List(repository, remoteOptions...)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list tags of repository '%s': %w", repositoryName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' tags in repository '%s'.", len(tags), repositoryName)
+
+	return tags, nil
+}
+
+// Returns true if the imageReference points to a multi-arch image index instead of a single image manifest.
+func IsImageIndex(ctx context.Context, imageReference string, options *containeroptions.RegistryOptions) (bool, error) {
+	if options == nil {
+		return false, tracederrors.TracedErrorNil("options")
+	}
+
+	ref, err := parseReference(imageReference, options)
+	if err != nil {
+		return false, err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return false, err
+	}
+
+	descriptor, err := remote.Head(ref, remoteOptions...)
+	if err != nil {
+		return false, tracederrors.TracedErrorf("Failed to get descriptor of '%s': %w", imageReference, err)
+	}
+
+	return descriptor.MediaType.IsIndex(), nil
+}
+
+// Lists the platform specific manifests of a multi-arch image index.
+// For a single platform image the only manifest is returned.
+func ListImagePlatforms(ctx context.Context, imageReference string, options *containeroptions.RegistryOptions) ([]*ImagePlatformManifest, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	ref, err := parseReference(imageReference, options)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor, err := remote.Get(ref, remoteOptions...)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get descriptor of '%s': %w", imageReference, err)
+	}
+
+	if !descriptor.MediaType.IsIndex() {
+		info, err := InspectImage(ctx, imageReference, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*ImagePlatformManifest{
+			{
+				Platform:  info.GetPlatform(),
+				Digest:    descriptor.Digest.String(),
+				MediaType: string(descriptor.MediaType),
+				Size:      descriptor.Size,
+			},
+		}, nil
+	}
+
+	index, err := descriptor.ImageIndex()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image index of '%s': %w", imageReference, err)
+	}
+
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get index manifest of '%s': %w", imageReference, err)
+	}
+
+	platforms := []*ImagePlatformManifest{}
+	for _, manifest := range indexManifest.Manifests {
+		platform := ""
+		if manifest.Platform != nil {
+			platform = manifest.Platform.String()
+		}
+
+		platforms = append(platforms, &ImagePlatformManifest{
+			Platform:  platform,
+			Digest:    manifest.Digest.String(),
+			MediaType: string(manifest.MediaType),
+			Size:      manifest.Size,
+		})
+	}
+
+	logging.LogInfoByCtxf(ctx, "Image index '%s' references '%d' manifests.", imageReference, len(platforms))
+
+	return platforms, nil
+}
+
+// Returns the image referenced by imageReference.
+// Multi-arch image indexes are resolved to the image matching the platform in the options.
+func GetRemoteImage(ctx context.Context, imageReference string, options *containeroptions.RegistryOptions) (v1.Image, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	ref, err := parseReference(imageReference, options)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := remote.Image(ref, remoteOptions...)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image '%s' for platform '%s': %w", imageReference, options.GetPlatformOrDefault(), err)
+	}
+
+	return image, nil
+}
+
+// Returns manifest and config information like labels, architecture and layer digests and sizes of an image.
+// Multi-arch image indexes are resolved to the image matching the platform in the options.
+func InspectImage(ctx context.Context, imageReference string, options *containeroptions.RegistryOptions) (*ImageInfo, error) {
+	image, err := GetRemoteImage(ctx, imageReference, options)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := GetImageInfo(image)
+	if err != nil {
+		return nil, err
+	}
+
+	info.Reference = imageReference
+
+	logging.LogInfoByCtxf(ctx, "Inspected image '%s': platform '%s', '%d' layers.", imageReference, info.GetPlatform(), len(info.Layers))
+
+	return info, nil
+}
+
+// Collects manifest and config information of the given image.
+func GetImageInfo(image v1.Image) (*ImageInfo, error) {
+	if image == nil {
+		return nil, tracederrors.TracedErrorNil("image")
+	}
+
+	digest, err := image.Digest()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image digest: %w", err)
+	}
+
+	mediaType, err := image.MediaType()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image media type: %w", err)
+	}
+
+	configDigest, err := image.ConfigName()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image config digest: %w", err)
+	}
+
+	configFile, err := image.ConfigFile()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image config: %w", err)
+	}
+
+	manifest, err := image.Manifest()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get image manifest: %w", err)
+	}
+
+	info := &ImageInfo{
+		Digest:       digest.String(),
+		MediaType:    string(mediaType),
+		ConfigDigest: configDigest.String(),
+		Architecture: configFile.Architecture,
+		OS:           configFile.OS,
+		Variant:      configFile.Variant,
+		Created:      configFile.Created.Time,
+		Labels:       map[string]string{},
+		Layers:       []*ImageLayerInfo{},
+	}
+
+	for k, v := range configFile.Config.Labels {
+		info.Labels[k] = v
+	}
+
+	for _, layer := range manifest.Layers {
+		info.Layers = append(info.Layers, &ImageLayerInfo{
+			Digest:    layer.Digest.String(),
+			MediaType: string(layer.MediaType),
+			Size:      layer.Size,
+		})
+	}
+
+	return info, nil
+}
+
+// Copies an image between registries.
+// Multi-arch image indexes are copied including all referenced platform images.
+func CopyImage(ctx context.Context, sourceReference string, destinationReference string, options *containeroptions.RegistryOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	src, err := parseReference(sourceReference, options)
+	if err != nil {
+		return err
+	}
+
+	dst, err := parseReference(destinationReference, options)
+	if err != nil {
+		return err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Copy image '%s' to '%s' started.", sourceReference, destinationReference)
+
+	descriptor, err := remote.Get(src, remoteOptions...)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to get descriptor of '%s': %w", sourceReference, err)
+	}
+
+	if descriptor.MediaType.IsIndex() {
+		index, err := descriptor.ImageIndex()
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to get image index of '%s': %w", sourceReference, err)
+		}
+
+		err = remote.WriteIndex(dst, index, remoteOptions...)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write image index '%s': %w", destinationReference, err)
+		}
+	} else {
+		image, err := descriptor.Image()
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to get image '%s': %w", sourceReference, err)
+		}
+
+		err = remote.Write(dst, image, remoteOptions...)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to write image '%s': %w", destinationReference, err)
+		}
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied image '%s' to '%s'.", sourceReference, destinationReference)
+
+	logging.LogInfoByCtxf(ctx, "Copy image '%s' to '%s' finished.", sourceReference, destinationReference)
+
+	return nil
+}
+
+// Deletes the manifest referenced by the given tag or digest.
+//
+// Registries like Docker Distribution (registry:2) reject deleting manifests by tag.
+// Therefore a tag is resolved to its digest first and the manifest is deleted by digest.
+// As this would remove every tag pointing at that digest an error is returned if other tags of the repository share the digest.
+// When a digest is given instead of a tag the manifest and all its tags are deleted.
+// Deleting a tag which does not exist is not an error.
+func DeleteTag(ctx context.Context, imageReference string, options *containeroptions.RegistryOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	ref, err := parseReference(imageReference, options)
+	if err != nil {
+		return err
+	}
+
+	remoteOptions, err := getRemoteOptions(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	digestRef := ref
+	if tag, ok := ref.(name.Tag); ok {
+		descriptor, err := remote.Head(tag, remoteOptions...)
+		if err != nil {
+			if isNotFoundError(err) {
+				logging.LogInfoByCtxf(ctx, "Image '%s' already absent. Skip delete.", imageReference)
+				return nil
+			}
+
+			return tracederrors.TracedErrorf("Failed to resolve digest of '%s': %w", imageReference, err)
+		}
+
+		otherTags, err := listOtherTagsWithDigest(tag, descriptor.Digest, remoteOptions)
+		if err != nil {
+			return err
+		}
+
+		if len(otherTags) > 0 {
+			return tracederrors.TracedErrorf(
+				"Unable to delete tag '%s': Deleting it would also delete the tags %v pointing to the same digest '%s'.",
+				imageReference,
+				otherTags,
+				descriptor.Digest.String(),
+			)
+		}
+
+		digestRef = tag.Context().Digest(descriptor.Digest.String())
+	}
+
+	err = remote.Delete(digestRef, remoteOptions...)
+	if err != nil {
+		if isNotFoundError(err) {
+			logging.LogInfoByCtxf(ctx, "Image '%s' already absent. Skip delete.", imageReference)
+			return nil
+		}
+
+		return tracederrors.TracedErrorf("Failed to delete '%s': %w", imageReference, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Deleted image '%s' (%s).", imageReference, digestRef.Identifier())
+
+	return nil
+}
+
+// Returns the tags of the repository other than the given one which point to the given digest.
+func listOtherTagsWithDigest(tag name.Tag, digest v1.Hash, remoteOptions []remote.Option) ([]string, error) {
+	tags, err := remote.List(tag.Context(), remoteOptions...)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list tags of repository '%s': %w", tag.Context().Name(), err)
+	}
+
+	otherTags := []string{}
+	for _, t := range tags {
+		if t == tag.TagStr() {
+			continue
+		}
+
+		descriptor, err := remote.Head(tag.Context().Tag(t), remoteOptions...)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+
+			return nil, tracederrors.TracedErrorf("Failed to resolve digest of tag '%s': %w", t, err)
+		}
+
+		if descriptor.Digest == digest {
+			otherTags = append(otherTags, t)
+		}
+	}
+
+	return otherTags, nil
+}
+
+func isNotFoundError(err error) bool {
+	var transportErr *transport.Error
+
+	return errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,296 @@
+package containerimagehandler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerimagehandler"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+// Starts an in-process registry and returns its host, e.g. "127.0.0.1:12345".
+func startTestRegistry(t *testing.T) string {
+	server := httptest.NewServer(newDistributionLikeDeleteHandler(registry.New()))
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// Wraps the in-process registry to delete manifests like Docker Distribution (registry:2) does:
+// Deleting by tag is rejected and deleting by digest removes all tags pointing at this digest.
+func newDistributionLikeDeleteHandler(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		repository, reference, found := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v2/"), "/manifests/")
+		if r.Method != http.MethodDelete || !found {
+			inner.ServeHTTP(w, r)
+			return
+		}
+
+		if !strings.HasPrefix(reference, "sha256:") {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			fmt.Fprint(w, `{"errors":[{"code":"UNSUPPORTED","message":"The operation is unsupported."}]}`)
+			return
+		}
+
+		tagsResponse := httptest.NewRecorder()
+		inner.ServeHTTP(tagsResponse, httptest.NewRequest(http.MethodGet, "/v2/"+repository+"/tags/list", nil))
+		tagList := struct {
+			Tags []string `json:"tags"`
+		}{}
+		_ = json.Unmarshal(tagsResponse.Body.Bytes(), &tagList)
+
+		for _, tag := range tagList.Tags {
+			headResponse := httptest.NewRecorder()
+			inner.ServeHTTP(headResponse, httptest.NewRequest(http.MethodHead, "/v2/"+repository+"/manifests/"+tag, nil))
+			if headResponse.Header().Get("Docker-Content-Digest") == reference {
+				inner.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, "/v2/"+repository+"/manifests/"+tag, nil))
+			}
+		}
+
+		inner.ServeHTTP(w, r)
+	})
+}
+
+func pushRandomImage(t *testing.T, imageReference string, architecture string, labels map[string]string) v1.Image {
+	image, err := random.Image(256, 2)
+	require.NoError(t, err)
+
+	configFile, err := image.ConfigFile()
+	require.NoError(t, err)
+	configFile.OS = "linux"
+	configFile.Architecture = architecture
+	configFile.Config.Labels = labels
+	image, err = mutate.ConfigFile(image, configFile)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(imageReference)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, image))
+
+	return image
+}
+
+func pushMultiArchIndex(t *testing.T, imageReference string) {
+	amd64 := pushRandomImage(t, imageReference+"-amd64", "amd64", nil)
+	arm64 := pushRandomImage(t, imageReference+"-arm64", "arm64", nil)
+
+	index := mutate.AppendManifests(
+		mutate.IndexMediaType(empty.Index, types.OCIImageIndex),
+		mutate.IndexAddendum{Add: amd64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+		mutate.IndexAddendum{Add: arm64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}}},
+	)
+
+	ref, err := name.ParseReference(imageReference)
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, index))
+}
+
+func TestListTags(t *testing.T) {
+	ctx := getCtx()
+	host := startTestRegistry(t)
+
+	for _, tag := range []string{"v1", "v2", "latest"} {
+		pushRandomImage(t, host+"/example/image:"+tag, "amd64", nil)
+	}
+
+	tags, err := containerimagehandler.ListTags(ctx, host+"/example/image", &containeroptions.RegistryOptions{})
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"latest", "v1", "v2"}, tags)
+}
+
+func TestInspectImage(t *testing.T) {
+	ctx := getCtx()
+	host := startTestRegistry(t)
+
+	image := pushRandomImage(t, host+"/example/image:v1", "arm64", map[string]string{"maintainer": "example"})
+
+	info, err := containerimagehandler.InspectImage(ctx, host+"/example/image:v1", &containeroptions.RegistryOptions{})
+	require.NoError(t, err)
+
+	digest, err := image.Digest()
+	require.NoError(t, err)
+
+	require.EqualValues(t, digest.String(), info.Digest)
+	require.EqualValues(t, "linux/arm64", info.GetPlatform())
+	require.EqualValues(t, map[string]string{"maintainer": "example"}, info.Labels)
+	require.Len(t, info.Layers, 2)
+	require.Len(t, info.GetLayerDigests(), 2)
+	require.Greater(t, info.GetLayersSize(), int64(0))
+}
+
+func TestResolveMultiArchIndex(t *testing.T) {
+	tests := []struct {
+		platform             string
+		expectedArchitecture string
+	}{
+		{"", "amd64"},
+		{"linux/amd64", "amd64"},
+		{"linux/arm64", "arm64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			ctx := getCtx()
+			host := startTestRegistry(t)
+
+			imageReference := host + "/example/multiarch:v1"
+			pushMultiArchIndex(t, imageReference)
+
+			isIndex, err := containerimagehandler.IsImageIndex(ctx, imageReference, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+			require.True(t, isIndex)
+
+			platforms, err := containerimagehandler.ListImagePlatforms(ctx, imageReference, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+			require.Len(t, platforms, 2)
+			require.EqualValues(t, "linux/amd64", platforms[0].Platform)
+			require.EqualValues(t, "linux/arm64", platforms[1].Platform)
+
+			info, err := containerimagehandler.InspectImage(ctx, imageReference, &containeroptions.RegistryOptions{Platform: tt.platform})
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedArchitecture, info.Architecture)
+		})
+	}
+}
+
+func TestCopyImage(t *testing.T) {
+	tests := []struct {
+		multiArch bool
+	}{
+		{false},
+		{true},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			ctx := getCtx()
+			sourceHost := startTestRegistry(t)
+			destinationHost := startTestRegistry(t)
+
+			source := sourceHost + "/example/image:v1"
+			if tt.multiArch {
+				pushMultiArchIndex(t, source)
+			} else {
+				pushRandomImage(t, source, "amd64", nil)
+			}
+
+			destination := destinationHost + "/copied/image:v1"
+			err := containerimagehandler.CopyImage(ctx, source, destination, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+
+			isIndex, err := containerimagehandler.IsImageIndex(ctx, destination, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+			require.EqualValues(t, tt.multiArch, isIndex)
+
+			sourceInfo, err := containerimagehandler.InspectImage(ctx, source, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+			destinationInfo, err := containerimagehandler.InspectImage(ctx, destination, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+			require.EqualValues(t, sourceInfo.Digest, destinationInfo.Digest)
+		})
+	}
+}
+
+func TestCopyImageToAndFromOciLayout(t *testing.T) {
+	tests := []struct {
+		multiArch bool
+	}{
+		{false},
+		{true},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			ctx := getCtx()
+			host := startTestRegistry(t)
+			layoutPath := t.TempDir()
+
+			source := host + "/example/image:v1"
+			if tt.multiArch {
+				pushMultiArchIndex(t, source)
+			} else {
+				pushRandomImage(t, source, "amd64", nil)
+			}
+
+			// Copying twice under the same ref name replaces the stored image:
+			for i := 0; i < 2; i++ {
+				err := containerimagehandler.CopyImageToOciLayout(ctx, source, layoutPath, "v1", &containeroptions.RegistryOptions{})
+				require.NoError(t, err)
+			}
+
+			refNames, err := containerimagehandler.ListOciLayoutRefNames(ctx, layoutPath)
+			require.NoError(t, err)
+			require.EqualValues(t, []string{"v1"}, refNames)
+
+			destination := host + "/restored/image:v1"
+			err = containerimagehandler.CopyImageFromOciLayout(ctx, layoutPath, "v1", destination, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+
+			isIndex, err := containerimagehandler.IsImageIndex(ctx, destination, &containeroptions.RegistryOptions{})
+			require.NoError(t, err)
+			require.EqualValues(t, tt.multiArch, isIndex)
+
+			err = containerimagehandler.CopyImageFromOciLayout(ctx, layoutPath, "unknown", destination, &containeroptions.RegistryOptions{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	ctx := getCtx()
+	host := startTestRegistry(t)
+
+	image := pushRandomImage(t, host+"/example/image:v1", "amd64", nil)
+	pushRandomImage(t, host+"/example/image:v2", "amd64", nil)
+
+	// The registry rejects deleting by tag like Docker Distribution does:
+	v1Ref, err := name.ParseReference(host + "/example/image:v1")
+	require.NoError(t, err)
+	require.Error(t, remote.Delete(v1Ref))
+
+	// A tag sharing its digest with another tag is not deleted as this would remove both tags:
+	latestRef, err := name.ParseReference(host + "/example/image:latest")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(latestRef, image))
+
+	err = containerimagehandler.DeleteTag(ctx, host+"/example/image:v1", &containeroptions.RegistryOptions{})
+	require.ErrorContains(t, err, "latest")
+
+	tags, err := containerimagehandler.ListTags(ctx, host+"/example/image", &containeroptions.RegistryOptions{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"latest", "v1", "v2"}, tags)
+
+	// Deleting the manifest by digest removes all its tags:
+	digest, err := image.Digest()
+	require.NoError(t, err)
+	err = containerimagehandler.DeleteTag(ctx, host+"/example/image@"+digest.String(), &containeroptions.RegistryOptions{})
+	require.NoError(t, err)
+
+	tags, err = containerimagehandler.ListTags(ctx, host+"/example/image", &containeroptions.RegistryOptions{})
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"v2"}, tags)
+
+	// Deleting twice is idempotent:
+	for i := 0; i < 2; i++ {
+		err := containerimagehandler.DeleteTag(ctx, host+"/example/image:v2", &containeroptions.RegistryOptions{})
+		require.NoError(t, err)
+	}
+
+	tags, err = containerimagehandler.ListTags(ctx, host+"/example/image", &containeroptions.RegistryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, tags)
+}
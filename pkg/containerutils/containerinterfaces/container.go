@@ -8,6 +8,7 @@ import (
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandoutput"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
 )
 
@@ -41,12 +42,47 @@ type Container interface {
 	RunCommandAndGetStdoutAsIoReadCloser(ctx context.Context, options *parameteroptions.RunCommandOptions) (io.ReadCloser, error)
 	RunCommandAndGetStdinAsIoWriteCloser(ctx context.Context, options *parameteroptions.RunCommandOptions) (io.WriteCloser, error)
 
+	// Copies a local file to destPath in the container.
+	// The parent directory of destPath has to exist in the container.
+	// Equivalent to the CLI command 'docker cp <localFilePath> <container>:<destPath>' if docker is used.
+	CopyFileToContainer(ctx context.Context, localFilePath string, destPath string) error
+
+	// Copies the file srcPath from the container to the local localFilePath.
+	// Missing local parent directories are created.
+	CopyFileFromContainer(ctx context.Context, srcPath string, localFilePath string) error
+
+	// Copies the content of the local directory recursively into destDirPath in the container.
+	// destDirPath is created if missing but its parent directory has to exist in the container.
+	// Equivalent to the CLI command 'docker cp <localDirPath>/. <container>:<destDirPath>' if docker is used.
+	CopyDirectoryToContainer(ctx context.Context, localDirPath string, destDirPath string) error
+
+	// Copies the content of srcDirPath in the container recursively into the local directory localDirPath.
+	// localDirPath is created if missing.
+	// The native implementation skips symlinks and hardlinks since the container content is not trusted.
+	CopyDirectoryFromContainer(ctx context.Context, srcDirPath string, localDirPath string) error
+
+	// Returns the directory at path inside the container.
+	GetDirectoryByPath(ctx context.Context, path string) (filesinterfaces.Directory, error)
+
+	// Returns the file at path inside the container.
+	GetFileByPath(path string) (filesinterfaces.File, error)
+
 	// GetLogs returns the stdout and stderr logs of the container.
 	GetLogs(ctx context.Context) ([]byte, []byte, error)
 
 	// WaitUntilFinished waits until the container finishes execution or the timeout is reached.
 	WaitUntilFinished(ctx context.Context, timeout time.Duration) error
 
+	// Returns the health status of the container: "none" if no healthcheck is defined, "starting", "healthy" or "unhealthy".
+	GetHealthStatus(ctx context.Context) (string, error)
+
+	// Returns true if the healthcheck of the container reports healthy.
+	IsHealthy(ctx context.Context) (bool, error)
+
+	// WaitUntilHealthy waits until the healthcheck of the container reports healthy.
+	// Fails if the container becomes unhealthy, stops, has no healthcheck or the timeout is reached.
+	WaitUntilHealthy(ctx context.Context, timeout time.Duration) error
+
 	// These Commands can be implemented by embedding the `CommandExecutorBase` struct:
 	IsRunningOnLocalhost() (bool, error)
 	GetCPUArchitecture(context.Context) (string, error)
@@ -12,6 +12,10 @@ type Image interface {
 	// Get the name of the image.
 	GetName() (string, error)
 
+	// Get the image id like "sha256:..." which is the digest of the image configuration.
+	// This is not the manifest digest used to pull an image by digest from a registry.
+	GetId(ctx context.Context) (string, error)
+
 	// Remove removes the image.
 	Remove(ctx context.Context, options *dockeroptions.RemoveOptions) error
 }
@@ -0,0 +1,36 @@
+package containeroptions
+
+import (
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const DefaultPlatform = "linux/amd64"
+
+type RegistryOptions struct {
+	// Platform used to resolve multi-arch image indexes, e.g. "linux/arm64".
+	// Defaults to DefaultPlatform if not set.
+	Platform string
+
+	// Use plain HTTP to access the registry.
+	// Registries on localhost are always accessed using plain HTTP.
+	Insecure bool
+}
+
+func (r *RegistryOptions) GetPlatformOrDefault() string {
+	if r.Platform == "" {
+		return DefaultPlatform
+	}
+
+	return r.Platform
+}
+
+func (r *RegistryOptions) GetPlatform() (*v1.Platform, error) {
+	platform, err := v1.ParsePlatform(r.GetPlatformOrDefault())
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse platform '%s': %w", r.GetPlatformOrDefault(), err)
+	}
+
+	return platform, nil
+}
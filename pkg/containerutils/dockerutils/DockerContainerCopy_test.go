@@ -0,0 +1,141 @@
+package dockerutils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func TestContainers_CopyFile(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-copy-file-" + tt.implementationName
+
+				container, _ := getRunningDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				tempDir := t.TempDir()
+				localFile := filepath.Join(tempDir, "source.txt")
+				require.NoError(t, os.WriteFile(localFile, []byte("hello world\n"), 0644))
+
+				require.NoError(t, container.CopyFileToContainer(ctx, localFile, "/tmp/copied.txt"))
+
+				stdout, err := container.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{Command: []string{"cat", "/tmp/copied.txt"}})
+				require.NoError(t, err)
+				require.EqualValues(t, "hello world\n", stdout)
+
+				copiedBack := filepath.Join(tempDir, "not", "existing", "copied-back.txt")
+				require.NoError(t, container.CopyFileFromContainer(ctx, "/tmp/copied.txt", copiedBack))
+				content, err := os.ReadFile(copiedBack)
+				require.NoError(t, err)
+				require.EqualValues(t, "hello world\n", string(content))
+
+				// Directories are rejected when copying files:
+				require.Error(t, container.CopyFileFromContainer(ctx, "/tmp", filepath.Join(tempDir, "tmp")))
+			},
+		)
+	}
+}
+
+func TestContainers_CopyDirectory(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-copy-directory-" + tt.implementationName
+
+				container, _ := getRunningDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				localDir := t.TempDir()
+				require.NoError(t, os.WriteFile(filepath.Join(localDir, "a.txt"), []byte("a"), 0644))
+				require.NoError(t, os.MkdirAll(filepath.Join(localDir, "sub"), 0755))
+				require.NoError(t, os.WriteFile(filepath.Join(localDir, "sub", "b.txt"), []byte("b"), 0644))
+
+				require.NoError(t, container.CopyDirectoryToContainer(ctx, localDir, "/tmp/copied-dir"))
+
+				stdout, err := container.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{Command: []string{"cat", "/tmp/copied-dir/sub/b.txt"}})
+				require.NoError(t, err)
+				require.EqualValues(t, "b", stdout)
+
+				copiedBack := filepath.Join(t.TempDir(), "copied-back")
+				require.NoError(t, container.CopyDirectoryFromContainer(ctx, "/tmp/copied-dir", copiedBack))
+
+				content, err := os.ReadFile(filepath.Join(copiedBack, "a.txt"))
+				require.NoError(t, err)
+				require.EqualValues(t, "a", string(content))
+
+				content, err = os.ReadFile(filepath.Join(copiedBack, "sub", "b.txt"))
+				require.NoError(t, err)
+				require.EqualValues(t, "b", string(content))
+			},
+		)
+	}
+}
+
+func TestContainers_GetFileByPath(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-get-file-by-path-" + tt.implementationName
+
+				container, _ := getRunningDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				directory, err := container.GetDirectoryByPath(ctx, "/tmp/files-test")
+				require.NoError(t, err)
+				require.NoError(t, directory.Create(ctx, &filesoptions.CreateOptions{}))
+
+				file, err := container.GetFileByPath("/tmp/files-test/hello.txt")
+				require.NoError(t, err)
+
+				exists, err := file.Exists(ctx)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				require.NoError(t, file.WriteString(ctx, "hello\n", &filesoptions.WriteOptions{}))
+
+				content, err := file.ReadAsString(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, "hello\n", content)
+
+				fileNames, err := directory.ListFilePaths(ctx, &parameteroptions.ListFileOptions{ReturnRelativePaths: true})
+				require.NoError(t, err)
+				require.EqualValues(t, []string{"hello.txt"}, fileNames)
+			},
+		)
+	}
+}
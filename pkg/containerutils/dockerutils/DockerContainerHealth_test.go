@@ -0,0 +1,187 @@
+package dockerutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func TestContainers_WaitUntilHealthy(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-wait-until-healthy-" + tt.implementationName
+
+				container, _ := getDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				err := container.Run(ctx, &dockeroptions.DockerRunContainerOptions{
+					ImageName: "ubuntu",
+					Command:   []string{"sleep", "1m"},
+					Healthcheck: &dockeroptions.HealthcheckOptions{
+						Command:  []string{"test", "-e", "/tmp/ready"},
+						Interval: time.Millisecond * 200,
+						Retries:  100,
+					},
+				})
+				require.NoError(t, err)
+
+				isHealthy, err := container.IsHealthy(ctx)
+				require.NoError(t, err)
+				require.False(t, isHealthy)
+
+				_, err = container.RunCommand(ctx, &parameteroptions.RunCommandOptions{Command: []string{"touch", "/tmp/ready"}})
+				require.NoError(t, err)
+
+				err = container.WaitUntilHealthy(ctx, time.Second*30)
+				require.NoError(t, err)
+
+				status, err := container.GetHealthStatus(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, dockergeneric.HealthStatusHealthy, status)
+			},
+		)
+	}
+}
+
+func TestContainers_WaitUntilHealthy_Unhealthy(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-wait-until-unhealthy-" + tt.implementationName
+
+				container, _ := getDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				err := container.Run(ctx, &dockeroptions.DockerRunContainerOptions{
+					ImageName: "ubuntu",
+					Command:   []string{"sleep", "1m"},
+					Healthcheck: &dockeroptions.HealthcheckOptions{
+						Command:  []string{"false"},
+						Interval: time.Millisecond * 200,
+						Retries:  1,
+					},
+				})
+				require.NoError(t, err)
+
+				err = container.WaitUntilHealthy(ctx, time.Second*30)
+				require.Error(t, err)
+
+				status, err := container.GetHealthStatus(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, dockergeneric.HealthStatusUnhealthy, status)
+			},
+		)
+	}
+}
+
+func TestContainers_GetHealthStatus_NoHealthcheck(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-health-status-none-" + tt.implementationName
+
+				container, _ := getRunningDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				status, err := container.GetHealthStatus(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, dockergeneric.HealthStatusNone, status)
+
+				err = container.WaitUntilHealthy(ctx, time.Second*5)
+				require.Error(t, err)
+			},
+		)
+	}
+}
+
+func TestContainers_Run_RuntimeSettings(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExectuorDockerContainer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				containerName := "test-runtime-settings-" + tt.implementationName
+
+				container, docker := getDockerContainerToTest(t, tt.implementationName, containerName)
+				defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+				err := container.Run(ctx, &dockeroptions.DockerRunContainerOptions{
+					ImageName:               "ubuntu",
+					Command:                 []string{"sleep", "1m"},
+					MemoryLimitBytes:        128 * 1024 * 1024,
+					CPUs:                    0.5,
+					RestartPolicy:           "on-failure",
+					RestartPolicyMaxRetries: 2,
+					KeepStoppedContainer:    true,
+					Labels:                  map[string]string{"test-label": "test-value"},
+					User:                    "65534",
+					Group:                   "65534",
+					ReadOnlyRootFilesystem:  true,
+					CapDrop:                 []string{"ALL"},
+				})
+				require.NoError(t, err)
+
+				stdout, err := container.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{Command: []string{"id", "-u"}})
+				require.NoError(t, err)
+				require.EqualValues(t, "65534\n", stdout)
+
+				stdout, err = container.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{Command: []string{"id", "-g"}})
+				require.NoError(t, err)
+				require.EqualValues(t, "65534\n", stdout)
+
+				// The root filesystem is read only:
+				output, err := container.RunCommand(ctx, &parameteroptions.RunCommandOptions{Command: []string{"touch", "/tmp/file"}, AllowAllExitCodes: true})
+				require.NoError(t, err)
+				require.False(t, output.IsExitSuccess())
+
+				isRunning, err := container.IsRunning(ctx)
+				require.NoError(t, err)
+				require.True(t, isRunning)
+
+				exists, err := docker.ContainerExists(ctx, containerName)
+				require.NoError(t, err)
+				require.True(t, exists)
+			},
+		)
+	}
+}
@@ -0,0 +1,235 @@
+package dockerutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func TestDocker_NetworkCreateInspectAndRemove(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"commandExecutorDocker"},
+		{"nativeDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				docker := getDockerImplementationByName(tt.implementationName)
+
+				networkName := "test-network-" + tt.implementationName
+
+				require.NoError(t, docker.RemoveNetwork(ctx, networkName))
+				defer docker.RemoveNetwork(ctx, networkName)
+
+				_, err := docker.InspectNetwork(ctx, networkName)
+				require.True(t, dockergeneric.IsErrorNetworkNotFound(err))
+
+				// Create is idempotent:
+				for i := 0; i < 2; i++ {
+					err = docker.CreateNetwork(ctx, &dockeroptions.CreateNetworkOptions{
+						Name:     networkName,
+						Labels:   map[string]string{"test": "label"},
+						Internal: true,
+						Subnet:   "172.29.16.0/24",
+					})
+					require.NoError(t, err)
+				}
+
+				info, err := docker.InspectNetwork(ctx, networkName)
+				require.NoError(t, err)
+				require.EqualValues(t, networkName, info.Name)
+				require.EqualValues(t, "bridge", info.Driver)
+				require.True(t, info.Internal)
+				require.EqualValues(t, "label", info.Labels["test"])
+				require.EqualValues(t, []string{"172.29.16.0/24"}, info.Subnets)
+				require.Empty(t, info.ContainerNames)
+
+				names, err := docker.ListNetworkNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, networkName)
+
+				// Remove is idempotent:
+				for i := 0; i < 2; i++ {
+					require.NoError(t, docker.RemoveNetwork(ctx, networkName))
+				}
+
+				exists, err := docker.NetworkExists(ctx, networkName)
+				require.NoError(t, err)
+				require.False(t, exists)
+			},
+		)
+	}
+}
+
+func TestDocker_ConnectAndDisconnectContainer(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"commandExecutorDocker"},
+		{"nativeDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				docker := getDockerImplementationByName(tt.implementationName)
+
+				networkName := "test-connect-network-" + tt.implementationName
+				serverName := "test-connect-server-" + tt.implementationName
+				clientName := "test-connect-client-" + tt.implementationName
+
+				for _, containerName := range []string{serverName, clientName} {
+					require.NoError(t, docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true}))
+					defer docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true})
+				}
+				require.NoError(t, docker.RemoveNetwork(ctx, networkName))
+				defer docker.RemoveNetwork(ctx, networkName)
+
+				require.NoError(t, docker.CreateNetwork(ctx, &dockeroptions.CreateNetworkOptions{Name: networkName}))
+
+				_, err := docker.RunContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+					Name:           serverName,
+					ImageName:      "alpine",
+					Command:        []string{"sleep", "1m"},
+					Network:        networkName,
+					NetworkAliases: []string{"server"},
+				})
+				require.NoError(t, err)
+
+				// The client starts on the default bridge and is connected afterwards:
+				client, err := docker.RunContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+					Name:      clientName,
+					ImageName: "alpine",
+					Command:   []string{"sleep", "1m"},
+				})
+				require.NoError(t, err)
+
+				for i := 0; i < 2; i++ {
+					err = docker.ConnectContainerToNetwork(ctx, &dockeroptions.ConnectNetworkOptions{
+						NetworkName:   networkName,
+						ContainerName: clientName,
+					})
+					require.NoError(t, err)
+				}
+
+				info, err := docker.InspectNetwork(ctx, networkName)
+				require.NoError(t, err)
+				require.True(t, info.IsContainerConnected(clientName))
+				require.True(t, info.IsContainerConnected(serverName))
+
+				output, err := client.RunCommand(ctx, &parameteroptions.RunCommandOptions{
+					Command:           []string{"ping", "-c", "1", "server"},
+					AllowAllExitCodes: true,
+				})
+				require.NoError(t, err)
+				require.True(t, output.IsExitSuccess())
+
+				for i := 0; i < 2; i++ {
+					require.NoError(t, docker.DisconnectContainerFromNetwork(ctx, networkName, clientName))
+				}
+
+				info, err = docker.InspectNetwork(ctx, networkName)
+				require.NoError(t, err)
+				require.False(t, info.IsContainerConnected(clientName))
+				require.True(t, info.IsContainerConnected(serverName))
+
+				// The network is in use by the server and therefore not pruned:
+				pruned, err := docker.PruneNetworks(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, pruned, networkName)
+			},
+		)
+	}
+}
+
+func TestDocker_VolumeCreateMountAndRemove(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"commandExecutorDocker"},
+		{"nativeDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				docker := getDockerImplementationByName(tt.implementationName)
+
+				volumeName := "test-volume-" + tt.implementationName
+				containerName := "test-volume-container-" + tt.implementationName
+
+				require.NoError(t, docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true}))
+				defer docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true})
+				require.NoError(t, docker.RemoveVolume(ctx, volumeName, &dockeroptions.RemoveOptions{Force: true}))
+				defer docker.RemoveVolume(ctx, volumeName, &dockeroptions.RemoveOptions{Force: true})
+
+				_, err := docker.InspectVolume(ctx, volumeName)
+				require.True(t, dockergeneric.IsErrorVolumeNotFound(err))
+
+				// Create is idempotent:
+				for i := 0; i < 2; i++ {
+					err = docker.CreateVolume(ctx, &dockeroptions.CreateVolumeOptions{
+						Name:   volumeName,
+						Labels: map[string]string{"test": "label"},
+					})
+					require.NoError(t, err)
+				}
+
+				info, err := docker.InspectVolume(ctx, volumeName)
+				require.NoError(t, err)
+				require.EqualValues(t, volumeName, info.Name)
+				require.EqualValues(t, "local", info.Driver)
+				require.EqualValues(t, "label", info.Labels["test"])
+
+				names, err := docker.ListVolumeNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, volumeName)
+
+				// Data written to the volume survives the container:
+				output, err := docker.RunCommandInTemporaryContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+					Name:         containerName,
+					ImageName:    "alpine",
+					Command:      []string{"sh", "-c", "echo hello > /data/hello.txt"},
+					VolumeMounts: []dockeroptions.VolumeMount{{VolumeName: volumeName, Target: "/data"}},
+				})
+				require.NoError(t, err)
+				require.True(t, output.IsExitSuccess())
+
+				output, err = docker.RunCommandInTemporaryContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+					Name:         containerName,
+					ImageName:    "alpine",
+					Command:      []string{"cat", "/data/hello.txt"},
+					VolumeMounts: []dockeroptions.VolumeMount{{VolumeName: volumeName, Target: "/data", ReadOnly: true}},
+				})
+				require.NoError(t, err)
+				stdout, err := output.GetStdoutAsString()
+				require.NoError(t, err)
+				require.EqualValues(t, "hello\n", stdout)
+
+				require.NoError(t, docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true}))
+
+				// Remove is idempotent:
+				for i := 0; i < 2; i++ {
+					require.NoError(t, docker.RemoveVolume(ctx, volumeName, &dockeroptions.RemoveOptions{}))
+				}
+
+				exists, err := docker.VolumeExists(ctx, volumeName)
+				require.NoError(t, err)
+				require.False(t, exists)
+			},
+		)
+	}
+}
@@ -0,0 +1,115 @@
+package dockerutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockerstack"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func TestDockerStack_UpAndDown(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"commandExecutorDocker"},
+		{"nativeDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				docker := getDockerImplementationByName(tt.implementationName)
+
+				definition, err := dockerstack.LoadStackDefinitionFromYamlString(`
+name: test-stack-` + tt.implementationName + `
+services:
+  server:
+    image: alpine
+    command: ["sh", "-c", "sleep 2 && touch /ready && sleep infinity"]
+    volumes:
+      - data:/data
+    healthcheck:
+      test: ["CMD", "test", "-e", "/ready"]
+      interval: 500ms
+      retries: 20
+  client:
+    image: alpine
+    command: ["sleep", "infinity"]
+    environment:
+      SERVER_HOST: server
+    depends_on:
+      server:
+        condition: service_healthy
+volumes:
+  data:
+`)
+				require.NoError(t, err)
+
+				stack, err := dockerstack.NewStack(docker, definition)
+				require.NoError(t, err)
+
+				// Down is idempotent and ensures a clean environment:
+				for i := 0; i < 2; i++ {
+					require.NoError(t, stack.Down(ctx))
+				}
+				defer stack.Down(ctx)
+
+				isUp, err := stack.IsUp(ctx)
+				require.NoError(t, err)
+				require.False(t, isUp)
+
+				// Up is idempotent as well:
+				for i := 0; i < 2; i++ {
+					require.NoError(t, stack.Up(ctx))
+				}
+
+				isUp, err = stack.IsUp(ctx)
+				require.NoError(t, err)
+				require.True(t, isUp)
+
+				require.NoError(t, stack.WaitUntilAllServicesHealthy(ctx))
+
+				// The services reach each other by service name:
+				client, err := stack.GetServiceCommandExecutor("client")
+				require.NoError(t, err)
+				stdout, err := client.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{
+					Command: []string{"sh", "-c", "ping -c 1 \"$SERVER_HOST\" > /dev/null && echo reachable"},
+				})
+				require.NoError(t, err)
+				require.EqualValues(t, "reachable\n", stdout)
+
+				require.NoError(t, stack.Down(ctx))
+
+				for _, serviceName := range definition.GetServiceNames() {
+					containerName, err := stack.GetContainerName(serviceName)
+					require.NoError(t, err)
+					exists, err := docker.ContainerExists(ctx, containerName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+
+				networkName, err := stack.GetNetworkName(dockerstack.DefaultNetworkName)
+				require.NoError(t, err)
+				exists, err := docker.NetworkExists(ctx, networkName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				// Named volumes are kept by Down and removed explicitly:
+				volumeName, err := stack.GetVolumeName("data")
+				require.NoError(t, err)
+				exists, err = docker.VolumeExists(ctx, volumeName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				require.NoError(t, stack.RemoveVolumes(ctx))
+				exists, err = docker.VolumeExists(ctx, volumeName)
+				require.NoError(t, err)
+				require.False(t, exists)
+			},
+		)
+	}
+}
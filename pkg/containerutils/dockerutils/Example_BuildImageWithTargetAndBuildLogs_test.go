@@ -0,0 +1,57 @@
+package dockerutils_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+)
+
+// This example shows how to build a single stage of a multi-stage Dockerfile while streaming the build logs and get the id of the resulting image.
+func Test_BuildImageWithTargetAndBuildLogs_Example(t *testing.T) {
+	// use a context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	const imageName = "example-build-image-with-target:latest"
+
+	dockerfileContent := `FROM alpine:latest AS base
+RUN echo "installing dependencies"
+
+FROM base AS test
+RUN echo "running tests"
+
+FROM base AS release
+RUN echo "building release"
+`
+
+	image, err := dockerutils.BuildContainerImage(
+		ctx,
+		&dockeroptions.BuildContainerOptions{
+			ImageNameAndTag:   imageName,
+			DockerfileContent: dockerfileContent,
+
+			// Only the stages needed for 'release' are built. The 'test' stage is skipped:
+			Target: "release",
+
+			// Labels are stored in the image metadata:
+			Labels: map[string]string{
+				"org.opencontainers.image.title": "example",
+			},
+
+			// The build logs are streamed to stdout while the build is running:
+			BuildLogWriter: os.Stdout,
+		},
+	)
+	require.NoError(t, err)
+
+	// In any case we delete the image after this example:
+	defer image.Remove(ctx, &dockeroptions.RemoveOptions{})
+
+	// The image id is the digest of the image configuration like "sha256:..." and not a registry manifest digest:
+	imageId, err := image.GetId(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, imageId)
+}
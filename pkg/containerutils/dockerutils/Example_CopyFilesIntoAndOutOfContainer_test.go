@@ -0,0 +1,58 @@
+package dockerutils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+)
+
+// This example shows how to copy files and directories into and out of a running container and how to access files in the container directly.
+func Test_CopyFilesIntoAndOutOfContainer_Example(t *testing.T) {
+	// use a context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Get docker on local host
+	docker, err := dockerutils.GetDockerOnLocalHost()
+	require.NoError(t, err)
+
+	const containerName = "example-copy-files"
+
+	// Start a container to copy files into:
+	container, err := docker.RunContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+		Name:      containerName,
+		ImageName: "alpine",
+		Command:   []string{"sleep", "1m"},
+	})
+	require.NoError(t, err)
+	defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+	// Prepare a local directory with a config file:
+	localDir := t.TempDir()
+	err = os.WriteFile(filepath.Join(localDir, "app.conf"), []byte("debug=true\n"), 0644)
+	require.NoError(t, err)
+
+	// Copy the whole directory into the container. '/etc/app' is created since '/etc' exists:
+	err = container.CopyDirectoryToContainer(ctx, localDir, "/etc/app")
+	require.NoError(t, err)
+
+	// Files in the container can be used like any other file:
+	configFile, err := container.GetFileByPath("/etc/app/app.conf")
+	require.NoError(t, err)
+
+	err = configFile.AppendLine(ctx, "verbose=true")
+	require.NoError(t, err)
+
+	// Copy the modified file back:
+	localCopy := filepath.Join(t.TempDir(), "app.conf")
+	err = container.CopyFileFromContainer(ctx, "/etc/app/app.conf", localCopy)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(localCopy)
+	require.NoError(t, err)
+	require.EqualValues(t, "debug=true\nverbose=true\n", string(content))
+}
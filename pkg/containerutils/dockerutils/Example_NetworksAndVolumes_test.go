@@ -0,0 +1,84 @@
+package dockerutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+)
+
+// This example shows how to let containers talk to each other over a user defined network and share data using a named volume.
+func Test_NetworksAndVolumes_Example(t *testing.T) {
+	// use a context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Get docker on local host
+	docker, err := dockerutils.GetDockerOnLocalHost()
+	require.NoError(t, err)
+
+	const networkName = "example-network"
+	const volumeName = "example-volume"
+	const serverName = "example-network-server"
+	const clientName = "example-network-client"
+
+	// Create the network and the volume. Both calls do nothing if they already exist:
+	err = docker.CreateNetwork(ctx, &dockeroptions.CreateNetworkOptions{Name: networkName})
+	require.NoError(t, err)
+	defer docker.RemoveNetwork(ctx, networkName)
+
+	err = docker.CreateVolume(ctx, &dockeroptions.CreateVolumeOptions{Name: volumeName})
+	require.NoError(t, err)
+	defer docker.RemoveVolume(ctx, volumeName, &dockeroptions.RemoveOptions{Force: true})
+
+	// Start a web server in the network serving the content of the volume:
+	_, err = docker.RunContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+		Name:           serverName,
+		ImageName:      "nginx:alpine",
+		Network:        networkName,
+		NetworkAliases: []string{"web"},
+		VolumeMounts: []dockeroptions.VolumeMount{
+			{VolumeName: volumeName, Target: "/usr/share/nginx/html"},
+		},
+	})
+	require.NoError(t, err)
+	defer docker.RemoveContainer(ctx, serverName, &dockeroptions.RemoveOptions{Force: true})
+
+	// Start a client outside of the network:
+	client, err := docker.RunContainer(ctx, &dockeroptions.DockerRunContainerOptions{
+		Name:      clientName,
+		ImageName: "alpine",
+		Command:   []string{"sleep", "1m"},
+		VolumeMounts: []dockeroptions.VolumeMount{
+			{VolumeName: volumeName, Target: "/data"},
+		},
+	})
+	require.NoError(t, err)
+	defer docker.RemoveContainer(ctx, clientName, &dockeroptions.RemoveOptions{Force: true})
+
+	// Write a page into the shared volume:
+	_, err = client.RunCommand(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"sh", "-c", "echo hello > /data/index.html"},
+	})
+	require.NoError(t, err)
+
+	// Connect the client to the network so it can reach the server by its alias:
+	err = docker.ConnectContainerToNetwork(ctx, &dockeroptions.ConnectNetworkOptions{
+		NetworkName:   networkName,
+		ContainerName: clientName,
+	})
+	require.NoError(t, err)
+
+	stdout, err := client.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"wget", "-q", "-O", "-", "http://web"},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "hello\n", stdout)
+
+	// Inspect which containers are connected to the network:
+	info, err := docker.InspectNetwork(ctx, networkName)
+	require.NoError(t, err)
+	require.EqualValues(t, []string{clientName, serverName}, info.ContainerNames)
+}
@@ -0,0 +1,72 @@
+package dockerutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+)
+
+// This example shows how to run a hardened container with resource limits and a healthcheck and wait until it is healthy.
+func Test_RunContainerWithHealthcheckAndLimits_Example(t *testing.T) {
+	// use a context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Get docker on local host
+	docker, err := dockerutils.GetDockerOnLocalHost()
+	require.NoError(t, err)
+
+	const containerName = "example-run-container-with-healthcheck-and-limits"
+
+	// Ensure the container is absent before we start:
+	err = docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true})
+	require.NoError(t, err)
+
+	container, err := docker.RunContainer(
+		ctx,
+		&dockeroptions.DockerRunContainerOptions{
+			Name:      containerName,
+			ImageName: "ubuntu:latest",
+
+			// The container writes /tmp/ready after a few seconds to simulate a slow starting service:
+			Command: []string{"bash", "-c", "sleep 3 && touch /tmp/ready && sleep 1m"},
+
+			// The container is healthy as soon as /tmp/ready exists:
+			Healthcheck: &dockeroptions.HealthcheckOptions{
+				Command:  []string{"test", "-e", "/tmp/ready"},
+				Interval: time.Millisecond * 500,
+				Retries:  20,
+			},
+
+			// Limit the resources available to the container:
+			MemoryLimitBytes: 128 * 1024 * 1024,
+			CPUs:             0.5,
+
+			// Restart the container if it fails. Requires to keep stopped containers:
+			RestartPolicy:        "on-failure",
+			KeepStoppedContainer: true,
+
+			// Run as unprivileged user without capabilities:
+			User:    "65534",
+			Group:   "65534",
+			CapDrop: []string{"ALL"},
+
+			Labels: map[string]string{"example": "healthcheck-and-limits"},
+		},
+	)
+	require.NoError(t, err)
+
+	// In any case we delete the container after this example:
+	defer container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+
+	// Wait until the healthcheck reports healthy:
+	err = container.WaitUntilHealthy(ctx, time.Second*30)
+	require.NoError(t, err)
+
+	isHealthy, err := container.IsHealthy(ctx)
+	require.NoError(t, err)
+	require.True(t, isHealthy)
+}
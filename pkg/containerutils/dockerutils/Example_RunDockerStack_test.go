@@ -0,0 +1,65 @@
+package dockerutils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+)
+
+// This example shows how to bring up a compose-like stack of multiple containers, use its services and tear it down again.
+func Test_RunDockerStack_Example(t *testing.T) {
+	// use a context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Define the stack. Usually this is a file checked in next to the tests:
+	stackYamlPath := filepath.Join(t.TempDir(), "stack.yaml")
+	err := os.WriteFile(stackYamlPath, []byte(`
+name: example-stack
+services:
+  web:
+    image: nginx:alpine
+    ports:
+      - "127.0.0.1:18080:80"
+    healthcheck:
+      test: ["CMD", "wget", "-q", "-O", "/dev/null", "http://localhost"]
+      interval: 1s
+      retries: 30
+  client:
+    image: alpine
+    command: ["sleep", "infinity"]
+    depends_on:
+      web:
+        condition: service_healthy
+`), 0644)
+	require.NoError(t, err)
+
+	// Load the stack to run it on the local docker:
+	stack, err := dockerutils.LoadStackOnLocalHost(ctx, stackYamlPath)
+	require.NoError(t, err)
+
+	// Start all services. The client is started after the web service became healthy:
+	err = stack.Up(ctx)
+	require.NoError(t, err)
+
+	// In any case we remove the stack after this example:
+	defer stack.Down(ctx)
+
+	// Every service is available as container and command executor.
+	// Inside the stack the services reach each other by their service name:
+	client, err := stack.GetServiceCommandExecutor("client")
+	require.NoError(t, err)
+	stdout, err := client.RunCommandAndGetStdoutAsString(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"wget", "-q", "-O", "-", "http://web"},
+	})
+	require.NoError(t, err)
+	require.Contains(t, stdout, "Welcome to nginx!")
+
+	// Tear down the stack. This removes all containers and networks but keeps named volumes:
+	err = stack.Down(ctx)
+	require.NoError(t, err)
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfiles"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
 	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/shellutils/shelllinehandler"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
 )
 
@@ -30,6 +35,11 @@ func (c *CommandExecutorDocker) BuildImage(ctx context.Context, options *dockero
 
 	// Prepare build command
 	buildCommand := []string{"docker", "build"}
+	if options.IsBuildKitRequired() {
+		// 'docker buildx build' is used to ensure BuildKit is available.
+		// '--load' stores the resulting image in the local image store, which is not the default for all builder drivers.
+		buildCommand = []string{"docker", "buildx", "build", "--load"}
+	}
 
 	// Add tag
 	buildCommand = append(buildCommand, "-t", options.ImageNameAndTag)
@@ -49,6 +59,12 @@ func (c *CommandExecutorDocker) BuildImage(ctx context.Context, options *dockero
 		buildCommand = append(buildCommand, "--build-arg", fmt.Sprintf("%s=%s", key, value))
 	}
 
+	buildKitArgs, err := getBuildArgs(options)
+	if err != nil {
+		return nil, err
+	}
+	buildCommand = append(buildCommand, buildKitArgs...)
+
 	// Handle Dockerfile source
 	var buildContextPath string
 	var cleanupFunc func() error
@@ -125,11 +141,12 @@ func (c *CommandExecutorDocker) BuildImage(ctx context.Context, options *dockero
 		return nil, err
 	}
 
-	_, err = commandExecutor.RunCommand(ctx, &parameteroptions.RunCommandOptions{
-		Command: buildCommand,
-	})
-	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to build image '%s': %w", options.ImageNameAndTag, err)
+	if options.BuildLogWriter != nil {
+		err = runBuildCommandAndStreamLogs(ctx, commandExecutor, buildCommand, options.BuildLogWriter)
+	} else {
+		_, err = commandExecutor.RunCommand(ctx, &parameteroptions.RunCommandOptions{
+			Command: buildCommand,
+		})
 	}
 
 	// Cleanup temporary directory if created
@@ -139,7 +156,16 @@ func (c *CommandExecutorDocker) BuildImage(ctx context.Context, options *dockero
 		}
 	}
 
-	logging.LogChangedByCtxf(ctx, "Built Docker image '%s'.", options.ImageNameAndTag)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to build image '%s': %w", options.ImageNameAndTag, err)
+	}
+
+	imageId, err := c.GetImageId(contextutils.WithSilent(ctx), options.ImageNameAndTag)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Built Docker image '%s' with id '%s'.", options.ImageNameAndTag, imageId)
 
 	return c.GetImageByName(options.ImageNameAndTag)
 }
@@ -158,3 +184,85 @@ func readDockerfileContent(dockerfilePath string) (string, error) {
 
 	return string(content), nil
 }
+
+// getBuildArgs returns the 'docker build' arguments for target, labels, platform, secrets and cache settings.
+func getBuildArgs(options *dockeroptions.BuildContainerOptions) ([]string, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	args := []string{}
+
+	if options.IsBuildKitRequired() {
+		// Plain progress output is readable when streamed or logged:
+		args = append(args, "--progress", "plain")
+	}
+
+	if options.Builder != "" {
+		args = append(args, "--builder", options.Builder)
+	}
+
+	if options.Target != "" {
+		args = append(args, "--target", options.Target)
+	}
+
+	for _, labelName := range slices.Sorted(maps.Keys(options.Labels)) {
+		args = append(args, "--label", labelName+"="+options.Labels[labelName])
+	}
+
+	if options.Platform != "" {
+		args = append(args, "--platform", options.Platform)
+	}
+
+	for _, secret := range options.Secrets {
+		secretArgument, err := secret.GetSecretArgument()
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, "--secret", secretArgument)
+	}
+
+	if options.CacheFromDirectory != "" {
+		args = append(args, "--cache-from", "type=local,src="+options.CacheFromDirectory)
+	}
+
+	if options.CacheToDirectory != "" {
+		args = append(args, "--cache-to", "type=local,dest="+options.CacheToDirectory+",mode=max")
+	}
+
+	return args, nil
+}
+
+// runBuildCommandAndStreamLogs runs the build command and writes stdout and stderr to buildLogWriter while the build is running.
+func runBuildCommandAndStreamLogs(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, buildCommand []string, buildLogWriter io.Writer) error {
+	if commandExecutor == nil {
+		return tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if buildLogWriter == nil {
+		return tracederrors.TracedErrorNil("buildLogWriter")
+	}
+
+	joinedCommand, err := shelllinehandler.Join(buildCommand)
+	if err != nil {
+		return err
+	}
+
+	// Docker writes the build progress to stderr:
+	readCloser, err := commandExecutor.RunCommandAndGetStdoutAsIoReadCloser(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"bash", "-c", joinedCommand + " 2>&1"},
+	})
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+
+	// Reading until EOF returns an error if the build failed:
+	_, err = io.Copy(buildLogWriter, readCloser)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
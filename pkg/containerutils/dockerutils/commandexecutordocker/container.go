@@ -614,3 +614,63 @@ func (c *CommandExecutorDockerContainer) WaitUntilFinished(ctx context.Context,
 
 	return tracederrors.TracedErrorf("Container '%s' did not finish within timeout %v", name, timeout)
 }
+
+func (c *CommandExecutorDockerContainer) GetHealthStatus(ctx context.Context) (string, error) {
+	containerName, err := c.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return "", err
+	}
+
+	output, err := commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command:           []string{"docker", "inspect", "--format", "{{if .State.Health}}{{.State.Health.Status}}{{else}}" + dockergeneric.HealthStatusNone + "{{end}}", containerName},
+			AllowAllExitCodes: true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if !output.IsExitSuccess() {
+		stderr, err := output.GetStderrAsString()
+		if err != nil {
+			return "", err
+		}
+
+		if stringsutils.ContainsIgnoreCase(stderr, "error: no such object:") {
+			return "", tracederrors.TracedErrorf("Unable to get health status of docker container '%s': %w", containerName, dockergeneric.ErrDockerContainerNotFound)
+		}
+
+		return "", tracederrors.TracedErrorf("Unable to get health status of docker container '%s': %s", containerName, stderr)
+	}
+
+	stdout, err := output.GetStdoutAsString()
+	if err != nil {
+		return "", err
+	}
+
+	status := strings.TrimSpace(stdout)
+
+	logging.LogInfoByCtxf(ctx, "Docker container '%s' has health status '%s'.", containerName, status)
+
+	return status, nil
+}
+
+func (c *CommandExecutorDockerContainer) IsHealthy(ctx context.Context) (bool, error) {
+	status, err := c.GetHealthStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return status == dockergeneric.HealthStatusHealthy, nil
+}
+
+func (c *CommandExecutorDockerContainer) WaitUntilHealthy(ctx context.Context, timeout time.Duration) error {
+	return dockergeneric.WaitUntilHealthy(ctx, c, timeout)
+}
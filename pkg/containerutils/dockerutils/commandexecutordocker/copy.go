@@ -0,0 +1,191 @@
+package commandexecutordocker
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutorfile"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Runs 'docker cp <src> <dest>'. Local paths refer to the host docker is running on.
+// Therefore they are checked and created using the command executor instead of the local file system.
+func (c *CommandExecutorDockerContainer) dockerCp(ctx context.Context, src string, dest string) error {
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return err
+	}
+
+	_, err = commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{"docker", "cp", src, dest},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CommandExecutorDockerContainer) CopyFileToContainer(ctx context.Context, localFilePath string, destPath string) error {
+	if localFilePath == "" {
+		return tracederrors.TracedErrorEmptyString("localFilePath")
+	}
+
+	if destPath == "" {
+		return tracederrors.TracedErrorEmptyString("destPath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return err
+	}
+
+	exists, err := commandexecutorfile.FileExists(contextutils.WithSilent(ctx), commandExecutor, localFilePath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return tracederrors.TracedErrorf("'%s' is not a regular file.", localFilePath)
+	}
+
+	err = c.dockerCp(ctx, localFilePath, name+":"+destPath)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied local file '%s' to '%s' in container '%s'.", localFilePath, destPath, name)
+
+	return nil
+}
+
+func (c *CommandExecutorDockerContainer) CopyFileFromContainer(ctx context.Context, srcPath string, localFilePath string) error {
+	if srcPath == "" {
+		return tracederrors.TracedErrorEmptyString("srcPath")
+	}
+
+	if localFilePath == "" {
+		return tracederrors.TracedErrorEmptyString("localFilePath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return err
+	}
+
+	// 'docker cp' would copy into an existing directory instead of replacing it:
+	isDir, err := commandexecutorfile.DirectoryExists(contextutils.WithSilent(ctx), commandExecutor, localFilePath)
+	if err != nil {
+		return err
+	}
+
+	if isDir {
+		return tracederrors.TracedErrorf("Local destination '%s' is a directory.", localFilePath)
+	}
+
+	err = commandexecutorfile.CreateDirectory(contextutils.WithSilent(ctx), commandExecutor, filepath.Dir(localFilePath), &filesoptions.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	err = c.dockerCp(ctx, name+":"+srcPath, localFilePath)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied '%s' from container '%s' to local file '%s'.", srcPath, name, localFilePath)
+
+	return nil
+}
+
+func (c *CommandExecutorDockerContainer) CopyDirectoryToContainer(ctx context.Context, localDirPath string, destDirPath string) error {
+	if localDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("localDirPath")
+	}
+
+	if destDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("destDirPath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return err
+	}
+
+	isDir, err := commandexecutorfile.DirectoryExists(contextutils.WithSilent(ctx), commandExecutor, localDirPath)
+	if err != nil {
+		return err
+	}
+
+	if !isDir {
+		return tracederrors.TracedErrorf("'%s' is not a directory.", localDirPath)
+	}
+
+	// The trailing '/.' copies the content instead of the directory itself:
+	err = c.dockerCp(ctx, filepath.Clean(localDirPath)+"/.", name+":"+destDirPath)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied local directory '%s' to '%s' in container '%s'.", localDirPath, destDirPath, name)
+
+	return nil
+}
+
+func (c *CommandExecutorDockerContainer) CopyDirectoryFromContainer(ctx context.Context, srcDirPath string, localDirPath string) error {
+	if srcDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("srcDirPath")
+	}
+
+	if localDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("localDirPath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.CreateDirectory(contextutils.WithSilent(ctx), commandExecutor, filepath.Dir(filepath.Clean(localDirPath)), &filesoptions.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The trailing '/.' copies the content instead of the directory itself:
+	err = c.dockerCp(ctx, name+":"+path.Clean(srcDirPath)+"/.", localDirPath)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied directory '%s' from container '%s' to local directory '%s'.", srcDirPath, name, localDirPath)
+
+	return nil
+}
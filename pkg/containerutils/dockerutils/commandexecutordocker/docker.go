@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandoutput"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockerinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
@@ -324,6 +327,14 @@ func (c *CommandExecutorDocker) RunContainer(ctx context.Context, runOptions *do
 		startCommand = append(startCommand, "--net=host")
 	}
 
+	if runOptions.Network != "" {
+		startCommand = append(startCommand, "--network", runOptions.Network)
+	}
+
+	for _, alias := range runOptions.NetworkAliases {
+		startCommand = append(startCommand, "--network-alias", alias)
+	}
+
 	for envName, envValue := range runOptions.AdditionalEnvVars {
 		startCommand = append(startCommand, "-e", fmt.Sprintf("%s=%s", envName, envValue))
 	}
@@ -336,6 +347,22 @@ func (c *CommandExecutorDocker) RunContainer(ctx context.Context, runOptions *do
 		startCommand = append(startCommand, "-v", mount)
 	}
 
+	for _, v := range runOptions.VolumeMounts {
+		mountArgument, err := v.GetMountArgument()
+		if err != nil {
+			return nil, err
+		}
+
+		startCommand = append(startCommand, "--mount", mountArgument)
+	}
+
+	runtimeArgs, err := getRuntimeSettingsArgs(runOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	startCommand = append(startCommand, runtimeArgs...)
+
 	// Add entrypoint if specified (nil = not set, empty = overwrite to empty, non-empty = use value)
 	startCommand = appendEntryPointToCommand(startCommand, runOptions.EntryPoint)
 
@@ -552,6 +579,55 @@ func (c *CommandExecutorDocker) ImageExists(ctx context.Context, imageName strin
 	return false, tracederrors.TracedErrorf("Unknown docker output on stderr: %w", err)
 }
 
+func (c *CommandExecutorDocker) GetImageId(ctx context.Context, imageName string) (string, error) {
+	if imageName == "" {
+		return "", tracederrors.TracedErrorEmptyString("imageName")
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return "", err
+	}
+
+	output, err := commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command:           []string{"docker", "image", "inspect", "--format", "{{.Id}}", imageName},
+			AllowAllExitCodes: true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if !output.IsExitSuccess() {
+		stderr, err := output.GetStderrAsString()
+		if err != nil {
+			return "", err
+		}
+
+		if strings.Contains(stderr, "No such image:") {
+			return "", tracederrors.TracedErrorf("Docker image inspect for '%s' failed: %w", imageName, dockergeneric.ErrDockerImageNotFound)
+		}
+
+		return "", tracederrors.TracedErrorf("Docker image inspect for '%s' failed: %s", imageName, stderr)
+	}
+
+	stdout, err := output.GetStdoutAsString()
+	if err != nil {
+		return "", err
+	}
+
+	imageId := strings.TrimSpace(stdout)
+	if imageId == "" {
+		return "", tracederrors.TracedErrorf("Got empty image id for docker image '%s'.", imageName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker image '%s' has id '%s'.", imageName, imageId)
+
+	return imageId, nil
+}
+
 func (c *CommandExecutorDocker) RemoveImage(ctx context.Context, imageName string, options *dockeroptions.RemoveOptions) error {
 	if imageName == "" {
 		return tracederrors.TracedErrorEmptyString("imageName")
@@ -690,6 +766,16 @@ func (c *CommandExecutorDocker) RunCommandInTemporaryContainer(ctx context.Conte
 		runCommand = append(runCommand, "-v", mount)
 	}
 
+	// Add named volumes
+	for _, v := range options.VolumeMounts {
+		mountArgument, err := v.GetMountArgument()
+		if err != nil {
+			return nil, err
+		}
+
+		runCommand = append(runCommand, "--mount", mountArgument)
+	}
+
 	// Add entrypoint if specified (nil = not set, empty = overwrite to empty, non-empty = use value)
 	runCommand = appendEntryPointToCommand(runCommand, options.EntryPoint)
 
@@ -761,3 +847,87 @@ func (c *CommandExecutorDocker) RunCommandInTemporaryContainer(ctx context.Conte
 
 	return output, nil
 }
+
+// Returns the 'docker run' arguments for the resource limits, restart policy, healthcheck, labels and user.
+func getRuntimeSettingsArgs(runOptions *dockeroptions.DockerRunContainerOptions) ([]string, error) {
+	if runOptions == nil {
+		return nil, tracederrors.TracedErrorNil("runOptions")
+	}
+
+	err := runOptions.ValidateRuntimeSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{}
+
+	if runOptions.MemoryLimitBytes > 0 {
+		args = append(args, "--memory", strconv.FormatInt(runOptions.MemoryLimitBytes, 10))
+	}
+
+	if runOptions.CPUs > 0 {
+		args = append(args, "--cpus", strconv.FormatFloat(runOptions.CPUs, 'f', -1, 64))
+	}
+
+	restartPolicy, err := runOptions.GetRestartPolicyOrEmptyString()
+	if err != nil {
+		return nil, err
+	}
+
+	if restartPolicy != "" {
+		if runOptions.RestartPolicyMaxRetries > 0 {
+			restartPolicy = fmt.Sprintf("%s:%d", restartPolicy, runOptions.RestartPolicyMaxRetries)
+		}
+
+		args = append(args, "--restart", restartPolicy)
+	}
+
+	if runOptions.Healthcheck != nil {
+		// '--health-cmd' is run using the shell inside the container:
+		healthcheckCommandString, err := runOptions.Healthcheck.GetCommandString()
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, "--health-cmd", healthcheckCommandString)
+
+		if runOptions.Healthcheck.Interval > 0 {
+			args = append(args, "--health-interval", runOptions.Healthcheck.Interval.String())
+		}
+
+		if runOptions.Healthcheck.Timeout > 0 {
+			args = append(args, "--health-timeout", runOptions.Healthcheck.Timeout.String())
+		}
+
+		if runOptions.Healthcheck.StartPeriod > 0 {
+			args = append(args, "--health-start-period", runOptions.Healthcheck.StartPeriod.String())
+		}
+
+		if runOptions.Healthcheck.Retries > 0 {
+			args = append(args, "--health-retries", strconv.Itoa(runOptions.Healthcheck.Retries))
+		}
+	}
+
+	for _, labelName := range slices.Sorted(maps.Keys(runOptions.Labels)) {
+		args = append(args, "--label", labelName+"="+runOptions.Labels[labelName])
+	}
+
+	user, err := runOptions.GetUserArgumentOrEmptyString()
+	if err != nil {
+		return nil, err
+	}
+
+	if user != "" {
+		args = append(args, "--user", user)
+	}
+
+	if runOptions.ReadOnlyRootFilesystem {
+		args = append(args, "--read-only")
+	}
+
+	for _, capability := range runOptions.CapDrop {
+		args = append(args, "--cap-drop", capability)
+	}
+
+	return args, nil
+}
@@ -0,0 +1,27 @@
+package commandexecutordocker
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutorfileoo"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Returns the directory at path inside the container. All operations are executed using 'docker exec'.
+func (c *CommandExecutorDockerContainer) GetDirectoryByPath(ctx context.Context, path string) (filesinterfaces.Directory, error) {
+	if path == "" {
+		return nil, tracederrors.TracedErrorEmptyString("path")
+	}
+
+	return commandexecutorfileoo.NewDirectory(c, path)
+}
+
+// Returns the file at path inside the container. All operations are executed using 'docker exec'.
+func (c *CommandExecutorDockerContainer) GetFileByPath(path string) (filesinterfaces.File, error) {
+	if path == "" {
+		return nil, tracederrors.TracedErrorEmptyString("path")
+	}
+
+	return commandexecutorfileoo.New(c, path)
+}
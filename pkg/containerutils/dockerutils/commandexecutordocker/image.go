@@ -62,6 +62,20 @@ func (i *Image) Exists(ctx context.Context) (bool, error) {
 	return docker.ImageExists(ctx, name)
 }
 
+func (i *Image) GetId(ctx context.Context) (string, error) {
+	name, err := i.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	docker, err := i.GetDocker()
+	if err != nil {
+		return "", err
+	}
+
+	return docker.GetImageId(ctx, name)
+}
+
 func (i *Image) Remove(ctx context.Context, options *dockeroptions.RemoveOptions) error {
 	name, err := i.GetName()
 	if err != nil {
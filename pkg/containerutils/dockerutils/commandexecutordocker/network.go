@@ -0,0 +1,359 @@
+package commandexecutordocker
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/stringsutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (c *CommandExecutorDocker) NetworkExists(ctx context.Context, name string) (bool, error) {
+	if name == "" {
+		return false, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	_, err := c.InspectNetwork(contextutils.WithSilent(ctx), name)
+	if err != nil {
+		if dockergeneric.IsErrorNetworkNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Docker network '%s' does not exist.", name)
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker network '%s' exists.", name)
+
+	return true, nil
+}
+
+func (c *CommandExecutorDocker) InspectNetwork(ctx context.Context, name string) (*dockergeneric.NetworkInfo, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command:           []string{"docker", "network", "inspect", "--format", "{{json .}}", name},
+			AllowAllExitCodes: true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !output.IsExitSuccess() {
+		stderr, err := output.GetStderrAsString()
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.Contains(stderr, "not found") || strings.Contains(stderr, "No such network") {
+			return nil, tracederrors.TracedErrorf("Docker network '%s' does not exist: %w", name, dockergeneric.ErrDockerNetworkNotFound)
+		}
+
+		return nil, tracederrors.TracedErrorf("Failed to inspect docker network '%s': %s", name, stderr)
+	}
+
+	stdout, err := output.GetStdoutAsString()
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := struct {
+		Name     string            `json:"Name"`
+		Id       string            `json:"Id"`
+		Driver   string            `json:"Driver"`
+		Internal bool              `json:"Internal"`
+		Labels   map[string]string `json:"Labels"`
+		IPAM     struct {
+			Config []struct {
+				Subnet string `json:"Subnet"`
+			} `json:"Config"`
+		} `json:"IPAM"`
+		Containers map[string]struct {
+			Name string `json:"Name"`
+		} `json:"Containers"`
+	}{}
+
+	err = json.Unmarshal([]byte(stdout), &parsed)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to parse docker network inspect output of '%s': %w", name, err)
+	}
+
+	info := &dockergeneric.NetworkInfo{
+		Name:           parsed.Name,
+		Id:             parsed.Id,
+		Driver:         parsed.Driver,
+		Internal:       parsed.Internal,
+		Labels:         parsed.Labels,
+		Subnets:        []string{},
+		ContainerNames: []string{},
+	}
+
+	for _, config := range parsed.IPAM.Config {
+		if config.Subnet != "" {
+			info.Subnets = append(info.Subnets, config.Subnet)
+		}
+	}
+
+	for _, endpoint := range parsed.Containers {
+		info.ContainerNames = append(info.ContainerNames, endpoint.Name)
+	}
+	sort.Strings(info.ContainerNames)
+
+	logging.LogInfoByCtxf(ctx, "Inspected docker network '%s'.", name)
+
+	return info, nil
+}
+
+func (c *CommandExecutorDocker) CreateNetwork(ctx context.Context, options *dockeroptions.CreateNetworkOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	exists, err := c.NetworkExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		logging.LogInfoByCtxf(ctx, "Docker network '%s' already exists. Skip create.", name)
+		return nil
+	}
+
+	command := []string{"docker", "network", "create", "--driver", options.GetDriverOrDefault()}
+
+	if options.Internal {
+		command = append(command, "--internal")
+	}
+
+	if options.Subnet != "" {
+		command = append(command, "--subnet", options.Subnet)
+	}
+
+	labelKeys := []string{}
+	for k := range options.Labels {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+
+	for _, k := range labelKeys {
+		command = append(command, "--label", k+"="+options.Labels[k])
+	}
+
+	command = append(command, name)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: command,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Created docker network '%s'.", name)
+
+	return nil
+}
+
+func (c *CommandExecutorDocker) RemoveNetwork(ctx context.Context, name string) error {
+	if name == "" {
+		return tracederrors.TracedErrorEmptyString("name")
+	}
+
+	exists, err := c.NetworkExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logging.LogInfoByCtxf(ctx, "Docker network '%s' is already absent. Skip remove.", name)
+		return nil
+	}
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{"docker", "network", "rm", name},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Removed docker network '%s'.", name)
+
+	return nil
+}
+
+func (c *CommandExecutorDocker) ListNetworkNames(ctx context.Context) ([]string, error) {
+	stdout, err := c.RunCommandAndGetStdoutAsString(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{"docker", "network", "ls", "--format", "{{.Name}}"},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := stringsutils.SplitLines(stdout, true)
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' docker networks.", len(names))
+
+	return names, nil
+}
+
+func (c *CommandExecutorDocker) ConnectContainerToNetwork(ctx context.Context, options *dockeroptions.ConnectNetworkOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	networkName, err := options.GetNetworkName()
+	if err != nil {
+		return err
+	}
+
+	containerName, err := options.GetContainerName()
+	if err != nil {
+		return err
+	}
+
+	info, err := c.InspectNetwork(contextutils.WithSilent(ctx), networkName)
+	if err != nil {
+		return err
+	}
+
+	if info.IsContainerConnected(containerName) {
+		logging.LogInfoByCtxf(ctx, "Container '%s' is already connected to docker network '%s'.", containerName, networkName)
+		return nil
+	}
+
+	command := []string{"docker", "network", "connect"}
+	for _, alias := range options.Aliases {
+		command = append(command, "--alias", alias)
+	}
+	command = append(command, networkName, containerName)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: command,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Connected container '%s' to docker network '%s'.", containerName, networkName)
+
+	return nil
+}
+
+func (c *CommandExecutorDocker) DisconnectContainerFromNetwork(ctx context.Context, networkName string, containerName string) error {
+	if networkName == "" {
+		return tracederrors.TracedErrorEmptyString("networkName")
+	}
+
+	if containerName == "" {
+		return tracederrors.TracedErrorEmptyString("containerName")
+	}
+
+	info, err := c.InspectNetwork(contextutils.WithSilent(ctx), networkName)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsContainerConnected(containerName) {
+		logging.LogInfoByCtxf(ctx, "Container '%s' is not connected to docker network '%s'. Skip disconnect.", containerName, networkName)
+		return nil
+	}
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{"docker", "network", "disconnect", networkName, containerName},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Disconnected container '%s' from docker network '%s'.", containerName, networkName)
+
+	return nil
+}
+
+func (c *CommandExecutorDocker) PruneNetworks(ctx context.Context) ([]string, error) {
+	stdout, err := c.RunCommandAndGetStdoutAsString(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{"docker", "network", "prune", "--force"},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := parsePruneOutput(stdout, "Deleted Networks:")
+
+	if len(removed) > 0 {
+		logging.LogChangedByCtxf(ctx, "Pruned '%d' docker networks: %v", len(removed), removed)
+	} else {
+		logging.LogInfoByCtxf(ctx, "No unused docker networks to prune.")
+	}
+
+	return removed, nil
+}
+
+// Returns the sorted names listed after the given header in the output of 'docker network|volume prune'.
+func parsePruneOutput(stdout string, header string) []string {
+	removed := []string{}
+
+	inSection := false
+	for _, line := range stringsutils.SplitLines(stdout, true) {
+		line = strings.TrimSpace(line)
+
+		if line == header {
+			inSection = true
+			continue
+		}
+
+		if !inSection {
+			continue
+		}
+
+		if line == "" {
+			break
+		}
+
+		removed = append(removed, line)
+	}
+
+	sort.Strings(removed)
+
+	return removed
+}
@@ -0,0 +1,225 @@
+package commandexecutordocker
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/stringsutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (c *CommandExecutorDocker) InspectVolume(ctx context.Context, name string) (*dockergeneric.VolumeInfo, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command:           []string{"docker", "volume", "inspect", "--format", "{{json .}}", name},
+			AllowAllExitCodes: true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !output.IsExitSuccess() {
+		stderr, err := output.GetStderrAsString()
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.Contains(strings.ToLower(stderr), "no such volume") {
+			return nil, tracederrors.TracedErrorf("Docker volume '%s' does not exist: %w", name, dockergeneric.ErrDockerVolumeNotFound)
+		}
+
+		return nil, tracederrors.TracedErrorf("Failed to inspect docker volume '%s': %s", name, stderr)
+	}
+
+	stdout, err := output.GetStdoutAsString()
+	if err != nil {
+		return nil, err
+	}
+
+	info := new(dockergeneric.VolumeInfo)
+	err = json.Unmarshal([]byte(stdout), info)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to parse docker volume inspect output of '%s': %w", name, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Inspected docker volume '%s'.", name)
+
+	return info, nil
+}
+
+func (c *CommandExecutorDocker) VolumeExists(ctx context.Context, name string) (bool, error) {
+	if name == "" {
+		return false, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	_, err := c.InspectVolume(ctx, name)
+	if err != nil {
+		if dockergeneric.IsErrorVolumeNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Docker volume '%s' does not exist.", name)
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker volume '%s' exists.", name)
+
+	return true, nil
+}
+
+func (c *CommandExecutorDocker) CreateVolume(ctx context.Context, options *dockeroptions.CreateVolumeOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	exists, err := c.VolumeExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		logging.LogInfoByCtxf(ctx, "Docker volume '%s' already exists. Skip create.", name)
+		return nil
+	}
+
+	command := []string{"docker", "volume", "create", "--driver", options.GetDriverOrDefault()}
+
+	labelKeys := []string{}
+	for k := range options.Labels {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+
+	for _, k := range labelKeys {
+		command = append(command, "--label", k+"="+options.Labels[k])
+	}
+
+	command = append(command, name)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: command,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Created docker volume '%s'.", name)
+
+	return nil
+}
+
+func (c *CommandExecutorDocker) ListVolumeNames(ctx context.Context) ([]string, error) {
+	stdout, err := c.RunCommandAndGetStdoutAsString(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{"docker", "volume", "ls", "--format", "{{.Name}}"},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := stringsutils.SplitLines(stdout, true)
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' docker volumes.", len(names))
+
+	return names, nil
+}
+
+func (c *CommandExecutorDocker) RemoveVolume(ctx context.Context, name string, options *dockeroptions.RemoveOptions) error {
+	if name == "" {
+		return tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	exists, err := c.VolumeExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logging.LogInfoByCtxf(ctx, "Docker volume '%s' is already absent. Skip remove.", name)
+		return nil
+	}
+
+	command := []string{"docker", "volume", "rm"}
+	if options.Force {
+		command = append(command, "--force")
+	}
+	command = append(command, name)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: command,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Removed docker volume '%s'.", name)
+
+	return nil
+}
+
+func (c *CommandExecutorDocker) PruneVolumes(ctx context.Context, options *dockeroptions.PruneVolumesOptions) ([]string, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	command := []string{"docker", "volume", "prune", "--force"}
+	if options.All {
+		command = append(command, "--all")
+	}
+
+	stdout, err := c.RunCommandAndGetStdoutAsString(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: command,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := parsePruneOutput(stdout, "Deleted Volumes:")
+
+	if len(removed) > 0 {
+		logging.LogChangedByCtxf(ctx, "Pruned '%d' docker volumes: %v", len(removed), removed)
+	} else {
+		logging.LogInfoByCtxf(ctx, "No unused docker volumes to prune.")
+	}
+
+	return removed, nil
+}
@@ -4,6 +4,8 @@ import "errors"
 
 var ErrDockerContainerNotFound = errors.New("docker container not found")
 var ErrDockerImageNotFound = errors.New("docker image not found")
+var ErrDockerNetworkNotFound = errors.New("docker network not found")
+var ErrDockerVolumeNotFound = errors.New("docker volume not found")
 
 func IsErrorContainerNotFound(err error) bool {
 	if err == nil {
@@ -20,3 +22,19 @@ func IsErrorImageNotFound(err error) bool {
 
 	return errors.Is(err, ErrDockerImageNotFound)
 }
+
+func IsErrorNetworkNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return errors.Is(err, ErrDockerNetworkNotFound)
+}
+
+func IsErrorVolumeNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return errors.Is(err, ErrDockerVolumeNotFound)
+}
@@ -27,3 +27,33 @@ func Test_IsContainerNotFoundError(t *testing.T) {
 		require.True(t, dockergeneric.IsErrorContainerNotFound(err))
 	})
 }
+
+func Test_IsNetworkNotFoundError(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		require.False(t, dockergeneric.IsErrorNetworkNotFound(nil))
+	})
+
+	t.Run("volume not found error", func(t *testing.T) {
+		require.False(t, dockergeneric.IsErrorNetworkNotFound(dockergeneric.ErrDockerVolumeNotFound))
+	})
+
+	t.Run("TracedError wrapping the ErrDockerNetworkNotFound", func(t *testing.T) {
+		err := tracederrors.TracedErrorf("wrapping: %w", dockergeneric.ErrDockerNetworkNotFound)
+		require.True(t, dockergeneric.IsErrorNetworkNotFound(err))
+	})
+}
+
+func Test_IsVolumeNotFoundError(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		require.False(t, dockergeneric.IsErrorVolumeNotFound(nil))
+	})
+
+	t.Run("network not found error", func(t *testing.T) {
+		require.False(t, dockergeneric.IsErrorVolumeNotFound(dockergeneric.ErrDockerNetworkNotFound))
+	})
+
+	t.Run("TracedError wrapping the ErrDockerVolumeNotFound", func(t *testing.T) {
+		err := tracederrors.TracedErrorf("wrapping: %w", dockergeneric.ErrDockerVolumeNotFound)
+		require.True(t, dockergeneric.IsErrorVolumeNotFound(err))
+	})
+}
@@ -0,0 +1,80 @@
+package dockergeneric
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Health status of a container as reported by docker:
+const (
+	// The container has no healthcheck defined:
+	HealthStatusNone      = "none"
+	HealthStatusStarting  = "starting"
+	HealthStatusHealthy   = "healthy"
+	HealthStatusUnhealthy = "unhealthy"
+)
+
+// Container providing its health status. Used to share the wait logic between the docker implementations.
+type HealthStatusProvider interface {
+	GetName() (string, error)
+	GetHealthStatus(ctx context.Context) (string, error)
+	IsRunning(ctx context.Context) (bool, error)
+}
+
+// Waits until the health status of the container is healthy.
+// Returns an error if the container becomes unhealthy, stops, has no healthcheck or the timeout is reached.
+func WaitUntilHealthy(ctx context.Context, container HealthStatusProvider, timeout time.Duration) error {
+	if container == nil {
+		return tracederrors.TracedErrorNil("container")
+	}
+
+	name, err := container.GetName()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait until container '%s' is healthy started. Timeout is %v.", name, timeout)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		status, err := container.GetHealthStatus(contextutils.WithSilent(ctx))
+		if err != nil {
+			return err
+		}
+
+		switch status {
+		case HealthStatusHealthy:
+			logging.LogInfoByCtxf(ctx, "Wait until container '%s' is healthy finished.", name)
+			return nil
+		case HealthStatusUnhealthy:
+			return tracederrors.TracedErrorf("Container '%s' is unhealthy.", name)
+		case HealthStatusNone:
+			return tracederrors.TracedErrorf("Container '%s' has no healthcheck defined.", name)
+		}
+
+		isRunning, err := container.IsRunning(contextutils.WithSilent(ctx))
+		if err != nil {
+			return err
+		}
+
+		if !isRunning {
+			return tracederrors.TracedErrorf("Container '%s' is not running and will therefore never become healthy.", name)
+		}
+
+		if time.Now().After(deadline) {
+			return tracederrors.TracedErrorf("Container '%s' did not become healthy within timeout %v. Last health status is '%s'.", name, timeout, status)
+		}
+
+		time.Sleep(time.Millisecond * 200)
+	}
+}
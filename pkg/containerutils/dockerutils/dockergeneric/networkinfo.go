@@ -0,0 +1,22 @@
+package dockergeneric
+
+import "slices"
+
+// Implementation independent information about a docker network.
+type NetworkInfo struct {
+	Name     string
+	Id       string
+	Driver   string
+	Internal bool
+	Labels   map[string]string
+
+	// Subnets in CIDR notation:
+	Subnets []string
+
+	// Names of the containers connected to the network:
+	ContainerNames []string
+}
+
+func (n *NetworkInfo) IsContainerConnected(containerName string) bool {
+	return slices.Contains(n.ContainerNames, containerName)
+}
@@ -0,0 +1,10 @@
+package dockergeneric
+
+// Implementation independent information about a docker volume.
+type VolumeInfo struct {
+	Name       string
+	Driver     string
+	Mountpoint string
+	Labels     map[string]string
+	CreatedAt  string
+}
@@ -5,32 +5,77 @@ import (
 
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandoutput"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
 )
 
 type Docker interface {
+	// Connects a container to a network. Does nothing if the container is already connected.
+	ConnectContainerToNetwork(ctx context.Context, options *dockeroptions.ConnectNetworkOptions) error
+
 	ContainerExists(ctx context.Context, name string) (bool, error)
 
+	// Creates a network. Does nothing if the network already exists.
+	CreateNetwork(ctx context.Context, options *dockeroptions.CreateNetworkOptions) error
+
+	// Creates a volume. Does nothing if the volume already exists.
+	CreateVolume(ctx context.Context, options *dockeroptions.CreateVolumeOptions) error
+
+	// Disconnects a container from a network. Does nothing if the container is not connected.
+	DisconnectContainerFromNetwork(ctx context.Context, networkName string, containerName string) error
+
 	GetDeepCopyAsDocker() Docker
 
 	GetContainerByName(name string) (containerinterfaces.Container, error)
 	GetImageByName(name string) (containerinterfaces.Image, error)
 	GetHostDescription() (string, error)
 
+	// Returns the image id like "sha256:..." which is the digest of the image configuration.
+	// This is not the manifest digest used to pull an image by digest from a registry.
+	// Returns dockergeneric.ErrDockerImageNotFound if the image does not exist.
+	GetImageId(ctx context.Context, imageName string) (string, error)
+
 	ImageExists(ctx context.Context, name string) (bool, error)
 
+	// Returns dockergeneric.ErrDockerNetworkNotFound if the network does not exist.
+	InspectNetwork(ctx context.Context, name string) (*dockergeneric.NetworkInfo, error)
+
+	// Returns dockergeneric.ErrDockerVolumeNotFound if the volume does not exist.
+	InspectVolume(ctx context.Context, name string) (*dockergeneric.VolumeInfo, error)
+
 	KillContainerByName(ctx context.Context, name string) error
 
 	ListContainers(ctx context.Context) ([]containerinterfaces.Container, error)
 	ListContainerNames(ctx context.Context) ([]string, error)
+	ListNetworkNames(ctx context.Context) ([]string, error)
+	ListVolumeNames(ctx context.Context) ([]string, error)
+
+	NetworkExists(ctx context.Context, name string) (bool, error)
+
+	// Removes all unused networks and returns the names of the removed networks.
+	PruneNetworks(ctx context.Context) ([]string, error)
+
+	// Removes unused volumes and returns the names of the removed volumes.
+	PruneVolumes(ctx context.Context, options *dockeroptions.PruneVolumesOptions) ([]string, error)
 
 	PullImage(ctx context.Context, imageName string) (containerinterfaces.Image, error)
 
 	RemoveImage(ctx context.Context, imageName string, options *dockeroptions.RemoveOptions) error
 	RunContainer(ctx context.Context, options *dockeroptions.DockerRunContainerOptions) (containerinterfaces.Container, error)
+
+	// Builds an image. Use GetId on the returned image to get the image id of the built image.
+	// Locally built images have no manifest digest until they are pushed to a registry.
 	BuildImage(ctx context.Context, options *dockeroptions.BuildContainerOptions) (containerinterfaces.Image, error)
 
 	RemoveContainer(ctx context.Context, name string, options *dockeroptions.RemoveOptions) error
 
+	// Removes a network. Does nothing if the network is already absent.
+	RemoveNetwork(ctx context.Context, name string) error
+
+	// Removes a volume. Does nothing if the volume is already absent.
+	RemoveVolume(ctx context.Context, name string, options *dockeroptions.RemoveOptions) error
+
 	RunCommandInTemporaryContainer(ctx context.Context, options *dockeroptions.DockerRunContainerOptions) (*commandoutput.CommandOutput, error)
+
+	VolumeExists(ctx context.Context, name string) (bool, error)
 }
@@ -1,6 +1,10 @@
 package dockeroptions
 
 import (
+	"io"
+	"regexp"
+	"strings"
+
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
 )
 
@@ -27,8 +31,35 @@ type BuildContainerOptions struct {
 
 	// PullParentImages attempts to pull the parent images during build
 	PullParentImages bool
+
+	// Target specifies the build stage to build in a multi-stage Dockerfile
+	Target string
+
+	// Labels are set as metadata on the built image
+	Labels map[string]string
+
+	// Platform specifies the target platform like "linux/amd64" or "linux/arm64/v8"
+	Platform string
+
+	// Secrets are available during the build but not stored in the image. Requires BuildKit.
+	Secrets []BuildSecret
+
+	// CacheFromDirectory imports the build cache from a local directory. Requires BuildKit.
+	CacheFromDirectory string
+
+	// CacheToDirectory exports the build cache to a local directory. Requires BuildKit and a Builder supporting cache export
+	// like one using the docker-container driver. Therefore it is only supported by the command executor docker implementation.
+	CacheToDirectory string
+
+	// Builder specifies the buildx builder instance to use like one created by 'docker buildx create --driver docker-container'
+	Builder string
+
+	// BuildLogWriter receives the build logs while the build is running
+	BuildLogWriter io.Writer
 }
 
+var platformRegex = regexp.MustCompile(`^[a-z0-9]+/[a-z0-9_]+(/[a-z0-9]+)?$`)
+
 func NewBuildContainerOptions() *BuildContainerOptions {
 	return new(BuildContainerOptions)
 }
@@ -77,6 +108,61 @@ func (o *BuildContainerOptions) SetPullParentImages(pull bool) error {
 	return nil
 }
 
+func (o *BuildContainerOptions) SetTarget(target string) error {
+	if target == "" {
+		return tracederrors.TracedErrorf("target is empty string")
+	}
+	o.Target = target
+	return nil
+}
+
+func (o *BuildContainerOptions) SetLabels(labels map[string]string) error {
+	o.Labels = labels
+	return nil
+}
+
+func (o *BuildContainerOptions) SetPlatform(platform string) error {
+	if platform == "" {
+		return tracederrors.TracedErrorf("platform is empty string")
+	}
+	o.Platform = platform
+	return nil
+}
+
+func (o *BuildContainerOptions) SetBuildLogWriter(buildLogWriter io.Writer) error {
+	if buildLogWriter == nil {
+		return tracederrors.TracedErrorNil("buildLogWriter")
+	}
+	o.BuildLogWriter = buildLogWriter
+	return nil
+}
+
+// IsBuildKitRequired returns true if options only supported by BuildKit are set.
+func (o *BuildContainerOptions) IsBuildKitRequired() bool {
+	return len(o.Secrets) > 0 || o.CacheFromDirectory != "" || o.CacheToDirectory != "" || o.Builder != ""
+}
+
+// GetPlatformOsArchAndVariant returns the parts of the Platform like "linux", "arm64" and "v8" for "linux/arm64/v8".
+// The variant is an empty string if not specified.
+func (o *BuildContainerOptions) GetPlatformOsArchAndVariant() (os string, arch string, variant string, err error) {
+	if o.Platform == "" {
+		return "", "", "", tracederrors.TracedError("Platform not set")
+	}
+
+	if !platformRegex.MatchString(o.Platform) {
+		return "", "", "", tracederrors.TracedErrorf("Invalid Platform '%s'. Expected format is 'os/arch' or 'os/arch/variant'", o.Platform)
+	}
+
+	splitted := strings.Split(o.Platform, "/")
+	os = splitted[0]
+	arch = splitted[1]
+	if len(splitted) > 2 {
+		variant = splitted[2]
+	}
+
+	return os, arch, variant, nil
+}
+
 func (o *BuildContainerOptions) Validate() error {
 	if o.ImageNameAndTag == "" {
 		return tracederrors.TracedErrorf("ImageNameAndTag is required")
@@ -94,5 +180,16 @@ func (o *BuildContainerOptions) Validate() error {
 		return tracederrors.TracedErrorf("Only one of DockerfilePath or DockerfileContent can be set, not both")
 	}
 
+	if o.Platform != "" && !platformRegex.MatchString(o.Platform) {
+		return tracederrors.TracedErrorf("Invalid Platform '%s'. Expected format is 'os/arch' or 'os/arch/variant'", o.Platform)
+	}
+
+	for _, secret := range o.Secrets {
+		_, err := secret.GetSecretArgument()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,46 @@
+package dockeroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Secret mounted during an image build using 'RUN --mount=type=secret,id=<Id>'.
+// The secret is not stored in the resulting image.
+type BuildSecret struct {
+	// Id used in the Dockerfile to reference the secret:
+	Id string
+
+	// Local file containing the secret:
+	SourceFilePath string
+}
+
+func (b *BuildSecret) GetId() (string, error) {
+	if b.Id == "" {
+		return "", tracederrors.TracedError("Id not set")
+	}
+
+	return b.Id, nil
+}
+
+func (b *BuildSecret) GetSourceFilePath() (string, error) {
+	if b.SourceFilePath == "" {
+		return "", tracederrors.TracedError("SourceFilePath not set")
+	}
+
+	return b.SourceFilePath, nil
+}
+
+// Returns the secret as used by 'docker build --secret' like "id=mysecret,src=/path/to/secret".
+func (b *BuildSecret) GetSecretArgument() (string, error) {
+	id, err := b.GetId()
+	if err != nil {
+		return "", err
+	}
+
+	sourceFilePath, err := b.GetSourceFilePath()
+	if err != nil {
+		return "", err
+	}
+
+	return "id=" + id + ",src=" + sourceFilePath, nil
+}
@@ -0,0 +1,45 @@
+package dockeroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/slicesutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type ConnectNetworkOptions struct {
+	// Name of the network to connect to:
+	NetworkName string
+
+	// Name of the container to connect:
+	ContainerName string
+
+	// Additional DNS names of the container in the network:
+	Aliases []string
+}
+
+func (c *ConnectNetworkOptions) GetNetworkName() (string, error) {
+	if c.NetworkName == "" {
+		return "", tracederrors.TracedError("NetworkName not set")
+	}
+
+	return c.NetworkName, nil
+}
+
+func (c *ConnectNetworkOptions) GetContainerName() (string, error) {
+	if c.ContainerName == "" {
+		return "", tracederrors.TracedError("ContainerName not set")
+	}
+
+	return c.ContainerName, nil
+}
+
+func (c *ConnectNetworkOptions) GetDeepCopy() *ConnectNetworkOptions {
+	copy := new(ConnectNetworkOptions)
+
+	*copy = *c
+
+	if c.Aliases != nil {
+		copy.Aliases = slicesutils.GetDeepCopyOfStringsSlice(c.Aliases)
+	}
+
+	return copy
+}
@@ -0,0 +1,36 @@
+package dockeroptions
+
+import "github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+
+type CreateNetworkOptions struct {
+	// Name of the network to create:
+	Name string
+
+	// Network driver. Defaults to "bridge" if not set.
+	Driver string
+
+	// Labels to add to the network:
+	Labels map[string]string
+
+	// If set to true the network has no access to the outside world:
+	Internal bool
+
+	// Subnet in CIDR notation like "172.28.0.0/16". Docker chooses one if not set.
+	Subnet string
+}
+
+func (c *CreateNetworkOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateNetworkOptions) GetDriverOrDefault() string {
+	if c.Driver == "" {
+		return "bridge"
+	}
+
+	return c.Driver
+}
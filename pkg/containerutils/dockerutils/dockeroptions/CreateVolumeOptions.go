@@ -0,0 +1,30 @@
+package dockeroptions
+
+import "github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+
+type CreateVolumeOptions struct {
+	// Name of the volume to create:
+	Name string
+
+	// Volume driver. Defaults to "local" if not set.
+	Driver string
+
+	// Labels to add to the volume:
+	Labels map[string]string
+}
+
+func (c *CreateVolumeOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateVolumeOptions) GetDriverOrDefault() string {
+	if c.Driver == "" {
+		return "local"
+	}
+
+	return c.Driver
+}
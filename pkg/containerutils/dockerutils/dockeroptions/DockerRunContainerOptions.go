@@ -1,6 +1,7 @@
 package dockeroptions
 
 import (
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -20,6 +21,42 @@ type DockerRunContainerOptions struct {
 	UseHostNet           bool
 	AdditionalEnvVars    map[string]string
 
+	// Name of the network to attach the container to:
+	Network string
+
+	// Additional DNS names of the container in the Network:
+	NetworkAliases []string
+
+	// Named volumes to mount into the container:
+	VolumeMounts []VolumeMount
+
+	// Memory limit in bytes. 0 means unlimited.
+	MemoryLimitBytes int64
+
+	// Number of CPUs the container is allowed to use like "1.5". 0 means unlimited.
+	CPUs float64
+
+	// One of "no", "always", "unless-stopped" or "on-failure". Requires KeepStoppedContainer.
+	RestartPolicy string
+
+	// Maximum number of restarts for the "on-failure" RestartPolicy. 0 means unlimited.
+	RestartPolicyMaxRetries int
+
+	Healthcheck *HealthcheckOptions
+
+	Labels map[string]string
+
+	// User name or UID to run the container as:
+	User string
+
+	// Group name or GID to run the container as. Requires User.
+	Group string
+
+	ReadOnlyRootFilesystem bool
+
+	// Linux capabilities to drop like "NET_RAW" or "ALL":
+	CapDrop []string
+
 	// If Ports are specified this waits until a connect to all ports is accepted:
 	WaitForPortsOpen bool
 
@@ -54,6 +91,26 @@ func (d *DockerRunContainerOptions) GetDeepCopy() *DockerRunContainerOptions {
 		copy.Mounts = slicesutils.GetDeepCopyOfStringsSlice(d.Mounts)
 	}
 
+	if d.NetworkAliases != nil {
+		copy.NetworkAliases = slicesutils.GetDeepCopyOfStringsSlice(d.NetworkAliases)
+	}
+
+	if d.VolumeMounts != nil {
+		copy.VolumeMounts = slices.Clone(d.VolumeMounts)
+	}
+
+	if d.Healthcheck != nil {
+		copy.Healthcheck = d.Healthcheck.GetDeepCopy()
+	}
+
+	if d.Labels != nil {
+		copy.Labels = maps.Clone(d.Labels)
+	}
+
+	if d.CapDrop != nil {
+		copy.CapDrop = slicesutils.GetDeepCopyOfStringsSlice(d.CapDrop)
+	}
+
 	return copy
 }
 
@@ -270,3 +327,81 @@ func (o *DockerRunContainerOptions) GetEntryPoint() (entryPoint []string, err er
 
 	return o.EntryPoint, nil
 }
+
+// Returns the validated restart policy or an empty string if not set.
+func (o *DockerRunContainerOptions) GetRestartPolicyOrEmptyString() (string, error) {
+	switch o.RestartPolicy {
+	case "":
+		if o.RestartPolicyMaxRetries != 0 {
+			return "", tracederrors.TracedError("RestartPolicyMaxRetries requires the 'on-failure' RestartPolicy")
+		}
+
+		return "", nil
+	case "no", "always", "unless-stopped", "on-failure":
+	default:
+		return "", tracederrors.TracedErrorf("Unsupported RestartPolicy '%s'. Use 'no', 'always', 'unless-stopped' or 'on-failure'.", o.RestartPolicy)
+	}
+
+	if o.RestartPolicyMaxRetries < 0 {
+		return "", tracederrors.TracedErrorf("RestartPolicyMaxRetries '%d' must not be negative.", o.RestartPolicyMaxRetries)
+	}
+
+	if o.RestartPolicyMaxRetries != 0 && o.RestartPolicy != "on-failure" {
+		return "", tracederrors.TracedErrorf("RestartPolicyMaxRetries requires the 'on-failure' RestartPolicy but got '%s'.", o.RestartPolicy)
+	}
+
+	// Docker removes containers started with '--rm' instead of restarting them:
+	if o.RestartPolicy != "no" && !o.KeepStoppedContainer {
+		return "", tracederrors.TracedErrorf("RestartPolicy '%s' requires KeepStoppedContainer.", o.RestartPolicy)
+	}
+
+	return o.RestartPolicy, nil
+}
+
+// Returns the user as used by 'docker run --user' like "user" or "user:group".
+// Returns an empty string if no user is set.
+func (o *DockerRunContainerOptions) GetUserArgumentOrEmptyString() (string, error) {
+	if o.User == "" {
+		if o.Group != "" {
+			return "", tracederrors.TracedErrorf("Group '%s' is set without User.", o.Group)
+		}
+
+		return "", nil
+	}
+
+	if o.Group == "" {
+		return o.User, nil
+	}
+
+	return o.User + ":" + o.Group, nil
+}
+
+// Validates the resource limits, restart policy, healthcheck and user settings.
+func (o *DockerRunContainerOptions) ValidateRuntimeSettings() error {
+	if o.MemoryLimitBytes < 0 {
+		return tracederrors.TracedErrorf("MemoryLimitBytes '%d' must not be negative.", o.MemoryLimitBytes)
+	}
+
+	if o.CPUs < 0 {
+		return tracederrors.TracedErrorf("CPUs '%v' must not be negative.", o.CPUs)
+	}
+
+	_, err := o.GetRestartPolicyOrEmptyString()
+	if err != nil {
+		return err
+	}
+
+	_, err = o.GetUserArgumentOrEmptyString()
+	if err != nil {
+		return err
+	}
+
+	if o.Healthcheck != nil {
+		err = o.Healthcheck.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
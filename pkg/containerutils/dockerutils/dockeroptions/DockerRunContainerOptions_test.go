@@ -2,9 +2,11 @@ package dockeroptions_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
 )
 
@@ -172,3 +174,128 @@ func Test_OverwriteEntrypointBehavior(t *testing.T) {
 		require.EqualValues(t, []string{}, entrypoint2)
 	})
 }
+
+func Test_GetRestartPolicyOrEmptyString(t *testing.T) {
+	tests := []struct {
+		restartPolicy        string
+		maxRetries           int
+		keepStoppedContainer bool
+		expectedError        bool
+	}{
+		{"", 0, false, false},
+		{"no", 0, false, false},
+		{"always", 0, true, false},
+		{"unless-stopped", 0, true, false},
+		{"on-failure", 0, true, false},
+		{"on-failure", 3, true, false},
+		{"always", 0, false, true},
+		{"always", 3, true, true},
+		{"", 3, true, true},
+		{"on-failure", -1, true, true},
+		{"sometimes", 0, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			options := &dockeroptions.DockerRunContainerOptions{
+				RestartPolicy:           tt.restartPolicy,
+				RestartPolicyMaxRetries: tt.maxRetries,
+				KeepStoppedContainer:    tt.keepStoppedContainer,
+			}
+
+			restartPolicy, err := options.GetRestartPolicyOrEmptyString()
+			if tt.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.EqualValues(t, tt.restartPolicy, restartPolicy)
+			}
+		})
+	}
+}
+
+func Test_GetUserArgumentOrEmptyString(t *testing.T) {
+	tests := []struct {
+		user          string
+		group         string
+		expected      string
+		expectedError bool
+	}{
+		{"", "", "", false},
+		{"nobody", "", "nobody", false},
+		{"1000", "1000", "1000:1000", false},
+		{"", "users", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			options := &dockeroptions.DockerRunContainerOptions{User: tt.user, Group: tt.group}
+
+			user, err := options.GetUserArgumentOrEmptyString()
+			if tt.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.EqualValues(t, tt.expected, user)
+			}
+		})
+	}
+}
+
+func Test_ValidateRuntimeSettings(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		require.NoError(t, (&dockeroptions.DockerRunContainerOptions{}).ValidateRuntimeSettings())
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		options := &dockeroptions.DockerRunContainerOptions{
+			MemoryLimitBytes: 64 * 1024 * 1024,
+			CPUs:             0.5,
+			Healthcheck: &dockeroptions.HealthcheckOptions{
+				Command:  []string{"true"},
+				Interval: time.Second,
+				Retries:  3,
+			},
+		}
+		require.NoError(t, options.ValidateRuntimeSettings())
+	})
+
+	t.Run("negative memory", func(t *testing.T) {
+		require.Error(t, (&dockeroptions.DockerRunContainerOptions{MemoryLimitBytes: -1}).ValidateRuntimeSettings())
+	})
+
+	t.Run("negative cpus", func(t *testing.T) {
+		require.Error(t, (&dockeroptions.DockerRunContainerOptions{CPUs: -1}).ValidateRuntimeSettings())
+	})
+
+	t.Run("healthcheck without command", func(t *testing.T) {
+		options := &dockeroptions.DockerRunContainerOptions{Healthcheck: &dockeroptions.HealthcheckOptions{}}
+		require.Error(t, options.ValidateRuntimeSettings())
+	})
+
+	t.Run("healthcheck interval too short", func(t *testing.T) {
+		options := &dockeroptions.DockerRunContainerOptions{
+			Healthcheck: &dockeroptions.HealthcheckOptions{Command: []string{"true"}, Interval: time.Microsecond},
+		}
+		require.Error(t, options.ValidateRuntimeSettings())
+	})
+}
+
+func Test_GetDeepCopy_includes_RuntimeSettings(t *testing.T) {
+	options := &dockeroptions.DockerRunContainerOptions{
+		Healthcheck: &dockeroptions.HealthcheckOptions{Command: []string{"true"}},
+		Labels:      map[string]string{"a": "b"},
+		CapDrop:     []string{"ALL"},
+	}
+
+	copy := options.GetDeepCopy()
+	require.EqualValues(t, options, copy)
+
+	copy.Healthcheck.Command[0] = "false"
+	copy.Labels["a"] = "c"
+	copy.CapDrop[0] = "NET_RAW"
+
+	require.EqualValues(t, []string{"true"}, options.Healthcheck.Command)
+	require.EqualValues(t, map[string]string{"a": "b"}, options.Labels)
+	require.EqualValues(t, []string{"ALL"}, options.CapDrop)
+}
@@ -0,0 +1,79 @@
+package dockeroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/slicesutils"
+	"github.com/asciich/asciichgolangpublic/pkg/shellutils/shelllinehandler"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Healthcheck docker runs periodically inside the container to determine its health status.
+// Unset values use the docker defaults.
+type HealthcheckOptions struct {
+	// Command to run inside the container. Exit code 0 means healthy.
+	// The command is run by '/bin/sh -c' so the image has to contain a shell.
+	Command []string
+
+	// Time between two checks:
+	Interval time.Duration
+
+	// A check running longer than Timeout is considered failed:
+	Timeout time.Duration
+
+	// Failed checks during the StartPeriod are not counted:
+	StartPeriod time.Duration
+
+	// Number of consecutive failed checks until the container is considered unhealthy:
+	Retries int
+}
+
+func (h *HealthcheckOptions) GetCommand() ([]string, error) {
+	if len(h.Command) == 0 {
+		return nil, tracederrors.TracedError("Command not set")
+	}
+
+	return h.Command, nil
+}
+
+// Returns the Command joined as shell line which is passed to '/bin/sh -c' inside the container.
+func (h *HealthcheckOptions) GetCommandString() (string, error) {
+	command, err := h.GetCommand()
+	if err != nil {
+		return "", err
+	}
+
+	return shelllinehandler.Join(command)
+}
+
+func (h *HealthcheckOptions) GetDeepCopy() *HealthcheckOptions {
+	copy := new(HealthcheckOptions)
+
+	*copy = *h
+
+	if h.Command != nil {
+		copy.Command = slicesutils.GetDeepCopyOfStringsSlice(h.Command)
+	}
+
+	return copy
+}
+
+func (h *HealthcheckOptions) Validate() error {
+	_, err := h.GetCommand()
+	if err != nil {
+		return err
+	}
+
+	for name, duration := range map[string]time.Duration{"Interval": h.Interval, "Timeout": h.Timeout, "StartPeriod": h.StartPeriod} {
+		// Docker rejects durations between 0 and 1ms:
+		if duration < 0 || (duration > 0 && duration < time.Millisecond) {
+			return tracederrors.TracedErrorf("Healthcheck %s '%v' is invalid. Use 0 for the default or at least 1ms.", name, duration)
+		}
+	}
+
+	if h.Retries < 0 {
+		return tracederrors.TracedErrorf("Healthcheck Retries '%d' must not be negative.", h.Retries)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package dockeroptions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+)
+
+func Test_HealthcheckOptionsGetCommandString(t *testing.T) {
+	t.Run("simple command", func(t *testing.T) {
+		h := &dockeroptions.HealthcheckOptions{Command: []string{"test", "-f", "/tmp/healthy"}}
+		command, err := h.GetCommandString()
+		require.NoError(t, err)
+		require.EqualValues(t, "test -f /tmp/healthy", command)
+	})
+
+	t.Run("argument with space", func(t *testing.T) {
+		h := &dockeroptions.HealthcheckOptions{Command: []string{"echo", "hello world"}}
+		command, err := h.GetCommandString()
+		require.NoError(t, err)
+		require.EqualValues(t, "echo 'hello world'", command)
+	})
+
+	t.Run("command not set", func(t *testing.T) {
+		h := &dockeroptions.HealthcheckOptions{}
+		_, err := h.GetCommandString()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,7 @@
+package dockeroptions
+
+type PruneVolumesOptions struct {
+	// By default only anonymous volumes are pruned.
+	// If set to true all unused volumes including named ones are removed.
+	All bool
+}
@@ -0,0 +1,50 @@
+package dockeroptions
+
+import "github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+
+// Mounts a named docker volume into a container.
+type VolumeMount struct {
+	// Name of the docker volume. Docker creates the volume if it does not exist.
+	VolumeName string
+
+	// Path inside the container:
+	Target string
+
+	ReadOnly bool
+}
+
+func (v *VolumeMount) GetVolumeName() (string, error) {
+	if v.VolumeName == "" {
+		return "", tracederrors.TracedError("VolumeName not set")
+	}
+
+	return v.VolumeName, nil
+}
+
+func (v *VolumeMount) GetTarget() (string, error) {
+	if v.Target == "" {
+		return "", tracederrors.TracedError("Target not set")
+	}
+
+	return v.Target, nil
+}
+
+// Returns the mount as used by 'docker run --mount'.
+func (v *VolumeMount) GetMountArgument() (string, error) {
+	volumeName, err := v.GetVolumeName()
+	if err != nil {
+		return "", err
+	}
+
+	target, err := v.GetTarget()
+	if err != nil {
+		return "", err
+	}
+
+	argument := "type=volume,source=" + volumeName + ",target=" + target
+	if v.ReadOnly {
+		argument += ",readonly"
+	}
+
+	return argument, nil
+}
@@ -0,0 +1,36 @@
+package dockeroptions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+)
+
+func Test_VolumeMountGetMountArgument(t *testing.T) {
+	t.Run("read write", func(t *testing.T) {
+		v := &dockeroptions.VolumeMount{VolumeName: "data", Target: "/data"}
+		argument, err := v.GetMountArgument()
+		require.NoError(t, err)
+		require.EqualValues(t, "type=volume,source=data,target=/data", argument)
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		v := &dockeroptions.VolumeMount{VolumeName: "data", Target: "/data", ReadOnly: true}
+		argument, err := v.GetMountArgument()
+		require.NoError(t, err)
+		require.EqualValues(t, "type=volume,source=data,target=/data,readonly", argument)
+	})
+
+	t.Run("volume name missing", func(t *testing.T) {
+		v := &dockeroptions.VolumeMount{Target: "/data"}
+		_, err := v.GetMountArgument()
+		require.Error(t, err)
+	})
+
+	t.Run("target missing", func(t *testing.T) {
+		v := &dockeroptions.VolumeMount{VolumeName: "data"}
+		_, err := v.GetMountArgument()
+		require.Error(t, err)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
 )
 
 func Test_BuildContainerOptions_Validation(t *testing.T) {
@@ -114,3 +115,64 @@ func Test_BuildContainerOptions_Setters(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, options.PullParentImages)
 }
+
+func Test_BuildContainerOptions_Platform(t *testing.T) {
+	tests := []struct {
+		platform        string
+		expectedOs      string
+		expectedArch    string
+		expectedVariant string
+		expectedError   bool
+	}{
+		{"linux/amd64", "linux", "amd64", "", false},
+		{"linux/arm64/v8", "linux", "arm64", "v8", false},
+		{"windows/amd64", "windows", "amd64", "", false},
+		{"linux", "", "", "", true},
+		{"linux/amd64/v8/extra", "", "", "", true},
+		{"Linux/AMD64", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			options := &dockeroptions.BuildContainerOptions{
+				ImageNameAndTag:   "test:latest",
+				DockerfileContent: "FROM alpine:latest",
+				Platform:          tt.platform,
+			}
+
+			platformOs, platformArch, platformVariant, err := options.GetPlatformOsArchAndVariant()
+			if tt.expectedError {
+				require.Error(t, err)
+				require.Error(t, options.Validate())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, options.Validate())
+				require.EqualValues(t, tt.expectedOs, platformOs)
+				require.EqualValues(t, tt.expectedArch, platformArch)
+				require.EqualValues(t, tt.expectedVariant, platformVariant)
+			}
+		})
+	}
+}
+
+func Test_BuildContainerOptions_IsBuildKitRequired(t *testing.T) {
+	require.False(t, (&dockeroptions.BuildContainerOptions{Target: "builder", Platform: "linux/amd64"}).IsBuildKitRequired())
+	require.True(t, (&dockeroptions.BuildContainerOptions{Secrets: []dockeroptions.BuildSecret{{Id: "a", SourceFilePath: "/a"}}}).IsBuildKitRequired())
+	require.True(t, (&dockeroptions.BuildContainerOptions{CacheFromDirectory: "/cache"}).IsBuildKitRequired())
+	require.True(t, (&dockeroptions.BuildContainerOptions{CacheToDirectory: "/cache"}).IsBuildKitRequired())
+	require.True(t, (&dockeroptions.BuildContainerOptions{Builder: "ci-builder"}).IsBuildKitRequired())
+}
+
+func Test_BuildContainerOptions_Secrets(t *testing.T) {
+	secret := dockeroptions.BuildSecret{Id: "mysecret", SourceFilePath: "/path/to/secret"}
+	secretArgument, err := secret.GetSecretArgument()
+	require.NoError(t, err)
+	require.EqualValues(t, "id=mysecret,src=/path/to/secret", secretArgument)
+
+	options := &dockeroptions.BuildContainerOptions{
+		ImageNameAndTag:   "test:latest",
+		DockerfileContent: "FROM alpine:latest",
+		Secrets:           []dockeroptions.BuildSecret{{Id: "mysecret"}},
+	}
+	require.Error(t, options.Validate())
+}
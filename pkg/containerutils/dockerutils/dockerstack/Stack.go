@@ -0,0 +1,707 @@
+package dockerstack
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockerinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Label added to all networks and volumes created for a stack.
+const StackNameLabel = "dockerstack.name"
+
+// Network used for services without explicitly defined networks.
+const DefaultNetworkName = "default"
+
+// A multi container stack defined by a StackDefinition running on a Docker implementation.
+type Stack struct {
+	docker     dockerinterfaces.Docker
+	definition *StackDefinition
+}
+
+func NewStack(docker dockerinterfaces.Docker, definition *StackDefinition) (*Stack, error) {
+	if docker == nil {
+		return nil, tracederrors.TracedErrorNil("docker")
+	}
+
+	if definition == nil {
+		return nil, tracederrors.TracedErrorNil("definition")
+	}
+
+	err := definition.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	stack := &Stack{
+		docker:     docker,
+		definition: definition,
+	}
+
+	// Detect invalid volumes before any container is started:
+	for _, serviceName := range definition.GetServiceNames() {
+		_, err = stack.GetRunContainerOptions(serviceName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stack, nil
+}
+
+func (s *Stack) GetDocker() (dockerinterfaces.Docker, error) {
+	if s.docker == nil {
+		return nil, tracederrors.TracedError("docker not set")
+	}
+
+	return s.docker, nil
+}
+
+func (s *Stack) GetDefinition() (*StackDefinition, error) {
+	if s.definition == nil {
+		return nil, tracederrors.TracedError("definition not set")
+	}
+
+	return s.definition, nil
+}
+
+func (s *Stack) GetName() (string, error) {
+	definition, err := s.GetDefinition()
+	if err != nil {
+		return "", err
+	}
+
+	return definition.GetName()
+}
+
+// Returns the container_name of the service or "<stack>-<service>" if not set.
+func (s *Stack) GetContainerName(serviceName string) (string, error) {
+	definition, err := s.GetDefinition()
+	if err != nil {
+		return "", err
+	}
+
+	service, err := definition.GetService(serviceName)
+	if err != nil {
+		return "", err
+	}
+
+	if service.ContainerName != "" {
+		return service.ContainerName, nil
+	}
+
+	return definition.Name + "-" + serviceName, nil
+}
+
+// Returns the docker network name "<stack>_<network>" of a network defined in the stack.
+func (s *Stack) GetNetworkName(networkName string) (string, error) {
+	if networkName == "" {
+		return "", tracederrors.TracedErrorEmptyString("networkName")
+	}
+
+	name, err := s.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	return name + "_" + networkName, nil
+}
+
+// Returns the docker volume name "<stack>_<volume>" of a volume defined in the stack.
+func (s *Stack) GetVolumeName(volumeName string) (string, error) {
+	if volumeName == "" {
+		return "", tracederrors.TracedErrorEmptyString("volumeName")
+	}
+
+	name, err := s.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	return name + "_" + volumeName, nil
+}
+
+// Returns the names of the stack networks used by at least one service including the implicit default network.
+func (s *Stack) getUsedNetworkNames() []string {
+	used := []string{}
+
+	for _, serviceName := range s.definition.GetServiceNames() {
+		service := s.definition.Services[serviceName]
+
+		if len(service.Networks) == 0 {
+			if !slices.Contains(used, DefaultNetworkName) {
+				used = append(used, DefaultNetworkName)
+			}
+			continue
+		}
+
+		for networkName := range service.Networks {
+			if !slices.Contains(used, networkName) {
+				used = append(used, networkName)
+			}
+		}
+	}
+
+	slices.Sort(used)
+
+	return used
+}
+
+// Returns the sorted stack networks the service is attached to.
+// The container is started in the first one and connected to the others afterwards.
+func (s *Stack) getServiceNetworkNames(serviceName string) ([]string, error) {
+	service, err := s.definition.GetService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(service.Networks) == 0 {
+		return []string{DefaultNetworkName}, nil
+	}
+
+	networkNames := []string{}
+	for networkName := range service.Networks {
+		networkNames = append(networkNames, networkName)
+	}
+	slices.Sort(networkNames)
+
+	return networkNames, nil
+}
+
+// Returns the DNS names of the service in the given stack network.
+func (s *Stack) getServiceNetworkAliases(serviceName string, networkName string) ([]string, error) {
+	service, err := s.definition.GetService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := []string{serviceName}
+	if network := service.Networks[networkName]; network != nil {
+		aliases = append(aliases, network.Aliases...)
+	}
+
+	return aliases, nil
+}
+
+// Returns the stack volumes used by at least one service.
+func (s *Stack) getUsedVolumeNames() []string {
+	used := []string{}
+
+	for _, serviceName := range s.definition.GetServiceNames() {
+		for _, volume := range s.definition.Services[serviceName].Volumes {
+			source, _, _ := strings.Cut(volume, ":")
+			if _, ok := s.definition.Volumes[source]; ok && !slices.Contains(used, source) {
+				used = append(used, source)
+			}
+		}
+	}
+
+	slices.Sort(used)
+
+	return used
+}
+
+// Returns either a bind mount "/abs/source:target" or a named volume mount.
+func (s *Stack) getMount(volume string) (string, *dockeroptions.VolumeMount, error) {
+	source, target, found := strings.Cut(volume, ":")
+	if !found || source == "" || target == "" {
+		return "", nil, tracederrors.TracedErrorf("Unsupported volume '%s'. Use 'source:target[:ro]'.", volume)
+	}
+
+	readOnly := false
+	if before, mode, found := strings.Cut(target, ":"); found {
+		switch mode {
+		case "ro":
+			readOnly = true
+		case "rw":
+		default:
+			return "", nil, tracederrors.TracedErrorf("Unsupported volume '%s'. Only the mount options 'ro' and 'rw' are supported.", volume)
+		}
+		target = before
+	}
+
+	if strings.HasPrefix(source, "/") || strings.HasPrefix(source, ".") {
+		absolute, err := filepath.Abs(source)
+		if err != nil {
+			return "", nil, tracederrors.TracedErrorf("Failed to get absolute path of '%s': %w", source, err)
+		}
+
+		bind := absolute + ":" + target
+		if readOnly {
+			bind += ":ro"
+		}
+
+		return bind, nil, nil
+	}
+
+	if _, ok := s.definition.Volumes[source]; !ok {
+		return "", nil, tracederrors.TracedErrorf("Named volume '%s' is not defined in the top level volumes of stack '%s'.", source, s.definition.Name)
+	}
+
+	volumeName, err := s.GetVolumeName(source)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "", &dockeroptions.VolumeMount{VolumeName: volumeName, Target: target, ReadOnly: readOnly}, nil
+}
+
+// Returns the options used to start the container of the given service.
+func (s *Stack) GetRunContainerOptions(serviceName string) (*dockeroptions.DockerRunContainerOptions, error) {
+	definition, err := s.GetDefinition()
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := definition.GetService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	containerName, err := s.GetContainerName(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &dockeroptions.DockerRunContainerOptions{
+		Name:                 containerName,
+		ImageName:            service.Image,
+		Command:              service.Command,
+		Ports:                service.Ports,
+		KeepStoppedContainer: true,
+		AdditionalEnvVars:    service.Environment,
+	}
+
+	if service.Entrypoint != nil {
+		options.EntryPoint = service.Entrypoint
+	}
+
+	if service.Healthcheck != nil {
+		options.Healthcheck, err = service.Healthcheck.GetHealthcheckOptions()
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Invalid healthcheck in service '%s': %w", serviceName, err)
+		}
+	}
+
+	for _, volume := range service.Volumes {
+		bind, volumeMount, err := s.getMount(volume)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Invalid volume in service '%s': %w", serviceName, err)
+		}
+
+		if volumeMount != nil {
+			options.VolumeMounts = append(options.VolumeMounts, *volumeMount)
+		} else {
+			options.Mounts = append(options.Mounts, bind)
+		}
+	}
+
+	// The container is started in the first network. Additional networks are connected by Up:
+	networkNames, err := s.getServiceNetworkNames(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	options.Network, err = s.GetNetworkName(networkNames[0])
+	if err != nil {
+		return nil, err
+	}
+
+	options.NetworkAliases, err = s.getServiceNetworkAliases(serviceName, networkNames[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func (s *Stack) GetServiceContainer(serviceName string) (containerinterfaces.Container, error) {
+	containerName, err := s.GetContainerName(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	docker, err := s.GetDocker()
+	if err != nil {
+		return nil, err
+	}
+
+	return docker.GetContainerByName(containerName)
+}
+
+func (s *Stack) GetServiceCommandExecutor(serviceName string) (commandexecutorinterfaces.CommandExecutor, error) {
+	return s.GetServiceContainer(serviceName)
+}
+
+// Starts all networks and services of the stack.
+// Services are started after the services they depend on are started or healthy as defined in depends_on.
+// Already running services are left untouched so calling Up again is idempotent.
+func (s *Stack) Up(ctx context.Context) error {
+	name, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	docker, err := s.GetDocker()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Start docker stack '%s' started.", name)
+
+	for _, networkName := range s.getUsedNetworkNames() {
+		dockerNetworkName, err := s.GetNetworkName(networkName)
+		if err != nil {
+			return err
+		}
+
+		driver := ""
+		if network := s.definition.Networks[networkName]; network != nil {
+			driver = network.Driver
+		}
+
+		err = docker.CreateNetwork(ctx, &dockeroptions.CreateNetworkOptions{
+			Name:   dockerNetworkName,
+			Driver: driver,
+			Labels: map[string]string{StackNameLabel: name},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, volumeName := range s.getUsedVolumeNames() {
+		dockerVolumeName, err := s.GetVolumeName(volumeName)
+		if err != nil {
+			return err
+		}
+
+		driver := ""
+		if volume := s.definition.Volumes[volumeName]; volume != nil {
+			driver = volume.Driver
+		}
+
+		err = docker.CreateVolume(ctx, &dockeroptions.CreateVolumeOptions{
+			Name:   dockerVolumeName,
+			Driver: driver,
+			Labels: map[string]string{StackNameLabel: name},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	serviceNames, err := s.definition.GetServiceNamesInStartOrder()
+	if err != nil {
+		return err
+	}
+
+	for _, serviceName := range serviceNames {
+		err = s.waitForDependencies(ctx, serviceName)
+		if err != nil {
+			return err
+		}
+
+		err = s.startService(ctx, serviceName)
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Start docker stack '%s' finished.", name)
+
+	return nil
+}
+
+func (s *Stack) waitForDependencies(ctx context.Context, serviceName string) error {
+	service, err := s.definition.GetService(serviceName)
+	if err != nil {
+		return err
+	}
+
+	dependencyNames := []string{}
+	for dependencyName := range service.DependsOn {
+		dependencyNames = append(dependencyNames, dependencyName)
+	}
+	slices.Sort(dependencyNames)
+
+	for _, dependencyName := range dependencyNames {
+		if service.DependsOn[dependencyName].Condition == DependencyConditionServiceHealthy {
+			logging.LogInfoByCtxf(ctx, "Service '%s' waits for '%s' to become healthy.", serviceName, dependencyName)
+
+			err = s.WaitUntilServiceHealthy(ctx, dependencyName)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Stack) startService(ctx context.Context, serviceName string) error {
+	options, err := s.GetRunContainerOptions(serviceName)
+	if err != nil {
+		return err
+	}
+
+	container, err := s.GetServiceContainer(serviceName)
+	if err != nil {
+		return err
+	}
+
+	isRunning, err := container.IsRunning(ctx)
+	if err != nil {
+		return err
+	}
+
+	if isRunning {
+		logging.LogInfoByCtxf(ctx, "Container '%s' of service '%s' is already running.", options.Name, serviceName)
+		return nil
+	}
+
+	// Remove a stopped container of a previous run to reuse the name:
+	err = container.Remove(ctx, &dockeroptions.RemoveOptions{Force: true})
+	if err != nil {
+		return err
+	}
+
+	docker, err := s.GetDocker()
+	if err != nil {
+		return err
+	}
+
+	_, err = docker.RunContainer(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	networkNames, err := s.getServiceNetworkNames(serviceName)
+	if err != nil {
+		return err
+	}
+
+	for _, networkName := range networkNames[1:] {
+		dockerNetworkName, err := s.GetNetworkName(networkName)
+		if err != nil {
+			return err
+		}
+
+		aliases, err := s.getServiceNetworkAliases(serviceName, networkName)
+		if err != nil {
+			return err
+		}
+
+		err = docker.ConnectContainerToNetwork(ctx, &dockeroptions.ConnectNetworkOptions{
+			NetworkName:   dockerNetworkName,
+			ContainerName: options.Name,
+			Aliases:       aliases,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogChangedByCtxf(ctx, "Started service '%s' as container '%s'.", serviceName, options.Name)
+
+	return nil
+}
+
+// Removes all containers and networks of the stack.
+// Named volumes are kept like 'docker compose down' does. Use RemoveVolumes to remove them as well.
+// Calling Down on an already removed stack is not an error.
+func (s *Stack) Down(ctx context.Context) error {
+	name, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	docker, err := s.GetDocker()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Remove docker stack '%s' started.", name)
+
+	serviceNames, err := s.definition.GetServiceNamesInStartOrder()
+	if err != nil {
+		return err
+	}
+	slices.Reverse(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		containerName, err := s.GetContainerName(serviceName)
+		if err != nil {
+			return err
+		}
+
+		err = docker.RemoveContainer(ctx, containerName, &dockeroptions.RemoveOptions{Force: true})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, networkName := range s.getUsedNetworkNames() {
+		dockerNetworkName, err := s.GetNetworkName(networkName)
+		if err != nil {
+			return err
+		}
+
+		err = docker.RemoveNetwork(ctx, dockerNetworkName)
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Remove docker stack '%s' finished.", name)
+
+	return nil
+}
+
+// Removes the named volumes of the stack like 'docker compose down --volumes' does.
+// The containers using the volumes have to be removed first by Down.
+func (s *Stack) RemoveVolumes(ctx context.Context) error {
+	name, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	docker, err := s.GetDocker()
+	if err != nil {
+		return err
+	}
+
+	for _, volumeName := range s.getUsedVolumeNames() {
+		dockerVolumeName, err := s.GetVolumeName(volumeName)
+		if err != nil {
+			return err
+		}
+
+		err = docker.RemoveVolume(ctx, dockerVolumeName, &dockeroptions.RemoveOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Removed volumes of docker stack '%s'.", name)
+
+	return nil
+}
+
+// Returns true if the containers of all services are running.
+func (s *Stack) IsUp(ctx context.Context) (bool, error) {
+	definition, err := s.GetDefinition()
+	if err != nil {
+		return false, err
+	}
+
+	for _, serviceName := range definition.GetServiceNames() {
+		container, err := s.GetServiceContainer(serviceName)
+		if err != nil {
+			return false, err
+		}
+
+		isRunning, err := container.IsRunning(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if !isRunning {
+			logging.LogInfoByCtxf(ctx, "Service '%s' of docker stack '%s' is not running.", serviceName, definition.Name)
+			return false, nil
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "All services of docker stack '%s' are running.", definition.Name)
+
+	return true, nil
+}
+
+// Returns true if docker reports the service container as healthy.
+func (s *Stack) IsServiceHealthy(ctx context.Context, serviceName string) (bool, error) {
+	service, err := s.definition.GetService(serviceName)
+	if err != nil {
+		return false, err
+	}
+
+	if service.Healthcheck == nil {
+		return false, tracederrors.TracedErrorf("Service '%s' of stack '%s' has no healthcheck.", serviceName, s.definition.Name)
+	}
+
+	container, err := s.GetServiceContainer(serviceName)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := container.GetHealthStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return status == dockergeneric.HealthStatusHealthy, nil
+}
+
+// Waits until docker reports the service container as healthy.
+//
+// The healthcheck itself is run by docker as defined in the HEALTHCHECK of the container.
+// An error is returned if docker reports the service as unhealthy.
+func (s *Stack) WaitUntilServiceHealthy(ctx context.Context, serviceName string) error {
+	service, err := s.definition.GetService(serviceName)
+	if err != nil {
+		return err
+	}
+
+	if service.Healthcheck == nil {
+		return tracederrors.TracedErrorf("Service '%s' of stack '%s' has no healthcheck.", serviceName, s.definition.Name)
+	}
+
+	timeout, err := service.Healthcheck.GetMaxTimeUntilHealthStatusKnown()
+	if err != nil {
+		return err
+	}
+
+	container, err := s.GetServiceContainer(serviceName)
+	if err != nil {
+		return err
+	}
+
+	err = container.WaitUntilHealthy(ctx, timeout)
+	if err != nil {
+		return tracederrors.TracedErrorf("Service '%s' of stack '%s' did not become healthy: %w", serviceName, s.definition.Name, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Service '%s' of stack '%s' is healthy.", serviceName, s.definition.Name)
+
+	return nil
+}
+
+// Waits until all services with a healthcheck are healthy.
+func (s *Stack) WaitUntilAllServicesHealthy(ctx context.Context) error {
+	definition, err := s.GetDefinition()
+	if err != nil {
+		return err
+	}
+
+	for _, serviceName := range definition.GetServiceNames() {
+		if definition.Services[serviceName].Healthcheck == nil {
+			continue
+		}
+
+		err = s.WaitUntilServiceHealthy(ctx, serviceName)
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "All services of docker stack '%s' are healthy.", definition.Name)
+
+	return nil
+}
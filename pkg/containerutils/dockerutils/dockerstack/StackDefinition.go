@@ -0,0 +1,481 @@
+package dockerstack
+
+import (
+	"context"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const (
+	DependencyConditionServiceStarted = "service_started"
+	DependencyConditionServiceHealthy = "service_healthy"
+)
+
+// Compose-like definition of a multi container stack.
+//
+// Only a subset of the compose file format is supported:
+// services with image, container_name, command, entrypoint, environment, ports, volumes, networks, depends_on and healthcheck
+// as well as top level networks and volumes.
+type StackDefinition struct {
+	Name     string                        `yaml:"name"`
+	Services map[string]*ServiceDefinition `yaml:"services"`
+	Networks map[string]*NetworkDefinition `yaml:"networks"`
+	Volumes  map[string]*VolumeDefinition  `yaml:"volumes"`
+}
+
+type ServiceDefinition struct {
+	Image         string                 `yaml:"image"`
+	ContainerName string                 `yaml:"container_name"`
+	Command       StringOrSlice          `yaml:"command"`
+	Entrypoint    StringOrSlice          `yaml:"entrypoint"`
+	Environment   Environment            `yaml:"environment"`
+	Ports         []string               `yaml:"ports"`
+	Volumes       []string               `yaml:"volumes"`
+	Networks      ServiceNetworks        `yaml:"networks"`
+	DependsOn     DependsOn              `yaml:"depends_on"`
+	Healthcheck   *HealthcheckDefinition `yaml:"healthcheck"`
+}
+
+type NetworkDefinition struct {
+	Driver string `yaml:"driver"`
+}
+
+type VolumeDefinition struct {
+	Driver string `yaml:"driver"`
+}
+
+type HealthcheckDefinition struct {
+	// Either ["CMD", "arg", ...], ["CMD-SHELL", "command"] or a plain string run using a shell.
+	Test        StringOrSlice `yaml:"test"`
+	Interval    string        `yaml:"interval"`
+	Timeout     string        `yaml:"timeout"`
+	Retries     int           `yaml:"retries"`
+	StartPeriod string        `yaml:"start_period"`
+}
+
+// Custom type so we can implement the UnmarshalYAML for both a string and a list of strings:
+type StringOrSlice []string
+
+func (s *StringOrSlice) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		var str string
+		if err := value.Decode(&str); err != nil {
+			return tracederrors.TracedErrorf("failed to decode scalar: %w", err)
+		}
+		*s = []string{str}
+		return nil
+	case yaml.SequenceNode:
+		var ss []string
+		if err := value.Decode(&ss); err != nil {
+			return tracederrors.TracedErrorf("failed to decode sequence: %w", err)
+		}
+		*s = ss
+		return nil
+	default:
+		return tracederrors.TracedErrorf("unsupported YAML node kind for string or list of strings: %v", value.Kind)
+	}
+}
+
+// Custom type so we can implement the UnmarshalYAML for both a map and a list of "KEY=value" strings:
+type Environment map[string]string
+
+func (e *Environment) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.MappingNode:
+		var m map[string]string
+		if err := value.Decode(&m); err != nil {
+			return tracederrors.TracedErrorf("failed to decode environment map: %w", err)
+		}
+		*e = m
+		return nil
+	case yaml.SequenceNode:
+		var ss []string
+		if err := value.Decode(&ss); err != nil {
+			return tracederrors.TracedErrorf("failed to decode environment list: %w", err)
+		}
+
+		m := map[string]string{}
+		for _, entry := range ss {
+			key, val, _ := strings.Cut(entry, "=")
+			m[key] = val
+		}
+		*e = m
+		return nil
+	default:
+		return tracederrors.TracedErrorf("unsupported YAML node kind for environment: %v", value.Kind)
+	}
+}
+
+type ServiceNetwork struct {
+	Aliases []string `yaml:"aliases"`
+}
+
+// Custom type so we can implement the UnmarshalYAML for both a list of network names and a map with aliases:
+type ServiceNetworks map[string]*ServiceNetwork
+
+func (s *ServiceNetworks) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.MappingNode:
+		var m map[string]*ServiceNetwork
+		if err := value.Decode(&m); err != nil {
+			return tracederrors.TracedErrorf("failed to decode networks map: %w", err)
+		}
+		for k, v := range m {
+			if v == nil {
+				m[k] = &ServiceNetwork{}
+			}
+		}
+		*s = m
+		return nil
+	case yaml.SequenceNode:
+		var ss []string
+		if err := value.Decode(&ss); err != nil {
+			return tracederrors.TracedErrorf("failed to decode networks list: %w", err)
+		}
+
+		m := map[string]*ServiceNetwork{}
+		for _, name := range ss {
+			m[name] = &ServiceNetwork{}
+		}
+		*s = m
+		return nil
+	default:
+		return tracederrors.TracedErrorf("unsupported YAML node kind for networks: %v", value.Kind)
+	}
+}
+
+type Dependency struct {
+	Condition string `yaml:"condition"`
+}
+
+// Custom type so we can implement the UnmarshalYAML for both a list of service names and a map with conditions:
+type DependsOn map[string]*Dependency
+
+func (d *DependsOn) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.MappingNode:
+		var m map[string]*Dependency
+		if err := value.Decode(&m); err != nil {
+			return tracederrors.TracedErrorf("failed to decode depends_on map: %w", err)
+		}
+		for k, v := range m {
+			if v == nil {
+				m[k] = &Dependency{}
+			}
+			if m[k].Condition == "" {
+				m[k].Condition = DependencyConditionServiceStarted
+			}
+		}
+		*d = m
+		return nil
+	case yaml.SequenceNode:
+		var ss []string
+		if err := value.Decode(&ss); err != nil {
+			return tracederrors.TracedErrorf("failed to decode depends_on list: %w", err)
+		}
+
+		m := map[string]*Dependency{}
+		for _, name := range ss {
+			m[name] = &Dependency{Condition: DependencyConditionServiceStarted}
+		}
+		*d = m
+		return nil
+	default:
+		return tracederrors.TracedErrorf("unsupported YAML node kind for depends_on: %v", value.Kind)
+	}
+}
+
+func LoadStackDefinitionFromYamlString(yamlString string) (*StackDefinition, error) {
+	if strings.TrimSpace(yamlString) == "" {
+		return nil, tracederrors.TracedErrorEmptyString("yamlString")
+	}
+
+	definition := new(StackDefinition)
+	err := yaml.Unmarshal([]byte(yamlString), definition)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse stack definition: %w", err)
+	}
+
+	err = definition.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+func LoadStackDefinitionFromFile(ctx context.Context, path string) (*StackDefinition, error) {
+	if path == "" {
+		return nil, tracederrors.TracedErrorEmptyString("path")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to read stack definition '%s': %w", path, err)
+	}
+
+	definition, err := LoadStackDefinitionFromYamlString(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Loaded stack definition '%s' with '%d' services from '%s'.", definition.Name, len(definition.Services), path)
+
+	return definition, nil
+}
+
+func (s *StackDefinition) GetName() (string, error) {
+	if s.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return s.Name, nil
+}
+
+func (s *StackDefinition) GetServiceNames() []string {
+	names := []string{}
+	for name := range s.Services {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (s *StackDefinition) GetService(serviceName string) (*ServiceDefinition, error) {
+	if serviceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	service, ok := s.Services[serviceName]
+	if !ok || service == nil {
+		return nil, tracederrors.TracedErrorf("Service '%s' not defined in stack '%s'. Available services are: '%v'.", serviceName, s.Name, s.GetServiceNames())
+	}
+
+	return service, nil
+}
+
+func (s *StackDefinition) Validate() error {
+	if s.Name == "" {
+		return tracederrors.TracedError("Stack definition has no name.")
+	}
+
+	if len(s.Services) == 0 {
+		return tracederrors.TracedErrorf("Stack definition '%s' has no services.", s.Name)
+	}
+
+	for _, serviceName := range s.GetServiceNames() {
+		service, err := s.GetService(serviceName)
+		if err != nil {
+			return err
+		}
+
+		if service.Image == "" {
+			return tracederrors.TracedErrorf("Service '%s' of stack '%s' has no image.", serviceName, s.Name)
+		}
+
+		for networkName := range service.Networks {
+			if _, ok := s.Networks[networkName]; !ok {
+				return tracederrors.TracedErrorf("Service '%s' of stack '%s' uses undefined network '%s'.", serviceName, s.Name, networkName)
+			}
+		}
+
+		for dependencyName, dependency := range service.DependsOn {
+			dependencyService, ok := s.Services[dependencyName]
+			if !ok {
+				return tracederrors.TracedErrorf("Service '%s' of stack '%s' depends on undefined service '%s'.", serviceName, s.Name, dependencyName)
+			}
+
+			switch dependency.Condition {
+			case DependencyConditionServiceStarted:
+			case DependencyConditionServiceHealthy:
+				if dependencyService.Healthcheck == nil {
+					return tracederrors.TracedErrorf("Service '%s' of stack '%s' waits for '%s' to be healthy but '%s' has no healthcheck.", serviceName, s.Name, dependencyName, dependencyName)
+				}
+			default:
+				return tracederrors.TracedErrorf("Unsupported depends_on condition '%s' in service '%s' of stack '%s'.", dependency.Condition, serviceName, s.Name)
+			}
+		}
+
+		if service.Healthcheck != nil {
+			err := service.Healthcheck.Validate()
+			if err != nil {
+				return tracederrors.TracedErrorf("Invalid healthcheck of service '%s' in stack '%s': %w", serviceName, s.Name, err)
+			}
+		}
+	}
+
+	_, err := s.GetServiceNamesInStartOrder()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the service names ordered so every service comes after the services it depends on.
+// Services without dependencies between each other are sorted by name.
+func (s *StackDefinition) GetServiceNamesInStartOrder() ([]string, error) {
+	ordered := []string{}
+
+	for len(ordered) < len(s.Services) {
+		added := false
+
+		for _, serviceName := range s.GetServiceNames() {
+			if slices.Contains(ordered, serviceName) {
+				continue
+			}
+
+			dependenciesStarted := true
+			for dependencyName := range s.Services[serviceName].DependsOn {
+				if !slices.Contains(ordered, dependencyName) {
+					dependenciesStarted = false
+					break
+				}
+			}
+
+			if dependenciesStarted {
+				ordered = append(ordered, serviceName)
+				added = true
+			}
+		}
+
+		if !added {
+			return nil, tracederrors.TracedErrorf("Cyclic depends_on between the services of stack '%s' detected.", s.Name)
+		}
+	}
+
+	return ordered, nil
+}
+
+func (h *HealthcheckDefinition) Validate() error {
+	_, err := h.GetCommand()
+	if err != nil {
+		return err
+	}
+
+	_, err = h.GetIntervalOrDefault()
+	if err != nil {
+		return err
+	}
+
+	_, err = h.GetTimeoutOrDefault()
+	if err != nil {
+		return err
+	}
+
+	_, err = h.GetStartPeriodOrDefault()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the command to run inside the container to check the health.
+func (h *HealthcheckDefinition) GetCommand() ([]string, error) {
+	if len(h.Test) == 0 {
+		return nil, tracederrors.TracedError("Test not set")
+	}
+
+	if len(h.Test) == 1 {
+		return []string{"sh", "-c", h.Test[0]}, nil
+	}
+
+	switch h.Test[0] {
+	case "CMD":
+		return h.Test[1:], nil
+	case "CMD-SHELL":
+		return []string{"sh", "-c", strings.Join(h.Test[1:], " ")}, nil
+	default:
+		return nil, tracederrors.TracedErrorf("Unsupported healthcheck test '%v'. Use 'CMD' or 'CMD-SHELL' as first element.", h.Test)
+	}
+}
+
+func parseDurationOrDefault(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, tracederrors.TracedErrorf("Failed to parse duration '%s': %w", value, err)
+	}
+
+	return duration, nil
+}
+
+// Defaults to 30s like docker.
+func (h *HealthcheckDefinition) GetIntervalOrDefault() (time.Duration, error) {
+	return parseDurationOrDefault(h.Interval, 30*time.Second)
+}
+
+// Defaults to 30s like docker.
+func (h *HealthcheckDefinition) GetTimeoutOrDefault() (time.Duration, error) {
+	return parseDurationOrDefault(h.Timeout, 30*time.Second)
+}
+
+func (h *HealthcheckDefinition) GetStartPeriodOrDefault() (time.Duration, error) {
+	return parseDurationOrDefault(h.StartPeriod, 0)
+}
+
+// Defaults to 3 like docker.
+func (h *HealthcheckDefinition) GetRetriesOrDefault() int {
+	if h.Retries <= 0 {
+		return 3
+	}
+
+	return h.Retries
+}
+
+// Returns the healthcheck options used to define the docker HEALTHCHECK of the service container.
+func (h *HealthcheckDefinition) GetHealthcheckOptions() (*dockeroptions.HealthcheckOptions, error) {
+	command, err := h.GetCommand()
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := h.GetIntervalOrDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := h.GetTimeoutOrDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	startPeriod, err := h.GetStartPeriodOrDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	return &dockeroptions.HealthcheckOptions{
+		Command:     command,
+		Interval:    interval,
+		Timeout:     timeout,
+		StartPeriod: startPeriod,
+		Retries:     h.GetRetriesOrDefault(),
+	}, nil
+}
+
+// Returns the maximum time docker needs to report the service as healthy or unhealthy.
+func (h *HealthcheckDefinition) GetMaxTimeUntilHealthStatusKnown() (time.Duration, error) {
+	options, err := h.GetHealthcheckOptions()
+	if err != nil {
+		return 0, err
+	}
+
+	return options.StartPeriod + time.Duration(options.Retries+1)*(options.Interval+options.Timeout), nil
+}
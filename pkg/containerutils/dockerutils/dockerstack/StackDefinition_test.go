@@ -0,0 +1,164 @@
+package dockerstack_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockerstack"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/nativedocker"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+const exampleStackYaml = `
+name: example
+services:
+  app:
+    image: alpine
+    command: ["sleep", "infinity"]
+    environment:
+      - DB_HOST=db
+    ports:
+      - "8080:80"
+    networks:
+      backend:
+        aliases:
+          - application
+      frontend:
+    depends_on:
+      db:
+        condition: service_healthy
+      mock:
+  db:
+    image: postgres
+    environment:
+      POSTGRES_PASSWORD: secret
+    volumes:
+      - dbdata:/var/lib/postgresql/data
+      - ./init:/docker-entrypoint-initdb.d:ro
+    networks:
+      - backend
+    healthcheck:
+      test: ["CMD-SHELL", "pg_isready -U postgres"]
+      interval: 1s
+      retries: 10
+  mock:
+    image: alpine
+    command: sleep infinity
+networks:
+  backend:
+  frontend:
+volumes:
+  dbdata:
+`
+
+func TestLoadStackDefinitionFromYamlString(t *testing.T) {
+	definition, err := dockerstack.LoadStackDefinitionFromYamlString(exampleStackYaml)
+	require.NoError(t, err)
+
+	require.EqualValues(t, "example", definition.Name)
+	require.EqualValues(t, []string{"app", "db", "mock"}, definition.GetServiceNames())
+
+	app, err := definition.GetService("app")
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"sleep", "infinity"}, app.Command)
+	require.EqualValues(t, map[string]string{"DB_HOST": "db"}, app.Environment)
+	require.EqualValues(t, []string{"application"}, app.Networks["backend"].Aliases)
+	require.EqualValues(t, dockerstack.DependencyConditionServiceHealthy, app.DependsOn["db"].Condition)
+	require.EqualValues(t, dockerstack.DependencyConditionServiceStarted, app.DependsOn["mock"].Condition)
+
+	db, err := definition.GetService("db")
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{"POSTGRES_PASSWORD": "secret"}, db.Environment)
+	command, err := db.Healthcheck.GetCommand()
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"sh", "-c", "pg_isready -U postgres"}, command)
+	require.EqualValues(t, 10, db.Healthcheck.GetRetriesOrDefault())
+
+	mock, err := definition.GetService("mock")
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"sleep infinity"}, mock.Command)
+
+	startOrder, err := definition.GetServiceNamesInStartOrder()
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"db", "mock", "app"}, startOrder)
+}
+
+func TestLoadStackDefinitionFromYamlString_Invalid(t *testing.T) {
+	tests := []struct {
+		description string
+		yamlString  string
+	}{
+		{"no name", "services:\n  a:\n    image: alpine\n"},
+		{"no services", "name: x\n"},
+		{"no image", "name: x\nservices:\n  a:\n    command: ls\n"},
+		{"undefined dependency", "name: x\nservices:\n  a:\n    image: alpine\n    depends_on: [b]\n"},
+		{"cyclic dependency", "name: x\nservices:\n  a:\n    image: alpine\n    depends_on: [b]\n  b:\n    image: alpine\n    depends_on: [a]\n"},
+		{"healthy without healthcheck", "name: x\nservices:\n  a:\n    image: alpine\n    depends_on:\n      b:\n        condition: service_healthy\n  b:\n    image: alpine\n"},
+		{"unknown condition", "name: x\nservices:\n  a:\n    image: alpine\n    depends_on:\n      b:\n        condition: service_completed_successfully\n  b:\n    image: alpine\n"},
+		{"undefined network", "name: x\nservices:\n  a:\n    image: alpine\n    networks: [n]\n"},
+		{"invalid healthcheck interval", "name: x\nservices:\n  a:\n    image: alpine\n    healthcheck:\n      test: ls\n      interval: often\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			_, err := dockerstack.LoadStackDefinitionFromYamlString(tt.yamlString)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestStack_GetRunContainerOptions(t *testing.T) {
+	definition, err := dockerstack.LoadStackDefinitionFromYamlString(exampleStackYaml)
+	require.NoError(t, err)
+
+	stack, err := dockerstack.NewStack(nativedocker.NewDocker(), definition)
+	require.NoError(t, err)
+
+	options, err := stack.GetRunContainerOptions("app")
+	require.NoError(t, err)
+	require.EqualValues(t, "example-app", options.Name)
+	require.EqualValues(t, "alpine", options.ImageName)
+	require.EqualValues(t, "example_backend", options.Network)
+	require.EqualValues(t, []string{"app", "application"}, options.NetworkAliases)
+	require.EqualValues(t, []string{"8080:80"}, options.Ports)
+
+	options, err = stack.GetRunContainerOptions("db")
+	require.NoError(t, err)
+	require.EqualValues(t, []dockeroptions.VolumeMount{{VolumeName: "example_dbdata", Target: "/var/lib/postgresql/data"}}, options.VolumeMounts)
+	require.Len(t, options.Mounts, 1)
+	require.True(t, strings.HasSuffix(options.Mounts[0], "/init:/docker-entrypoint-initdb.d:ro"))
+	require.EqualValues(
+		t,
+		&dockeroptions.HealthcheckOptions{
+			Command:  []string{"sh", "-c", "pg_isready -U postgres"},
+			Interval: time.Second,
+			Timeout:  30 * time.Second,
+			Retries:  10,
+		},
+		options.Healthcheck,
+	)
+
+	options, err = stack.GetRunContainerOptions("mock")
+	require.NoError(t, err)
+	require.EqualValues(t, "example_default", options.Network)
+	require.Nil(t, options.Healthcheck)
+}
+
+func TestNewStack_UndefinedNamedVolume(t *testing.T) {
+	definition, err := dockerstack.LoadStackDefinitionFromYamlString("name: x\nservices:\n  a:\n    image: alpine\n    volumes: [data:/data]\n")
+	require.NoError(t, err)
+
+	_, err = dockerstack.NewStack(nativedocker.NewDocker(), definition)
+	require.Error(t, err)
+}
+
+func TestNewStack_UnsupportedVolumeOption(t *testing.T) {
+	definition, err := dockerstack.LoadStackDefinitionFromYamlString("name: x\nservices:\n  a:\n    image: alpine\n    volumes: [\"./data:/data:z\"]\n")
+	require.NoError(t, err)
+
+	_, err = dockerstack.NewStack(nativedocker.NewDocker(), definition)
+	require.Error(t, err)
+}
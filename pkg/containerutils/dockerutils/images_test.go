@@ -1,13 +1,17 @@
 package dockerutils_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorbash"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/testutils"
 )
 
@@ -290,3 +294,207 @@ func Test_BuildContainerImage_Validation(t *testing.T) {
 		)
 	}
 }
+
+func Test_BuildContainerImage_TargetLabelsAndBuildLogs(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExecutorDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				docker := getDockerImplementationByName(tt.implementationName)
+
+				const imageName = "test-build-target-and-labels:latest"
+
+				err := docker.RemoveImage(ctx, imageName, &dockeroptions.RemoveOptions{})
+				require.NoError(t, err)
+				defer docker.RemoveImage(ctx, imageName, &dockeroptions.RemoveOptions{})
+
+				// The 'final' stage fails to build. Selecting the 'builder' target skips it:
+				dockerfileContent := `FROM alpine:latest AS builder
+RUN echo "hello from builder stage"
+RUN echo builder > /stage.txt
+
+FROM builder AS final
+RUN exit 1`
+
+				buildLogs := new(bytes.Buffer)
+				image, err := docker.BuildImage(ctx, &dockeroptions.BuildContainerOptions{
+					ImageNameAndTag:   imageName,
+					DockerfileContent: dockerfileContent,
+					Target:            "builder",
+					Labels:            map[string]string{"org.opencontainers.image.title": "test"},
+					NoCache:           true,
+					BuildLogWriter:    buildLogs,
+				})
+				require.NoError(t, err)
+				require.Contains(t, buildLogs.String(), "hello from builder stage")
+
+				imageId, err := image.GetId(ctx)
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(imageId, "sha256:"))
+
+				imageIdFromDocker, err := docker.GetImageId(ctx, imageName)
+				require.NoError(t, err)
+				require.EqualValues(t, imageId, imageIdFromDocker)
+			},
+		)
+	}
+}
+
+func Test_BuildContainerImage_FailingBuild(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExecutorDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				docker := getDockerImplementationByName(tt.implementationName)
+
+				const imageName = "test-build-failing:latest"
+
+				buildLogs := new(bytes.Buffer)
+				_, err := docker.BuildImage(ctx, &dockeroptions.BuildContainerOptions{
+					ImageNameAndTag:   imageName,
+					DockerfileContent: "FROM alpine:latest\nRUN echo 'about to fail' && exit 1",
+					BuildLogWriter:    buildLogs,
+				})
+				require.Error(t, err)
+				require.Contains(t, buildLogs.String(), "about to fail")
+			},
+		)
+	}
+}
+
+func Test_BuildContainerImage_Secrets(t *testing.T) {
+	ctx := getCtx()
+
+	const imageName = "test-build-secrets:latest"
+
+	tempDir := t.TempDir()
+	secretPath := filepath.Join(tempDir, "secret.txt")
+	require.NoError(t, os.WriteFile(secretPath, []byte("my-secret-value"), 0600))
+
+	options := &dockeroptions.BuildContainerOptions{
+		ImageNameAndTag:   imageName,
+		DockerfileContent: "FROM alpine:latest\nRUN --mount=type=secret,id=mysecret test \"$(cat /run/secrets/mysecret)\" = my-secret-value",
+		Secrets:           []dockeroptions.BuildSecret{{Id: "mysecret", SourceFilePath: secretPath}},
+	}
+
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExecutorDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				docker := getDockerImplementationByName(tt.implementationName)
+				defer docker.RemoveImage(ctx, imageName, &dockeroptions.RemoveOptions{})
+
+				image, err := docker.BuildImage(ctx, options)
+				require.NoError(t, err)
+
+				exists, err := image.Exists(ctx)
+				require.NoError(t, err)
+				require.True(t, exists)
+			},
+		)
+	}
+}
+
+func Test_BuildContainerImage_BuilderNotSupportedByNativeDocker(t *testing.T) {
+	ctx := getCtx()
+
+	_, err := getDockerImplementationByName("nativeDocker").BuildImage(ctx, &dockeroptions.BuildContainerOptions{
+		ImageNameAndTag:   "test-build-builder:latest",
+		DockerfileContent: "FROM alpine:latest",
+		Builder:           "ci-builder",
+	})
+	require.Error(t, err)
+}
+
+func Test_BuildContainerImage_CacheToAndFromDirectory(t *testing.T) {
+	ctx := getCtx()
+
+	const imageName = "test-build-cache:latest"
+	const builderName = "test-build-cache"
+	const dockerfileContent = "FROM alpine:latest\nRUN echo cached > /cached.txt"
+
+	// Exporting the cache needs a builder supporting cache export like the docker-container driver:
+	_, err := commandexecutorbash.RunCommand(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"docker", "buildx", "create", "--name", builderName, "--driver", "docker-container"},
+	})
+	require.NoError(t, err)
+	defer commandexecutorbash.RunCommand(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"docker", "buildx", "rm", builderName},
+	})
+
+	cacheDir := filepath.Join(t.TempDir(), "cache")
+
+	docker := getDockerImplementationByName("commandExecutorDocker")
+	defer docker.RemoveImage(ctx, imageName, &dockeroptions.RemoveOptions{})
+
+	_, err = docker.BuildImage(ctx, &dockeroptions.BuildContainerOptions{
+		ImageNameAndTag:   imageName,
+		DockerfileContent: dockerfileContent,
+		Builder:           builderName,
+		CacheToDirectory:  cacheDir,
+	})
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(cacheDir, "index.json"))
+
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeDocker"},
+		{"commandExecutorDocker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				docker := getDockerImplementationByName(tt.implementationName)
+				defer docker.RemoveImage(ctx, imageName, &dockeroptions.RemoveOptions{})
+
+				image, err := docker.BuildImage(ctx, &dockeroptions.BuildContainerOptions{
+					ImageNameAndTag:    imageName,
+					DockerfileContent:  dockerfileContent,
+					CacheFromDirectory: cacheDir,
+				})
+				require.NoError(t, err)
+
+				exists, err := image.Exists(ctx)
+				require.NoError(t, err)
+				require.True(t, exists)
+			},
+		)
+	}
+}
+
+func Test_BuildContainerImage_CacheToDirectoryNotSupportedByNativeDocker(t *testing.T) {
+	ctx := getCtx()
+
+	_, err := getDockerImplementationByName("nativeDocker").BuildImage(ctx, &dockeroptions.BuildContainerOptions{
+		ImageNameAndTag:   "test-build-cache-to:latest",
+		DockerfileContent: "FROM alpine:latest",
+		CacheToDirectory:  filepath.Join(t.TempDir(), "cache"),
+	})
+	require.ErrorContains(t, err, "not supported")
+}
@@ -4,14 +4,20 @@ import (
 	"archive/tar"
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/moby/moby/api/types/jsonstream"
 	"github.com/moby/moby/client"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/containerinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfiles"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
@@ -19,6 +25,9 @@ import (
 )
 
 // BuildImage builds a Docker image using the native Docker API.
+//
+// Secrets and importing a local cache directory are supported by using the BuildKit instance embedded in the docker daemon.
+// A buildx Builder and exporting the cache are only supported by the command executor docker implementation.
 func (d *Docker) BuildImage(ctx context.Context, options *dockeroptions.BuildContainerOptions) (containerinterfaces.Image, error) {
 	if options == nil {
 		return nil, tracederrors.TracedErrorNil("options")
@@ -28,6 +37,16 @@ func (d *Docker) BuildImage(ctx context.Context, options *dockeroptions.BuildCon
 		return nil, err
 	}
 
+	// Builders are managed by the 'docker buildx' CLI and are not available using the docker API:
+	if options.Builder != "" {
+		return nil, tracederrors.TracedErrorf("Building image '%s' using builder '%s' is not supported by the native docker implementation. Use the command executor docker implementation instead.", options.ImageNameAndTag, options.Builder)
+	}
+
+	// The docker driver of the embedded BuildKit does not support exporting the cache:
+	if options.CacheToDirectory != "" {
+		return nil, tracederrors.TracedErrorf("Exporting the build cache of image '%s' to '%s' is not supported by the native docker implementation. Use the command executor docker implementation with a Builder supporting cache export instead.", options.ImageNameAndTag, options.CacheToDirectory)
+	}
+
 	logging.LogInfoByCtxf(ctx, "Building Docker image '%s' started.", options.ImageNameAndTag)
 
 	cli, err := client.New(client.FromEnv)
@@ -36,6 +55,16 @@ func (d *Docker) BuildImage(ctx context.Context, options *dockeroptions.BuildCon
 	}
 	defer cli.Close()
 
+	// Secrets and local cache directories need a BuildKit session which is not available using the plain docker build API:
+	if options.IsBuildKitRequired() {
+		err = d.buildImageWithBuildKitSession(ctx, cli, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return d.getBuiltImage(ctx, options)
+	}
+
 	// Prepare build options
 	buildOptions := client.ImageBuildOptions{
 		Tags:        []string{options.ImageNameAndTag},
@@ -43,6 +72,17 @@ func (d *Docker) BuildImage(ctx context.Context, options *dockeroptions.BuildCon
 		PullParent:  options.PullParentImages,
 		Remove:      true,
 		ForceRemove: true,
+		Target:      options.Target,
+		Labels:      options.Labels,
+	}
+
+	if options.Platform != "" {
+		platformOs, platformArch, platformVariant, err := options.GetPlatformOsArchAndVariant()
+		if err != nil {
+			return nil, err
+		}
+
+		buildOptions.Platforms = []ocispec.Platform{{OS: platformOs, Architecture: platformArch, Variant: platformVariant}}
 	}
 
 	// Convert build args to the required format (map[string]*string)
@@ -127,12 +167,8 @@ func (d *Docker) BuildImage(ctx context.Context, options *dockeroptions.BuildCon
 	}
 	defer response.Body.Close()
 
-	buildOutput, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to read build output: %w", err)
-	}
-
-	logging.LogInfoByCtxf(ctx, "Build output: %s", string(buildOutput))
+	buildOutput, err := processBuildOutput(response.Body, options.BuildLogWriter)
+	logging.LogInfoByCtxf(ctx, "Build output: %s", buildOutput)
 
 	if cleanupFunc != nil {
 		if cleanupErr := cleanupFunc(); cleanupErr != nil {
@@ -140,11 +176,101 @@ func (d *Docker) BuildImage(ctx context.Context, options *dockeroptions.BuildCon
 		}
 	}
 
-	logging.LogChangedByCtxf(ctx, "Built Docker image '%s'.", options.ImageNameAndTag)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to build image '%s': %w", options.ImageNameAndTag, err)
+	}
+
+	return d.getBuiltImage(ctx, options)
+}
+
+// getBuiltImage logs the id of the built image and returns it.
+func (d *Docker) getBuiltImage(ctx context.Context, options *dockeroptions.BuildContainerOptions) (containerinterfaces.Image, error) {
+	imageId, err := d.GetImageId(contextutils.WithSilent(ctx), options.ImageNameAndTag)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Built Docker image '%s' with id '%s'.", options.ImageNameAndTag, imageId)
 
 	return d.GetImageByName(options.ImageNameAndTag)
 }
 
+// buildImageWithBuildKitSession prepares the Dockerfile and build context and builds the image using BuildKit.
+func (d *Docker) buildImageWithBuildKitSession(ctx context.Context, cli *client.Client, options *dockeroptions.BuildContainerOptions) error {
+	dockerfilePath := options.DockerfilePath
+	if options.DockerfileContent != "" {
+		tempDir, err := tempfiles.CreateTempDir(ctx)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to create temp directory: %w", err)
+		}
+		defer func() {
+			if cleanupErr := nativefiles.Delete(ctx, tempDir, nil); cleanupErr != nil {
+				logging.LogErrorByCtxf(ctx, "Failed to cleanup temp directory: %v", cleanupErr)
+			}
+		}()
+
+		dockerfilePath = filepath.Join(tempDir, "Dockerfile")
+		if err := nativefiles.WriteString(ctx, dockerfilePath, options.DockerfileContent); err != nil {
+			return tracederrors.TracedErrorf("Failed to write Dockerfile: %w", err)
+		}
+	}
+
+	buildContextPath := options.BuildContextPath
+	if buildContextPath == "" {
+		buildContextPath = filepath.Dir(dockerfilePath)
+	}
+
+	return d.buildImageUsingBuildKit(ctx, cli, options, buildContextPath, dockerfilePath)
+}
+
+// processBuildOutput decodes the JSON messages streamed by the docker daemon during a build.
+// The build logs are written to buildLogWriter as soon as they are received and are returned as string.
+// An error is returned if the daemon reports a failed build.
+func processBuildOutput(body io.Reader, buildLogWriter io.Writer) (string, error) {
+	if body == nil {
+		return "", tracederrors.TracedErrorNil("body")
+	}
+
+	buildOutput := new(strings.Builder)
+
+	decoder := json.NewDecoder(body)
+	for {
+		var message jsonstream.Message
+		err := decoder.Decode(&message)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return buildOutput.String(), tracederrors.TracedErrorf("Failed to decode build output: %w", err)
+		}
+
+		if message.Error != nil {
+			return buildOutput.String(), tracederrors.TracedErrorf("Build failed: %s", message.Error.Message)
+		}
+
+		logLine := message.Stream
+		if logLine == "" && message.Status != "" {
+			logLine = message.Status + "\n"
+		}
+
+		if logLine == "" {
+			continue
+		}
+
+		buildOutput.WriteString(logLine)
+
+		if buildLogWriter != nil {
+			_, err = io.WriteString(buildLogWriter, logLine)
+			if err != nil {
+				return buildOutput.String(), tracederrors.TracedErrorf("Failed to write build log: %w", err)
+			}
+		}
+	}
+
+	return buildOutput.String(), nil
+}
+
 func createTarArchive(dir string) (io.ReadCloser, error) {
 	buf := new(bytes.Buffer)
 	tw := tar.NewWriter(buf)
@@ -0,0 +1,244 @@
+package nativedocker
+
+import (
+	"context"
+	"io"
+	"maps"
+	"net"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	bkclient "github.com/moby/buildkit/client"
+	"github.com/moby/buildkit/session"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
+	"github.com/moby/buildkit/util/progress/progressui"
+	"github.com/moby/moby/client"
+	"github.com/tonistiigi/fsutil"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// newBuildKitClient returns a client for the BuildKit instance embedded in the docker daemon.
+// The connection and the build sessions are tunneled through the docker API like 'docker buildx' does for the 'docker' driver.
+func newBuildKitClient(ctx context.Context, cli *client.Client) (*bkclient.Client, error) {
+	if cli == nil {
+		return nil, tracederrors.TracedErrorNil("cli")
+	}
+
+	buildKitClient, err := bkclient.New(
+		ctx,
+		"",
+		bkclient.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return cli.DialHijack(ctx, "/grpc", "h2c", nil)
+		}),
+		bkclient.WithSessionDialer(func(ctx context.Context, proto string, meta map[string][]string) (net.Conn, error) {
+			return cli.DialHijack(ctx, "/session", proto, meta)
+		}),
+	)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create BuildKit client: %w", err)
+	}
+
+	return buildKitClient, nil
+}
+
+// getBuildKitFrontendAttrs returns the attributes passed to the dockerfile frontend.
+func getBuildKitFrontendAttrs(options *dockeroptions.BuildContainerOptions, dockerfileName string) (map[string]string, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	if dockerfileName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("dockerfileName")
+	}
+
+	frontendAttrs := map[string]string{
+		"filename": dockerfileName,
+	}
+
+	if options.Target != "" {
+		frontendAttrs["target"] = options.Target
+	}
+
+	if options.Platform != "" {
+		frontendAttrs["platform"] = options.Platform
+	}
+
+	if options.NoCache {
+		frontendAttrs["no-cache"] = ""
+	}
+
+	if options.PullParentImages {
+		frontendAttrs["image-resolve-mode"] = "pull"
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(options.AdditionalBuildArgs)) {
+		frontendAttrs["build-arg:"+key] = options.AdditionalBuildArgs[key]
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(options.Labels)) {
+		frontendAttrs["label:"+key] = options.Labels[key]
+	}
+
+	return frontendAttrs, nil
+}
+
+// getBuildKitSessionAttachables returns the session attachables providing the build secrets.
+func getBuildKitSessionAttachables(options *dockeroptions.BuildContainerOptions) ([]session.Attachable, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	if len(options.Secrets) <= 0 {
+		return []session.Attachable{}, nil
+	}
+
+	sources := []secretsprovider.Source{}
+	for _, secret := range options.Secrets {
+		id, err := secret.GetId()
+		if err != nil {
+			return nil, err
+		}
+
+		sourceFilePath, err := secret.GetSourceFilePath()
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, secretsprovider.Source{ID: id, FilePath: sourceFilePath})
+	}
+
+	store, err := secretsprovider.NewStore(sources)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to load build secrets: %w", err)
+	}
+
+	return []session.Attachable{secretsprovider.NewSecretProvider(store)}, nil
+}
+
+// getBuildKitSolveOpt returns the BuildKit solve options to build the image defined by the Dockerfile in dockerfileDirectory using buildContextPath as build context.
+func getBuildKitSolveOpt(options *dockeroptions.BuildContainerOptions, buildContextPath string, dockerfileDirectory string, dockerfileName string) (*bkclient.SolveOpt, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	if buildContextPath == "" {
+		return nil, tracederrors.TracedErrorEmptyString("buildContextPath")
+	}
+
+	if dockerfileDirectory == "" {
+		return nil, tracederrors.TracedErrorEmptyString("dockerfileDirectory")
+	}
+
+	frontendAttrs, err := getBuildKitFrontendAttrs(options, dockerfileName)
+	if err != nil {
+		return nil, err
+	}
+
+	attachables, err := getBuildKitSessionAttachables(options)
+	if err != nil {
+		return nil, err
+	}
+
+	contextFs, err := fsutil.NewFS(buildContextPath)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to open build context '%s': %w", buildContextPath, err)
+	}
+
+	dockerfileFs, err := fsutil.NewFS(dockerfileDirectory)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to open Dockerfile directory '%s': %w", dockerfileDirectory, err)
+	}
+
+	solveOpt := &bkclient.SolveOpt{
+		Frontend:      "dockerfile.v0",
+		FrontendAttrs: frontendAttrs,
+		LocalMounts: map[string]fsutil.FS{
+			"context":    contextFs,
+			"dockerfile": dockerfileFs,
+		},
+		Session: attachables,
+		// The 'image' exporter of the BuildKit embedded in the docker daemon stores the image in the local image store:
+		Exports: []bkclient.ExportEntry{
+			{
+				Type:  bkclient.ExporterImage,
+				Attrs: map[string]string{"name": options.ImageNameAndTag},
+			},
+		},
+	}
+
+	if options.CacheFromDirectory != "" {
+		solveOpt.CacheImports = append(solveOpt.CacheImports, bkclient.CacheOptionsEntry{
+			Type:  "local",
+			Attrs: map[string]string{"src": options.CacheFromDirectory},
+		})
+	}
+
+	return solveOpt, nil
+}
+
+// buildImageUsingBuildKit builds the image using the BuildKit instance embedded in the docker daemon.
+// This is needed for secrets and local cache directories which are not supported by the plain docker build API.
+//
+// The build logs are written in plain progress format to options.BuildLogWriter while the build is running.
+func (d *Docker) buildImageUsingBuildKit(ctx context.Context, cli *client.Client, options *dockeroptions.BuildContainerOptions, buildContextPath string, dockerfilePath string) error {
+	if cli == nil {
+		return tracederrors.TracedErrorNil("cli")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	if dockerfilePath == "" {
+		return tracederrors.TracedErrorEmptyString("dockerfilePath")
+	}
+
+	solveOpt, err := getBuildKitSolveOpt(options, buildContextPath, filepath.Dir(dockerfilePath), filepath.Base(dockerfilePath))
+	if err != nil {
+		return err
+	}
+
+	buildKitClient, err := newBuildKitClient(ctx, cli)
+	if err != nil {
+		return err
+	}
+	defer buildKitClient.Close()
+
+	buildOutput := new(strings.Builder)
+	var logWriter io.Writer = buildOutput
+	if options.BuildLogWriter != nil {
+		logWriter = io.MultiWriter(buildOutput, options.BuildLogWriter)
+	}
+
+	display, err := progressui.NewDisplay(logWriter, progressui.PlainMode)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to create build log display: %w", err)
+	}
+
+	statusChan := make(chan *bkclient.SolveStatus)
+	errGroup, errGroupCtx := errgroup.WithContext(ctx)
+
+	errGroup.Go(func() error {
+		_, err := buildKitClient.Solve(errGroupCtx, nil, *solveOpt, statusChan)
+		return err
+	})
+
+	errGroup.Go(func() error {
+		// The status channel is closed by Solve:
+		_, err := display.UpdateFrom(context.WithoutCancel(errGroupCtx), statusChan)
+		return err
+	})
+
+	err = errGroup.Wait()
+	logging.LogInfoByCtxf(ctx, "Build output: %s", buildOutput.String())
+	if err != nil {
+		return tracederrors.TracedErrorf("BuildKit build of image '%s' failed: %w", options.ImageNameAndTag, err)
+	}
+
+	return nil
+}
@@ -652,3 +652,37 @@ func (c *Container) WaitUntilFinished(ctx context.Context, timeout time.Duration
 
 	return tracederrors.TracedErrorf("Container '%s' did not finish within timeout %v", name, timeout)
 }
+
+func (c *Container) GetHealthStatus(ctx context.Context) (string, error) {
+	name, err := c.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	inspect, err := c.inspect(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	status := dockergeneric.HealthStatusNone
+	if inspect.Container.State != nil && inspect.Container.State.Health != nil {
+		status = string(inspect.Container.State.Health.Status)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker container '%s' has health status '%s'.", name, status)
+
+	return status, nil
+}
+
+func (c *Container) IsHealthy(ctx context.Context) (bool, error) {
+	status, err := c.GetHealthStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return status == dockergeneric.HealthStatusHealthy, nil
+}
+
+func (c *Container) WaitUntilHealthy(ctx context.Context, timeout time.Duration) error {
+	return dockergeneric.WaitUntilHealthy(ctx, c, timeout)
+}
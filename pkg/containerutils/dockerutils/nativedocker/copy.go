@@ -0,0 +1,206 @@
+package nativedocker
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+
+	"github.com/moby/moby/client"
+
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/tarutils"
+	"github.com/asciich/asciichgolangpublic/pkg/archiveutils/tarutils/tarparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Sends the tar archive to the container where it is extracted into destDirPath.
+func (c *Container) copyTarToContainer(ctx context.Context, content io.Reader, destDirPath string) error {
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.CopyToContainer(ctx, name, client.CopyToContainerOptions{
+		DestinationPath: destDirPath,
+		Content:         content,
+	})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to copy into '%s' of container '%s': %w", destDirPath, name, err)
+	}
+
+	return nil
+}
+
+// Returns the tar archive of srcPath in the container. The caller has to close it.
+func (c *Container) copyTarFromContainer(ctx context.Context, srcPath string) (*client.CopyFromContainerResult, error) {
+	name, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.CopyFromContainer(ctx, name, client.CopyFromContainerOptions{
+		SourcePath: srcPath,
+	})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to copy '%s' from container '%s': %w", srcPath, name, err)
+	}
+
+	return &result, nil
+}
+
+func (c *Container) CopyFileToContainer(ctx context.Context, localFilePath string, destPath string) error {
+	if localFilePath == "" {
+		return tracederrors.TracedErrorEmptyString("localFilePath")
+	}
+
+	if destPath == "" {
+		return tracederrors.TracedErrorEmptyString("destPath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	fileInfo, err := os.Stat(localFilePath)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to get file info of '%s': %w", localFilePath, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return tracederrors.TracedErrorf("'%s' is not a regular file.", localFilePath)
+	}
+
+	tarReader, err := tarutils.FileToTarReader(localFilePath, &tarparameteroptions.FileToTarOptions{
+		OverrideFileName: path.Base(destPath),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.copyTarToContainer(ctx, tarReader, path.Dir(destPath))
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied local file '%s' to '%s' in container '%s'.", localFilePath, destPath, name)
+
+	return nil
+}
+
+func (c *Container) CopyFileFromContainer(ctx context.Context, srcPath string, localFilePath string) error {
+	if srcPath == "" {
+		return tracederrors.TracedErrorEmptyString("srcPath")
+	}
+
+	if localFilePath == "" {
+		return tracederrors.TracedErrorEmptyString("localFilePath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.copyTarFromContainer(ctx, srcPath)
+	if err != nil {
+		return err
+	}
+	defer result.Content.Close()
+
+	if !result.Stat.Mode.IsRegular() {
+		return tracederrors.TracedErrorf("'%s' in container '%s' is not a regular file.", srcPath, name)
+	}
+
+	err = tarutils.ExtractSingleFileFromTarReader(ctx, result.Content, localFilePath)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied '%s' from container '%s' to local file '%s'.", srcPath, name, localFilePath)
+
+	return nil
+}
+
+func (c *Container) CopyDirectoryToContainer(ctx context.Context, localDirPath string, destDirPath string) error {
+	if localDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("localDirPath")
+	}
+
+	if destDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("destDirPath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	// The archive contains the destination directory itself so docker creates it if missing:
+	tarReader, err := tarutils.DirectoryToTarReader(localDirPath, &tarparameteroptions.DirectoryToTarOptions{
+		PathPrefix: path.Base(destDirPath),
+	})
+	if err != nil {
+		return err
+	}
+	defer tarReader.Close()
+
+	err = c.copyTarToContainer(ctx, tarReader, path.Dir(destDirPath))
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied local directory '%s' to '%s' in container '%s'.", localDirPath, destDirPath, name)
+
+	return nil
+}
+
+func (c *Container) CopyDirectoryFromContainer(ctx context.Context, srcDirPath string, localDirPath string) error {
+	if srcDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("srcDirPath")
+	}
+
+	if localDirPath == "" {
+		return tracederrors.TracedErrorEmptyString("localDirPath")
+	}
+
+	name, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.copyTarFromContainer(ctx, srcDirPath)
+	if err != nil {
+		return err
+	}
+	defer result.Content.Close()
+
+	if !result.Stat.Mode.IsDir() {
+		return tracederrors.TracedErrorf("'%s' in container '%s' is not a directory.", srcDirPath, name)
+	}
+
+	// The entries are prefixed by the base name of srcDirPath:
+	err = tarutils.ExtractTarReaderToDirectory(ctx, result.Content, localDirPath, &tarparameteroptions.ExtractOptions{
+		StripComponents: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Copied directory '%s' from container '%s' to local directory '%s'.", srcDirPath, name, localDirPath)
+
+	return nil
+}
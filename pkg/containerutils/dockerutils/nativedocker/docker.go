@@ -190,21 +190,34 @@ func (d *Docker) RunContainer(ctx context.Context, options *dockeroptions.Docker
 		portBindings[containerPort] = []network.PortBinding{hostBinding}
 	}
 
-	mounts := []mount.Mount{}
-	for _, m := range options.Mounts {
-		splitted := strings.Split(m, ":")
-		if len(splitted) != 2 {
-			return nil, tracederrors.TracedErrorf("Failed to process mount: '%s'.", m)
-		}
+	mounts, err := getMounts(options)
+	if err != nil {
+		return nil, err
+	}
 
-		toAdd := mount.Mount{
-			Type:     mount.TypeBind,
-			Source:   splitted[0],
-			Target:   splitted[1],
-			ReadOnly: false,
-		}
+	hostConfig, containerConfig, err := getRuntimeSettings(options)
+	if err != nil {
+		return nil, err
+	}
 
-		mounts = append(mounts, toAdd)
+	hostConfig.AutoRemove = autoremove
+	hostConfig.PortBindings = portBindings
+	hostConfig.Mounts = mounts
+
+	containerConfig.Env = envVars
+	containerConfig.Cmd = command
+	containerConfig.Entrypoint = entrypoint
+
+	var networkingConfig *network.NetworkingConfig
+	if options.Network != "" {
+		hostConfig.NetworkMode = container.NetworkMode(options.Network)
+		networkingConfig = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				options.Network: {
+					Aliases: options.NetworkAliases,
+				},
+			},
+		}
 	}
 
 	exists, err := d.ContainerExists(ctx, name)
@@ -223,18 +236,11 @@ func (d *Docker) RunContainer(ctx context.Context, options *dockeroptions.Docker
 	if !skipCreation {
 
 		createResult, err := cli.ContainerCreate(ctx, client.ContainerCreateOptions{
-			Name:  name,
-			Image: imageName,
-			Config: &container.Config{
-				Env:        envVars,
-				Cmd:        command,
-				Entrypoint: entrypoint,
-			},
-			HostConfig: &container.HostConfig{
-				AutoRemove:   autoremove,
-				PortBindings: portBindings,
-				Mounts:       mounts,
-			},
+			Name:             name,
+			Image:            imageName,
+			Config:           containerConfig,
+			HostConfig:       hostConfig,
+			NetworkingConfig: networkingConfig,
 		})
 		if err != nil {
 			return nil, tracederrors.TracedErrorf("Failed to create container '%s': %w", name, err)
@@ -347,6 +353,22 @@ func (d *Docker) ImageExists(ctx context.Context, imageName string) (bool, error
 	return true, nil
 }
 
+func (d *Docker) GetImageId(ctx context.Context, imageName string) (string, error) {
+	inspect, err := d.imageInspect(ctx, imageName)
+	if err != nil {
+		return "", err
+	}
+
+	imageId := inspect.ID
+	if imageId == "" {
+		return "", tracederrors.TracedErrorf("Got empty image id for docker image '%s'.", imageName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker image '%s' has id '%s'.", imageName, imageId)
+
+	return imageId, nil
+}
+
 func (d *Docker) GetImageByName(imageName string) (containerinterfaces.Image, error) {
 	image := NewImage()
 
@@ -599,21 +621,9 @@ func (d *Docker) RunCommandInTemporaryContainer(ctx context.Context, options *do
 		portBindings[containerPort] = []network.PortBinding{hostBinding}
 	}
 
-	mounts := []mount.Mount{}
-	for _, m := range options.Mounts {
-		splitted := strings.Split(m, ":")
-		if len(splitted) != 2 {
-			return nil, tracederrors.TracedErrorf("Failed to process mount: '%s'.", m)
-		}
-
-		toAdd := mount.Mount{
-			Type:     mount.TypeBind,
-			Source:   splitted[0],
-			Target:   splitted[1],
-			ReadOnly: false,
-		}
-
-		mounts = append(mounts, toAdd)
+	mounts, err := getMounts(options)
+	if err != nil {
+		return nil, err
 	}
 
 	// Step 1: Create and start the container
@@ -700,3 +710,115 @@ func (d *Docker) RunCommandInTemporaryContainer(ctx context.Context, options *do
 
 	return output, nil
 }
+
+// Returns the bind mounts and named volumes to mount into the container.
+func getMounts(options *dockeroptions.DockerRunContainerOptions) ([]mount.Mount, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	mounts := []mount.Mount{}
+	for _, m := range options.Mounts {
+		splitted := strings.Split(m, ":")
+		if len(splitted) != 2 {
+			return nil, tracederrors.TracedErrorf("Failed to process mount: '%s'.", m)
+		}
+
+		// Like 'docker run -v' a source which is not a path refers to a named volume:
+		mountType := mount.TypeBind
+		if !strings.HasPrefix(splitted[0], "/") && !strings.HasPrefix(splitted[0], ".") {
+			mountType = mount.TypeVolume
+		}
+
+		toAdd := mount.Mount{
+			Type:     mountType,
+			Source:   splitted[0],
+			Target:   splitted[1],
+			ReadOnly: false,
+		}
+
+		mounts = append(mounts, toAdd)
+	}
+
+	for _, v := range options.VolumeMounts {
+		volumeName, err := v.GetVolumeName()
+		if err != nil {
+			return nil, err
+		}
+
+		target, err := v.GetTarget()
+		if err != nil {
+			return nil, err
+		}
+
+		mounts = append(mounts, mount.Mount{
+			Type:     mount.TypeVolume,
+			Source:   volumeName,
+			Target:   target,
+			ReadOnly: v.ReadOnly,
+		})
+	}
+
+	return mounts, nil
+}
+
+// Returns the host and container config with the resource limits, restart policy, healthcheck, labels and user set.
+func getRuntimeSettings(options *dockeroptions.DockerRunContainerOptions) (*container.HostConfig, *container.Config, error) {
+	if options == nil {
+		return nil, nil, tracederrors.TracedErrorNil("options")
+	}
+
+	err := options.ValidateRuntimeSettings()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restartPolicy, err := options.GetRestartPolicyOrEmptyString()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := options.GetUserArgumentOrEmptyString()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hostConfig := &container.HostConfig{
+		ReadonlyRootfs: options.ReadOnlyRootFilesystem,
+		CapDrop:        options.CapDrop,
+		Resources: container.Resources{
+			Memory:   options.MemoryLimitBytes,
+			NanoCPUs: int64(options.CPUs * 1e9),
+		},
+	}
+
+	if restartPolicy != "" {
+		hostConfig.RestartPolicy = container.RestartPolicy{
+			Name:              container.RestartPolicyMode(restartPolicy),
+			MaximumRetryCount: options.RestartPolicyMaxRetries,
+		}
+	}
+
+	containerConfig := &container.Config{
+		Labels: options.Labels,
+		User:   user,
+	}
+
+	if options.Healthcheck != nil {
+		// Use the shell inside the container like 'docker run --health-cmd' does:
+		command, err := options.Healthcheck.GetCommandString()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		containerConfig.Healthcheck = &container.HealthConfig{
+			Test:        []string{"CMD-SHELL", command},
+			Interval:    options.Healthcheck.Interval,
+			Timeout:     options.Healthcheck.Timeout,
+			StartPeriod: options.Healthcheck.StartPeriod,
+			Retries:     options.Healthcheck.Retries,
+		}
+	}
+
+	return hostConfig, containerConfig, nil
+}
@@ -42,6 +42,15 @@ func (i *Image) Exists(ctx context.Context) (bool, error) {
 	return NewDocker().ImageExists(ctx, name)
 }
 
+func (i *Image) GetId(ctx context.Context) (string, error) {
+	name, err := i.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	return NewDocker().GetImageId(ctx, name)
+}
+
 func (i *Image) Remove(ctx context.Context, options *dockeroptions.RemoveOptions) error {
 	name, err := i.GetName()
 	if err != nil {
@@ -0,0 +1,307 @@
+package nativedocker
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"slices"
+
+	"github.com/containerd/errdefs"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/client"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (d *Docker) NetworkExists(ctx context.Context, name string) (bool, error) {
+	if name == "" {
+		return false, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return false, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.NetworkInspect(ctx, name, client.NetworkInspectOptions{})
+	if err != nil {
+		if errors.Is(err, errdefs.ErrNotFound) {
+			logging.LogInfoByCtxf(ctx, "Docker network '%s' does not exist.", name)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to inspect docker network '%s': %w", name, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker network '%s' exists.", name)
+
+	return true, nil
+}
+
+func (d *Docker) CreateNetwork(ctx context.Context, options *dockeroptions.CreateNetworkOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	exists, err := d.NetworkExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		logging.LogInfoByCtxf(ctx, "Docker network '%s' already exists. Skip create.", name)
+		return nil
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	createOptions := client.NetworkCreateOptions{
+		Driver:   options.GetDriverOrDefault(),
+		Labels:   options.Labels,
+		Internal: options.Internal,
+	}
+
+	if options.Subnet != "" {
+		subnet, err := netip.ParsePrefix(options.Subnet)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to parse subnet '%s' of docker network '%s': %w", options.Subnet, name, err)
+		}
+
+		createOptions.IPAM = &network.IPAM{
+			Config: []network.IPAMConfig{{Subnet: subnet}},
+		}
+	}
+
+	_, err = cli.NetworkCreate(ctx, name, createOptions)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to create docker network '%s': %w", name, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Created docker network '%s'.", name)
+
+	return nil
+}
+
+func (d *Docker) RemoveNetwork(ctx context.Context, name string) error {
+	if name == "" {
+		return tracederrors.TracedErrorEmptyString("name")
+	}
+
+	exists, err := d.NetworkExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logging.LogInfoByCtxf(ctx, "Docker network '%s' is already absent. Skip remove.", name)
+		return nil
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.NetworkRemove(ctx, name, client.NetworkRemoveOptions{})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to remove docker network '%s': %w", name, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Removed docker network '%s'.", name)
+
+	return nil
+}
+
+func (d *Docker) InspectNetwork(ctx context.Context, name string) (*dockergeneric.NetworkInfo, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.NetworkInspect(ctx, name, client.NetworkInspectOptions{})
+	if err != nil {
+		if errors.Is(err, errdefs.ErrNotFound) {
+			return nil, tracederrors.TracedErrorf("Docker network '%s' does not exist: %w: %w", name, dockergeneric.ErrDockerNetworkNotFound, err)
+		}
+
+		return nil, tracederrors.TracedErrorf("Failed to inspect docker network '%s': %w", name, err)
+	}
+
+	info := &dockergeneric.NetworkInfo{
+		Name:           result.Network.Name,
+		Id:             result.Network.ID,
+		Driver:         result.Network.Driver,
+		Internal:       result.Network.Internal,
+		Labels:         result.Network.Labels,
+		Subnets:        []string{},
+		ContainerNames: []string{},
+	}
+
+	for _, config := range result.Network.IPAM.Config {
+		if config.Subnet.IsValid() {
+			info.Subnets = append(info.Subnets, config.Subnet.String())
+		}
+	}
+
+	for _, endpoint := range result.Network.Containers {
+		info.ContainerNames = append(info.ContainerNames, endpoint.Name)
+	}
+	slices.Sort(info.ContainerNames)
+
+	logging.LogInfoByCtxf(ctx, "Inspected docker network '%s'.", name)
+
+	return info, nil
+}
+
+func (d *Docker) ListNetworkNames(ctx context.Context) ([]string, error) {
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.NetworkList(ctx, client.NetworkListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list docker networks: %w", err)
+	}
+
+	names := []string{}
+	for _, n := range result.Items {
+		names = append(names, n.Name)
+	}
+	slices.Sort(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' docker networks.", len(names))
+
+	return names, nil
+}
+
+func (d *Docker) ConnectContainerToNetwork(ctx context.Context, options *dockeroptions.ConnectNetworkOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	networkName, err := options.GetNetworkName()
+	if err != nil {
+		return err
+	}
+
+	containerName, err := options.GetContainerName()
+	if err != nil {
+		return err
+	}
+
+	info, err := d.InspectNetwork(contextutils.WithSilent(ctx), networkName)
+	if err != nil {
+		return err
+	}
+
+	if info.IsContainerConnected(containerName) {
+		logging.LogInfoByCtxf(ctx, "Container '%s' is already connected to docker network '%s'.", containerName, networkName)
+		return nil
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.NetworkConnect(ctx, networkName, client.NetworkConnectOptions{
+		Container: containerName,
+		EndpointConfig: &network.EndpointSettings{
+			Aliases: options.Aliases,
+		},
+	})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to connect container '%s' to docker network '%s': %w", containerName, networkName, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Connected container '%s' to docker network '%s'.", containerName, networkName)
+
+	return nil
+}
+
+func (d *Docker) DisconnectContainerFromNetwork(ctx context.Context, networkName string, containerName string) error {
+	if networkName == "" {
+		return tracederrors.TracedErrorEmptyString("networkName")
+	}
+
+	if containerName == "" {
+		return tracederrors.TracedErrorEmptyString("containerName")
+	}
+
+	info, err := d.InspectNetwork(contextutils.WithSilent(ctx), networkName)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsContainerConnected(containerName) {
+		logging.LogInfoByCtxf(ctx, "Container '%s' is not connected to docker network '%s'. Skip disconnect.", containerName, networkName)
+		return nil
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.NetworkDisconnect(ctx, networkName, client.NetworkDisconnectOptions{
+		Container: containerName,
+	})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to disconnect container '%s' from docker network '%s': %w", containerName, networkName, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Disconnected container '%s' from docker network '%s'.", containerName, networkName)
+
+	return nil
+}
+
+func (d *Docker) PruneNetworks(ctx context.Context) ([]string, error) {
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.NetworkPrune(ctx, client.NetworkPruneOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to prune docker networks: %w", err)
+	}
+
+	removed := slices.Clone(result.Report.NetworksDeleted)
+	if removed == nil {
+		removed = []string{}
+	}
+	slices.Sort(removed)
+
+	if len(removed) > 0 {
+		logging.LogChangedByCtxf(ctx, "Pruned '%d' docker networks: %v", len(removed), removed)
+	} else {
+		logging.LogInfoByCtxf(ctx, "No unused docker networks to prune.")
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,195 @@
+package nativedocker
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/containerd/errdefs"
+	"github.com/moby/moby/client"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockergeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockeroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (d *Docker) InspectVolume(ctx context.Context, name string) (*dockergeneric.VolumeInfo, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.VolumeInspect(ctx, name, client.VolumeInspectOptions{})
+	if err != nil {
+		if errors.Is(err, errdefs.ErrNotFound) {
+			return nil, tracederrors.TracedErrorf("Docker volume '%s' does not exist: %w: %w", name, dockergeneric.ErrDockerVolumeNotFound, err)
+		}
+
+		return nil, tracederrors.TracedErrorf("Failed to inspect docker volume '%s': %w", name, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Inspected docker volume '%s'.", name)
+
+	return &dockergeneric.VolumeInfo{
+		Name:       result.Volume.Name,
+		Driver:     result.Volume.Driver,
+		Mountpoint: result.Volume.Mountpoint,
+		Labels:     result.Volume.Labels,
+		CreatedAt:  result.Volume.CreatedAt,
+	}, nil
+}
+
+func (d *Docker) VolumeExists(ctx context.Context, name string) (bool, error) {
+	if name == "" {
+		return false, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	_, err := d.InspectVolume(ctx, name)
+	if err != nil {
+		if dockergeneric.IsErrorVolumeNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Docker volume '%s' does not exist.", name)
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Docker volume '%s' exists.", name)
+
+	return true, nil
+}
+
+func (d *Docker) CreateVolume(ctx context.Context, options *dockeroptions.CreateVolumeOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	exists, err := d.VolumeExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		logging.LogInfoByCtxf(ctx, "Docker volume '%s' already exists. Skip create.", name)
+		return nil
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.VolumeCreate(ctx, client.VolumeCreateOptions{
+		Name:   name,
+		Driver: options.GetDriverOrDefault(),
+		Labels: options.Labels,
+	})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to create docker volume '%s': %w", name, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Created docker volume '%s'.", name)
+
+	return nil
+}
+
+func (d *Docker) ListVolumeNames(ctx context.Context) ([]string, error) {
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.VolumeList(ctx, client.VolumeListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list docker volumes: %w", err)
+	}
+
+	names := []string{}
+	for _, v := range result.Items {
+		names = append(names, v.Name)
+	}
+	slices.Sort(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' docker volumes.", len(names))
+
+	return names, nil
+}
+
+func (d *Docker) RemoveVolume(ctx context.Context, name string, options *dockeroptions.RemoveOptions) error {
+	if name == "" {
+		return tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	exists, err := d.VolumeExists(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logging.LogInfoByCtxf(ctx, "Docker volume '%s' is already absent. Skip remove.", name)
+		return nil
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	_, err = cli.VolumeRemove(ctx, name, client.VolumeRemoveOptions{Force: options.Force})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to remove docker volume '%s': %w", name, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Removed docker volume '%s'.", name)
+
+	return nil
+}
+
+func (d *Docker) PruneVolumes(ctx context.Context, options *dockeroptions.PruneVolumesOptions) ([]string, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	cli, err := client.New(client.FromEnv)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("unable to create docker client: %w", err)
+	}
+	defer cli.Close()
+
+	result, err := cli.VolumePrune(ctx, client.VolumePruneOptions{All: options.All})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to prune docker volumes: %w", err)
+	}
+
+	removed := slices.Clone(result.Report.VolumesDeleted)
+	if removed == nil {
+		removed = []string{}
+	}
+	slices.Sort(removed)
+
+	if len(removed) > 0 {
+		logging.LogChangedByCtxf(ctx, "Pruned '%d' docker volumes: %v", len(removed), removed)
+	} else {
+		logging.LogInfoByCtxf(ctx, "No unused docker volumes to prune.")
+	}
+
+	return removed, nil
+}
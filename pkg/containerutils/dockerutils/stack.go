@@ -0,0 +1,27 @@
+package dockerutils
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/containerutils/dockerutils/dockerstack"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Loads the compose-like stack definition at path and returns the stack using docker on localhost.
+func LoadStackOnLocalHost(ctx context.Context, path string) (*dockerstack.Stack, error) {
+	if path == "" {
+		return nil, tracederrors.TracedErrorEmptyString("path")
+	}
+
+	definition, err := dockerstack.LoadStackDefinitionFromFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	docker, err := GetDockerOnLocalHost()
+	if err != nil {
+		return nil, err
+	}
+
+	return dockerstack.NewStack(docker, definition)
+}
@@ -0,0 +1,116 @@
+package cryptoutils
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"slices"
+
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const KEY_ALGORITHM_RSA_2048 = "rsa-2048"
+const KEY_ALGORITHM_RSA_3072 = "rsa-3072"
+const KEY_ALGORITHM_RSA_4096 = "rsa-4096"
+const KEY_ALGORITHM_ECDSA_P256 = "ecdsa-p256"
+const KEY_ALGORITHM_ECDSA_P384 = "ecdsa-p384"
+const KEY_ALGORITHM_ED25519 = "ed25519"
+
+// GetSupportedKeyAlgorithms returns all key algorithms which can be used to generate private keys.
+func GetSupportedKeyAlgorithms() []string {
+	return []string{
+		KEY_ALGORITHM_RSA_2048,
+		KEY_ALGORITHM_RSA_3072,
+		KEY_ALGORITHM_RSA_4096,
+		KEY_ALGORITHM_ECDSA_P256,
+		KEY_ALGORITHM_ECDSA_P384,
+		KEY_ALGORITHM_ED25519,
+	}
+}
+
+func IsKeyAlgorithmSupported(keyAlgorithm string) bool {
+	return slices.Contains(GetSupportedKeyAlgorithms(), keyAlgorithm)
+}
+
+// GeneratePrivateKey generates a new private key using the given keyAlgorithm.
+// The returned key is a *rsa.PrivateKey, *ecdsa.PrivateKey or ed25519.PrivateKey.
+func GeneratePrivateKey(ctx context.Context, keyAlgorithm string) (crypto.PrivateKey, error) {
+	if keyAlgorithm == "" {
+		return nil, tracederrors.TracedErrorEmptyString("keyAlgorithm")
+	}
+
+	var privateKey crypto.PrivateKey
+	var err error
+
+	switch keyAlgorithm {
+	case KEY_ALGORITHM_RSA_2048:
+		return GenerateRsaPrivateKey(ctx, 2048)
+	case KEY_ALGORITHM_RSA_3072:
+		return GenerateRsaPrivateKey(ctx, 3072)
+	case KEY_ALGORITHM_RSA_4096:
+		return GenerateRsaPrivateKey(ctx, 4096)
+	case KEY_ALGORITHM_ECDSA_P256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KEY_ALGORITHM_ECDSA_P384:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KEY_ALGORITHM_ED25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, tracederrors.TracedErrorf("Unsupported key algorithm '%s'. Supported are: %v", keyAlgorithm, GetSupportedKeyAlgorithms())
+	}
+
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to generate '%s' private key: %w", keyAlgorithm, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Generated '%s' private key.", keyAlgorithm)
+
+	return privateKey, nil
+}
+
+func GenerateRsaPrivateKey(ctx context.Context, keySize int) (*rsa.PrivateKey, error) {
+	if keySize <= 0 {
+		return nil, tracederrors.TracedErrorf("Invalid RSA key size '%d'.", keySize)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Generated RSA private key with key size '%d'.", keySize)
+
+	return privateKey, nil
+}
+
+// GetKeyAlgorithm returns the key algorithm of the given privateKey.
+// For RSA keys with a key size not listed in GetSupportedKeyAlgorithms "rsa-<keySize>" is returned.
+func GetKeyAlgorithm(privateKey crypto.PrivateKey) (string, error) {
+	if privateKey == nil {
+		return "", tracederrors.TracedErrorNil("privateKey")
+	}
+
+	switch k := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return fmt.Sprintf("rsa-%d", k.N.BitLen()), nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return KEY_ALGORITHM_ECDSA_P256, nil
+		case elliptic.P384():
+			return KEY_ALGORITHM_ECDSA_P384, nil
+		default:
+			return "", tracederrors.TracedErrorf("Unsupported ECDSA curve '%s'.", k.Curve.Params().Name)
+		}
+	case ed25519.PrivateKey:
+		return KEY_ALGORITHM_ED25519, nil
+	default:
+		return "", tracederrors.TracedErrorf("Unsupported private key type '%T'.", privateKey)
+	}
+}
@@ -0,0 +1,75 @@
+package cryptoutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+)
+
+func Test_GeneratePrivateKey(t *testing.T) {
+	t.Run("empty key algorithm", func(t *testing.T) {
+		key, err := cryptoutils.GeneratePrivateKey(contextutils.ContextVerbose(), "")
+		require.Error(t, err)
+		require.Nil(t, key)
+	})
+
+	t.Run("unsupported key algorithm", func(t *testing.T) {
+		key, err := cryptoutils.GeneratePrivateKey(contextutils.ContextVerbose(), "dsa-1024")
+		require.Error(t, err)
+		require.Nil(t, key)
+	})
+
+	for _, keyAlgorithm := range cryptoutils.GetSupportedKeyAlgorithms() {
+		t.Run(keyAlgorithm, func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+
+			key, err := cryptoutils.GeneratePrivateKey(ctx, keyAlgorithm)
+			require.NoError(t, err)
+
+			detectedAlgorithm, err := cryptoutils.GetKeyAlgorithm(key)
+			require.NoError(t, err)
+			require.EqualValues(t, keyAlgorithm, detectedAlgorithm)
+
+			// Ensure the generated key can be PEM encoded and loaded again:
+			pemEncoded, err := cryptoutils.EncodePrivateKeyAsPEMString(key)
+			require.NoError(t, err)
+
+			loaded, err := cryptoutils.LoadPrivateKeyFromPEMString(pemEncoded)
+			require.NoError(t, err)
+
+			isEqual, err := cryptoutils.IsPrivateKeyEqual(key, loaded)
+			require.NoError(t, err)
+			require.True(t, isEqual)
+
+			_, err = cryptoutils.GetPublicKeyFromPrivateKey(key)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_GetKeyAlgorithm(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		_, err := cryptoutils.GetKeyAlgorithm(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("rsa with custom size", func(t *testing.T) {
+		key, err := cryptoutils.GenerateRsaPrivateKey(contextutils.ContextVerbose(), 1024)
+		require.NoError(t, err)
+
+		keyAlgorithm, err := cryptoutils.GetKeyAlgorithm(key)
+		require.NoError(t, err)
+		require.EqualValues(t, "rsa-1024", keyAlgorithm)
+	})
+
+	t.Run("openssl generated ec key", func(t *testing.T) {
+		key, err := cryptoutils.LoadPrivateKeyFromPEMString(generateECPrivateKeyPEM(t))
+		require.NoError(t, err)
+
+		keyAlgorithm, err := cryptoutils.GetKeyAlgorithm(key)
+		require.NoError(t, err)
+		require.EqualValues(t, cryptoutils.KEY_ALGORITHM_ECDSA_P256, keyAlgorithm)
+	})
+}
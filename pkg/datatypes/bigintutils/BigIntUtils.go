@@ -50,6 +50,22 @@ func GetFromDecimalString(decimal string) (bigInt *big.Int, err error) {
 	return bigInt, nil
 }
 
+// Parses a hex string like "01:FF" as returned by ToHexStringColonSeparated.
+func GetFromHexStringColonSeparated(hexString string) (bigInt *big.Int, err error) {
+	if hexString == "" {
+		return nil, tracederrors.TracedErrorEmptyString("hexString")
+	}
+
+	bigInt = new(big.Int)
+
+	_, ok := bigInt.SetString(strings.ReplaceAll(hexString, ":", ""), 16)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("failed to parse hex string '%s' as *big.Int", hexString)
+	}
+
+	return bigInt, nil
+}
+
 func GreatherThanInts(i1 *big.Int, i2 *big.Int) bool {
 	if i1 == nil {
 		return false
@@ -85,6 +85,37 @@ func TestGetAsHexColonSeparatedString(t *testing.T) {
 	}
 }
 
+func Test_GetFromHexStringColonSeparated(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected *big.Int
+	}{
+		{"00", big.NewInt(0)},
+		{"0A", big.NewInt(10)},
+		{"ff", big.NewInt(255)},
+		{"01:00", big.NewInt(256)},
+		{"01:FF", big.NewInt(256 + 255)},
+		{"02:00:00", big.NewInt(256 * 256 * 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.input,
+			func(t *testing.T) {
+				out, err := bigintutils.GetFromHexStringColonSeparated(tt.input)
+				require.NoError(t, err)
+
+				require.True(t, bigintutils.EqualsInts(tt.expected, out))
+			},
+		)
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := bigintutils.GetFromHexStringColonSeparated("XY:00")
+		require.Error(t, err)
+	})
+}
+
 func Test_EqualInts(t *testing.T) {
 	t.Run("both nil", func(t *testing.T) {
 		require.False(t, bigintutils.EqualsInts(nil, nil))
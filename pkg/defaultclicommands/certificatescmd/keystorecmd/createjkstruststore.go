@@ -0,0 +1,57 @@
+package keystorecmd
+
+import (
+	"crypto/x509"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCreateJksTrustStoreCmd() *cobra.Command {
+	const short = "Create a Java keystore (JKS) truststore from PEM encoded certificates."
+
+	cmd := &cobra.Command{
+		Use:   "create-jks-truststore",
+		Short: short,
+		Long: short + `
+
+The password is read from the env var '` + PASSWORD_ENV_VAR_NAME + `'.
+
+Usage:
+  ` + PASSWORD_ENV_VAR_NAME + `=changeit ` + os.Args[0] + ` certificates keystore create-jks-truststore --cert=root-ca.pem --cert=intermediate-ca.pem --output=truststore.jks
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := contextutils.GetVerbosityContextByCobraCmd(cmd)
+
+			outputPath := mustGetRequiredStringFlag(cmd, "output")
+			password := mustGetPassword()
+
+			certPaths, err := cmd.Flags().GetStringArray("cert")
+			if err != nil {
+				logging.LogGoErrorFatalWithTrace(err)
+			}
+
+			if len(certPaths) == 0 {
+				logging.LogFatal("Please specify at least one --cert.")
+			}
+
+			certs := []*x509.Certificate{}
+			for _, certPath := range certPaths {
+				certs = append(certs, mustReadCertsFromPemFile(ctx, certPath)...)
+			}
+
+			mustutils.Must0(x509utils.WriteJksTrustStoreToFile(ctx, certs, password, outputPath))
+
+			logging.LogGoodByCtxf(ctx, "Created JKS truststore '%s' with %d certificates.", outputPath, len(certs))
+		},
+	}
+
+	cmd.Flags().StringArray("cert", []string{}, "Path to a PEM encoded certificate file. Can be specified multiple times.")
+	cmd.Flags().String("output", "", "Path of the JKS truststore to write.")
+
+	return cmd
+}
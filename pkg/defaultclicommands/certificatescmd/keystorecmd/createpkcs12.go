@@ -0,0 +1,65 @@
+package keystorecmd
+
+import (
+	"crypto/x509"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCreatePkcs12Cmd() *cobra.Command {
+	const short = "Create a password protected PKCS#12 (.p12) bundle from PEM encoded certificate, key and chain."
+
+	cmd := &cobra.Command{
+		Use:   "create-pkcs12",
+		Short: short,
+		Long: short + `
+
+The password is read from the env var '` + PASSWORD_ENV_VAR_NAME + `'.
+If the certificate file contains multiple certificates the first one is used as certificate and all others are added to the chain.
+
+Usage:
+  ` + PASSWORD_ENV_VAR_NAME + `=<PASSWORD> ` + os.Args[0] + ` certificates keystore create-pkcs12 --cert=cert.pem --key=key.pem --chain=chain.pem --output=bundle.p12
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := contextutils.GetVerbosityContextByCobraCmd(cmd)
+
+			certPath := mustGetRequiredStringFlag(cmd, "cert")
+			keyPath := mustGetRequiredStringFlag(cmd, "key")
+			outputPath := mustGetRequiredStringFlag(cmd, "output")
+			password := mustGetPassword()
+
+			chainPath, err := cmd.Flags().GetString("chain")
+			if err != nil {
+				logging.LogGoErrorFatalWithTrace(err)
+			}
+
+			certs := mustReadCertsFromPemFile(ctx, certPath)
+			chain := append([]*x509.Certificate{}, certs[1:]...)
+			if chainPath != "" {
+				chain = append(chain, mustReadCertsFromPemFile(ctx, chainPath)...)
+			}
+
+			certKeyPair := &genericx509utils.X509CertKeyPair{
+				Cert: certs[0],
+				Key:  mustReadPrivateKeyFromPemFile(ctx, keyPath),
+			}
+
+			mustutils.Must0(x509utils.WritePkcs12ToFile(ctx, certKeyPair, chain, password, outputPath))
+
+			logging.LogGoodByCtxf(ctx, "Created PKCS#12 '%s' for '%s' with %d chain certificates.", outputPath, certKeyPair.Cert.Subject.String(), len(chain))
+		},
+	}
+
+	cmd.Flags().String("cert", "", "Path to the PEM encoded certificate.")
+	cmd.Flags().String("key", "", "Path to the PEM encoded private key.")
+	cmd.Flags().String("chain", "", "Optional path to the PEM encoded chain (intermediate and root CA certificates).")
+	cmd.Flags().String("output", "", "Path of the PKCS#12 file to write.")
+
+	return cmd
+}
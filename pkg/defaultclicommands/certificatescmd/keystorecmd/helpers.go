@@ -0,0 +1,48 @@
+package keystorecmd
+
+import (
+	"context"
+	"crypto/x509"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/spf13/cobra"
+)
+
+func mustGetPassword() string {
+	password := os.Getenv(PASSWORD_ENV_VAR_NAME)
+	if password == "" {
+		logging.LogFatalf("Please set the keystore password in the env var '%s'.", PASSWORD_ENV_VAR_NAME)
+	}
+
+	return password
+}
+
+func mustGetRequiredStringFlag(cmd *cobra.Command, name string) string {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		logging.LogGoErrorFatalWithTrace(err)
+	}
+
+	if value == "" {
+		logging.LogFatalf("Please specify --%s.", name)
+	}
+
+	return value
+}
+
+func mustReadCertsFromPemFile(ctx context.Context, path string) []*x509.Certificate {
+	content := mustutils.Must(nativefiles.ReadAsString(ctx, path, nil))
+
+	return mustutils.Must(genericx509utils.ReadCertsFromString(content))
+}
+
+func mustReadPrivateKeyFromPemFile(ctx context.Context, path string) any {
+	content := mustutils.Must(nativefiles.ReadAsString(ctx, path, nil))
+
+	return mustutils.Must(cryptoutils.LoadPrivateKeyFromPEMString(content))
+}
@@ -0,0 +1,76 @@
+package keystorecmd
+
+import (
+	"crypto/x509"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/stringsutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/nativex509utils"
+	"github.com/spf13/cobra"
+)
+
+func NewReadPkcs12Cmd() *cobra.Command {
+	const short = "Read a password protected PKCS#12 (.p12) bundle, print the included certificates and optionally extract them as PEM."
+
+	cmd := &cobra.Command{
+		Use:   "read-pkcs12",
+		Short: short,
+		Long: short + `
+
+The password is read from the env var '` + PASSWORD_ENV_VAR_NAME + `'.
+
+Usage:
+  ` + PASSWORD_ENV_VAR_NAME + `=<PASSWORD> ` + os.Args[0] + ` certificates keystore read-pkcs12 --input=bundle.p12 --cert-output=cert.pem --key-output=key.pem
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := contextutils.GetVerbosityContextByCobraCmd(cmd)
+
+			inputPath := mustGetRequiredStringFlag(cmd, "input")
+			password := mustGetPassword()
+
+			certOutputPath, err := cmd.Flags().GetString("cert-output")
+			if err != nil {
+				logging.LogGoErrorFatalWithTrace(err)
+			}
+
+			keyOutputPath, err := cmd.Flags().GetString("key-output")
+			if err != nil {
+				logging.LogGoErrorFatalWithTrace(err)
+			}
+
+			certKeyPair, chain := mustutils.Must2(x509utils.ReadPkcs12FromFile(ctx, inputPath, password))
+
+			certs := append([]*x509.Certificate{certKeyPair.Cert}, chain...)
+			for _, c := range certs {
+				print(stringsutils.EnsureEndsWithExactlyOneLineBreak(mustutils.Must(x509utils.GetInfoString(c))))
+			}
+
+			if certOutputPath != "" {
+				mustutils.Must0(nativex509utils.WriteCertsToFile(certs, certOutputPath))
+				logging.LogInfoByCtxf(ctx, "Wrote certificate and chain to '%s'.", certOutputPath)
+			}
+
+			if keyOutputPath != "" {
+				keyPem := mustutils.Must(cryptoutils.EncodePrivateKeyAsPEMString(certKeyPair.Key))
+				perm := os.FileMode(0600)
+				mustutils.Must0(nativefiles.WriteBytes(ctx, keyOutputPath, []byte(keyPem), &filesoptions.WriteOptions{Perm: &perm}))
+				logging.LogInfoByCtxf(ctx, "Wrote private key to '%s'.", keyOutputPath)
+			}
+
+			logging.LogGoodByCtxf(ctx, "Read PKCS#12 '%s' with %d certificates.", inputPath, len(certs))
+		},
+	}
+
+	cmd.Flags().String("input", "", "Path to the PKCS#12 file to read.")
+	cmd.Flags().String("cert-output", "", "Optional path to write the PEM encoded certificate and chain to.")
+	cmd.Flags().String("key-output", "", "Optional path to write the PEM encoded private key to.")
+
+	return cmd
+}
@@ -0,0 +1,21 @@
+package keystorecmd
+
+import "github.com/spf13/cobra"
+
+// Environment variable used to pass the keystore password to avoid exposing it in the process list.
+const PASSWORD_ENV_VAR_NAME = "KEYSTORE_PASSWORD"
+
+func NewKeystoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keystore",
+		Short: "PKCS#12 and Java keystore (JKS) related commands",
+	}
+
+	cmd.AddCommand(
+		NewCreatePkcs12Cmd(),
+		NewReadPkcs12Cmd(),
+		NewCreateJksTrustStoreCmd(),
+	)
+
+	return cmd
+}
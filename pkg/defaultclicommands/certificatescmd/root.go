@@ -2,6 +2,7 @@ package certificatescmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/asciich/asciichgolangpublic/pkg/defaultclicommands/certificatescmd/keystorecmd"
 	"github.com/asciich/asciichgolangpublic/pkg/defaultclicommands/certificatescmd/truststorecmd"
 )
 
@@ -12,6 +13,8 @@ func NewCertificatesCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		keystorecmd.NewKeystoreCmd(),
+		NewScanExpiryCmd(),
 		truststorecmd.NewTrustStoreCmd(),
 	)
 
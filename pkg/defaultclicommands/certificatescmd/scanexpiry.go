@@ -0,0 +1,100 @@
+package certificatescmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/files"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/certificateexpiryutils"
+	"github.com/spf13/cobra"
+)
+
+func NewScanExpiryCmd() *cobra.Command {
+	const short = "Scan directories, kubernetes TLS secrets and TLS endpoints for expiring certificates."
+
+	cmd := &cobra.Command{
+		Use:   "scan-expiry",
+		Short: short,
+		Long: short + `
+
+Reports subject, issuer, SANs, serial number and days until expiry of every certificate found.
+
+Usage:
+  ` + os.Args[0] + ` certificates scan-expiry --directory=/etc/ssl/private --endpoint=example.com:443 --kubernetes --namespace=ingress --output-format=prometheus
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := contextutils.GetVerbosityContextByCobraCmd(cmd)
+
+			directoryPaths := mustutils.Must(cmd.Flags().GetStringArray("directory"))
+			endpoints := mustutils.Must(cmd.Flags().GetStringArray("endpoint"))
+			scanKubernetes := mustutils.Must(cmd.Flags().GetBool("kubernetes"))
+			contextName := mustutils.Must(cmd.Flags().GetString("context"))
+			outputFormat := mustutils.Must(cmd.Flags().GetString("output-format"))
+			failOn := mustutils.Must(cmd.Flags().GetString("fail-on"))
+
+			if len(directoryPaths) == 0 && len(endpoints) == 0 && !scanKubernetes {
+				logging.LogFatal("Please specify at least one of --directory, --endpoint or --kubernetes.")
+			}
+
+			options := &certificateexpiryutils.ScanOptions{
+				WarningThresholdDays:  mustutils.Must(cmd.Flags().GetInt("warning-days")),
+				CriticalThresholdDays: mustutils.Must(cmd.Flags().GetInt("critical-days")),
+				NamespaceNames:        mustutils.Must(cmd.Flags().GetStringArray("namespace")),
+			}
+			mustutils.Must0(options.CheckThresholds())
+
+			report := certificateexpiryutils.NewExpiryReport()
+
+			for _, directoryPath := range directoryPaths {
+				directory := mustutils.Must(files.GetLocalDirectoryByPath(ctx, directoryPath))
+				mustutils.Must0(report.AddReport(mustutils.Must(certificateexpiryutils.ScanDirectory(ctx, directory, options))))
+			}
+
+			if scanKubernetes {
+				var cluster *nativekubernetesoo.NativeKubernetesCluster
+				if contextName == "" {
+					cluster = mustutils.Must(nativekubernetesoo.GetDefaultCluster(ctx))
+				} else {
+					cluster = mustutils.Must(nativekubernetesoo.GetClusterByName(ctx, contextName))
+				}
+				mustutils.Must0(report.AddReport(mustutils.Must(certificateexpiryutils.ScanKubernetesTlsSecrets(ctx, cluster, options))))
+			}
+
+			if len(endpoints) > 0 {
+				mustutils.Must0(report.AddReport(mustutils.Must(certificateexpiryutils.ScanTlsEndpoints(ctx, endpoints, options))))
+			}
+
+			report.SortByExpiry()
+
+			fmt.Print(mustutils.Must(report.GetAsString(outputFormat)))
+
+			if failOn != "" {
+				if report.HasScanErrors() {
+					logging.LogFatalf("Scan of %d sources failed.", len(report.Errors))
+				}
+
+				failOnSeverity := mustutils.Must(certificateexpiryutils.GetStatusSeverity(failOn))
+				worstStatus := mustutils.Must(report.GetWorstStatus())
+				if mustutils.Must(certificateexpiryutils.GetStatusSeverity(worstStatus)) >= failOnSeverity {
+					logging.LogFatalf("At least one certificate has status '%s'.", worstStatus)
+				}
+			}
+		},
+	}
+
+	cmd.Flags().StringArray("directory", []string{}, "Local directory to scan recursively for certificate files. Can be specified multiple times.")
+	cmd.Flags().StringArray("endpoint", []string{}, "TLS endpoint to scan as URL or '<host>:<port>'. Can be specified multiple times.")
+	cmd.Flags().Bool("kubernetes", false, "Scan 'kubernetes.io/tls' secrets in the kubernetes cluster.")
+	cmd.Flags().String("context", "", "Kubernetes context to use. The current context is used if not set.")
+	cmd.Flags().StringArray("namespace", []string{}, "Kubernetes namespace to scan. Can be specified multiple times. All namespaces are scanned if not set.")
+	cmd.Flags().Int("warning-days", certificateexpiryutils.DEFAULT_WARNING_THRESHOLD_DAYS, "Certificates expiring in less days are reported as warning.")
+	cmd.Flags().Int("critical-days", certificateexpiryutils.DEFAULT_CRITICAL_THRESHOLD_DAYS, "Certificates expiring in less days are reported as critical.")
+	cmd.Flags().String("output-format", certificateexpiryutils.OUTPUT_FORMAT_TABLE, fmt.Sprintf("Output format. One of %v.", certificateexpiryutils.GetSupportedOutputFormats()))
+	cmd.Flags().String("fail-on", "", "Exit with an error if at least one certificate has the given status or a more severe one or if a source could not be scanned. One of 'warning', 'critical' or 'expired'.")
+
+	return cmd
+}
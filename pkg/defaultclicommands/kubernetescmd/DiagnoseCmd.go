@@ -0,0 +1,49 @@
+package kubernetescmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+)
+
+func NewDiagnoseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Summarize why pods in a namespace are not running: pod states, restarts, last terminations, recent warning events, pending PVCs and resource usage.",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := contextutils.GetVerbosityContextByCobraCmd(cmd)
+
+			contextName := mustutils.Must(cmd.Flags().GetString("context"))
+			namespaceName := mustutils.Must(cmd.Flags().GetString("namespace"))
+
+			options := &kubernetesparameteroptions.NamespaceDiagnosticsOptions{
+				EventsSince:       mustutils.Must(cmd.Flags().GetDuration("events-since")),
+				SkipResourceUsage: mustutils.Must(cmd.Flags().GetBool("skip-resource-usage")),
+			}
+
+			var cluster *nativekubernetesoo.NativeKubernetesCluster
+			if contextName == "" {
+				cluster = mustutils.Must(nativekubernetesoo.GetDefaultCluster(ctx))
+			} else {
+				cluster = mustutils.Must(nativekubernetesoo.GetClusterByName(ctx, contextName))
+			}
+
+			namespace := mustutils.Must(cluster.GetNamespaceByName(namespaceName))
+
+			diagnostics := mustutils.Must(namespace.GetDiagnostics(ctx, options))
+
+			fmt.Print(mustutils.Must(diagnostics.RenderAsString()))
+		},
+	}
+
+	cmd.PersistentFlags().String("context", "", "Kubernetes context to use. The current context is used if not set.")
+	cmd.PersistentFlags().String("namespace", "default", "Namespace to diagnose.")
+	cmd.PersistentFlags().Duration("events-since", kubernetesparameteroptions.DefaultDiagnosticsEventsSince, "Only show warning events seen within the given duration, e.g. '15m'.")
+	cmd.PersistentFlags().Bool("skip-resource-usage", false, "Do not query the metrics-server for CPU and memory usage.")
+
+	return cmd
+}
@@ -20,6 +20,8 @@ func NewKubernetesCmd() *cobra.Command {
 		kindcmd.NewKindCmd(),
 		kubectlcmd.NewKubectlCmd(),
 		ListKindNamesCmd(),
+		NewDiagnoseCmd(),
+		NewLogsCmd(),
 	)
 
 	return cmd
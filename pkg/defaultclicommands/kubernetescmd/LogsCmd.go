@@ -0,0 +1,101 @@
+package kubernetescmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/spf13/cobra"
+)
+
+func NewLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [POD_NAME]",
+		Short: "Print or follow the logs of a pod or aggregate the logs of all pods matching --selector.",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := contextutils.GetVerbosityContextByCobraCmd(cmd)
+
+			ctx, cancel := signal.NotifyContext(ctx, os.Interrupt)
+			defer cancel()
+
+			if len(args) > 1 {
+				logging.LogFatal("Please specify at most one pod name.")
+			}
+
+			contextName := mustutils.Must(cmd.Flags().GetString("context"))
+			namespaceName := mustutils.Must(cmd.Flags().GetString("namespace"))
+			selector := mustutils.Must(cmd.Flags().GetString("selector"))
+
+			if len(args) == 1 && selector != "" {
+				logging.LogFatal("Please specify either a pod name or --selector but not both.")
+			}
+
+			if len(args) == 0 && selector == "" {
+				logging.LogFatal("Please specify a pod name or --selector.")
+			}
+
+			options := &kubernetesparameteroptions.StreamLogsOptions{
+				ContainerName: mustutils.Must(cmd.Flags().GetString("container")),
+				Follow:        mustutils.Must(cmd.Flags().GetBool("follow")),
+				Since:         mustutils.Must(cmd.Flags().GetDuration("since")),
+				Timestamps:    mustutils.Must(cmd.Flags().GetBool("timestamps")),
+				LabelSelector: selector,
+			}
+
+			// Like kubectl a negative value means all log lines are printed:
+			tailLines := mustutils.Must(cmd.Flags().GetInt64("tail"))
+			if tailLines >= 0 {
+				options.TailLines = &tailLines
+			}
+
+			var cluster *nativekubernetesoo.NativeKubernetesCluster
+			if contextName == "" {
+				cluster = mustutils.Must(nativekubernetesoo.GetDefaultCluster(ctx))
+			} else {
+				cluster = mustutils.Must(nativekubernetesoo.GetClusterByName(ctx, contextName))
+			}
+
+			namespace := mustutils.Must(cluster.GetNamespaceByName(namespaceName))
+
+			reader := mustutils.Must(openLogStream(ctx, namespace, args, options))
+
+			mustutils.Must0(kubernetesimplementationindependend.ForEachLogLine(ctx, reader, func(line string) error {
+				fmt.Println(line)
+				return nil
+			}))
+		},
+	}
+
+	cmd.PersistentFlags().String("context", "", "Kubernetes context to use. The current context is used if not set.")
+	cmd.PersistentFlags().String("namespace", "default", "Namespace of the pods.")
+	cmd.PersistentFlags().StringP("selector", "l", "", "Aggregate the logs of all pods matching the label selector, e.g. 'app=example'.")
+	cmd.PersistentFlags().StringP("container", "c", "", "Only print the logs of this container. The logs of all containers are printed if not set.")
+	cmd.PersistentFlags().BoolP("follow", "f", false, "Keep printing new log lines until interrupted.")
+	cmd.PersistentFlags().Duration("since", 0, "Only print log lines newer than the given duration, e.g. '5m'.")
+	cmd.PersistentFlags().Int64("tail", -1, "Only print the given number of most recent lines per container. All lines are printed if negative.")
+	cmd.PersistentFlags().Bool("timestamps", false, "Prefix every log line with its timestamp.")
+
+	return cmd
+}
+
+func openLogStream(ctx context.Context, namespace kubernetesinterfaces.Namespace, args []string, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if len(args) == 0 {
+		return namespace.StreamLogsByLabelSelector(ctx, options)
+	}
+
+	pod, err := namespace.GetPodByName(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return pod.StreamLogs(ctx, options)
+}
@@ -278,3 +278,33 @@ func IsYamlFile(ctx context.Context, path string, options *ValidateOptions) (boo
 
 	return isYaml, nil
 }
+
+// Loads a YAML document containing a mapping as key value dict.
+// An empty document results in an empty dict.
+func LoadKeyValueInterfaceDictFromYamlString(yamlString string) (keyValues map[string]interface{}, err error) {
+	keyValues = map[string]interface{}{}
+
+	if strings.TrimSpace(yamlString) == "" {
+		return keyValues, nil
+	}
+
+	err = yaml.Unmarshal([]byte(yamlString), &keyValues)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("%w: %w", ErrInvalidYaml, err)
+	}
+
+	return keyValues, nil
+}
+
+func LoadKeyValueInterfaceDictFromYamlFile(ctx context.Context, yamlFile filesinterfaces.File) (keyValues map[string]interface{}, err error) {
+	if yamlFile == nil {
+		return nil, tracederrors.TracedErrorNil("yamlFile")
+	}
+
+	yamlContent, err := yamlFile.ReadAsString(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadKeyValueInterfaceDictFromYamlString(yamlContent)
+}
@@ -207,3 +207,29 @@ func Test_IsYamlString(t *testing.T) {
 
 	})
 }
+
+func Test_LoadKeyValueInterfaceDictFromYamlString(t *testing.T) {
+	t.Run("empty string", func(t *testing.T) {
+		keyValues, err := yamlutils.LoadKeyValueInterfaceDictFromYamlString("")
+		require.NoError(t, err)
+		require.Len(t, keyValues, 0)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		keyValues, err := yamlutils.LoadKeyValueInterfaceDictFromYamlString("a: 42\nb:\n  c: hello\n")
+		require.NoError(t, err)
+		require.EqualValues(t, 42, keyValues["a"])
+		require.EqualValues(t, map[string]interface{}{"c": "hello"}, keyValues["b"])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		keyValues, err := yamlutils.LoadKeyValueInterfaceDictFromYamlString(`{"a": "b"}`)
+		require.NoError(t, err)
+		require.EqualValues(t, "b", keyValues["a"])
+	})
+
+	t.Run("list is not a dict", func(t *testing.T) {
+		_, err := yamlutils.LoadKeyValueInterfaceDictFromYamlString("- a\n- b\n")
+		require.Error(t, err)
+	})
+}
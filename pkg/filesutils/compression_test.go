@@ -0,0 +1,75 @@
+package filesutils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompressToFileAndDecompressToFile(t *testing.T) {
+	implementationNames := []string{
+		"localFile",
+		"localCommandExecutorFile",
+		"commandExecutorFileExec",
+		"commandExecutorFileBash",
+		"nativefilesoo",
+	}
+
+	content := strings.Repeat("hello world\n", 1000)
+
+	for _, format := range []compressionoptions.CompressionFormat{compressionoptions.FormatGzip, compressionoptions.FormatXz, compressionoptions.FormatZstd} {
+		for _, implementation := range implementationNames {
+			t.Run(implementation+"_"+format.String(), func(t *testing.T) {
+				ctx := getCtx()
+
+				file := getTemporaryFileToTest(implementation)
+				defer file.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				compressed := getTemporaryFileToTest(implementation)
+				defer compressed.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				decompressed := getTemporaryFileToTest(implementation)
+				defer decompressed.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				err := file.WriteString(ctx, content, &filesoptions.WriteOptions{})
+				require.NoError(t, err)
+
+				err = file.CompressToFile(ctx, compressed, format)
+				require.NoError(t, err)
+
+				header, err := compressed.ReadFirstNBytes(ctx, compressionutils.NBytesToDetectFormat)
+				require.NoError(t, err)
+				detected, err := compressionutils.DetectFormatFromBytes(header)
+				require.NoError(t, err)
+				require.EqualValues(t, format, detected)
+
+				err = compressed.DecompressToFile(ctx, decompressed)
+				require.NoError(t, err)
+
+				got, err := decompressed.ReadAsString(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, content, got)
+			})
+		}
+	}
+}
+
+func Test_DecompressToFile_UncompressedFile(t *testing.T) {
+	ctx := getCtx()
+
+	file := getTemporaryFileToTest("nativefilesoo")
+	defer file.Delete(ctx, &filesoptions.DeleteOptions{})
+
+	dest := getTemporaryFileToTest("nativefilesoo")
+	defer dest.Delete(ctx, &filesoptions.DeleteOptions{})
+
+	err := file.WriteString(ctx, "not compressed", &filesoptions.WriteOptions{})
+	require.NoError(t, err)
+
+	err = file.DecompressToFile(ctx, dest)
+	require.ErrorIs(t, err, compressionutils.ErrUnknownCompressionFormat)
+}
@@ -0,0 +1,123 @@
+package filesgeneric
+
+import (
+	"context"
+	"io"
+
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Compress the content of this file using 'format' and write the result to 'destFile'.
+//
+// The content is streamed so large files are not loaded into memory.
+// Source and destination can be located on different hosts.
+func (f *FileBase) CompressToFile(ctx context.Context, destFile filesinterfaces.File, format compressionoptions.CompressionFormat) (err error) {
+	if destFile == nil {
+		return tracederrors.TracedErrorNil("destFile")
+	}
+
+	parent, err := f.GetParentFileForBaseClass()
+	if err != nil {
+		return err
+	}
+
+	srcPath, srcHostDescription, err := parent.GetPathAndHostDescription()
+	if err != nil {
+		return err
+	}
+
+	destPath, destHostDescription, err := destFile.GetPathAndHostDescription()
+	if err != nil {
+		return err
+	}
+
+	src, err := parent.OpenAsReadCloser(ctx)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := destFile.OpenAsWriteCloser(ctx, &filesoptions.WriteOptions{})
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	_, err = compressionutils.Compress(contextutils.WithSilent(ctx), format, src, dst)
+	if err != nil {
+		return err
+	}
+
+	err = dst.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close '%s' on host '%s': %w", destPath, destHostDescription, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Compressed '%s' on host '%s' using %s to '%s' on host '%s'.", srcPath, srcHostDescription, format, destPath, destHostDescription)
+
+	return nil
+}
+
+// Decompress the content of this file and write the result to 'destFile'.
+//
+// The compression format is detected by the magic bytes of the file content.
+// The content is streamed so large files are not loaded into memory.
+func (f *FileBase) DecompressToFile(ctx context.Context, destFile filesinterfaces.File) (err error) {
+	if destFile == nil {
+		return tracederrors.TracedErrorNil("destFile")
+	}
+
+	parent, err := f.GetParentFileForBaseClass()
+	if err != nil {
+		return err
+	}
+
+	srcPath, srcHostDescription, err := parent.GetPathAndHostDescription()
+	if err != nil {
+		return err
+	}
+
+	destPath, destHostDescription, err := destFile.GetPathAndHostDescription()
+	if err != nil {
+		return err
+	}
+
+	src, err := parent.OpenAsReadCloser(ctx)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// Ensure the source is compressed before the destination gets truncated:
+	decompressReader, format, err := compressionutils.NewAutoDecompressReader(src)
+	if err != nil {
+		return tracederrors.TracedErrorf("Unable to decompress '%s' on host '%s': %w", srcPath, srcHostDescription, err)
+	}
+	defer decompressReader.Close()
+
+	dst, err := destFile.OpenAsWriteCloser(ctx, &filesoptions.WriteOptions{})
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, decompressReader)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to decompress %s file '%s' on host '%s': %w", format, srcPath, srcHostDescription, err)
+	}
+
+	err = dst.Close()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to close '%s' on host '%s': %w", destPath, destHostDescription, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Decompressed %s file '%s' on host '%s' to '%s' on host '%s'.", format, srcPath, srcHostDescription, destPath, destHostDescription)
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/asciich/asciichgolangpublic/pkg/changesummary"
+	"github.com/asciich/asciichgolangpublic/pkg/compressionutils/compressionoptions"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
 	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
 )
@@ -46,9 +47,11 @@ type File interface {
 	// All methods below this line can be implemented by embedding the `FileBase` struct:
 	AppendLine(ctx context.Context, line string) (err error)
 	CheckIsLocalFile(ctx context.Context) (err error)
+	CompressToFile(ctx context.Context, destFile File, format compressionoptions.CompressionFormat) (err error)
 	ContainsLine(ctx context.Context, line string) (containsLine bool, err error)
 	CopyToFile(ctx context.Context, destFile File, options *filesoptions.CopyOptions) (err error)
 	CreateParentDirectory(ctx context.Context) (err error)
+	DecompressToFile(ctx context.Context, destFile File) (err error)
 	EnsureLineInFile(ctx context.Context, line string) (err error)
 	EnsureEndsWithLineBreak(ctx context.Context) (err error)
 	GetCreationDateByFileName(ctx context.Context) (creationDate *time.Time, err error)
@@ -0,0 +1,98 @@
+package fluxutils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+)
+
+// This example shows how to create flux objects from typed options, trigger a reconciliation, suspend and resume them and
+// print a status overview of all flux objects in the cluster.
+//
+// To start this test use:
+//
+//	bash -c "cd pkg/fluxutils && go test -v -run Test_ReconcileSuspendResumeFluxObjects"
+func Test_ReconcileSuspendResumeFluxObjects(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// -----
+	// Prepare test environment start ...
+	const namespaceName = "flux-system"
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+	// Install flux using flux-operator
+	_, err = fluxutils.InstallFlux(ctx, &fluxparameteroptions.InstalFluxOptions{
+		KubernetesCluster: cluster,
+		Namespace:         namespaceName,
+	})
+	require.NoError(t, err)
+	// ... prepare test environment finished.
+	// -----
+
+	// Get the deployed flux
+	fluxDeployment, err := fluxutils.GetFluxDeployment(cluster, namespaceName)
+	require.NoError(t, err)
+
+	const gitRepoName = "podinfo"
+	const kustomizationName = "podinfo"
+
+	// Create a GitRepository:
+	err = fluxDeployment.CreateGitRepository(ctx, &fluxparameteroptions.CreateGitRepositoryOptions{
+		Name:      gitRepoName,
+		Namespace: namespaceName,
+		Url:       "https://github.com/stefanprodan/podinfo",
+		Branch:    "master",
+	})
+	require.NoError(t, err)
+
+	// Create a Kustomization deploying the content of the GitRepository:
+	err = fluxDeployment.CreateKustomization(ctx, &fluxparameteroptions.CreateKustomizationOptions{
+		Name:            kustomizationName,
+		Namespace:       namespaceName,
+		SourceName:      gitRepoName,
+		Path:            "./kustomize",
+		Prune:           true,
+		TargetNamespace: "default",
+	})
+	require.NoError(t, err)
+
+	// Trigger a reconciliation of the GitRepository and wait until the new revision is ready:
+	status, err := fluxDeployment.ReconcileGitRepository(ctx, gitRepoName, namespaceName, &fluxparameteroptions.ReconcileOptions{Timeout: 2 * time.Minute})
+	require.NoError(t, err)
+	require.True(t, status.IsReady())
+	require.NotEmpty(t, status.Revision)
+
+	// Same for the Kustomization:
+	status, err = fluxDeployment.ReconcileKustomization(ctx, kustomizationName, namespaceName, &fluxparameteroptions.ReconcileOptions{Timeout: 2 * time.Minute})
+	require.NoError(t, err)
+	require.True(t, status.IsReady())
+
+	// Suspend the Kustomization, e.g. during maintenance:
+	err = fluxDeployment.SuspendKustomization(ctx, kustomizationName, namespaceName)
+	require.NoError(t, err)
+
+	// Suspended objects are listed in the status overview:
+	overview, err := fluxDeployment.GetStatusOverview(ctx)
+	require.NoError(t, err)
+	rendered, err := overview.RenderAsString()
+	require.NoError(t, err)
+	require.Contains(t, rendered, kustomizationName)
+
+	// Resume the Kustomization again:
+	err = fluxDeployment.ResumeKustomization(ctx, kustomizationName, namespaceName)
+	require.NoError(t, err)
+
+	// Cleanup:
+	err = fluxDeployment.DeleteKustomization(ctx, kustomizationName, namespaceName)
+	require.NoError(t, err)
+	err = fluxDeployment.DeleteGitRepository(ctx, gitRepoName, namespaceName)
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package fluxutils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorbashoo"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/commandexecutorflux"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func getFluxDeploymentByImplementationName(t *testing.T, implementationName string, cluster kubernetesinterfaces.KubernetesCluster, namespaceName string) fluxinterfaces.FluxDeployment {
+	switch implementationName {
+	case "nativeflux":
+		fluxDeployment, err := fluxutils.GetFluxDeployment(cluster, namespaceName)
+		require.NoError(t, err)
+		return fluxDeployment
+	case "commandexecutorflux":
+		flux := commandexecutorflux.NewcommandExecutorFlux(commandexecutorbashoo.Bash()).(*commandexecutorflux.CommandExecutorFlux)
+		fluxDeployment, err := flux.GetDeployedFlux(cluster)
+		require.NoError(t, err)
+		return fluxDeployment
+	default:
+		t.Fatalf("Unknown implementation name '%s'", implementationName)
+		return nil
+	}
+}
+
+func Test_SuspendResumeAndReconcileGitRepository(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeflux"},
+		{"commandexecutorflux"},
+	}
+
+	for _, tt := range tests {
+		t.Run(testutils.MustFormatAsTestname(tt), func(t *testing.T) {
+			ctx := contextutils.WithVerbose(context.TODO())
+
+			const namespaceName = "flux-system"
+			cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+			require.NoError(t, err)
+			_, err = fluxutils.InstallFlux(ctx, &fluxparameteroptions.InstalFluxOptions{
+				KubernetesCluster: cluster,
+				Namespace:         namespaceName,
+			})
+			require.NoError(t, err)
+
+			fluxDeployment := getFluxDeploymentByImplementationName(t, tt.implementationName, cluster, namespaceName)
+
+			gitRepoName := "suspend-resume-" + tt.implementationName
+			err = fluxDeployment.CreateGitRepository(ctx, &fluxparameteroptions.CreateGitRepositoryOptions{
+				Name:      gitRepoName,
+				Namespace: namespaceName,
+				Url:       "https://github.com/stefanprodan/podinfo",
+				Branch:    "master",
+			})
+			require.NoError(t, err)
+			defer fluxDeployment.DeleteGitRepository(ctx, gitRepoName, namespaceName)
+
+			for i := 0; i < 2; i++ {
+				err = fluxDeployment.SuspendGitRepository(ctx, gitRepoName, namespaceName)
+				require.NoError(t, err)
+			}
+
+			overview, err := fluxDeployment.GetStatusOverview(ctx)
+			require.NoError(t, err)
+			found := false
+			for _, object := range overview.Objects {
+				if object.Kind == "GitRepository" && object.Name == gitRepoName {
+					require.True(t, object.Suspended)
+					found = true
+				}
+			}
+			require.True(t, found)
+
+			for i := 0; i < 2; i++ {
+				err = fluxDeployment.ResumeGitRepository(ctx, gitRepoName, namespaceName)
+				require.NoError(t, err)
+			}
+
+			status, err := fluxDeployment.ReconcileGitRepository(ctx, gitRepoName, namespaceName, &fluxparameteroptions.ReconcileOptions{Timeout: 2 * time.Minute})
+			require.NoError(t, err)
+			require.True(t, status.IsReady())
+			require.False(t, status.Suspended)
+			require.NotEmpty(t, status.Revision)
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package commandexecutorflux
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluximplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (c *CommandExecutorDeployedFlux) runKubectlAndGetStdout(ctx context.Context, args ...string) (string, error) {
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return "", err
+	}
+
+	if c.cluster == nil {
+		return "", tracederrors.TracedError("cluster not set")
+	}
+
+	kubeContext, err := c.cluster.GetKubectlContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	command := append([]string{"kubectl", "--context", kubeContext}, args...)
+
+	return commandExecutor.RunCommandAndGetStdoutAsString(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: command,
+		},
+	)
+}
+
+func (c *CommandExecutorDeployedFlux) applyManifest(ctx context.Context, manifest string) error {
+	if c.cluster == nil {
+		return tracederrors.TracedError("cluster not set")
+	}
+
+	_, err := c.cluster.ApplyManifests(ctx, &kubernetesparameteroptions.ApplyManifestsOptions{
+		YamlString:            manifest,
+		SkipNamespaceCreation: true,
+	})
+
+	return err
+}
+
+func (c *CommandExecutorDeployedFlux) CreateGitRepository(ctx context.Context, options *fluxparameteroptions.CreateGitRepositoryOptions) error {
+	manifest, err := fluximplementationindependend.GetGitRepositoryManifest(options)
+	if err != nil {
+		return err
+	}
+
+	return c.applyManifest(ctx, manifest)
+}
+
+func (c *CommandExecutorDeployedFlux) CreateKustomization(ctx context.Context, options *fluxparameteroptions.CreateKustomizationOptions) error {
+	manifest, err := fluximplementationindependend.GetKustomizationManifest(options)
+	if err != nil {
+		return err
+	}
+
+	return c.applyManifest(ctx, manifest)
+}
+
+func (c *CommandExecutorDeployedFlux) CreateHelmRelease(ctx context.Context, options *fluxparameteroptions.CreateHelmReleaseOptions) error {
+	manifest, err := fluximplementationindependend.GetHelmReleaseManifest(options)
+	if err != nil {
+		return err
+	}
+
+	return c.applyManifest(ctx, manifest)
+}
+
+func (c *CommandExecutorDeployedFlux) getFluxObjectStatus(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string) (*fluximplementationindependend.FluxObjectStatus, error) {
+	stdout, err := c.runKubectlAndGetStdout(ctx, "get", kind.GetKubectlResourceName(), name, "--namespace", namespaceName, "-o", "json")
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]interface{}{}
+	err = json.Unmarshal([]byte(stdout), &object)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse Flux %s '%s' in namespace '%s': %w", kind.Kind, name, namespaceName, err)
+	}
+
+	return fluximplementationindependend.GetFluxObjectStatus(object)
+}
+
+func (c *CommandExecutorDeployedFlux) patchFluxObject(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string, patch map[string]interface{}) error {
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to marshal patch: %w", err)
+	}
+
+	_, err = c.runKubectlAndGetStdout(ctx, "patch", kind.GetKubectlResourceName(), name, "--namespace", namespaceName, "--type", "merge", "-p", string(patchBytes))
+
+	return err
+}
+
+// Requests a reconciliation by setting the reconcile.fluxcd.io/requestedAt annotation and waits until it finished.
+func (c *CommandExecutorDeployedFlux) reconcile(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespace")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	requestedAt := time.Now().Format(time.RFC3339Nano)
+
+	_, err := c.runKubectlAndGetStdout(
+		ctx,
+		"annotate",
+		"--overwrite",
+		kind.GetKubectlResourceName(),
+		name,
+		"--namespace",
+		namespaceName,
+		fluximplementationindependend.ReconcileRequestedAtAnnotation+"="+requestedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Reconciliation of Flux %s '%s' in namespace '%s' requested.", kind.Kind, name, namespaceName)
+
+	if options.SkipWait {
+		return c.getFluxObjectStatus(ctx, kind, name, namespaceName)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, options.GetTimeoutOrDefault())
+	defer cancel()
+
+	for {
+		status, err := c.getFluxObjectStatus(timeoutCtx, kind, name, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.IsReconciliationFinished(requestedAt) {
+			if !status.IsReady() {
+				return nil, tracederrors.TracedErrorf("Reconciliation of Flux %s '%s' in namespace '%s' failed: %s", kind.Kind, name, namespaceName, status.Message)
+			}
+
+			logging.LogInfoByCtxf(ctx, "Flux %s '%s' in namespace '%s' reconciled revision '%s'.", kind.Kind, name, namespaceName, status.Revision)
+
+			return status, nil
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			return nil, tracederrors.TracedErrorf("Timeout waiting for reconciliation of Flux %s '%s' in namespace '%s'. Last status message: '%s'", kind.Kind, name, namespaceName, status.Message)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (c *CommandExecutorDeployedFlux) setSuspended(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string, suspend bool) error {
+	if name == "" {
+		return tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespace")
+	}
+
+	status, err := c.getFluxObjectStatus(ctx, kind, name, namespaceName)
+	if err != nil {
+		return err
+	}
+
+	if status.Suspended == suspend {
+		logging.LogInfoByCtxf(ctx, "Flux %s '%s' in namespace '%s' already suspended: '%t'.", kind.Kind, name, namespaceName, suspend)
+		return nil
+	}
+
+	err = c.patchFluxObject(ctx, kind, name, namespaceName, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"suspend": suspend,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if suspend {
+		logging.LogChangedByCtxf(ctx, "Flux %s '%s' in namespace '%s' suspended.", kind.Kind, name, namespaceName)
+		return nil
+	}
+
+	logging.LogChangedByCtxf(ctx, "Flux %s '%s' in namespace '%s' resumed.", kind.Kind, name, namespaceName)
+
+	// Like the flux CLI a resumed object is reconciled immediately instead of waiting for the next interval:
+	_, err = c.reconcile(contextutils.WithSilent(ctx), kind, name, namespaceName, &fluxparameteroptions.ReconcileOptions{SkipWait: true})
+
+	return err
+}
+
+func (c *CommandExecutorDeployedFlux) ReconcileGitRepository(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	return c.reconcile(ctx, fluximplementationindependend.GitRepositoryKind, name, namespace, options)
+}
+
+func (c *CommandExecutorDeployedFlux) ReconcileKustomization(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	return c.reconcile(ctx, fluximplementationindependend.KustomizationKind, name, namespace, options)
+}
+
+func (c *CommandExecutorDeployedFlux) ReconcileHelmRelease(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	return c.reconcile(ctx, fluximplementationindependend.HelmReleaseKind, name, namespace, options)
+}
+
+func (c *CommandExecutorDeployedFlux) SuspendGitRepository(ctx context.Context, name string, namespace string) error {
+	return c.setSuspended(ctx, fluximplementationindependend.GitRepositoryKind, name, namespace, true)
+}
+
+func (c *CommandExecutorDeployedFlux) SuspendKustomization(ctx context.Context, name string, namespace string) error {
+	return c.setSuspended(ctx, fluximplementationindependend.KustomizationKind, name, namespace, true)
+}
+
+func (c *CommandExecutorDeployedFlux) SuspendHelmRelease(ctx context.Context, name string, namespace string) error {
+	return c.setSuspended(ctx, fluximplementationindependend.HelmReleaseKind, name, namespace, true)
+}
+
+func (c *CommandExecutorDeployedFlux) ResumeGitRepository(ctx context.Context, name string, namespace string) error {
+	return c.setSuspended(ctx, fluximplementationindependend.GitRepositoryKind, name, namespace, false)
+}
+
+func (c *CommandExecutorDeployedFlux) ResumeKustomization(ctx context.Context, name string, namespace string) error {
+	return c.setSuspended(ctx, fluximplementationindependend.KustomizationKind, name, namespace, false)
+}
+
+func (c *CommandExecutorDeployedFlux) ResumeHelmRelease(ctx context.Context, name string, namespace string) error {
+	return c.setSuspended(ctx, fluximplementationindependend.HelmReleaseKind, name, namespace, false)
+}
+
+// Returns the status of all flux objects in all namespaces of the cluster.
+// Kinds whose CRD is not installed are skipped.
+func (c *CommandExecutorDeployedFlux) GetStatusOverview(ctx context.Context) (*fluximplementationindependend.FluxStatusOverview, error) {
+	overview := &fluximplementationindependend.FluxStatusOverview{
+		Objects: []*fluximplementationindependend.FluxObjectStatus{},
+	}
+
+	for _, kind := range fluximplementationindependend.GetFluxObjectKinds() {
+		stdout, err := c.runKubectlAndGetStdout(ctx, "get", kind.GetKubectlResourceName(), "--all-namespaces", "-o", "json")
+		if err != nil {
+			if strings.Contains(err.Error(), "the server doesn't have a resource type") {
+				logging.LogInfoByCtxf(ctx, "Flux kind '%s' is not installed. Skipped.", kind.Kind)
+				continue
+			}
+
+			return nil, err
+		}
+
+		list := struct {
+			Items []map[string]interface{} `json:"items"`
+		}{}
+
+		err = json.Unmarshal([]byte(stdout), &list)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to parse Flux %s list: %w", kind.Kind, err)
+		}
+
+		for _, item := range list.Items {
+			status, err := fluximplementationindependend.GetFluxObjectStatus(item)
+			if err != nil {
+				return nil, err
+			}
+
+			// Items of a list do not always carry their kind:
+			status.Kind = kind.Kind
+
+			overview.Objects = append(overview.Objects, status)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' Flux objects. '%d' are not ready.", len(overview.Objects), len(overview.GetNotReady()))
+
+	return overview, nil
+}
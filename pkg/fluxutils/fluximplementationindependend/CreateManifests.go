@@ -0,0 +1,159 @@
+package fluximplementationindependend
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/yamlutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func newFluxObject(kind FluxObjectKind, name string, namespace string, spec map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": kind.GetApiVersion(),
+		"kind":       kind.Kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": spec,
+	}
+}
+
+func GetGitRepositoryManifest(options *fluxparameteroptions.CreateGitRepositoryOptions) (string, error) {
+	if options == nil {
+		return "", tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	namespace, err := options.GetNamespace()
+	if err != nil {
+		return "", err
+	}
+
+	url, err := options.GetUrl()
+	if err != nil {
+		return "", err
+	}
+
+	if options.Branch != "" && options.Tag != "" {
+		return "", tracederrors.TracedErrorf("Only one of Branch '%s' and Tag '%s' can be set.", options.Branch, options.Tag)
+	}
+
+	spec := map[string]interface{}{
+		"url":      url,
+		"interval": options.GetIntervalOrDefault().String(),
+	}
+
+	if options.Branch != "" {
+		spec["ref"] = map[string]interface{}{"branch": options.Branch}
+	}
+
+	if options.Tag != "" {
+		spec["ref"] = map[string]interface{}{"tag": options.Tag}
+	}
+
+	if options.SecretRefName != "" {
+		spec["secretRef"] = map[string]interface{}{"name": options.SecretRefName}
+	}
+
+	return yamlutils.DataToYamlString(newFluxObject(GitRepositoryKind, name, namespace, spec))
+}
+
+func GetKustomizationManifest(options *fluxparameteroptions.CreateKustomizationOptions) (string, error) {
+	if options == nil {
+		return "", tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	namespace, err := options.GetNamespace()
+	if err != nil {
+		return "", err
+	}
+
+	sourceName, err := options.GetSourceName()
+	if err != nil {
+		return "", err
+	}
+
+	spec := map[string]interface{}{
+		"interval": options.GetIntervalOrDefault().String(),
+		"path":     options.GetPathOrDefault(),
+		"prune":    options.Prune,
+		"sourceRef": map[string]interface{}{
+			"kind": options.GetSourceKindOrDefault(),
+			"name": sourceName,
+		},
+	}
+
+	if options.TargetNamespace != "" {
+		spec["targetNamespace"] = options.TargetNamespace
+	}
+
+	return yamlutils.DataToYamlString(newFluxObject(KustomizationKind, name, namespace, spec))
+}
+
+func GetHelmReleaseManifest(options *fluxparameteroptions.CreateHelmReleaseOptions) (string, error) {
+	if options == nil {
+		return "", tracederrors.TracedErrorNil("options")
+	}
+
+	name, err := options.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	namespace, err := options.GetNamespace()
+	if err != nil {
+		return "", err
+	}
+
+	chartName, err := options.GetChartName()
+	if err != nil {
+		return "", err
+	}
+
+	sourceName, err := options.GetSourceName()
+	if err != nil {
+		return "", err
+	}
+
+	chartSpec := map[string]interface{}{
+		"chart": chartName,
+		"sourceRef": map[string]interface{}{
+			"kind": options.GetSourceKindOrDefault(),
+			"name": sourceName,
+		},
+	}
+
+	if options.ChartVersion != "" {
+		chartSpec["version"] = options.ChartVersion
+	}
+
+	spec := map[string]interface{}{
+		"interval": options.GetIntervalOrDefault().String(),
+		"chart": map[string]interface{}{
+			"spec": chartSpec,
+		},
+	}
+
+	if options.ReleaseName != "" {
+		spec["releaseName"] = options.ReleaseName
+	}
+
+	if options.TargetNamespace != "" {
+		spec["targetNamespace"] = options.TargetNamespace
+	}
+
+	if len(options.Values) > 0 {
+		spec["values"] = options.Values
+	}
+
+	return yamlutils.DataToYamlString(newFluxObject(HelmReleaseKind, name, namespace, spec))
+}
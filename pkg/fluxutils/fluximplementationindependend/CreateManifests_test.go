@@ -0,0 +1,84 @@
+package fluximplementationindependend_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluximplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
+)
+
+func Test_GetGitRepositoryManifest(t *testing.T) {
+	t.Run("branch", func(t *testing.T) {
+		manifest, err := fluximplementationindependend.GetGitRepositoryManifest(&fluxparameteroptions.CreateGitRepositoryOptions{
+			Name:          "repo",
+			Namespace:     "flux-system",
+			Url:           "https://example.com/repo.git",
+			Branch:        "main",
+			SecretRefName: "repo-credentials",
+		})
+		require.NoError(t, err)
+		require.EqualValues(
+			t,
+			"---\napiVersion: source.toolkit.fluxcd.io/v1\nkind: GitRepository\nmetadata:\n    name: repo\n    namespace: flux-system\nspec:\n    interval: 1m0s\n    ref:\n        branch: main\n    secretRef:\n        name: repo-credentials\n    url: https://example.com/repo.git\n",
+			manifest,
+		)
+	})
+
+	t.Run("branch and tag", func(t *testing.T) {
+		_, err := fluximplementationindependend.GetGitRepositoryManifest(&fluxparameteroptions.CreateGitRepositoryOptions{
+			Name:      "repo",
+			Namespace: "flux-system",
+			Url:       "https://example.com/repo.git",
+			Branch:    "main",
+			Tag:       "v1.0.0",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("missing url", func(t *testing.T) {
+		_, err := fluximplementationindependend.GetGitRepositoryManifest(&fluxparameteroptions.CreateGitRepositoryOptions{
+			Name:      "repo",
+			Namespace: "flux-system",
+		})
+		require.Error(t, err)
+	})
+}
+
+func Test_GetKustomizationManifest(t *testing.T) {
+	manifest, err := fluximplementationindependend.GetKustomizationManifest(&fluxparameteroptions.CreateKustomizationOptions{
+		Name:            "apps",
+		Namespace:       "flux-system",
+		SourceName:      "repo",
+		Path:            "./apps",
+		Interval:        5 * time.Minute,
+		Prune:           true,
+		TargetNamespace: "apps",
+	})
+	require.NoError(t, err)
+	require.EqualValues(
+		t,
+		"---\napiVersion: kustomize.toolkit.fluxcd.io/v1\nkind: Kustomization\nmetadata:\n    name: apps\n    namespace: flux-system\nspec:\n    interval: 5m0s\n    path: ./apps\n    prune: true\n    sourceRef:\n        kind: GitRepository\n        name: repo\n    targetNamespace: apps\n",
+		manifest,
+	)
+}
+
+func Test_GetHelmReleaseManifest(t *testing.T) {
+	manifest, err := fluximplementationindependend.GetHelmReleaseManifest(&fluxparameteroptions.CreateHelmReleaseOptions{
+		Name:         "podinfo",
+		Namespace:    "flux-system",
+		ChartName:    "podinfo",
+		ChartVersion: "6.5.*",
+		SourceName:   "podinfo",
+		Values: map[string]interface{}{
+			"replicaCount": 2,
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(
+		t,
+		"---\napiVersion: helm.toolkit.fluxcd.io/v2\nkind: HelmRelease\nmetadata:\n    name: podinfo\n    namespace: flux-system\nspec:\n    chart:\n        spec:\n            chart: podinfo\n            sourceRef:\n                kind: HelmRepository\n                name: podinfo\n            version: 6.5.*\n    interval: 10m0s\n    values:\n        replicaCount: 2\n",
+		manifest,
+	)
+}
@@ -0,0 +1,56 @@
+package fluximplementationindependend
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Annotation used by the flux CLI to request a reconciliation outside of the interval.
+const ReconcileRequestedAtAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+// Kind of a flux object and its API resource.
+type FluxObjectKind struct {
+	Kind     string
+	Group    string
+	Version  string
+	Resource string
+}
+
+var GitRepositoryKind = FluxObjectKind{Kind: "GitRepository", Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "gitrepositories"}
+var OCIRepositoryKind = FluxObjectKind{Kind: "OCIRepository", Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "ocirepositories"}
+var HelmRepositoryKind = FluxObjectKind{Kind: "HelmRepository", Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "helmrepositories"}
+var HelmChartKind = FluxObjectKind{Kind: "HelmChart", Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "helmcharts"}
+var BucketKind = FluxObjectKind{Kind: "Bucket", Group: "source.toolkit.fluxcd.io", Version: "v1", Resource: "buckets"}
+var KustomizationKind = FluxObjectKind{Kind: "Kustomization", Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Resource: "kustomizations"}
+var HelmReleaseKind = FluxObjectKind{Kind: "HelmRelease", Group: "helm.toolkit.fluxcd.io", Version: "v2", Resource: "helmreleases"}
+
+// Returns the kinds included in the status overview. Sources are listed before the objects using them.
+func GetFluxObjectKinds() []FluxObjectKind {
+	return []FluxObjectKind{
+		GitRepositoryKind,
+		OCIRepositoryKind,
+		HelmRepositoryKind,
+		HelmChartKind,
+		BucketKind,
+		KustomizationKind,
+		HelmReleaseKind,
+	}
+}
+
+func GetFluxObjectKindByName(kind string) (FluxObjectKind, error) {
+	for _, k := range GetFluxObjectKinds() {
+		if k.Kind == kind {
+			return k, nil
+		}
+	}
+
+	return FluxObjectKind{}, tracederrors.TracedErrorf("Unknown flux object kind '%s'.", kind)
+}
+
+func (f FluxObjectKind) GetApiVersion() string {
+	return f.Group + "/" + f.Version
+}
+
+// Returns the fully qualified resource name as used by kubectl, e.g. 'gitrepositories.source.toolkit.fluxcd.io'.
+func (f FluxObjectKind) GetKubectlResourceName() string {
+	return f.Resource + "." + f.Group
+}
@@ -0,0 +1,127 @@
+package fluximplementationindependend
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Reconciliation state of a flux object as reported by its 'Ready' condition.
+type FluxObjectStatus struct {
+	Kind      string
+	Name      string
+	Namespace string
+
+	// Status of the 'Ready' condition: "True", "False" or "Unknown" while reconciling.
+	Ready   string
+	Reason  string
+	Message string
+
+	Suspended bool
+
+	// Last applied revision, e.g. 'main@sha1:<commit>' for a GitRepository or the chart version of a HelmRelease.
+	Revision string
+
+	// Value of the reconcile.fluxcd.io/requestedAt annotation handled by the last reconciliation.
+	LastHandledReconcileAt string
+
+	Generation         int64
+	ObservedGeneration int64
+}
+
+func getNestedString(object map[string]interface{}, fields ...string) string {
+	value, _, _ := unstructured.NestedString(object, fields...)
+	return value
+}
+
+func getNestedInt64(object map[string]interface{}, fields ...string) int64 {
+	value, found, _ := unstructured.NestedFieldNoCopy(object, fields...)
+	if !found {
+		return 0
+	}
+
+	// Objects parsed from kubectl JSON output contain float64 instead of int64:
+	switch v := value.(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+// Returns the status of the given flux object.
+// Objects which were not reconciled yet have the Ready status "Unknown".
+func GetFluxObjectStatus(object map[string]interface{}) (*FluxObjectStatus, error) {
+	if object == nil {
+		return nil, tracederrors.TracedErrorNil("object")
+	}
+
+	status := &FluxObjectStatus{
+		Kind:                   getNestedString(object, "kind"),
+		Name:                   getNestedString(object, "metadata", "name"),
+		Namespace:              getNestedString(object, "metadata", "namespace"),
+		Ready:                  "Unknown",
+		LastHandledReconcileAt: getNestedString(object, "status", "lastHandledReconcileAt"),
+		Generation:             getNestedInt64(object, "metadata", "generation"),
+		ObservedGeneration:     getNestedInt64(object, "status", "observedGeneration"),
+	}
+
+	if status.Name == "" {
+		return nil, tracederrors.TracedError("Flux object has no name")
+	}
+
+	suspended, _, _ := unstructured.NestedBool(object, "spec", "suspend")
+	status.Suspended = suspended
+
+	for _, revisionField := range [][]string{
+		{"status", "lastAppliedRevision"},
+		{"status", "artifact", "revision"},
+		{"status", "lastAttemptedRevision"},
+	} {
+		status.Revision = getNestedString(object, revisionField...)
+		if status.Revision != "" {
+			break
+		}
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if getNestedString(condition, "type") != "Ready" {
+			continue
+		}
+
+		status.Ready = getNestedString(condition, "status")
+		status.Reason = getNestedString(condition, "reason")
+		status.Message = getNestedString(condition, "message")
+	}
+
+	return status, nil
+}
+
+func (f *FluxObjectStatus) IsReady() bool {
+	return f.Ready == "True"
+}
+
+// Returns true if the reconciliation requested at 'requestedAt' finished.
+// The result of the reconciliation is available by IsReady.
+func (f *FluxObjectStatus) IsReconciliationFinished(requestedAt string) bool {
+	if f.LastHandledReconcileAt != requestedAt {
+		return false
+	}
+
+	if f.ObservedGeneration < f.Generation {
+		return false
+	}
+
+	return f.Ready != "Unknown"
+}
+
+func (f *FluxObjectStatus) String() string {
+	return f.Kind + " '" + f.Name + "' in namespace '" + f.Namespace + "'"
+}
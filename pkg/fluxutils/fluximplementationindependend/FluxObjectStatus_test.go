@@ -0,0 +1,138 @@
+package fluximplementationindependend_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluximplementationindependend"
+)
+
+func getKustomization(lastHandledReconcileAt string, observedGeneration float64, readyStatus string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "kustomize.toolkit.fluxcd.io/v1",
+		"kind":       "Kustomization",
+		"metadata": map[string]interface{}{
+			"name":       "apps",
+			"namespace":  "flux-system",
+			"generation": int64(2),
+		},
+		"spec": map[string]interface{}{
+			"suspend": false,
+		},
+		"status": map[string]interface{}{
+			"lastHandledReconcileAt": lastHandledReconcileAt,
+			"lastAppliedRevision":    "main@sha1:0123456789",
+			"observedGeneration":     observedGeneration,
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":    "Reconciling",
+					"status":  "False",
+					"message": "ignored",
+				},
+				map[string]interface{}{
+					"type":    "Ready",
+					"status":  readyStatus,
+					"reason":  "ReconciliationSucceeded",
+					"message": "Applied revision: main@sha1:0123456789",
+				},
+			},
+		},
+	}
+}
+
+func Test_GetFluxObjectStatus(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		status, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("2025-01-01T00:00:00Z", 2, "True"))
+		require.NoError(t, err)
+		require.EqualValues(t, "Kustomization", status.Kind)
+		require.EqualValues(t, "apps", status.Name)
+		require.EqualValues(t, "flux-system", status.Namespace)
+		require.True(t, status.IsReady())
+		require.False(t, status.Suspended)
+		require.EqualValues(t, "main@sha1:0123456789", status.Revision)
+		require.EqualValues(t, "Applied revision: main@sha1:0123456789", status.Message)
+		require.True(t, status.IsReconciliationFinished("2025-01-01T00:00:00Z"))
+		require.False(t, status.IsReconciliationFinished("2025-01-01T00:00:01Z"))
+	})
+
+	t.Run("outdated generation", func(t *testing.T) {
+		status, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("2025-01-01T00:00:00Z", 1, "True"))
+		require.NoError(t, err)
+		require.False(t, status.IsReconciliationFinished("2025-01-01T00:00:00Z"))
+	})
+
+	t.Run("still reconciling", func(t *testing.T) {
+		status, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("2025-01-01T00:00:00Z", 2, "Unknown"))
+		require.NoError(t, err)
+		require.False(t, status.IsReady())
+		require.False(t, status.IsReconciliationFinished("2025-01-01T00:00:00Z"))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		status, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("2025-01-01T00:00:00Z", 2, "False"))
+		require.NoError(t, err)
+		require.False(t, status.IsReady())
+		require.True(t, status.IsReconciliationFinished("2025-01-01T00:00:00Z"))
+	})
+
+	t.Run("not reconciled yet", func(t *testing.T) {
+		status, err := fluximplementationindependend.GetFluxObjectStatus(map[string]interface{}{
+			"kind": "GitRepository",
+			"metadata": map[string]interface{}{
+				"name":      "repo",
+				"namespace": "flux-system",
+			},
+			"spec": map[string]interface{}{
+				"suspend": true,
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, "Unknown", status.Ready)
+		require.True(t, status.Suspended)
+		require.EqualValues(t, "", status.Revision)
+	})
+
+	t.Run("source revision", func(t *testing.T) {
+		status, err := fluximplementationindependend.GetFluxObjectStatus(map[string]interface{}{
+			"kind": "GitRepository",
+			"metadata": map[string]interface{}{
+				"name": "repo",
+			},
+			"status": map[string]interface{}{
+				"artifact": map[string]interface{}{
+					"revision": "main@sha1:abc",
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, "main@sha1:abc", status.Revision)
+	})
+}
+
+func Test_FluxStatusOverview(t *testing.T) {
+	ready, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("", 2, "True"))
+	require.NoError(t, err)
+
+	failed, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("", 2, "False"))
+	require.NoError(t, err)
+	failed.Name = "infrastructure"
+	failed.Message = "kustomization path not found"
+
+	suspended, err := fluximplementationindependend.GetFluxObjectStatus(getKustomization("", 2, "False"))
+	require.NoError(t, err)
+	suspended.Name = "legacy"
+	suspended.Suspended = true
+
+	overview := &fluximplementationindependend.FluxStatusOverview{
+		Objects: []*fluximplementationindependend.FluxObjectStatus{ready, failed, suspended},
+	}
+
+	notReady := overview.GetNotReady()
+	require.Len(t, notReady, 1)
+	require.EqualValues(t, "infrastructure", notReady[0].Name)
+
+	rendered, err := overview.RenderAsString()
+	require.NoError(t, err)
+	require.Contains(t, rendered, "3 flux objects, 1 not ready.")
+	require.Contains(t, rendered, "kustomization path not found")
+}
@@ -0,0 +1,71 @@
+package fluximplementationindependend
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/asciich/asciichgolangpublic/pkg/spreadsheet"
+)
+
+// Status of all flux objects in a cluster.
+type FluxStatusOverview struct {
+	Objects []*FluxObjectStatus
+}
+
+// Returns all objects which are not ready and not suspended.
+func (f *FluxStatusOverview) GetNotReady() []*FluxObjectStatus {
+	notReady := []*FluxObjectStatus{}
+	for _, o := range f.Objects {
+		if !o.IsReady() && !o.Suspended {
+			notReady = append(notReady, o)
+		}
+	}
+
+	return notReady
+}
+
+func (f *FluxStatusOverview) GetSpreadSheet() (*spreadsheet.SpreadSheet, error) {
+	s := spreadsheet.NewSpreadSheet()
+
+	err := s.SetColumnTitles([]string{"KIND", "NAMESPACE", "NAME", "READY", "SUSPENDED", "REVISION", "MESSAGE"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range f.Objects {
+		revision := o.Revision
+		if revision == "" {
+			revision = "-"
+		}
+
+		err = s.AddRow([]string{o.Kind, o.Namespace, o.Name, o.Ready, strconv.FormatBool(o.Suspended), revision, o.Message})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (f *FluxStatusOverview) RenderAsString() (string, error) {
+	rendered := fmt.Sprintf("%d flux objects, %d not ready.\n", len(f.Objects), len(f.GetNotReady()))
+	if len(f.Objects) == 0 {
+		return rendered, nil
+	}
+
+	s, err := f.GetSpreadSheet()
+	if err != nil {
+		return "", err
+	}
+
+	table, err := s.RenderAsString(&spreadsheet.SpreadSheetRenderOptions{
+		SameColumnWidthForAllRows: true,
+		TitleUnderline:            "-",
+		IncludeTitleInColumnWidth: true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rendered + "\n" + table, nil
+}
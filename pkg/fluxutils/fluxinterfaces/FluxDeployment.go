@@ -3,20 +3,35 @@ package fluxinterfaces
 import (
 	"context"
 
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluximplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // Represents flux deployed in a kubernetes cluster.
 type FluxDeployment interface {
+	CreateGitRepository(ctx context.Context, options *fluxparameteroptions.CreateGitRepositoryOptions) error
+	CreateHelmRelease(ctx context.Context, options *fluxparameteroptions.CreateHelmReleaseOptions) error
+	CreateKustomization(ctx context.Context, options *fluxparameteroptions.CreateKustomizationOptions) error
 	DeleteGitRepository(ctx context.Context, name string, namespace string) error
 	DeleteHelmRelease(ctx context.Context, name string, namespace string) error
 	DeleteKustomization(ctx context.Context, name string, namespace string) error
 	GetGitRepositoryStatusMessage(ctx context.Context, name string, namespace string) (string, error)
 	GetHelmReleaseStatusMessage(ctx context.Context, name string, namespace string) (string, error)
 	GetKustomizationStatusMessage(ctx context.Context, name string, namespace string) (string, error)
+	GetStatusOverview(ctx context.Context) (*fluximplementationindependend.FluxStatusOverview, error)
 	GitRepositoryExists(ctx context.Context, name string, namespace string) (bool, error)
 	HelmReleaseExists(ctx context.Context, name string, namespace string) (bool, error)
 	KustomizationExists(ctx context.Context, name string, namespace string) (bool, error)
+	ReconcileGitRepository(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error)
+	ReconcileHelmRelease(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error)
+	ReconcileKustomization(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error)
+	ResumeGitRepository(ctx context.Context, name string, namespace string) error
+	ResumeHelmRelease(ctx context.Context, name string, namespace string) error
+	ResumeKustomization(ctx context.Context, name string, namespace string) error
+	SuspendGitRepository(ctx context.Context, name string, namespace string) error
+	SuspendHelmRelease(ctx context.Context, name string, namespace string) error
+	SuspendKustomization(ctx context.Context, name string, namespace string) error
 	WatchGitRepository(ctx context.Context, name string, namespace string, create func(*unstructured.Unstructured), update func(*unstructured.Unstructured), delete func(*unstructured.Unstructured)) error
 	WatchHelmRelease(ctx context.Context, name string, namespace string, create func(*unstructured.Unstructured), update func(*unstructured.Unstructured), delete func(*unstructured.Unstructured)) error
 	WatchKustomization(ctx context.Context, name string, namespace string, create func(*unstructured.Unstructured), update func(*unstructured.Unstructured), delete func(*unstructured.Unstructured)) error
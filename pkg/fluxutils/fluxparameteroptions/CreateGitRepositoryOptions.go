@@ -0,0 +1,55 @@
+package fluxparameteroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreateGitRepositoryOptions struct {
+	Name      string
+	Namespace string
+	Url       string
+
+	// Branch or Tag to check out. Flux uses its default branch if both are not set.
+	Branch string
+	Tag    string
+
+	// Interval to check the repository for changes. 1m is used if not set.
+	Interval time.Duration
+
+	// Name of the Secret containing the credentials to access the repository.
+	SecretRefName string
+}
+
+func (c *CreateGitRepositoryOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateGitRepositoryOptions) GetNamespace() (string, error) {
+	if c.Namespace == "" {
+		return "", tracederrors.TracedError("Namespace not set")
+	}
+
+	return c.Namespace, nil
+}
+
+func (c *CreateGitRepositoryOptions) GetUrl() (string, error) {
+	if c.Url == "" {
+		return "", tracederrors.TracedError("Url not set")
+	}
+
+	return c.Url, nil
+}
+
+func (c *CreateGitRepositoryOptions) GetIntervalOrDefault() time.Duration {
+	if c.Interval <= 0 {
+		return time.Minute
+	}
+
+	return c.Interval
+}
@@ -0,0 +1,80 @@
+package fluxparameteroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreateHelmReleaseOptions struct {
+	Name      string
+	Namespace string
+
+	ChartName string
+
+	// Version or semver range of the chart, e.g. '6.5.*'. The latest version is used if not set.
+	ChartVersion string
+
+	// Kind of the source providing the chart. "HelmRepository" is used if not set.
+	SourceKind string
+	SourceName string
+
+	// Name of the helm release. Flux uses '<TargetNamespace>-<Name>' if not set.
+	ReleaseName string
+
+	// Namespace to install the helm release into. Namespace is used if not set.
+	TargetNamespace string
+
+	// Interval to reconcile the HelmRelease. 10m is used if not set.
+	Interval time.Duration
+
+	Values map[string]interface{}
+}
+
+func (c *CreateHelmReleaseOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateHelmReleaseOptions) GetNamespace() (string, error) {
+	if c.Namespace == "" {
+		return "", tracederrors.TracedError("Namespace not set")
+	}
+
+	return c.Namespace, nil
+}
+
+func (c *CreateHelmReleaseOptions) GetChartName() (string, error) {
+	if c.ChartName == "" {
+		return "", tracederrors.TracedError("ChartName not set")
+	}
+
+	return c.ChartName, nil
+}
+
+func (c *CreateHelmReleaseOptions) GetSourceKindOrDefault() string {
+	if c.SourceKind == "" {
+		return "HelmRepository"
+	}
+
+	return c.SourceKind
+}
+
+func (c *CreateHelmReleaseOptions) GetSourceName() (string, error) {
+	if c.SourceName == "" {
+		return "", tracederrors.TracedError("SourceName not set")
+	}
+
+	return c.SourceName, nil
+}
+
+func (c *CreateHelmReleaseOptions) GetIntervalOrDefault() time.Duration {
+	if c.Interval <= 0 {
+		return 10 * time.Minute
+	}
+
+	return c.Interval
+}
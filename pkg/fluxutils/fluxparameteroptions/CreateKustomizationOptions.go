@@ -0,0 +1,76 @@
+package fluxparameteroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreateKustomizationOptions struct {
+	Name      string
+	Namespace string
+
+	// Kind of the source containing the manifests. "GitRepository" is used if not set.
+	SourceKind string
+	SourceName string
+
+	// Path of the directory containing the kustomization.yaml or plain manifests. "./" is used if not set.
+	Path string
+
+	// Interval to reconcile the Kustomization. 10m is used if not set.
+	Interval time.Duration
+
+	// Delete objects removed from the source.
+	Prune bool
+
+	// Namespace for all objects of the Kustomization. The namespaces defined in the manifests are used if not set.
+	TargetNamespace string
+}
+
+func (c *CreateKustomizationOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateKustomizationOptions) GetNamespace() (string, error) {
+	if c.Namespace == "" {
+		return "", tracederrors.TracedError("Namespace not set")
+	}
+
+	return c.Namespace, nil
+}
+
+func (c *CreateKustomizationOptions) GetSourceKindOrDefault() string {
+	if c.SourceKind == "" {
+		return "GitRepository"
+	}
+
+	return c.SourceKind
+}
+
+func (c *CreateKustomizationOptions) GetSourceName() (string, error) {
+	if c.SourceName == "" {
+		return "", tracederrors.TracedError("SourceName not set")
+	}
+
+	return c.SourceName, nil
+}
+
+func (c *CreateKustomizationOptions) GetPathOrDefault() string {
+	if c.Path == "" {
+		return "./"
+	}
+
+	return c.Path
+}
+
+func (c *CreateKustomizationOptions) GetIntervalOrDefault() time.Duration {
+	if c.Interval <= 0 {
+		return 10 * time.Minute
+	}
+
+	return c.Interval
+}
@@ -0,0 +1,22 @@
+package fluxparameteroptions
+
+import "time"
+
+// Time to wait for a reconciliation to finish if ReconcileOptions.Timeout is not set.
+const DefaultReconcileTimeout = 5 * time.Minute
+
+type ReconcileOptions struct {
+	// Maximum time to wait until the requested reconciliation finished. DefaultReconcileTimeout is used if not set.
+	Timeout time.Duration
+
+	// Only request the reconciliation without waiting for the result.
+	SkipWait bool
+}
+
+func (r *ReconcileOptions) GetTimeoutOrDefault() time.Duration {
+	if r.Timeout <= 0 {
+		return DefaultReconcileTimeout
+	}
+
+	return r.Timeout
+}
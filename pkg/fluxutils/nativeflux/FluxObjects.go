@@ -0,0 +1,278 @@
+package nativeflux
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluximplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/fluxutils/fluxparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func getGVR(kind fluximplementationindependend.FluxObjectKind) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    kind.Group,
+		Version:  kind.Version,
+		Resource: kind.Resource,
+	}
+}
+
+func (f *FluxDeployment) applyManifest(ctx context.Context, manifest string) error {
+	cluster, err := f.GetKubernetesCluster()
+	if err != nil {
+		return err
+	}
+
+	_, err = cluster.ApplyManifests(ctx, &kubernetesparameteroptions.ApplyManifestsOptions{
+		YamlString:            manifest,
+		SkipNamespaceCreation: true,
+	})
+
+	return err
+}
+
+func (f *FluxDeployment) CreateGitRepository(ctx context.Context, options *fluxparameteroptions.CreateGitRepositoryOptions) error {
+	manifest, err := fluximplementationindependend.GetGitRepositoryManifest(options)
+	if err != nil {
+		return err
+	}
+
+	return f.applyManifest(ctx, manifest)
+}
+
+func (f *FluxDeployment) CreateKustomization(ctx context.Context, options *fluxparameteroptions.CreateKustomizationOptions) error {
+	manifest, err := fluximplementationindependend.GetKustomizationManifest(options)
+	if err != nil {
+		return err
+	}
+
+	return f.applyManifest(ctx, manifest)
+}
+
+func (f *FluxDeployment) CreateHelmRelease(ctx context.Context, options *fluxparameteroptions.CreateHelmReleaseOptions) error {
+	manifest, err := fluximplementationindependend.GetHelmReleaseManifest(options)
+	if err != nil {
+		return err
+	}
+
+	return f.applyManifest(ctx, manifest)
+}
+
+func (f *FluxDeployment) getFluxObjectStatus(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string) (*fluximplementationindependend.FluxObjectStatus, error) {
+	dynamicClient, err := f.GetDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := dynamicClient.Resource(getGVR(kind)).Namespace(namespaceName).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get Flux %s '%s' in namespace '%s': %w", kind.Kind, name, namespaceName, err)
+	}
+
+	return fluximplementationindependend.GetFluxObjectStatus(object.Object)
+}
+
+func (f *FluxDeployment) patchFluxObject(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string, patch map[string]interface{}) error {
+	dynamicClient, err := f.GetDynamicClient()
+	if err != nil {
+		return err
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to marshal patch: %w", err)
+	}
+
+	_, err = dynamicClient.Resource(getGVR(kind)).Namespace(namespaceName).Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to patch Flux %s '%s' in namespace '%s': %w", kind.Kind, name, namespaceName, err)
+	}
+
+	return nil
+}
+
+// Requests a reconciliation by setting the reconcile.fluxcd.io/requestedAt annotation and waits until it finished.
+func (f *FluxDeployment) reconcile(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespace")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	requestedAt := time.Now().Format(time.RFC3339Nano)
+
+	err := f.patchFluxObject(ctx, kind, name, namespaceName, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				fluximplementationindependend.ReconcileRequestedAtAnnotation: requestedAt,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Reconciliation of Flux %s '%s' in namespace '%s' requested.", kind.Kind, name, namespaceName)
+
+	if options.SkipWait {
+		return f.getFluxObjectStatus(ctx, kind, name, namespaceName)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, options.GetTimeoutOrDefault())
+	defer cancel()
+
+	for {
+		status, err := f.getFluxObjectStatus(timeoutCtx, kind, name, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.IsReconciliationFinished(requestedAt) {
+			if !status.IsReady() {
+				return nil, tracederrors.TracedErrorf("Reconciliation of Flux %s '%s' in namespace '%s' failed: %s", kind.Kind, name, namespaceName, status.Message)
+			}
+
+			logging.LogInfoByCtxf(ctx, "Flux %s '%s' in namespace '%s' reconciled revision '%s'.", kind.Kind, name, namespaceName, status.Revision)
+
+			return status, nil
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			return nil, tracederrors.TracedErrorf("Timeout waiting for reconciliation of Flux %s '%s' in namespace '%s'. Last status message: '%s'", kind.Kind, name, namespaceName, status.Message)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (f *FluxDeployment) setSuspended(ctx context.Context, kind fluximplementationindependend.FluxObjectKind, name string, namespaceName string, suspend bool) error {
+	if name == "" {
+		return tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespace")
+	}
+
+	status, err := f.getFluxObjectStatus(ctx, kind, name, namespaceName)
+	if err != nil {
+		return err
+	}
+
+	if status.Suspended == suspend {
+		logging.LogInfoByCtxf(ctx, "Flux %s '%s' in namespace '%s' already suspended: '%t'.", kind.Kind, name, namespaceName, suspend)
+		return nil
+	}
+
+	err = f.patchFluxObject(ctx, kind, name, namespaceName, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"suspend": suspend,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if suspend {
+		logging.LogChangedByCtxf(ctx, "Flux %s '%s' in namespace '%s' suspended.", kind.Kind, name, namespaceName)
+		return nil
+	}
+
+	logging.LogChangedByCtxf(ctx, "Flux %s '%s' in namespace '%s' resumed.", kind.Kind, name, namespaceName)
+
+	// Like the flux CLI a resumed object is reconciled immediately instead of waiting for the next interval:
+	_, err = f.reconcile(contextutils.WithSilent(ctx), kind, name, namespaceName, &fluxparameteroptions.ReconcileOptions{SkipWait: true})
+
+	return err
+}
+
+func (f *FluxDeployment) ReconcileGitRepository(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	return f.reconcile(ctx, fluximplementationindependend.GitRepositoryKind, name, namespace, options)
+}
+
+func (f *FluxDeployment) ReconcileKustomization(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	return f.reconcile(ctx, fluximplementationindependend.KustomizationKind, name, namespace, options)
+}
+
+func (f *FluxDeployment) ReconcileHelmRelease(ctx context.Context, name string, namespace string, options *fluxparameteroptions.ReconcileOptions) (*fluximplementationindependend.FluxObjectStatus, error) {
+	return f.reconcile(ctx, fluximplementationindependend.HelmReleaseKind, name, namespace, options)
+}
+
+func (f *FluxDeployment) SuspendGitRepository(ctx context.Context, name string, namespace string) error {
+	return f.setSuspended(ctx, fluximplementationindependend.GitRepositoryKind, name, namespace, true)
+}
+
+func (f *FluxDeployment) SuspendKustomization(ctx context.Context, name string, namespace string) error {
+	return f.setSuspended(ctx, fluximplementationindependend.KustomizationKind, name, namespace, true)
+}
+
+func (f *FluxDeployment) SuspendHelmRelease(ctx context.Context, name string, namespace string) error {
+	return f.setSuspended(ctx, fluximplementationindependend.HelmReleaseKind, name, namespace, true)
+}
+
+func (f *FluxDeployment) ResumeGitRepository(ctx context.Context, name string, namespace string) error {
+	return f.setSuspended(ctx, fluximplementationindependend.GitRepositoryKind, name, namespace, false)
+}
+
+func (f *FluxDeployment) ResumeKustomization(ctx context.Context, name string, namespace string) error {
+	return f.setSuspended(ctx, fluximplementationindependend.KustomizationKind, name, namespace, false)
+}
+
+func (f *FluxDeployment) ResumeHelmRelease(ctx context.Context, name string, namespace string) error {
+	return f.setSuspended(ctx, fluximplementationindependend.HelmReleaseKind, name, namespace, false)
+}
+
+// Returns the status of all flux objects in all namespaces of the cluster.
+// Kinds whose CRD is not installed are skipped.
+func (f *FluxDeployment) GetStatusOverview(ctx context.Context) (*fluximplementationindependend.FluxStatusOverview, error) {
+	dynamicClient, err := f.GetDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &fluximplementationindependend.FluxStatusOverview{
+		Objects: []*fluximplementationindependend.FluxObjectStatus{},
+	}
+
+	for _, kind := range fluximplementationindependend.GetFluxObjectKinds() {
+		list, err := dynamicClient.Resource(getGVR(kind)).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				logging.LogInfoByCtxf(ctx, "Flux kind '%s' is not installed. Skipped.", kind.Kind)
+				continue
+			}
+
+			return nil, tracederrors.TracedErrorf("Failed to list Flux %s objects: %w", kind.Kind, err)
+		}
+
+		for _, item := range list.Items {
+			status, err := fluximplementationindependend.GetFluxObjectStatus(item.Object)
+			if err != nil {
+				return nil, err
+			}
+
+			// Items of a list do not always carry their kind:
+			status.Kind = kind.Kind
+
+			overview.Objects = append(overview.Objects, status)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' Flux objects. '%d' are not ready.", len(overview.Objects), len(overview.GetNotReady()))
+
+	return overview, nil
+}
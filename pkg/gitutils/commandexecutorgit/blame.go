@@ -0,0 +1,98 @@
+package commandexecutorgit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// GetBlame returns for every line of a file the commit which last modified it using `git blame`.
+func GetBlame(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, repoPath string, options *gitparameteroptions.GitBlameOptions) ([]*gitimplementationindependend.BlameLine, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	path, err := options.GetPath()
+	if err != nil {
+		return nil, err
+	}
+
+	ref := options.GetRefOrDefault()
+
+	stdout, err := runGitCommandAndGetStdoutAsString(ctx, commandExecutor, repoPath, []string{"blame", "--line-porcelain", ref, "--", path})
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := parseBlameLinePorcelain(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Blamed '%d' lines of '%s' at '%s' in git repository '%s'.", len(ret), path, ref, repoPath)
+
+	return ret, nil
+}
+
+func parseBlameLinePorcelain(stdout string) ([]*gitimplementationindependend.BlameLine, error) {
+	ret := []*gitimplementationindependend.BlameLine{}
+
+	var current *gitimplementationindependend.BlameLine
+	for _, line := range strings.Split(stdout, "\n") {
+		if current == nil {
+			if line == "" {
+				continue
+			}
+
+			// Header: <hash> <original line number> <final line number> [<number of lines in group>]
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				return nil, tracederrors.TracedErrorf("Unable to parse git blame header '%s'", line)
+			}
+
+			lineNumber, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return nil, tracederrors.TracedErrorf("Unable to parse line number of git blame header '%s': %w", line, err)
+			}
+
+			current = &gitimplementationindependend.BlameLine{
+				Hash:       fields[0],
+				LineNumber: lineNumber,
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "\t") {
+			current.Content = strings.TrimPrefix(line, "\t")
+			ret = append(ret, current)
+			current = nil
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "author":
+			current.AuthorName = value
+		case "author-mail":
+			current.AuthorEmail = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		case "author-time":
+			authorTime, err := parseUnixTimestamp(value)
+			if err != nil {
+				return nil, err
+			}
+			current.AuthorTime = authorTime
+		}
+	}
+
+	if current != nil {
+		return nil, tracederrors.TracedErrorf("Incomplete git blame output for commit '%s'", current.Hash)
+	}
+
+	return ret, nil
+}
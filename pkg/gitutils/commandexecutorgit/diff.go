@@ -0,0 +1,71 @@
+package commandexecutorgit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// GetDiff returns the changes between two commits using `git diff`.
+//
+// Renames are not detected and therefore reported as deleted and added file.
+func GetDiff(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, repoPath string, options *gitparameteroptions.GitDiffOptions) (*gitimplementationindependend.Diff, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	fromRef, err := options.GetFromRef()
+	if err != nil {
+		return nil, err
+	}
+
+	fromHash, err := GetCommitHashByRef(ctx, commandExecutor, repoPath, fromRef)
+	if err != nil {
+		return nil, err
+	}
+
+	toHash, err := GetCommitHashByRef(ctx, commandExecutor, repoPath, options.GetToRefOrDefault())
+	if err != nil {
+		return nil, err
+	}
+
+	command := []string{"diff", "--no-color", "--no-ext-diff", "--no-renames", fromHash, toHash, "--"}
+	command = append(command, options.Paths...)
+
+	stdout, err := runGitCommandAndGetStdoutAsString(ctx, commandExecutor, repoPath, command)
+	if err != nil {
+		return nil, err
+	}
+
+	fileDiffs, err := gitimplementationindependend.ParseUnifiedDiff(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Diff between '%s' and '%s' in git repository '%s' contains '%d' changed files.", fromHash, toHash, repoPath, len(fileDiffs))
+
+	return &gitimplementationindependend.Diff{
+		FromHash: fromHash,
+		ToHash:   toHash,
+		Files:    fileDiffs,
+	}, nil
+}
+
+// GetCommitHashByRef resolves 'ref' which can be a branch, tag, commit hash or an expression like "HEAD~1".
+func GetCommitHashByRef(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, repoPath string, ref string) (string, error) {
+	if ref == "" {
+		return "", tracederrors.TracedErrorEmptyString("ref")
+	}
+
+	stdout, err := runGitCommandAndGetStdoutAsString(ctx, commandExecutor, repoPath, []string{"rev-parse", "--verify", ref + "^{commit}"})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
@@ -0,0 +1,122 @@
+package commandexecutorgit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const logFieldSeparator = "\x1f"
+const logRecordSeparator = "\x1e"
+
+// ListCommitLogEntries traverses the commit log of the repository at 'repoPath' using `git log`.
+// The newest commit is returned first.
+func ListCommitLogEntries(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, repoPath string, options *gitparameteroptions.GitLogOptions) ([]*gitimplementationindependend.CommitLogEntry, error) {
+	if options == nil {
+		options = &gitparameteroptions.GitLogOptions{}
+	}
+
+	command := []string{
+		"log",
+		"--no-color",
+		"--format=%H%x1f%P%x1f%an%x1f%ae%x1f%at%x1f%cn%x1f%ce%x1f%ct%x1f%B%x1e",
+	}
+
+	if options.MaxCount > 0 {
+		command = append(command, "--max-count="+strconv.Itoa(options.MaxCount))
+	}
+
+	if options.Author != "" {
+		command = append(command, "--fixed-strings", "--author="+options.Author)
+	}
+
+	if options.Since != nil {
+		command = append(command, fmt.Sprintf("--since=@%d", options.Since.Unix()))
+	}
+
+	if options.Until != nil {
+		command = append(command, fmt.Sprintf("--until=@%d", options.Until.Unix()))
+	}
+
+	if options.IsFromRefSet() {
+		command = append(command, options.FromRef+".."+options.GetToRefOrDefault())
+	} else {
+		command = append(command, options.GetToRefOrDefault())
+	}
+
+	command = append(command, "--")
+	if options.Path != "" {
+		command = append(command, options.Path)
+	}
+
+	stdout, err := runGitCommandAndGetStdoutAsString(ctx, commandExecutor, repoPath, command)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := parseLogOutput(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collected '%d' commit log entries of git repository '%s'.", len(ret), repoPath)
+
+	return ret, nil
+}
+
+func parseLogOutput(stdout string) ([]*gitimplementationindependend.CommitLogEntry, error) {
+	ret := []*gitimplementationindependend.CommitLogEntry{}
+
+	for _, record := range strings.Split(stdout, logRecordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+
+		fields := strings.Split(record, logFieldSeparator)
+		if len(fields) != 9 {
+			return nil, tracederrors.TracedErrorf("Unable to parse git log record, expected 9 fields but got %d: '%s'", len(fields), record)
+		}
+
+		authorTime, err := parseUnixTimestamp(fields[4])
+		if err != nil {
+			return nil, err
+		}
+
+		commitTime, err := parseUnixTimestamp(fields[7])
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, &gitimplementationindependend.CommitLogEntry{
+			Hash:           fields[0],
+			ParentHashes:   strings.Fields(fields[1]),
+			AuthorName:     fields[2],
+			AuthorEmail:    fields[3],
+			AuthorTime:     authorTime,
+			CommitterName:  fields[5],
+			CommitterEmail: fields[6],
+			CommitTime:     commitTime,
+			Message:        strings.TrimRight(fields[8], "\n"),
+		})
+	}
+
+	return ret, nil
+}
+
+func parseUnixTimestamp(timestamp string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return time.Time{}, tracederrors.TracedErrorf("Unable to parse unix timestamp '%s': %w", timestamp, err)
+	}
+
+	return time.Unix(seconds, 0), nil
+}
@@ -0,0 +1,30 @@
+package commandexecutorgit
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func runGitCommandAndGetStdoutAsString(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, repoPath string, gitCommand []string) (string, error) {
+	if commandExecutor == nil {
+		return "", tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if repoPath == "" {
+		return "", tracederrors.TracedErrorEmptyString("repoPath")
+	}
+
+	if len(gitCommand) <= 0 {
+		return "", tracederrors.TracedError("gitCommand has no elements")
+	}
+
+	return commandExecutor.RunCommandAndGetStdoutAsString(
+		ctx,
+		&parameteroptions.RunCommandOptions{
+			Command: append([]string{"git", "-C", repoPath}, gitCommand...),
+		},
+	)
+}
@@ -0,0 +1,51 @@
+package commandexecutorgitoo
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/commandexecutorgit"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+)
+
+func (g *GitRepository) GetBlame(ctx context.Context, options *gitparameteroptions.GitBlameOptions) ([]*gitimplementationindependend.BlameLine, error) {
+	path, err := g.GetPath()
+	if err != nil {
+		return nil, err
+	}
+
+	commandExecutor, err := g.GetCommandExecutor()
+	if err != nil {
+		return nil, err
+	}
+
+	return commandexecutorgit.GetBlame(ctx, commandExecutor, path, options)
+}
+
+func (g *GitRepository) GetDiff(ctx context.Context, options *gitparameteroptions.GitDiffOptions) (*gitimplementationindependend.Diff, error) {
+	path, err := g.GetPath()
+	if err != nil {
+		return nil, err
+	}
+
+	commandExecutor, err := g.GetCommandExecutor()
+	if err != nil {
+		return nil, err
+	}
+
+	return commandexecutorgit.GetDiff(ctx, commandExecutor, path, options)
+}
+
+func (g *GitRepository) ListCommitLogEntries(ctx context.Context, options *gitparameteroptions.GitLogOptions) ([]*gitimplementationindependend.CommitLogEntry, error) {
+	path, err := g.GetPath()
+	if err != nil {
+		return nil, err
+	}
+
+	commandExecutor, err := g.GetCommandExecutor()
+	if err != nil {
+		return nil, err
+	}
+
+	return commandexecutorgit.ListCommitLogEntries(ctx, commandExecutor, path, options)
+}
@@ -0,0 +1,76 @@
+package conventionalcommits
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Matches the header of a conventional commit: "type(optional scope)!: description"
+var headerRegex = regexp.MustCompile(`^([a-zA-Z]+)(?:\(([^()]*)\))?(!)?: (.+)$`)
+
+// Matches the footers marking a breaking change:
+var breakingChangeFooterRegex = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+
+// ConventionalCommit is a commit message following the https://www.conventionalcommits.org specification.
+type ConventionalCommit struct {
+	Hash             string
+	Type             string
+	Scope            string
+	Description      string
+	Body             string
+	IsBreakingChange bool
+}
+
+// ParseCommitMessage parses 'message' as conventional commit.
+// ErrNotAConventionalCommit is returned if the message does not follow the conventional commit format.
+func ParseCommitMessage(message string) (*ConventionalCommit, error) {
+	header, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+
+	matches := headerRegex.FindStringSubmatch(strings.TrimSpace(header))
+	if matches == nil {
+		return nil, tracederrors.TracedErrorf("%w: '%s'", ErrNotAConventionalCommit, header)
+	}
+
+	body = strings.TrimSpace(body)
+
+	return &ConventionalCommit{
+		Type:             strings.ToLower(matches[1]),
+		Scope:            strings.TrimSpace(matches[2]),
+		Description:      strings.TrimSpace(matches[4]),
+		Body:             body,
+		IsBreakingChange: matches[3] == "!" || breakingChangeFooterRegex.MatchString(body),
+	}, nil
+}
+
+// IsConventionalCommitMessage returns true if 'message' follows the conventional commit format.
+func IsConventionalCommitMessage(message string) bool {
+	_, err := ParseCommitMessage(message)
+	return err == nil
+}
+
+// GetBumpType returns the semantic version part to increase for this commit: "major", "minor", "patch" or empty string if no release is needed.
+func (c *ConventionalCommit) GetBumpType() string {
+	if c.IsBreakingChange {
+		return BumpTypeMajor
+	}
+
+	switch c.Type {
+	case "feat":
+		return BumpTypeMinor
+	case "fix", "perf":
+		return BumpTypePatch
+	}
+
+	return BumpTypeNone
+}
+
+// GetShortHash returns the first 7 characters of the commit hash.
+func (c *ConventionalCommit) GetShortHash() string {
+	if len(c.Hash) > 7 {
+		return c.Hash[:7]
+	}
+
+	return c.Hash
+}
@@ -0,0 +1,46 @@
+package conventionalcommits_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/conventionalcommits"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/versionutils"
+)
+
+// ExampleCreateReleasePlan demonstrates how the next version and the changelog are evaluated from conventional commits.
+//
+// To directly tag a git repository use `CreateConventionalCommitsRelease` available on every GitRepository and GitlabProject.
+func ExampleCreateReleasePlan() {
+	previousVersion, err := versionutils.NewSemanticVersionFromString("v1.4.2")
+	if err != nil {
+		fmt.Printf("Failed to parse version: %v\n", err)
+		return
+	}
+
+	// Usually the log entries are collected using ListCommitLogEntries of a GitRepository:
+	logEntries := []*gitimplementationindependend.CommitLogEntry{
+		{Hash: "9fceb02d0ae598e95dc970b74767f19372d61af8", Message: "feat(cli): add --json flag"},
+		{Hash: "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391", Message: "fix: do not crash on empty input"},
+	}
+
+	releasePlan, err := conventionalcommits.CreateReleasePlan(previousVersion, logEntries, "", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		fmt.Printf("Failed to create release plan: %v\n", err)
+		return
+	}
+
+	fmt.Print(releasePlan.ChangelogSection)
+
+	// Output:
+	// ## v1.5.0 (2024-01-31)
+	//
+	// ### Features
+	//
+	// * **cli:** add --json flag (9fceb02)
+	//
+	// ### Bug Fixes
+	//
+	// * do not crash on empty input (e69de29)
+}
@@ -0,0 +1,95 @@
+package conventionalcommits
+
+import (
+	"errors"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/versionutils"
+)
+
+// DefaultInitialVersion is used as first release if no version tag exists yet.
+const DefaultInitialVersion = "v0.1.0"
+
+// ReleasePlan describes the next release evaluated from the conventional commits since the last version.
+type ReleasePlan struct {
+	// The latest released version, nil if there is no release yet:
+	PreviousVersion versionutils.Version
+
+	// The version to release, nil if no release is needed:
+	NextVersion versionutils.Version
+
+	BumpType string
+
+	// All conventional commits since PreviousVersion:
+	Commits []*ConventionalCommit
+
+	// Hashes of the commits since PreviousVersion not following the conventional commit format:
+	IgnoredCommitHashes []string
+
+	// Markdown changelog section of NextVersion:
+	ChangelogSection string
+}
+
+// CreateReleasePlan evaluates the next release based on the 'logEntries' since 'previousVersion'.
+//
+// Commit messages not following the conventional commit format are ignored.
+// If 'initialVersion' is empty DefaultInitialVersion is used.
+func CreateReleasePlan(previousVersion versionutils.Version, logEntries []*gitimplementationindependend.CommitLogEntry, initialVersion string, releaseDate time.Time) (*ReleasePlan, error) {
+	if initialVersion == "" {
+		initialVersion = DefaultInitialVersion
+	}
+
+	ret := &ReleasePlan{
+		PreviousVersion:     previousVersion,
+		Commits:             []*ConventionalCommit{},
+		IgnoredCommitHashes: []string{},
+	}
+
+	for _, entry := range logEntries {
+		if entry == nil {
+			continue
+		}
+
+		conventionalCommit, err := ParseCommitMessage(entry.Message)
+		if err != nil {
+			if errors.Is(err, ErrNotAConventionalCommit) {
+				ret.IgnoredCommitHashes = append(ret.IgnoredCommitHashes, entry.Hash)
+				continue
+			}
+
+			return nil, err
+		}
+
+		conventionalCommit.Hash = entry.Hash
+		ret.Commits = append(ret.Commits, conventionalCommit)
+	}
+
+	ret.BumpType = GetBumpType(ret.Commits)
+
+	nextVersion, err := GetNextVersion(previousVersion, ret.Commits, initialVersion)
+	if err != nil {
+		return nil, err
+	}
+	ret.NextVersion = nextVersion
+
+	if ret.IsReleaseNeeded() {
+		ret.ChangelogSection = GenerateChangelogSection(nextVersion.String(), releaseDate, ret.Commits)
+	}
+
+	return ret, nil
+}
+
+// IsReleaseNeeded returns true if at least one commit requires a new version.
+func (r *ReleasePlan) IsReleaseNeeded() bool {
+	return r.NextVersion != nil
+}
+
+// GetNextVersionString returns the next version as string or an empty string if no release is needed.
+func (r *ReleasePlan) GetNextVersionString() string {
+	if r.NextVersion == nil {
+		return ""
+	}
+
+	return r.NextVersion.String()
+}
@@ -0,0 +1,58 @@
+package conventionalcommits
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"github.com/asciich/asciichgolangpublic/pkg/versionutils"
+)
+
+const (
+	BumpTypeNone  = ""
+	BumpTypePatch = "patch"
+	BumpTypeMinor = "minor"
+	BumpTypeMajor = "major"
+)
+
+var bumpTypePriority = map[string]int{
+	BumpTypeNone:  0,
+	BumpTypePatch: 1,
+	BumpTypeMinor: 2,
+	BumpTypeMajor: 3,
+}
+
+// GetBumpType returns the highest bump type of all 'commits'.
+func GetBumpType(commits []*ConventionalCommit) string {
+	ret := BumpTypeNone
+	for _, c := range commits {
+		bumpType := c.GetBumpType()
+		if bumpTypePriority[bumpType] > bumpTypePriority[ret] {
+			ret = bumpType
+		}
+	}
+
+	return ret
+}
+
+// GetNextVersion returns the version following 'currentVersion' according to the conventional 'commits'.
+//
+// If 'currentVersion' is nil there is no release yet and 'initialVersion' is returned as soon as a release is needed.
+// nil is returned if none of the commits requires a release.
+func GetNextVersion(currentVersion versionutils.Version, commits []*ConventionalCommit, initialVersion string) (versionutils.Version, error) {
+	bumpType := GetBumpType(commits)
+	if bumpType == BumpTypeNone {
+		return nil, nil
+	}
+
+	if currentVersion == nil {
+		if initialVersion == "" {
+			return nil, tracederrors.TracedErrorEmptyString("initialVersion")
+		}
+
+		return versionutils.NewSemanticVersionFromString(initialVersion)
+	}
+
+	if !currentVersion.IsSemanticVersion() {
+		return nil, tracederrors.TracedErrorf("%w: '%s'", ErrNotASemanticVersion, currentVersion)
+	}
+
+	return currentVersion.GetNextVersion(bumpType)
+}
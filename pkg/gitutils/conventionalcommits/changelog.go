@@ -0,0 +1,95 @@
+package conventionalcommits
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type changelogGroup struct {
+	title   string
+	matches func(c *ConventionalCommit) bool
+}
+
+var changelogGroups = []changelogGroup{
+	{"Breaking Changes", func(c *ConventionalCommit) bool { return c.IsBreakingChange }},
+	{"Features", func(c *ConventionalCommit) bool { return c.Type == "feat" }},
+	{"Bug Fixes", func(c *ConventionalCommit) bool { return c.Type == "fix" }},
+	{"Performance Improvements", func(c *ConventionalCommit) bool { return c.Type == "perf" }},
+	{"Other Changes", func(c *ConventionalCommit) bool { return true }},
+}
+
+// GenerateChangelogSection renders a Markdown CHANGELOG section for 'version' with the 'commits' grouped by type.
+//
+// Breaking changes are listed in their own group and additionally in the group of their type.
+func GenerateChangelogSection(version string, date time.Time, commits []*ConventionalCommit) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("## %s (%s)\n", version, date.Format(time.DateOnly)))
+
+	for i, group := range changelogGroups {
+		entries := []string{}
+		for _, c := range commits {
+			if i > 0 && isListedInEarlierTypeGroup(c, i) {
+				continue
+			}
+
+			if group.matches(c) {
+				entries = append(entries, formatChangelogEntry(c))
+			}
+		}
+
+		if len(entries) == 0 {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("\n### %s\n\n", group.title))
+		for _, entry := range entries {
+			sb.WriteString(entry + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// The first group collects the breaking changes, all other groups are exclusive.
+func isListedInEarlierTypeGroup(c *ConventionalCommit, groupIndex int) bool {
+	for j := 1; j < groupIndex; j++ {
+		if changelogGroups[j].matches(c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatChangelogEntry(c *ConventionalCommit) string {
+	entry := "* "
+	if c.Scope != "" {
+		entry += "**" + c.Scope + ":** "
+	}
+
+	entry += c.Description
+
+	if c.Hash != "" {
+		entry += " (" + c.GetShortHash() + ")"
+	}
+
+	return entry
+}
+
+// PrependChangelogSection adds 'section' on top of the existing 'changelog' content while keeping a leading "# " title.
+func PrependChangelogSection(changelog string, section string) string {
+	section = strings.TrimRight(section, "\n") + "\n"
+
+	if strings.TrimSpace(changelog) == "" {
+		return "# Changelog\n\n" + section
+	}
+
+	if strings.HasPrefix(changelog, "# ") {
+		title, rest, _ := strings.Cut(changelog, "\n")
+		return title + "\n\n" + section + "\n" + strings.TrimLeft(rest, "\n")
+	}
+
+	return section + "\n" + changelog
+}
@@ -0,0 +1,161 @@
+package conventionalcommits_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/conventionalcommits"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/versionutils"
+)
+
+func TestParseCommitMessage(t *testing.T) {
+	tests := []struct {
+		message          string
+		expectedType     string
+		expectedScope    string
+		expectedDesc     string
+		expectedBreaking bool
+		expectedBumpType string
+	}{
+		{"feat: add login", "feat", "", "add login", false, "minor"},
+		{"fix(api): handle nil", "fix", "api", "handle nil", false, "patch"},
+		{"perf: faster", "perf", "", "faster", false, "patch"},
+		{"docs: update readme", "docs", "", "update readme", false, ""},
+		{"feat(api)!: drop v1", "feat", "api", "drop v1", true, "major"},
+		{"refactor: rename\n\nBREAKING CHANGE: config key renamed", "refactor", "", "rename", true, "major"},
+		{"Fix: upper case type", "fix", "", "upper case type", false, "patch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			c, err := conventionalcommits.ParseCommitMessage(tt.message)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedType, c.Type)
+			require.EqualValues(t, tt.expectedScope, c.Scope)
+			require.EqualValues(t, tt.expectedDesc, c.Description)
+			require.EqualValues(t, tt.expectedBreaking, c.IsBreakingChange)
+			require.EqualValues(t, tt.expectedBumpType, c.GetBumpType())
+			require.True(t, conventionalcommits.IsConventionalCommitMessage(tt.message))
+		})
+	}
+
+	for _, message := range []string{"", "add login", "feat add login", "feat:missing space", "Merge branch 'main'"} {
+		t.Run("invalid "+message, func(t *testing.T) {
+			c, err := conventionalcommits.ParseCommitMessage(message)
+			require.ErrorIs(t, err, conventionalcommits.ErrNotAConventionalCommit)
+			require.Nil(t, c)
+			require.False(t, conventionalcommits.IsConventionalCommitMessage(message))
+		})
+	}
+}
+
+func TestGetNextVersion(t *testing.T) {
+	current, err := versionutils.NewSemanticVersionFromString("v1.2.3")
+	require.NoError(t, err)
+
+	parse := func(messages ...string) []*conventionalcommits.ConventionalCommit {
+		ret := []*conventionalcommits.ConventionalCommit{}
+		for _, m := range messages {
+			c, err := conventionalcommits.ParseCommitMessage(m)
+			require.NoError(t, err)
+			ret = append(ret, c)
+		}
+		return ret
+	}
+
+	tests := []struct {
+		name     string
+		current  versionutils.Version
+		commits  []*conventionalcommits.ConventionalCommit
+		expected string
+	}{
+		{"no commits", current, parse(), ""},
+		{"chore only", current, parse("chore: cleanup"), ""},
+		{"patch", current, parse("fix: a", "chore: b"), "v1.2.4"},
+		{"minor", current, parse("fix: a", "feat: b"), "v1.3.0"},
+		{"major", current, parse("feat!: a", "fix: b"), "v2.0.0"},
+		{"initial", nil, parse("fix: a"), "v0.1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := conventionalcommits.GetNextVersion(tt.current, tt.commits, conventionalcommits.DefaultInitialVersion)
+			require.NoError(t, err)
+
+			if tt.expected == "" {
+				require.Nil(t, next)
+			} else {
+				require.EqualValues(t, tt.expected, next.String())
+			}
+		})
+	}
+}
+
+func TestGenerateChangelogSection(t *testing.T) {
+	commits := []*conventionalcommits.ConventionalCommit{
+		{Hash: "1111111111", Type: "feat", Scope: "api", Description: "add endpoint"},
+		{Hash: "2222222222", Type: "fix", Description: "handle nil"},
+		{Hash: "3333333333", Type: "feat", Description: "remove old flag", IsBreakingChange: true},
+		{Hash: "4444444444", Type: "docs", Description: "update readme"},
+	}
+
+	section := conventionalcommits.GenerateChangelogSection("v2.0.0", time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC), commits)
+	require.EqualValues(
+		t,
+		"## v2.0.0 (2024-05-17)\n"+
+			"\n### Breaking Changes\n\n"+
+			"* remove old flag (3333333)\n"+
+			"\n### Features\n\n"+
+			"* **api:** add endpoint (1111111)\n"+
+			"* remove old flag (3333333)\n"+
+			"\n### Bug Fixes\n\n"+
+			"* handle nil (2222222)\n"+
+			"\n### Other Changes\n\n"+
+			"* update readme (4444444)\n",
+		section,
+	)
+}
+
+func TestPrependChangelogSection(t *testing.T) {
+	t.Run("empty changelog", func(t *testing.T) {
+		require.EqualValues(t, "# Changelog\n\n## v1.0.0\n", conventionalcommits.PrependChangelogSection("", "## v1.0.0\n"))
+	})
+
+	t.Run("keep title", func(t *testing.T) {
+		require.EqualValues(
+			t,
+			"# Changelog\n\n## v1.1.0\n\n## v1.0.0\n",
+			conventionalcommits.PrependChangelogSection("# Changelog\n\n## v1.0.0\n", "## v1.1.0\n"),
+		)
+	})
+}
+
+func TestCreateReleasePlan(t *testing.T) {
+	current, err := versionutils.NewSemanticVersionFromString("v0.3.1")
+	require.NoError(t, err)
+
+	logEntries := []*gitimplementationindependend.CommitLogEntry{
+		{Hash: "aaaaaaaaaa", Message: "feat: new feature\n\nwith body"},
+		{Hash: "bbbbbbbbbb", Message: "Merge branch 'feature'"},
+		{Hash: "cccccccccc", Message: "fix: bug"},
+	}
+
+	releasePlan, err := conventionalcommits.CreateReleasePlan(current, logEntries, "", time.Now())
+	require.NoError(t, err)
+	require.True(t, releasePlan.IsReleaseNeeded())
+	require.EqualValues(t, "v0.4.0", releasePlan.GetNextVersionString())
+	require.EqualValues(t, "minor", releasePlan.BumpType)
+	require.Len(t, releasePlan.Commits, 2)
+	require.EqualValues(t, "aaaaaaaaaa", releasePlan.Commits[0].Hash)
+	require.EqualValues(t, []string{"bbbbbbbbbb"}, releasePlan.IgnoredCommitHashes)
+	require.Contains(t, releasePlan.ChangelogSection, "* new feature (aaaaaaa)")
+
+	releasePlan, err = conventionalcommits.CreateReleasePlan(current, logEntries[1:2], "", time.Now())
+	require.NoError(t, err)
+	require.False(t, releasePlan.IsReleaseNeeded())
+	require.EqualValues(t, "", releasePlan.GetNextVersionString())
+	require.EqualValues(t, "", releasePlan.ChangelogSection)
+}
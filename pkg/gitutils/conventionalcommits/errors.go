@@ -0,0 +1,6 @@
+package conventionalcommits
+
+import "errors"
+
+var ErrNotAConventionalCommit = errors.New("not a conventional commit")
+var ErrNotASemanticVersion = errors.New("not a semantic version")
@@ -563,11 +563,31 @@ func (g *GitRepositoryBase) GetLatestTagVersionOrNilIfNotFound(ctx context.Conte
 		return nil, err
 	}
 
+	latestVersionTag, err := parent.GetLatestVersionTagOrNilIfNotFound(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if latestVersionTag == nil {
+		return nil, nil
+	}
+
+	return latestVersionTag.GetVersion()
+}
+
+// GetLatestVersionTagOrNilIfNotFound returns the tag with the latest version or nil if there is no version tag.
+func (g *GitRepositoryBase) GetLatestVersionTagOrNilIfNotFound(ctx context.Context) (latestVersionTag gitinterfaces.GitTag, err error) {
+	parent, err := g.GetParentRepositoryForBaseClass()
+	if err != nil {
+		return nil, err
+	}
+
 	versionTags, err := parent.ListVersionTags(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	var latestTagVersion versionutils.Version
 	for _, tag := range versionTags {
 		toCheck, err := tag.GetVersion()
 		if err != nil {
@@ -576,15 +596,22 @@ func (g *GitRepositoryBase) GetLatestTagVersionOrNilIfNotFound(ctx context.Conte
 
 		if latestTagVersion == nil {
 			latestTagVersion = toCheck
+			latestVersionTag = tag
+			continue
 		}
 
-		latestTagVersion, err = versionutils.ReturnNewerVersion(latestTagVersion, toCheck)
+		newerVersion, err := versionutils.ReturnNewerVersion(toCheck, latestTagVersion)
 		if err != nil {
 			return nil, err
 		}
+
+		if newerVersion == toCheck {
+			latestTagVersion = toCheck
+			latestVersionTag = tag
+		}
 	}
 
-	return latestTagVersion, nil
+	return latestVersionTag, nil
 }
 
 func (g *GitRepositoryBase) GetParentRepositoryForBaseClass() (parentRepositoryForBaseClass gitinterfaces.GitRepository, err error) {
@@ -0,0 +1,188 @@
+package gitgeneric
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/conventionalcommits"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"github.com/asciich/asciichgolangpublic/pkg/versionutils"
+)
+
+// GetConventionalCommitsReleasePlan evaluates the next semantic version based on the conventional commit messages since the latest version tag.
+func (g *GitRepositoryBase) GetConventionalCommitsReleasePlan(ctx context.Context, options *gitparameteroptions.GitConventionalCommitsReleaseOptions) (*conventionalcommits.ReleasePlan, error) {
+	if options == nil {
+		options = &gitparameteroptions.GitConventionalCommitsReleaseOptions{}
+	}
+
+	parent, err := g.GetParentRepositoryForBaseClass()
+	if err != nil {
+		return nil, err
+	}
+
+	path, hostDescription, err := parent.GetPathAndHostDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersionTag, err := parent.GetLatestVersionTagOrNilIfNotFound(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var latestVersion versionutils.Version
+	logOptions := &gitparameteroptions.GitLogOptions{}
+	if latestVersionTag != nil {
+		latestVersion, err = latestVersionTag.GetVersion()
+		if err != nil {
+			return nil, err
+		}
+
+		if !latestVersion.IsSemanticVersion() {
+			return nil, tracederrors.TracedErrorf("Latest version tag of git repository '%s' on host '%s' is not a semantic version. Conventional commits releases require semantic versions.", path, hostDescription)
+		}
+
+		tagHash, err := latestVersionTag.GetHash(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		logOptions.FromRef = tagHash
+	}
+
+	logEntries, err := parent.ListCommitLogEntries(contextutils.WithSilent(ctx), logOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	releasePlan, err := conventionalcommits.CreateReleasePlan(latestVersion, logEntries, options.InitialVersion, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if releasePlan.IsReleaseNeeded() {
+		logging.LogInfoByCtxf(ctx, "Next version of git repository '%s' on host '%s' is '%s' (%s release based on %d conventional commits).", path, hostDescription, releasePlan.NextVersion, releasePlan.BumpType, len(releasePlan.Commits))
+	} else {
+		logging.LogInfoByCtxf(ctx, "No release needed for git repository '%s' on host '%s' since none of the %d commits since the latest version requires one.", path, hostDescription, len(logEntries))
+	}
+
+	return releasePlan, nil
+}
+
+// CreateConventionalCommitsRelease tags the current commit with the next semantic version evaluated from the conventional commits since the latest version tag.
+//
+// If requested by 'options' the generated changelog section is added to the changelog file and committed before tagging.
+// Nothing is done if no commit since the latest version requires a release.
+func (g *GitRepositoryBase) CreateConventionalCommitsRelease(ctx context.Context, options *gitparameteroptions.GitConventionalCommitsReleaseOptions) (*conventionalcommits.ReleasePlan, error) {
+	if options == nil {
+		options = &gitparameteroptions.GitConventionalCommitsReleaseOptions{}
+	}
+
+	parent, err := g.GetParentRepositoryForBaseClass()
+	if err != nil {
+		return nil, err
+	}
+
+	releasePlan, err := g.GetConventionalCommitsReleasePlan(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if !releasePlan.IsReleaseNeeded() {
+		return releasePlan, nil
+	}
+
+	nextVersion := releasePlan.GetNextVersionString()
+
+	if options.UpdateChangelogFile {
+		err = g.prependToChangelogFile(ctx, options.GetChangelogFilePathOrDefault(), releasePlan.ChangelogSection)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = parent.Commit(
+			ctx,
+			&gitparameteroptions.GitCommitOptions{
+				Message: "chore(release): " + nextVersion,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	currentCommitHash, err := parent.GetCurrentCommitHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = parent.CreateTag(
+		ctx,
+		&gitparameteroptions.GitRepositoryCreateTagOptions{
+			CommitHash: currentCommitHash,
+			TagName:    nextVersion,
+			TagComment: releasePlan.ChangelogSection,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Push {
+		remoteName := options.GetRemoteNameOrDefault()
+
+		if options.UpdateChangelogFile {
+			err = parent.PushToRemote(ctx, remoteName)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = parent.PushTagsToRemote(ctx, remoteName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return releasePlan, nil
+}
+
+func (g *GitRepositoryBase) prependToChangelogFile(ctx context.Context, changelogFilePath string, section string) error {
+	if changelogFilePath == "" {
+		return tracederrors.TracedErrorEmptyString("changelogFilePath")
+	}
+
+	parent, err := g.GetParentRepositoryForBaseClass()
+	if err != nil {
+		return err
+	}
+
+	changelog := ""
+	exists, err := parent.FileByPathExists(ctx, changelogFilePath)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		changelogFile, err := parent.GetFileByPath(changelogFilePath)
+		if err != nil {
+			return err
+		}
+
+		changelog, err = changelogFile.ReadAsString(contextutils.WithSilent(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = parent.WriteStringToFile(ctx, changelogFilePath, conventionalcommits.PrependChangelogSection(changelog, section), &filesoptions.WriteOptions{})
+	if err != nil {
+		return err
+	}
+
+	return parent.AddFileByPath(ctx, changelogFilePath)
+}
@@ -0,0 +1,14 @@
+package gitimplementationindependend
+
+import "time"
+
+// BlameLine describes which commit last modified a line of a file.
+type BlameLine struct {
+	// LineNumber in the blamed file, starting at 1.
+	LineNumber  int
+	Hash        string
+	AuthorName  string
+	AuthorEmail string
+	AuthorTime  time.Time
+	Content     string
+}
@@ -0,0 +1,29 @@
+package gitimplementationindependend
+
+import (
+	"strings"
+	"time"
+)
+
+// CommitLogEntry contains the metadata of a single commit as returned by a commit log traversal.
+type CommitLogEntry struct {
+	Hash           string
+	ParentHashes   []string
+	AuthorName     string
+	AuthorEmail    string
+	AuthorTime     time.Time
+	CommitterName  string
+	CommitterEmail string
+	CommitTime     time.Time
+	Message        string
+}
+
+// GetSubject returns the first line of the commit message.
+func (c *CommitLogEntry) GetSubject() string {
+	subject, _, _ := strings.Cut(c.Message, "\n")
+	return strings.TrimSpace(subject)
+}
+
+func (c *CommitLogEntry) IsMergeCommit() bool {
+	return len(c.ParentHashes) > 1
+}
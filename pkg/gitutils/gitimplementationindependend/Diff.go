@@ -0,0 +1,96 @@
+package gitimplementationindependend
+
+// Diff contains all changes between two commits.
+type Diff struct {
+	FromHash string
+	ToHash   string
+	Files    []*FileDiff
+}
+
+// FileDiff contains the changes of a single file.
+//
+// For added files FromPath is empty, for deleted files ToPath is empty.
+type FileDiff struct {
+	FromPath  string
+	ToPath    string
+	IsBinary  bool
+	Additions int
+	Deletions int
+	Hunks     []*DiffHunk
+}
+
+// DiffHunk is a continuous block of changes.
+//
+// Every line is prefixed by ' ' for context, '+' for added and '-' for removed lines like in unified diffs.
+type DiffHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []string
+}
+
+// GetChangedFilePaths returns the paths of all changed files.
+// For deleted files the path before the change is used.
+func (d *Diff) GetChangedFilePaths() []string {
+	ret := []string{}
+	for _, f := range d.Files {
+		ret = append(ret, f.GetPath())
+	}
+
+	return ret
+}
+
+// GetFileDiffByPath returns the FileDiff of 'path' or nil if 'path' was not changed.
+func (d *Diff) GetFileDiffByPath(path string) *FileDiff {
+	for _, f := range d.Files {
+		if f.FromPath == path || f.ToPath == path {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func (d *Diff) GetTotalAdditions() int {
+	ret := 0
+	for _, f := range d.Files {
+		ret += f.Additions
+	}
+
+	return ret
+}
+
+func (d *Diff) GetTotalDeletions() int {
+	ret := 0
+	for _, f := range d.Files {
+		ret += f.Deletions
+	}
+
+	return ret
+}
+
+func (d *Diff) IsEmpty() bool {
+	return len(d.Files) == 0
+}
+
+// GetPath returns the path after the change or the path before the change if the file was deleted.
+func (f *FileDiff) GetPath() string {
+	if f.ToPath != "" {
+		return f.ToPath
+	}
+
+	return f.FromPath
+}
+
+func (f *FileDiff) IsAdded() bool {
+	return f.FromPath == ""
+}
+
+func (f *FileDiff) IsDeleted() bool {
+	return f.ToPath == ""
+}
+
+func (f *FileDiff) IsRenamed() bool {
+	return f.FromPath != "" && f.ToPath != "" && f.FromPath != f.ToPath
+}
@@ -0,0 +1,133 @@
+package gitimplementationindependend
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+var binaryFilesRegex = regexp.MustCompile(`^Binary files (.+) and (.+) differ$`)
+
+// ParseUnifiedDiff parses the output of `git diff` into FileDiffs.
+//
+// Renames are only detected if the diff contains the `rename from` and `rename to` headers.
+func ParseUnifiedDiff(unifiedDiff string) ([]*FileDiff, error) {
+	ret := []*FileDiff{}
+
+	var current *FileDiff
+	var currentHunk *DiffHunk
+	oldRemaining := 0
+	newRemaining := 0
+
+	lines := strings.Split(strings.ReplaceAll(unifiedDiff, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if currentHunk != nil && (oldRemaining > 0 || newRemaining > 0) {
+			if line == "" {
+				// Some tools strip the trailing whitespace of empty context lines.
+				line = " "
+			}
+
+			switch line[0] {
+			case ' ':
+				oldRemaining--
+				newRemaining--
+			case '-':
+				oldRemaining--
+				current.Deletions++
+			case '+':
+				newRemaining--
+				current.Additions++
+			case '\\':
+				// "\ No newline at end of file"
+				continue
+			default:
+				return nil, tracederrors.TracedErrorf("Unexpected line %d in hunk of '%s': '%s'", i+1, current.GetPath(), line)
+			}
+
+			currentHunk.Lines = append(currentHunk.Lines, line)
+			continue
+		}
+
+		if strings.HasPrefix(line, "diff --git ") {
+			current = &FileDiff{}
+			currentHunk = nil
+			ret = append(ret, current)
+
+			fromPath, toPath, found := strings.Cut(strings.TrimPrefix(line, "diff --git "), " b/")
+			if found {
+				current.FromPath = strings.TrimPrefix(fromPath, "a/")
+				current.ToPath = toPath
+			}
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "new file mode"):
+			current.FromPath = ""
+		case strings.HasPrefix(line, "deleted file mode"):
+			current.ToPath = ""
+		case strings.HasPrefix(line, "rename from "):
+			current.FromPath = strings.TrimPrefix(line, "rename from ")
+		case strings.HasPrefix(line, "rename to "):
+			current.ToPath = strings.TrimPrefix(line, "rename to ")
+		case strings.HasPrefix(line, "--- "):
+			current.FromPath = getPathFromDiffHeader(strings.TrimPrefix(line, "--- "), "a/")
+		case strings.HasPrefix(line, "+++ "):
+			current.ToPath = getPathFromDiffHeader(strings.TrimPrefix(line, "+++ "), "b/")
+		case binaryFilesRegex.MatchString(line):
+			matches := binaryFilesRegex.FindStringSubmatch(line)
+			current.IsBinary = true
+			current.FromPath = getPathFromDiffHeader(matches[1], "a/")
+			current.ToPath = getPathFromDiffHeader(matches[2], "b/")
+		case strings.HasPrefix(line, "@@ "):
+			matches := hunkHeaderRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, tracederrors.TracedErrorf("Invalid hunk header in line %d: '%s'", i+1, line)
+			}
+
+			currentHunk = &DiffHunk{
+				OldStart: atoiOrDefault(matches[1], 1),
+				OldLines: atoiOrDefault(matches[2], 1),
+				NewStart: atoiOrDefault(matches[3], 1),
+				NewLines: atoiOrDefault(matches[4], 1),
+			}
+			current.Hunks = append(current.Hunks, currentHunk)
+
+			oldRemaining = currentHunk.OldLines
+			newRemaining = currentHunk.NewLines
+		}
+	}
+
+	return ret, nil
+}
+
+func getPathFromDiffHeader(path string, prefix string) string {
+	// git adds a tab after the path if it contains spaces:
+	path = strings.TrimSuffix(path, "\t")
+
+	if path == "/dev/null" {
+		return ""
+	}
+
+	return strings.TrimPrefix(path, prefix)
+}
+
+func atoiOrDefault(s string, defaultValue int) int {
+	if s == "" {
+		return defaultValue
+	}
+
+	ret, err := strconv.Atoi(s)
+	if err != nil {
+		return defaultValue
+	}
+
+	return ret
+}
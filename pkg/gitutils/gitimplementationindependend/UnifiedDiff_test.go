@@ -0,0 +1,102 @@
+package gitimplementationindependend_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+)
+
+func TestParseUnifiedDiff(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		fileDiffs, err := gitimplementationindependend.ParseUnifiedDiff("")
+		require.NoError(t, err)
+		require.Len(t, fileDiffs, 0)
+	})
+
+	t.Run("modified, added, deleted and binary", func(t *testing.T) {
+		unifiedDiff := `diff --git a/hello.txt b/hello.txt
+index 3b18e51..a3c1f6a 100644
+--- a/hello.txt
++++ b/hello.txt
+@@ -1,3 +1,3 @@
+ hello
+--- removed
++world
+ end
+diff --git a/new.txt b/new.txt
+new file mode 100644
+index 0000000..e69de29
+--- /dev/null
++++ b/new.txt
+@@ -0,0 +1 @@
++new
+\ No newline at end of file
+diff --git a/old.txt b/old.txt
+deleted file mode 100644
+index 3b18e51..0000000
+--- a/old.txt
++++ /dev/null
+@@ -1,2 +0,0 @@
+-a
+-b
+diff --git a/image.png b/image.png
+new file mode 100644
+index 0000000..1234567
+Binary files /dev/null and b/image.png differ
+`
+
+		fileDiffs, err := gitimplementationindependend.ParseUnifiedDiff(unifiedDiff)
+		require.NoError(t, err)
+		require.Len(t, fileDiffs, 4)
+
+		modified := fileDiffs[0]
+		require.EqualValues(t, "hello.txt", modified.FromPath)
+		require.EqualValues(t, "hello.txt", modified.ToPath)
+		require.EqualValues(t, 1, modified.Additions)
+		require.EqualValues(t, 1, modified.Deletions)
+		require.Len(t, modified.Hunks, 1)
+		require.EqualValues(t, []string{" hello", "--- removed", "+world", " end"}, modified.Hunks[0].Lines)
+		require.EqualValues(t, 1, modified.Hunks[0].OldStart)
+		require.EqualValues(t, 3, modified.Hunks[0].OldLines)
+
+		added := fileDiffs[1]
+		require.True(t, added.IsAdded())
+		require.EqualValues(t, "new.txt", added.GetPath())
+		require.EqualValues(t, 1, added.Additions)
+		require.EqualValues(t, 1, added.Hunks[0].NewLines)
+
+		deleted := fileDiffs[2]
+		require.True(t, deleted.IsDeleted())
+		require.EqualValues(t, "old.txt", deleted.GetPath())
+		require.EqualValues(t, 2, deleted.Deletions)
+
+		binary := fileDiffs[3]
+		require.True(t, binary.IsBinary)
+		require.True(t, binary.IsAdded())
+		require.EqualValues(t, "image.png", binary.GetPath())
+		require.Len(t, binary.Hunks, 0)
+
+		diff := &gitimplementationindependend.Diff{Files: fileDiffs}
+		require.EqualValues(t, []string{"hello.txt", "new.txt", "old.txt", "image.png"}, diff.GetChangedFilePaths())
+		require.EqualValues(t, 2, diff.GetTotalAdditions())
+		require.EqualValues(t, 3, diff.GetTotalDeletions())
+		require.Nil(t, diff.GetFileDiffByPath("does-not-exist.txt"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		unifiedDiff := `diff --git a/a.txt b/b.txt
+similarity index 100%
+rename from a.txt
+rename to b.txt
+`
+
+		fileDiffs, err := gitimplementationindependend.ParseUnifiedDiff(unifiedDiff)
+		require.NoError(t, err)
+		require.Len(t, fileDiffs, 1)
+		require.True(t, fileDiffs[0].IsRenamed())
+		require.EqualValues(t, "a.txt", fileDiffs[0].FromPath)
+		require.EqualValues(t, "b.txt", fileDiffs[0].ToPath)
+	})
+}
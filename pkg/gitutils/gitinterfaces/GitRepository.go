@@ -6,6 +6,8 @@ import (
 
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/conventionalcommits"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/versionutils"
@@ -36,6 +38,7 @@ type GitRepository interface {
 
 	GetAuthorEmailByCommitHash(hash string) (authorEmail string, err error)
 	GetAuthorStringByCommitHash(hash string) (authorString string, err error)
+	GetBlame(ctx context.Context, options *gitparameteroptions.GitBlameOptions) (blameLines []*gitimplementationindependend.BlameLine, err error)
 	GetDirectoryByPath(ctx context.Context, pathToSubDir ...string) (subDir filesinterfaces.Directory, err error)
 	GetCommitAgeDurationByCommitHash(hash string) (ageDuration *time.Duration, err error)
 	GetCommitAgeSecondsByCommitHash(hash string) (ageSeconds float64, err error)
@@ -45,6 +48,7 @@ type GitRepository interface {
 	GetCurrentBranchName(ctx context.Context) (branchName string, err error)
 	GetCurrentCommit(ctx context.Context) (commit GitCommit, err error)
 	GetCurrentCommitHash(ctx context.Context) (currentCommitHash string, err error)
+	GetDiff(ctx context.Context, options *gitparameteroptions.GitDiffOptions) (diff *gitimplementationindependend.Diff, err error)
 	GetGitStatusOutput(ctx context.Context) (output string, err error)
 	GetHashByTagName(tagName string) (hash string, err error)
 	GetHostDescription() (hostDescription string, err error)
@@ -60,6 +64,7 @@ type GitRepository interface {
 	IsGitRepository(ctx context.Context) (isRepository bool, err error)
 	IsInitialized(ctx context.Context) (isInitialited bool, err error)
 	ListBranchNames(ctx context.Context) (branchNames []string, err error)
+	ListCommitLogEntries(ctx context.Context, options *gitparameteroptions.GitLogOptions) (logEntries []*gitimplementationindependend.CommitLogEntry, err error)
 	ListFilePaths(ctx context.Context, listFileOptions *parameteroptions.ListFileOptions) (filePaths []string, err error)
 	ListFiles(ctx context.Context, listFileOptions *parameteroptions.ListFileOptions) (files []filesinterfaces.File, err error)
 	ListTagNames(ctx context.Context) (tagNames []string, err error)
@@ -88,7 +93,9 @@ type GitRepository interface {
 	CommitAndPush(ctx context.Context, commitOptions *gitparameteroptions.GitCommitOptions) (createdCommit GitCommit, err error)
 	CommitIfUncommittedChanges(ctx context.Context, commitOptions *gitparameteroptions.GitCommitOptions) (createdCommit GitCommit, err error)
 	CreateAndInit(ctx context.Context, options *parameteroptions.CreateRepositoryOptions) (err error)
+	CreateConventionalCommitsRelease(ctx context.Context, options *gitparameteroptions.GitConventionalCommitsReleaseOptions) (releasePlan *conventionalcommits.ReleasePlan, err error)
 	EnsureMainReadmeMdExists(ctx context.Context) (err error)
+	GetConventionalCommitsReleasePlan(ctx context.Context, options *gitparameteroptions.GitConventionalCommitsReleaseOptions) (releasePlan *conventionalcommits.ReleasePlan, err error)
 	GetCurrentCommitMessage(ctx context.Context) (currentCommitMessage string, err error)
 	GetCurrentCommitsNewestVersion(ctx context.Context) (newestVersion versionutils.Version, err error)
 	GetCurrentCommitsNewestVersionOrNilIfNotPresent(ctx context.Context) (newestVersion versionutils.Version, err error)
@@ -96,6 +103,7 @@ type GitRepository interface {
 	GetLatestTagVersion(ctx context.Context) (latestTagVersion versionutils.Version, err error)
 	GetLatestTagVersionAsString(ctx context.Context) (latestTagVersion string, err error)
 	GetLatestTagVersionOrNilIfNotFound(ctx context.Context) (latestTagVersion versionutils.Version, err error)
+	GetLatestVersionTagOrNilIfNotFound(ctx context.Context) (latestVersionTag GitTag, err error)
 	GetPathAndHostDescription() (path string, hostDescription string, err error)
 	HasNoUncommittedChanges(ctx context.Context) (noUncommitedChnages bool, err error)
 	IsGolangApplication(ctx context.Context) (isGolangApplication bool, err error)
@@ -0,0 +1,27 @@
+package gitparameteroptions
+
+import "github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+
+type GitBlameOptions struct {
+	// Path of the file to blame relative to the repository root:
+	Path string
+
+	// Ref or commit hash to blame. Defaults to "HEAD" if not set.
+	Ref string
+}
+
+func (g *GitBlameOptions) GetPath() (string, error) {
+	if g.Path == "" {
+		return "", tracederrors.TracedError("Path not set")
+	}
+
+	return g.Path, nil
+}
+
+func (g *GitBlameOptions) GetRefOrDefault() string {
+	if g.Ref == "" {
+		return "HEAD"
+	}
+
+	return g.Ref
+}
@@ -0,0 +1,34 @@
+package gitparameteroptions
+
+type GitConventionalCommitsReleaseOptions struct {
+	// Version to use if there is no version tag yet. Defaults to "v0.1.0" if not set.
+	InitialVersion string
+
+	// Prepend the generated changelog section to the changelog file and commit it before tagging:
+	UpdateChangelogFile bool
+
+	// Path of the changelog file relative to the repository root. Defaults to "CHANGELOG.md" if not set.
+	ChangelogFilePath string
+
+	// Push the created tag and changelog commit:
+	Push bool
+
+	// Remote to push to. Defaults to "origin" if not set.
+	RemoteName string
+}
+
+func (g *GitConventionalCommitsReleaseOptions) GetChangelogFilePathOrDefault() string {
+	if g.ChangelogFilePath == "" {
+		return "CHANGELOG.md"
+	}
+
+	return g.ChangelogFilePath
+}
+
+func (g *GitConventionalCommitsReleaseOptions) GetRemoteNameOrDefault() string {
+	if g.RemoteName == "" {
+		return "origin"
+	}
+
+	return g.RemoteName
+}
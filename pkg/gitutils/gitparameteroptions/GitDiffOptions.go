@@ -0,0 +1,30 @@
+package gitparameteroptions
+
+import "github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+
+type GitDiffOptions struct {
+	// Ref or commit hash to compare from:
+	FromRef string
+
+	// Ref or commit hash to compare to. Defaults to "HEAD" if not set.
+	ToRef string
+
+	// Only include changes of the given files or directories:
+	Paths []string
+}
+
+func (g *GitDiffOptions) GetFromRef() (string, error) {
+	if g.FromRef == "" {
+		return "", tracederrors.TracedError("FromRef not set")
+	}
+
+	return g.FromRef, nil
+}
+
+func (g *GitDiffOptions) GetToRefOrDefault() string {
+	if g.ToRef == "" {
+		return "HEAD"
+	}
+
+	return g.ToRef
+}
@@ -0,0 +1,38 @@
+package gitparameteroptions
+
+import "time"
+
+type GitLogOptions struct {
+	// Only list commits reachable from this ref. Defaults to "HEAD" if not set.
+	ToRef string
+
+	// Exclude commits reachable from this ref. Together with ToRef this is equivalent to `git log FromRef..ToRef`.
+	FromRef string
+
+	// Only list commits changing the given file or directory:
+	Path string
+
+	// Only list commits where the author string "name <email>" contains the given value:
+	Author string
+
+	// Only list commits with a commit time at or after Since:
+	Since *time.Time
+
+	// Only list commits with a commit time at or before Until:
+	Until *time.Time
+
+	// Limit the number of returned commits. 0 means no limit.
+	MaxCount int
+}
+
+func (g *GitLogOptions) GetToRefOrDefault() string {
+	if g.ToRef == "" {
+		return "HEAD"
+	}
+
+	return g.ToRef
+}
+
+func (g *GitLogOptions) IsFromRefSet() bool {
+	return g.FromRef != ""
+}
@@ -0,0 +1,54 @@
+package nativegit
+
+import (
+	"context"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// GetBlame returns for every line of a file the commit which last modified it.
+func GetBlame(ctx context.Context, repo *git.Repository, options *gitparameteroptions.GitBlameOptions) ([]*gitimplementationindependend.BlameLine, error) {
+	if repo == nil {
+		return nil, tracederrors.TracedErrorNil("repo")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	path, err := options.GetPath()
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := GetCommitByRef(repo, options.GetRefOrDefault())
+	if err != nil {
+		return nil, err
+	}
+
+	blameResult, err := git.Blame(commit, path)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to blame '%s' at '%s': %w", path, commit.Hash, err)
+	}
+
+	ret := []*gitimplementationindependend.BlameLine{}
+	for i, line := range blameResult.Lines {
+		ret = append(ret, &gitimplementationindependend.BlameLine{
+			LineNumber:  i + 1,
+			Hash:        line.Hash.String(),
+			AuthorName:  line.AuthorName,
+			AuthorEmail: line.Author,
+			AuthorTime:  line.Date,
+			Content:     line.Text,
+		})
+	}
+
+	logging.LogInfoByCtxf(ctx, "Blamed '%d' lines of '%s' at '%s'.", len(ret), path, commit.Hash)
+
+	return ret, nil
+}
@@ -0,0 +1,88 @@
+package nativegit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// GetDiff returns the changes between two commits.
+//
+// Like `git diff --no-renames` a renamed file is reported as deleted and added file.
+func GetDiff(ctx context.Context, repo *git.Repository, options *gitparameteroptions.GitDiffOptions) (*gitimplementationindependend.Diff, error) {
+	if repo == nil {
+		return nil, tracederrors.TracedErrorNil("repo")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	fromRef, err := options.GetFromRef()
+	if err != nil {
+		return nil, err
+	}
+
+	fromCommit, err := GetCommitByRef(repo, fromRef)
+	if err != nil {
+		return nil, err
+	}
+
+	toCommit, err := GetCommitByRef(repo, options.GetToRefOrDefault())
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := fromCommit.PatchContext(ctx, toCommit)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to get patch between '%s' and '%s': %w", fromCommit.Hash, toCommit.Hash, err)
+	}
+
+	fileDiffs, err := gitimplementationindependend.ParseUnifiedDiff(patch.String())
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &gitimplementationindependend.Diff{
+		FromHash: fromCommit.Hash.String(),
+		ToHash:   toCommit.Hash.String(),
+		Files:    []*gitimplementationindependend.FileDiff{},
+	}
+
+	for _, fileDiff := range fileDiffs {
+		if isPathIncluded(fileDiff, options.Paths) {
+			ret.Files = append(ret.Files, fileDiff)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Diff between '%s' and '%s' contains '%d' changed files.", ret.FromHash, ret.ToHash, len(ret.Files))
+
+	return ret, nil
+}
+
+func isPathIncluded(fileDiff *gitimplementationindependend.FileDiff, paths []string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+
+	for _, path := range paths {
+		path = strings.TrimSuffix(path, "/")
+		for _, p := range []string{fileDiff.FromPath, fileDiff.ToPath} {
+			if p == "" {
+				continue
+			}
+
+			if p == path || strings.HasPrefix(p, path+"/") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package nativegit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/gitutils/gitparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// ListCommitLogEntries traverses the commit log like `git log` does.
+// The newest commit is returned first.
+func ListCommitLogEntries(ctx context.Context, repo *git.Repository, options *gitparameteroptions.GitLogOptions) ([]*gitimplementationindependend.CommitLogEntry, error) {
+	if repo == nil {
+		return nil, tracederrors.TracedErrorNil("repo")
+	}
+
+	if options == nil {
+		options = &gitparameteroptions.GitLogOptions{}
+	}
+
+	toCommit, err := GetCommitByRef(repo, options.GetToRefOrDefault())
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := map[plumbing.Hash]bool{}
+	if options.IsFromRefSet() {
+		fromCommit, err := GetCommitByRef(repo, options.FromRef)
+		if err != nil {
+			return nil, err
+		}
+
+		err = object.NewCommitPreorderIter(fromCommit, nil, nil).ForEach(func(c *object.Commit) error {
+			excluded[c.Hash] = true
+			return nil
+		})
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Unable to collect commits of '%s': %w", options.FromRef, err)
+		}
+	}
+
+	logOptions := &git.LogOptions{
+		From:  toCommit.Hash,
+		Order: git.LogOrderCommitterTime,
+		Since: options.Since,
+		Until: options.Until,
+	}
+
+	if options.Path != "" {
+		path := strings.TrimSuffix(options.Path, "/")
+		logOptions.PathFilter = func(p string) bool {
+			return p == path || strings.HasPrefix(p, path+"/")
+		}
+	}
+
+	commitIter, err := repo.Log(logOptions)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to get commit log: %w", err)
+	}
+	defer commitIter.Close()
+
+	ret := []*gitimplementationindependend.CommitLogEntry{}
+	err = commitIter.ForEach(func(c *object.Commit) error {
+		if excluded[c.Hash] {
+			return nil
+		}
+
+		if options.Author != "" && !strings.Contains(c.Author.String(), options.Author) {
+			return nil
+		}
+
+		ret = append(ret, GetCommitLogEntry(c))
+
+		if options.MaxCount > 0 && len(ret) >= options.MaxCount {
+			return storer.ErrStop
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, tracederrors.TracedErrorf("Unable to iterate commit log: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collected '%d' commit log entries.", len(ret))
+
+	return ret, nil
+}
+
+// GetCommitLogEntry converts a go-git commit into a CommitLogEntry.
+func GetCommitLogEntry(c *object.Commit) *gitimplementationindependend.CommitLogEntry {
+	parentHashes := []string{}
+	for _, p := range c.ParentHashes {
+		parentHashes = append(parentHashes, p.String())
+	}
+
+	return &gitimplementationindependend.CommitLogEntry{
+		Hash:           c.Hash.String(),
+		ParentHashes:   parentHashes,
+		AuthorName:     c.Author.Name,
+		AuthorEmail:    c.Author.Email,
+		AuthorTime:     c.Author.When,
+		CommitterName:  c.Committer.Name,
+		CommitterEmail: c.Committer.Email,
+		CommitTime:     c.Committer.When,
+		Message:        strings.TrimRight(c.Message, "\n"),
+	}
+}
@@ -0,0 +1,32 @@
+package nativegit
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// GetCommitByRef resolves 'ref' which can be a branch, tag, commit hash or an expression like "HEAD~1".
+func GetCommitByRef(repo *git.Repository, ref string) (*object.Commit, error) {
+	if repo == nil {
+		return nil, tracederrors.TracedErrorNil("repo")
+	}
+
+	if ref == "" {
+		return nil, tracederrors.TracedErrorEmptyString("ref")
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to resolve ref '%s': %w", ref, err)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to get commit '%s' of ref '%s': %w", hash, ref, err)
+	}
+
+	return commit, nil
+}
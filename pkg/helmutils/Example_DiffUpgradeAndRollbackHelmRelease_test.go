@@ -0,0 +1,86 @@
+package helmutils_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorbashoo"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+)
+
+// Example how to review the changes of new helm values before upgrading a release and how to roll back.
+//
+// To run this test use:
+//
+//	bash -c "cd pkg/helmutils && go test -v -run Test_Example_DiffUpgradeAndRollbackHelmRelease"
+func Test_Example_DiffUpgradeAndRollbackHelmRelease(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// -----
+	// Prepare test environment start ...
+	tempDirPath, err := tempfilesoo.CreateEmptyTemporaryDirectoryAndGetPath(ctx)
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirPath)
+
+	chartPath := filepath.Join(tempDirPath, "example")
+	_, err = commandexecutorbashoo.Bash().RunCommand(ctx, &parameteroptions.RunCommandOptions{
+		Command: []string{"helm", "create", chartPath},
+	})
+	require.NoError(t, err)
+	// ... prepare test environment finished.
+	// -----
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+	defer cluster.DeleteNamespaceByName(ctx, "example-helm")
+
+	options := &helmparameteroptions.InstallHelmChartOptions{
+		KubernetesCluster: cluster,
+		ChartReference:    "example",
+		ChartUri:          chartPath,
+		Namespace:         "example-helm",
+	}
+
+	// Equivalent helm command:
+	// helm upgrade --install example <chartPath> --namespace example-helm --create-namespace --wait
+	err = helmutils.InstallHelmChart(ctx, options)
+	require.NoError(t, err)
+
+	// Propose new values. Values files can be added using 'ValuesFiles'.
+	options.SetValues = map[string]string{"replicaCount": "2"}
+
+	// Review the changes before upgrading:
+	diff, err := helmutils.DiffHelmChart(ctx, options)
+	require.NoError(t, err)
+	fmt.Print(diff.UnifiedDiff)
+	require.Len(t, diff.Changed, 1)
+
+	// Upgrade the release using the new values:
+	err = helmutils.InstallHelmChart(ctx, options)
+	require.NoError(t, err)
+
+	releaseOptions := &helmparameteroptions.HelmReleaseOptions{
+		KubernetesCluster: cluster,
+		ReleaseName:       "example",
+		Namespace:         "example-helm",
+	}
+
+	// Roll back to the previous revision:
+	err = helmutils.RollbackHelmRelease(ctx, &helmparameteroptions.RollbackHelmReleaseOptions{HelmReleaseOptions: *releaseOptions})
+	require.NoError(t, err)
+
+	// Uninstall the release. Uninstalling an absent release is not an error:
+	err = helmutils.UninstallHelmRelease(ctx, releaseOptions)
+	require.NoError(t, err)
+}
@@ -2,13 +2,23 @@ package helmutils_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorbashoo"
 	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
 	"github.com/asciich/asciichgolangpublic/pkg/helmutils"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helminterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
 	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
 	"github.com/asciich/asciichgolangpublic/pkg/testutils"
 )
 
@@ -45,3 +55,125 @@ func TestRole_AddHelmRepo(t *testing.T) {
 		)
 	}
 }
+
+func TestHelm_ReleaseLifecycle(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"commandExecutorHelm"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				const releaseName = "lifecycle"
+				const namespaceName = "testhelmlifecycle"
+
+				helm := getHelmImplementationByName(tt.implementationName)
+
+				cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+				require.NoError(t, err)
+				defer cluster.DeleteNamespaceByName(ctx, namespaceName)
+
+				// Use the example chart created by 'helm create' to avoid depending on a chart repository:
+				tempDirPath, err := tempfilesoo.CreateEmptyTemporaryDirectoryAndGetPath(ctx)
+				require.NoError(t, err)
+				defer os.RemoveAll(tempDirPath)
+
+				chartPath := filepath.Join(tempDirPath, "example")
+				_, err = commandexecutorbashoo.Bash().RunCommand(ctx, &parameteroptions.RunCommandOptions{
+					Command: []string{"helm", "create", chartPath},
+				})
+				require.NoError(t, err)
+
+				releaseOptions := &helmparameteroptions.HelmReleaseOptions{
+					KubernetesCluster: cluster,
+					ReleaseName:       releaseName,
+					Namespace:         namespaceName,
+				}
+
+				for range 2 {
+					require.NoError(t, helm.UninstallHelmRelease(ctx, releaseOptions))
+					require.False(t, mustutils.Must(helm.HelmReleaseExists(ctx, releaseOptions)))
+				}
+
+				installOptions := &helmparameteroptions.InstallHelmChartOptions{
+					KubernetesCluster: cluster,
+					ChartReference:    releaseName,
+					ChartUri:          chartPath,
+					Namespace:         namespaceName,
+				}
+
+				// All objects are added if the release is not installed yet:
+				diff, err := helm.DiffHelmChart(ctx, installOptions)
+				require.NoError(t, err)
+				require.NotEmpty(t, diff.Added)
+				require.Empty(t, diff.Changed)
+
+				require.NoError(t, helm.InstallHelmChart(ctx, installOptions))
+
+				release, err := helm.GetHelmRelease(ctx, releaseOptions)
+				require.NoError(t, err)
+				require.EqualValues(t, 1, release.Revision)
+				require.EqualValues(t, "example", release.GetChartName())
+				require.True(t, release.IsDeployed())
+
+				diff, err = helm.DiffHelmChart(ctx, installOptions)
+				require.NoError(t, err)
+				require.True(t, diff.IsEmpty())
+
+				installOptions.SetValues = map[string]string{"replicaCount": "2"}
+
+				rendered, err := helm.TemplateHelmChart(ctx, installOptions)
+				require.NoError(t, err)
+				require.Contains(t, rendered, "replicas: 2")
+
+				diff, err = helm.DiffHelmChart(ctx, installOptions)
+				require.NoError(t, err)
+				require.EqualValues(t, []string{"Deployment/" + releaseName + "-example in namespace '" + namespaceName + "'"}, diff.Changed)
+				require.Contains(t, diff.UnifiedDiff, "+  replicas: 2")
+
+				require.NoError(t, helm.InstallHelmChart(ctx, installOptions))
+				require.EqualValues(t, 2, mustutils.Must(helm.GetHelmRelease(ctx, releaseOptions)).Revision)
+
+				releases, err := helm.ListHelmReleases(ctx, &helmparameteroptions.ListHelmReleasesOptions{KubernetesCluster: cluster})
+				require.NoError(t, err)
+				require.True(t, slices.ContainsFunc(releases, func(r *helmimplementationindependend.HelmRelease) bool {
+					return r.Name == releaseName && r.Namespace == namespaceName
+				}))
+
+				// Looking up a release by name only matches the exact name:
+				releases, err = helm.ListHelmReleases(ctx, &helmparameteroptions.ListHelmReleasesOptions{KubernetesCluster: cluster, ReleaseName: releaseName})
+				require.NoError(t, err)
+				require.Len(t, releases, 1)
+				require.EqualValues(t, namespaceName, releases[0].Namespace)
+
+				for _, otherName := range []string{"lifecycl", "ifecycle", "lifecycle-other"} {
+					otherOptions := &helmparameteroptions.HelmReleaseOptions{
+						KubernetesCluster: cluster,
+						ReleaseName:       otherName,
+						Namespace:         namespaceName,
+					}
+					require.False(t, mustutils.Must(helm.HelmReleaseExists(ctx, otherOptions)))
+				}
+
+				// A rollback creates a new revision with the content of the previous one:
+				require.NoError(t, helm.RollbackHelmRelease(ctx, &helmparameteroptions.RollbackHelmReleaseOptions{HelmReleaseOptions: *releaseOptions}))
+				require.EqualValues(t, 3, mustutils.Must(helm.GetHelmRelease(ctx, releaseOptions)).Revision)
+
+				installOptions.SetValues = nil
+				diff, err = helm.DiffHelmChart(ctx, installOptions)
+				require.NoError(t, err)
+				require.True(t, diff.IsEmpty())
+
+				for range 2 {
+					require.NoError(t, helm.UninstallHelmRelease(ctx, releaseOptions))
+					require.False(t, mustutils.Must(helm.HelmReleaseExists(ctx, releaseOptions)))
+				}
+			},
+		)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorbashoo"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helminterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
 )
@@ -20,3 +21,48 @@ func InstallHelmChart(ctx context.Context, options *helmparameteroptions.Install
 
 	return helm.InstallHelmChart(ctx, options)
 }
+
+func UninstallHelmRelease(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) error {
+	helm, err := GetDefaultHelmImplementation()
+	if err != nil {
+		return err
+	}
+
+	return helm.UninstallHelmRelease(ctx, options)
+}
+
+func ListHelmReleases(ctx context.Context, options *helmparameteroptions.ListHelmReleasesOptions) ([]*helmimplementationindependend.HelmRelease, error) {
+	helm, err := GetDefaultHelmImplementation()
+	if err != nil {
+		return nil, err
+	}
+
+	return helm.ListHelmReleases(ctx, options)
+}
+
+func RollbackHelmRelease(ctx context.Context, options *helmparameteroptions.RollbackHelmReleaseOptions) error {
+	helm, err := GetDefaultHelmImplementation()
+	if err != nil {
+		return err
+	}
+
+	return helm.RollbackHelmRelease(ctx, options)
+}
+
+func TemplateHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) (string, error) {
+	helm, err := GetDefaultHelmImplementation()
+	if err != nil {
+		return "", err
+	}
+
+	return helm.TemplateHelmChart(ctx, options)
+}
+
+func DiffHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) (*helmimplementationindependend.ManifestDiff, error) {
+	helm, err := GetDefaultHelmImplementation()
+	if err != nil {
+		return nil, err
+	}
+
+	return helm.DiffHelmChart(ctx, options)
+}
@@ -0,0 +1,90 @@
+package helmimplementationindependend
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// A helm release as listed by 'helm list'.
+type HelmRelease struct {
+	Name       string
+	Namespace  string
+	Revision   int
+	Updated    string
+	Status     string
+	Chart      string
+	AppVersion string
+}
+
+// Returns the chart name without the version, e.g. 'flux-operator' for chart 'flux-operator-0.10.0'.
+func (h *HelmRelease) GetChartName() string {
+	name, _ := splitChartNameAndVersion(h.Chart)
+	return name
+}
+
+// Returns the chart version, e.g. '0.10.0' for chart 'flux-operator-0.10.0'.
+func (h *HelmRelease) GetChartVersion() string {
+	_, version := splitChartNameAndVersion(h.Chart)
+	return version
+}
+
+func (h *HelmRelease) IsDeployed() bool {
+	return h.Status == "deployed"
+}
+
+// Helm joins chart name and version with '-'. The version is the part after the first '-' followed by a digit.
+func splitChartNameAndVersion(chart string) (name string, version string) {
+	for i := 0; i < len(chart)-1; i++ {
+		if chart[i] == '-' && chart[i+1] >= '0' && chart[i+1] <= '9' {
+			return chart[:i], chart[i+1:]
+		}
+	}
+
+	return chart, ""
+}
+
+// Parses the output of 'helm list --output json'.
+func ParseHelmReleasesJson(helmListJson string) ([]*HelmRelease, error) {
+	helmListJson = strings.TrimSpace(helmListJson)
+	if helmListJson == "" {
+		return []*HelmRelease{}, nil
+	}
+
+	var parsed []struct {
+		Name       string `json:"name"`
+		Namespace  string `json:"namespace"`
+		Revision   string `json:"revision"`
+		Updated    string `json:"updated"`
+		Status     string `json:"status"`
+		Chart      string `json:"chart"`
+		AppVersion string `json:"app_version"`
+	}
+
+	err := json.Unmarshal([]byte(helmListJson), &parsed)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse helm releases: %w", err)
+	}
+
+	releases := make([]*HelmRelease, 0, len(parsed))
+	for _, p := range parsed {
+		revision, err := strconv.Atoi(p.Revision)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Invalid revision '%s' of helm release '%s': %w", p.Revision, p.Name, err)
+		}
+
+		releases = append(releases, &HelmRelease{
+			Name:       p.Name,
+			Namespace:  p.Namespace,
+			Revision:   revision,
+			Updated:    p.Updated,
+			Status:     p.Status,
+			Chart:      p.Chart,
+			AppVersion: p.AppVersion,
+		})
+	}
+
+	return releases, nil
+}
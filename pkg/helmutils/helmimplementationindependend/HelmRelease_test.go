@@ -0,0 +1,42 @@
+package helmimplementationindependend_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmimplementationindependend"
+)
+
+func Test_ParseHelmReleasesJson(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		releases, err := helmimplementationindependend.ParseHelmReleasesJson("[]\n")
+		require.NoError(t, err)
+		require.Len(t, releases, 0)
+	})
+
+	t.Run("releases", func(t *testing.T) {
+		releases, err := helmimplementationindependend.ParseHelmReleasesJson(`[
+			{"name":"flux-operator","namespace":"flux-system","revision":"3","updated":"2025-01-02 10:11:12.123 +0000 UTC","status":"deployed","chart":"flux-operator-0.10.0","app_version":"v0.10.0"},
+			{"name":"my-app","namespace":"apps","revision":"1","updated":"2025-01-02 10:11:12.123 +0000 UTC","status":"failed","chart":"my-app-chart-1.2.3-rc.1","app_version":"1.2.3"}
+		]`)
+		require.NoError(t, err)
+		require.Len(t, releases, 2)
+
+		require.EqualValues(t, "flux-operator", releases[0].Name)
+		require.EqualValues(t, "flux-system", releases[0].Namespace)
+		require.EqualValues(t, 3, releases[0].Revision)
+		require.EqualValues(t, "flux-operator", releases[0].GetChartName())
+		require.EqualValues(t, "0.10.0", releases[0].GetChartVersion())
+		require.EqualValues(t, "v0.10.0", releases[0].AppVersion)
+		require.True(t, releases[0].IsDeployed())
+
+		require.EqualValues(t, "my-app-chart", releases[1].GetChartName())
+		require.EqualValues(t, "1.2.3-rc.1", releases[1].GetChartVersion())
+		require.False(t, releases[1].IsDeployed())
+	})
+
+	t.Run("invalid revision", func(t *testing.T) {
+		_, err := helmimplementationindependend.ParseHelmReleasesJson(`[{"name":"a","revision":"x"}]`)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,170 @@
+package helmimplementationindependend
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/yamlutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+// Difference between the manifests of a deployed helm release and the manifests rendered with proposed values.
+type ManifestDiff struct {
+	// Objects only present in the proposed manifests.
+	Added []string
+
+	// Objects only present in the deployed manifests.
+	Removed []string
+
+	// Objects present in both manifests with different content.
+	Changed []string
+
+	// Unified diff of all added, removed and changed objects.
+	UnifiedDiff string
+}
+
+func (m *ManifestDiff) IsEmpty() bool {
+	return len(m.Added) == 0 && len(m.Removed) == 0 && len(m.Changed) == 0
+}
+
+// Parses a multi document manifest as rendered by helm into normalized YAML per object.
+// Documents without content (e.g. only the '# Source:' comment of an empty template) are skipped.
+// Hooks are skipped as well since 'helm get manifest' does not include them.
+func getNormalizedObjectsByReference(manifests string, defaultNamespace string) (map[string]string, error) {
+	objects := map[string]string{}
+
+	for _, document := range yamlutils.SplitMultiYaml(manifests) {
+		data := map[string]interface{}{}
+		err := yaml.Unmarshal([]byte(document), &data)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to parse manifest: %w", err)
+		}
+
+		if len(data) == 0 || isHelmHook(data) {
+			continue
+		}
+
+		entry := &kubernetesimplementationindependend.ObjectYamlEntry{Content: document}
+		err = entry.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		var normalized bytes.Buffer
+		encoder := yaml.NewEncoder(&normalized)
+		encoder.SetIndent(2)
+		err = encoder.Encode(data)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to marshal manifest: %w", err)
+		}
+
+		reference := entry.GetObjectReference(defaultNamespace).String()
+		if _, exists := objects[reference]; exists {
+			return nil, tracederrors.TracedErrorf("%s is defined more than once.", reference)
+		}
+
+		objects[reference] = normalized.String()
+	}
+
+	return objects, nil
+}
+
+func isHelmHook(object map[string]interface{}) bool {
+	metadata, ok := object["metadata"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	_, isHook := annotations["helm.sh/hook"]
+	return isHook
+}
+
+// Unlike difflib.SplitLines an empty string results in no lines at all.
+func splitLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// Compares the deployed manifests (as returned by 'helm get manifest') with the proposed ones (as rendered by 'helm template').
+// Objects are compared as normalized YAML so comments, key order and formatting do not cause differences.
+func DiffManifests(deployedManifests string, proposedManifests string, defaultNamespace string) (*ManifestDiff, error) {
+	deployed, err := getNormalizedObjectsByReference(deployedManifests, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	proposed, err := getNormalizedObjectsByReference(proposedManifests, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	references := []string{}
+	for reference := range deployed {
+		references = append(references, reference)
+	}
+
+	for reference := range proposed {
+		if _, exists := deployed[reference]; !exists {
+			references = append(references, reference)
+		}
+	}
+
+	sort.Strings(references)
+
+	diff := &ManifestDiff{
+		Added:   []string{},
+		Removed: []string{},
+		Changed: []string{},
+	}
+
+	var unifiedDiff strings.Builder
+	for _, reference := range references {
+		deployedObject, isDeployed := deployed[reference]
+		proposedObject, isProposed := proposed[reference]
+
+		switch {
+		case !isDeployed:
+			diff.Added = append(diff.Added, reference)
+		case !isProposed:
+			diff.Removed = append(diff.Removed, reference)
+		case deployedObject != proposedObject:
+			diff.Changed = append(diff.Changed, reference)
+		default:
+			continue
+		}
+
+		toAdd, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(deployedObject),
+			B:        splitLines(proposedObject),
+			FromFile: "deployed: " + reference,
+			ToFile:   "proposed: " + reference,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to diff %s: %w", reference, err)
+		}
+
+		unifiedDiff.WriteString(toAdd)
+	}
+
+	diff.UnifiedDiff = unifiedDiff.String()
+
+	return diff, nil
+}
@@ -0,0 +1,121 @@
+package helmimplementationindependend_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmimplementationindependend"
+)
+
+const deployedManifests = `---
+# Source: example/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example-config
+data:
+  replicas: "1"
+  logLevel: info
+---
+# Source: example/templates/empty.yaml
+---
+# Source: example/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: example
+spec:
+  ports:
+    - port: 80
+---
+# Source: example/templates/legacy.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: example-legacy
+`
+
+func Test_DiffManifests(t *testing.T) {
+	t.Run("identical ignoring comments and key order", func(t *testing.T) {
+		proposed := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example-config
+data:
+  logLevel: info
+  replicas: "1"
+---
+kind: Service
+apiVersion: v1
+metadata:
+  name: example
+spec:
+  ports:
+    - port: 80
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: example-legacy
+`
+
+		// Hooks are not part of the deployed manifests:
+		proposed += `---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: example-test-connection
+  annotations:
+    "helm.sh/hook": test
+`
+
+		diff, err := helmimplementationindependend.DiffManifests(deployedManifests, proposed, "apps")
+		require.NoError(t, err)
+		require.True(t, diff.IsEmpty())
+		require.EqualValues(t, "", diff.UnifiedDiff)
+	})
+
+	t.Run("added removed and changed", func(t *testing.T) {
+		proposed := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example-config
+data:
+  replicas: "1"
+  logLevel: debug
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: example
+spec:
+  ports:
+    - port: 80
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: example
+`
+
+		diff, err := helmimplementationindependend.DiffManifests(deployedManifests, proposed, "apps")
+		require.NoError(t, err)
+		require.False(t, diff.IsEmpty())
+		require.EqualValues(t, []string{"ServiceAccount/example in namespace 'apps'"}, diff.Added)
+		require.EqualValues(t, []string{"Secret/example-legacy in namespace 'apps'"}, diff.Removed)
+		require.EqualValues(t, []string{"ConfigMap/example-config in namespace 'apps'"}, diff.Changed)
+		require.Contains(t, diff.UnifiedDiff, "-  logLevel: info\n+  logLevel: debug\n   replicas: \"1\"\n")
+		require.Contains(t, diff.UnifiedDiff, "+++ proposed: ServiceAccount/example in namespace 'apps'")
+		require.NotContains(t, diff.UnifiedDiff, "Service/example in namespace")
+	})
+
+	t.Run("not yet deployed", func(t *testing.T) {
+		diff, err := helmimplementationindependend.DiffManifests("", deployedManifests, "apps")
+		require.NoError(t, err)
+		require.Len(t, diff.Added, 3)
+		require.Len(t, diff.Removed, 0)
+		require.Len(t, diff.Changed, 0)
+	})
+}
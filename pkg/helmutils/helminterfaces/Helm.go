@@ -3,10 +3,22 @@ package helminterfaces
 import (
 	"context"
 
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
 )
 
 type Helm interface {
 	AddRepositoryByName(ctx context.Context, name string, url string) error
+	// Compares the manifests of the deployed release with the manifests rendered using the given chart version and values.
+	DiffHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) (*helmimplementationindependend.ManifestDiff, error)
+	GetHelmRelease(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (*helmimplementationindependend.HelmRelease, error)
+	GetHelmReleaseManifests(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (string, error)
+	HelmReleaseExists(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (bool, error)
+	// Installs the chart or upgrades the already installed release.
 	InstallHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) error
+	ListHelmReleases(ctx context.Context, options *helmparameteroptions.ListHelmReleasesOptions) ([]*helmimplementationindependend.HelmRelease, error)
+	RollbackHelmRelease(ctx context.Context, options *helmparameteroptions.RollbackHelmReleaseOptions) error
+	// Renders the manifests of the chart locally without installing them.
+	TemplateHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) (string, error)
+	UninstallHelmRelease(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) error
 }
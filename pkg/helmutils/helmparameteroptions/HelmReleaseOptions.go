@@ -0,0 +1,37 @@
+package helmparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Selects a single installed helm release.
+type HelmReleaseOptions struct {
+	KubernetesCluster kubernetesinterfaces.KubernetesCluster
+	ReleaseName       string
+	Namespace         string
+}
+
+func (h *HelmReleaseOptions) GetKubernetesCluster() (kubernetesinterfaces.KubernetesCluster, error) {
+	if h.KubernetesCluster == nil {
+		return nil, tracederrors.TracedError("KubernetesCluster not set")
+	}
+
+	return h.KubernetesCluster, nil
+}
+
+func (h *HelmReleaseOptions) GetReleaseName() (string, error) {
+	if h.ReleaseName == "" {
+		return "", tracederrors.TracedError("ReleaseName not set")
+	}
+
+	return h.ReleaseName, nil
+}
+
+func (h *HelmReleaseOptions) GetNamespace() (string, error) {
+	if h.Namespace == "" {
+		return "", tracederrors.TracedError("Namespace not set")
+	}
+
+	return h.Namespace, nil
+}
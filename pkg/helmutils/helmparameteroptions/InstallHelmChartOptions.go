@@ -1,6 +1,8 @@
 package helmparameteroptions
 
 import (
+	"sort"
+
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
 )
@@ -10,6 +12,15 @@ type InstallHelmChartOptions struct {
 	ChartReference    string
 	ChartUri          string
 	Namespace         string
+
+	// Chart version to install. The latest version is used if not set.
+	ChartVersion string
+
+	// Values files passed as '--values'. The paths are evaluated on the host running helm.
+	ValuesFiles []string
+
+	// Values passed as '--set key=value'. They take precedence over the ValuesFiles.
+	SetValues map[string]string
 }
 
 func (i *InstallHelmChartOptions) GetKubernetesCluster() (kubernetesinterfaces.KubernetesCluster, error) {
@@ -43,3 +54,29 @@ func (i *InstallHelmChartOptions) GetNamespace() (string, error) {
 
 	return i.Namespace, nil
 }
+
+// Returns the '--version', '--values' and '--set' arguments for helm.
+// The '--set' arguments are sorted by key to get a reproducible command.
+func (i *InstallHelmChartOptions) GetChartVersionAndValuesArgs() []string {
+	args := []string{}
+
+	if i.ChartVersion != "" {
+		args = append(args, "--version", i.ChartVersion)
+	}
+
+	for _, valuesFile := range i.ValuesFiles {
+		args = append(args, "--values", valuesFile)
+	}
+
+	keys := make([]string, 0, len(i.SetValues))
+	for k := range i.SetValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		args = append(args, "--set", k+"="+i.SetValues[k])
+	}
+
+	return args
+}
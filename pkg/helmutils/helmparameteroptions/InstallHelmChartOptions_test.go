@@ -0,0 +1,40 @@
+package helmparameteroptions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
+)
+
+func Test_GetChartVersionAndValuesArgs(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		options := &helmparameteroptions.InstallHelmChartOptions{}
+		require.EqualValues(t, []string{}, options.GetChartVersionAndValuesArgs())
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		options := &helmparameteroptions.InstallHelmChartOptions{
+			ChartVersion: "1.2.3",
+			ValuesFiles:  []string{"/etc/values.yaml", "/etc/values-prod.yaml"},
+			SetValues: map[string]string{
+				"replicaCount": "2",
+				"image.tag":    "v1",
+				"service.type": "NodePort",
+			},
+		}
+
+		require.EqualValues(
+			t,
+			[]string{
+				"--version", "1.2.3",
+				"--values", "/etc/values.yaml",
+				"--values", "/etc/values-prod.yaml",
+				"--set", "image.tag=v1",
+				"--set", "replicaCount=2",
+				"--set", "service.type=NodePort",
+			},
+			options.GetChartVersionAndValuesArgs(),
+		)
+	})
+}
@@ -0,0 +1,34 @@
+package helmparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type ListHelmReleasesOptions struct {
+	KubernetesCluster kubernetesinterfaces.KubernetesCluster
+
+	// Only list the releases in this namespace. Releases in all namespaces are listed if not set.
+	Namespace string
+
+	// Only list the release with exactly this name. All releases are listed if not set.
+	ReleaseName string
+}
+
+func (l *ListHelmReleasesOptions) GetKubernetesCluster() (kubernetesinterfaces.KubernetesCluster, error) {
+	if l.KubernetesCluster == nil {
+		return nil, tracederrors.TracedError("KubernetesCluster not set")
+	}
+
+	return l.KubernetesCluster, nil
+}
+
+// Returns the regular expression passed to 'helm list --filter' to only match ReleaseName.
+// An empty string is returned if ReleaseName is not set.
+func (l *ListHelmReleasesOptions) GetReleaseNameFilter() string {
+	if l.ReleaseName == "" {
+		return ""
+	}
+
+	return "^" + l.ReleaseName + "$"
+}
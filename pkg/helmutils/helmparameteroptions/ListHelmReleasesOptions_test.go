@@ -0,0 +1,20 @@
+package helmparameteroptions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
+)
+
+func Test_ListHelmReleasesOptions_GetReleaseNameFilter(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		options := &helmparameteroptions.ListHelmReleasesOptions{}
+		require.EqualValues(t, "", options.GetReleaseNameFilter())
+	})
+
+	t.Run("anchored", func(t *testing.T) {
+		options := &helmparameteroptions.ListHelmReleasesOptions{ReleaseName: "my-release"}
+		require.EqualValues(t, "^my-release$", options.GetReleaseNameFilter())
+	})
+}
@@ -0,0 +1,20 @@
+package helmparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type RollbackHelmReleaseOptions struct {
+	HelmReleaseOptions
+
+	// Revision to roll back to. The previous revision is used if not set.
+	Revision int
+}
+
+func (r *RollbackHelmReleaseOptions) GetRevision() (int, error) {
+	if r.Revision < 0 {
+		return 0, tracederrors.TracedErrorf("Invalid Revision '%d'", r.Revision)
+	}
+
+	return r.Revision, nil
+}
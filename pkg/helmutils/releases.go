@@ -0,0 +1,326 @@
+package helmutils
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/helmutils/helmparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Runs 'helm <args>' and returns stdout.
+func (c *commandExecutorHelm) runHelmAndGetStdout(ctx context.Context, args ...string) (string, error) {
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return "", err
+	}
+
+	return commandExecutor.RunCommandAndGetStdoutAsString(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: append([]string{"helm"}, args...),
+		},
+	)
+}
+
+func getKubeContext(ctx context.Context, cluster kubernetesinterfaces.KubernetesCluster) (string, error) {
+	if cluster == nil {
+		return "", tracederrors.TracedErrorNil("cluster")
+	}
+
+	return cluster.GetKubectlContext(ctx)
+}
+
+// Returns the kube context, the release name and the namespace selected by the options.
+func getKubeContextReleaseNameAndNamespace(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (kubeContext string, releaseName string, namespace string, err error) {
+	if options == nil {
+		return "", "", "", tracederrors.TracedErrorNil("options")
+	}
+
+	cluster, err := options.GetKubernetesCluster()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	kubeContext, err = getKubeContext(ctx, cluster)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	releaseName, err = options.GetReleaseName()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	namespace, err = options.GetNamespace()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return kubeContext, releaseName, namespace, nil
+}
+
+func (c *commandExecutorHelm) ListHelmReleases(ctx context.Context, options *helmparameteroptions.ListHelmReleasesOptions) ([]*helmimplementationindependend.HelmRelease, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	cluster, err := options.GetKubernetesCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeContext, err := getKubeContext(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// '--all' includes failed and pending releases which are hidden by default.
+	// '--max 0' disables the default limit of 256 listed releases:
+	args := []string{"list", "--kube-context", kubeContext, "--all", "--max", "0", "--output", "json"}
+	if options.ReleaseName != "" {
+		args = append(args, "--filter", options.GetReleaseNameFilter())
+	}
+	if options.Namespace == "" {
+		args = append(args, "--all-namespaces")
+	} else {
+		args = append(args, "--namespace", options.Namespace)
+	}
+
+	stdout, err := c.runHelmAndGetStdout(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := helmimplementationindependend.ParseHelmReleasesJson(stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ReleaseName != "" {
+		// The filter is a regular expression, so only keep exact matches:
+		releases = slices.DeleteFunc(releases, func(r *helmimplementationindependend.HelmRelease) bool { return r.Name != options.ReleaseName })
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' helm releases using kube context '%s'.", len(releases), kubeContext)
+
+	return releases, nil
+}
+
+func (c *commandExecutorHelm) GetHelmRelease(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (*helmimplementationindependend.HelmRelease, error) {
+	_, releaseName, namespace, err := getKubeContextReleaseNameAndNamespace(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := c.ListHelmReleases(contextutils.WithSilent(ctx), &helmparameteroptions.ListHelmReleasesOptions{
+		KubernetesCluster: options.KubernetesCluster,
+		Namespace:         namespace,
+		ReleaseName:       releaseName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(releases, func(r *helmimplementationindependend.HelmRelease) bool { return r.Name == releaseName })
+	if index < 0 {
+		return nil, tracederrors.TracedErrorf("Helm release '%s' not found in namespace '%s'.", releaseName, namespace)
+	}
+
+	return releases[index], nil
+}
+
+func (c *commandExecutorHelm) HelmReleaseExists(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (bool, error) {
+	_, releaseName, namespace, err := getKubeContextReleaseNameAndNamespace(ctx, options)
+	if err != nil {
+		return false, err
+	}
+
+	releases, err := c.ListHelmReleases(contextutils.WithSilent(ctx), &helmparameteroptions.ListHelmReleasesOptions{
+		KubernetesCluster: options.KubernetesCluster,
+		Namespace:         namespace,
+		ReleaseName:       releaseName,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	exists := slices.ContainsFunc(releases, func(r *helmimplementationindependend.HelmRelease) bool { return r.Name == releaseName })
+
+	logging.LogInfoByCtxf(ctx, "Helm release '%s' in namespace '%s' exists: '%t'.", releaseName, namespace, exists)
+
+	return exists, nil
+}
+
+func (c *commandExecutorHelm) UninstallHelmRelease(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) error {
+	kubeContext, releaseName, namespace, err := getKubeContextReleaseNameAndNamespace(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	exists, err := c.HelmReleaseExists(contextutils.WithSilent(ctx), options)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logging.LogInfoByCtxf(ctx, "Helm release '%s' in namespace '%s' using kube context '%s' already absent.", releaseName, namespace, kubeContext)
+		return nil
+	}
+
+	_, err = c.runHelmAndGetStdout(ctx, "uninstall", releaseName, "--kube-context", kubeContext, "--namespace", namespace, "--wait")
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Helm release '%s' in namespace '%s' using kube context '%s' uninstalled.", releaseName, namespace, kubeContext)
+
+	return nil
+}
+
+func (c *commandExecutorHelm) RollbackHelmRelease(ctx context.Context, options *helmparameteroptions.RollbackHelmReleaseOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	kubeContext, releaseName, namespace, err := getKubeContextReleaseNameAndNamespace(ctx, &options.HelmReleaseOptions)
+	if err != nil {
+		return err
+	}
+
+	revision, err := options.GetRevision()
+	if err != nil {
+		return err
+	}
+
+	args := []string{"rollback", releaseName}
+	revisionDescription := "previous revision"
+	if revision > 0 {
+		args = append(args, strconv.Itoa(revision))
+		revisionDescription = "revision '" + strconv.Itoa(revision) + "'"
+	}
+	args = append(args, "--kube-context", kubeContext, "--namespace", namespace, "--wait")
+
+	logging.LogInfoByCtxf(ctx, "Rollback helm release '%s' in namespace '%s' to %s using kube context '%s' started.", releaseName, namespace, revisionDescription, kubeContext)
+
+	_, err = c.runHelmAndGetStdout(ctx, args...)
+	if err != nil {
+		return err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Helm release '%s' in namespace '%s' rolled back to %s.", releaseName, namespace, revisionDescription)
+	logging.LogInfoByCtxf(ctx, "Rollback helm release '%s' in namespace '%s' to %s using kube context '%s' finished.", releaseName, namespace, revisionDescription, kubeContext)
+
+	return nil
+}
+
+// Returns the rendered manifests of the currently deployed revision of the release.
+func (c *commandExecutorHelm) GetHelmReleaseManifests(ctx context.Context, options *helmparameteroptions.HelmReleaseOptions) (string, error) {
+	kubeContext, releaseName, namespace, err := getKubeContextReleaseNameAndNamespace(ctx, options)
+	if err != nil {
+		return "", err
+	}
+
+	return c.runHelmAndGetStdout(ctx, "get", "manifest", releaseName, "--kube-context", kubeContext, "--namespace", namespace)
+}
+
+// Renders the manifests of the chart locally without installing them.
+// The KubernetesCluster of the options is not used.
+func (c *commandExecutorHelm) TemplateHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) (string, error) {
+	if options == nil {
+		return "", tracederrors.TracedErrorNil("options")
+	}
+
+	chartReference, err := options.GetChartReference()
+	if err != nil {
+		return "", err
+	}
+
+	chartUri, err := options.GetChartUri()
+	if err != nil {
+		return "", err
+	}
+
+	namespace, err := options.GetNamespace()
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{"template", chartReference, chartUri, "--namespace", namespace}
+	args = append(args, options.GetChartVersionAndValuesArgs()...)
+
+	rendered, err := c.runHelmAndGetStdout(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Rendered helm chart '%s' as '%s' in namespace '%s'.", chartUri, chartReference, namespace)
+
+	return rendered, nil
+}
+
+// Compares the manifests of the deployed release with the manifests rendered using the given chart version and values.
+// All objects are reported as added if the release is not installed yet.
+func (c *commandExecutorHelm) DiffHelmChart(ctx context.Context, options *helmparameteroptions.InstallHelmChartOptions) (*helmimplementationindependend.ManifestDiff, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	cluster, err := options.GetKubernetesCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	chartReference, err := options.GetChartReference()
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := options.GetNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	releaseOptions := &helmparameteroptions.HelmReleaseOptions{
+		KubernetesCluster: cluster,
+		ReleaseName:       chartReference,
+		Namespace:         namespace,
+	}
+
+	exists, err := c.HelmReleaseExists(ctx, releaseOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	deployed := ""
+	if exists {
+		deployed, err = c.GetHelmReleaseManifests(ctx, releaseOptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proposed, err := c.TemplateHelmChart(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := helmimplementationindependend.DiffManifests(deployed, proposed, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(
+		ctx,
+		"Diff of helm release '%s' in namespace '%s': '%d' added, '%d' removed and '%d' changed objects.",
+		chartReference, namespace, len(diff.Added), len(diff.Removed), len(diff.Changed),
+	)
+
+	return diff, nil
+}
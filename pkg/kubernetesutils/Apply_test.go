@@ -0,0 +1,181 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func getConfigMapManifest(name string) string {
+	return "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: " + name + "\ndata:\n  key: value\n"
+}
+
+func getDeploymentManifest(name string) string {
+	manifest := "---\n"
+	manifest += "apiVersion: apps/v1\n"
+	manifest += "kind: Deployment\n"
+	manifest += "metadata:\n"
+	manifest += "  name: " + name + "\n"
+	manifest += "spec:\n"
+	manifest += "  replicas: 1\n"
+	manifest += "  selector:\n"
+	manifest += "    matchLabels:\n"
+	manifest += "      app: " + name + "\n"
+	manifest += "  template:\n"
+	manifest += "    metadata:\n"
+	manifest += "      labels:\n"
+	manifest += "        app: " + name + "\n"
+	manifest += "    spec:\n"
+	manifest += "      containers:\n"
+	manifest += "        - name: main\n"
+	manifest += "          image: ubuntu\n"
+	manifest += "          command: [\"sleep\", \"infinity\"]\n"
+
+	return manifest
+}
+
+func Test_ApplyManifests(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testapplymanifests"
+
+				kubernetes := getKubernetesByImplementationName(ctx, t, tt.implementationName)
+
+				err := kubernetes.DeleteNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				options := &kubernetesparameteroptions.ApplyManifestsOptions{
+					YamlString:       getConfigMapManifest("first") + getConfigMapManifest("second") + getDeploymentManifest("app"),
+					PruneLabels:      map[string]string{"app.kubernetes.io/part-of": "testapplymanifests"},
+					Prune:            true,
+					WaitForReadiness: true,
+					WaitTimeout:      2 * time.Minute,
+				}
+
+				// Applying twice must be idempotent:
+				for i := 0; i < 2; i++ {
+					result, err := namespace.ApplyManifests(ctx, options)
+					require.NoError(t, err)
+					require.ElementsMatch(t, []string{"first", "second", "app"}, result.GetAppliedObjectNames())
+					require.Empty(t, result.PrunedObjects)
+				}
+
+				configMap, err := namespace.GetConfigMapByName("first")
+				require.NoError(t, err)
+				allLabels, err := configMap.GetAllLabels(ctx)
+				require.NoError(t, err)
+				require.EqualValues(t, "testapplymanifests", allLabels["app.kubernetes.io/part-of"])
+
+				exists, err := namespace.DeploymentByNameExists(ctx, "app")
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				// Removing an object from the manifests prunes it:
+				options.YamlString = getConfigMapManifest("first") + getDeploymentManifest("app")
+				result, err := namespace.ApplyManifests(ctx, options)
+				require.NoError(t, err)
+				require.EqualValues(t, []string{"second"}, result.GetPrunedObjectNames())
+
+				exists, err = namespace.ConfigMapByNameExists(ctx, "second")
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				exists, err = namespace.ConfigMapByNameExists(ctx, "first")
+				require.NoError(t, err)
+				require.True(t, exists)
+			},
+		)
+	}
+}
+
+func Test_ApplyManifests_clusterWidePruneReportsNamespace(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testapplyclusterwideprune"
+
+				kubernetes := getKubernetesByImplementationName(ctx, t, tt.implementationName)
+
+				err := kubernetes.DeleteNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				getManifest := func(name string) string {
+					return "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: " + name + "\n  namespace: " + namespaceName + "\ndata:\n  key: value\n"
+				}
+
+				options := &kubernetesparameteroptions.ApplyManifestsOptions{
+					YamlString:  getManifest("keep") + getManifest("remove"),
+					PruneLabels: map[string]string{"app.kubernetes.io/part-of": namespaceName},
+					Prune:       true,
+				}
+
+				// Applied on cluster level without a namespace:
+				_, err = kubernetes.ApplyManifests(ctx, options)
+				require.NoError(t, err)
+
+				options.YamlString = getManifest("keep")
+				result, err := kubernetes.ApplyManifests(ctx, options)
+				require.NoError(t, err)
+				require.Len(t, result.PrunedObjects, 1)
+				require.EqualValues(t, "ConfigMap", result.PrunedObjects[0].Kind)
+				require.EqualValues(t, namespaceName, result.PrunedObjects[0].Namespace)
+				require.EqualValues(t, "remove", result.PrunedObjects[0].Name)
+
+				err = kubernetes.DeleteNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
+
+func Test_ApplyManifests_rejectsOtherNamespace(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				kubernetes := getKubernetesByImplementationName(ctx, t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, "testapplyothernamespace")
+				require.NoError(t, err)
+
+				manifest := "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n  namespace: another-namespace\n"
+				_, err = namespace.ApplyManifests(ctx, &kubernetesparameteroptions.ApplyManifestsOptions{YamlString: manifest})
+				require.Error(t, err)
+			},
+		)
+	}
+}
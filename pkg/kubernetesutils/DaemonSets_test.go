@@ -0,0 +1,163 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_DaemonSetByNameExists(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const daemonSetName = "daemonsetname"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteDaemonSetByName(ctx, daemonSetName)
+				require.NoError(t, err)
+
+				exists, err := namespace.DaemonSetByNameExists(ctx, daemonSetName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				for i := 0; i < 2; i++ {
+					daemonSet, err := namespace.CreateDaemonSet(ctx, &kubernetesparameteroptions.CreateDaemonSetOptions{
+						Name:      daemonSetName,
+						ImageName: "busybox",
+						Command:   []string{"sleep", "3600"},
+					})
+					require.NoError(t, err)
+
+					exists, err = daemonSet.Exists(ctx)
+					require.NoError(t, err)
+					require.True(t, exists)
+				}
+
+				exists, err = namespace.DaemonSetByNameExists(ctx, daemonSetName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				for i := 0; i < 2; i++ {
+					err = namespace.DeleteDaemonSetByName(ctx, daemonSetName)
+					require.NoError(t, err)
+
+					exists, err := namespace.DaemonSetByNameExists(ctx, daemonSetName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+			},
+		)
+	}
+}
+
+func Test_ListDaemonSetNames(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const daemonSetName1 = "daemonset1"
+				const daemonSetName2 = "daemonset2"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				_, err = namespace.CreateDaemonSet(ctx, &kubernetesparameteroptions.CreateDaemonSetOptions{
+					Name:      daemonSetName1,
+					ImageName: "busybox",
+					Command:   []string{"sleep", "3600"},
+				})
+				require.NoError(t, err)
+				_, err = namespace.CreateDaemonSet(ctx, &kubernetesparameteroptions.CreateDaemonSetOptions{
+					Name:      daemonSetName2,
+					ImageName: "busybox",
+					Command:   []string{"sleep", "3600"},
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListDaemonSetNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, daemonSetName1)
+				require.Contains(t, names, daemonSetName2)
+
+				err = namespace.DeleteDaemonSetByName(ctx, daemonSetName1)
+				require.NoError(t, err)
+				err = namespace.DeleteDaemonSetByName(ctx, daemonSetName2)
+				require.NoError(t, err)
+
+				names, err = namespace.ListDaemonSetNames(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, names, daemonSetName1)
+				require.NotContains(t, names, daemonSetName2)
+			},
+		)
+	}
+}
+
+func Test_DaemonSetWaitUntilReady(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const daemonSetName = "daemonsetwaitready"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteDaemonSetByName(ctx, daemonSetName)
+				require.NoError(t, err)
+
+				daemonSet, err := namespace.CreateDaemonSet(ctx, &kubernetesparameteroptions.CreateDaemonSetOptions{
+					Name:      daemonSetName,
+					ImageName: "busybox",
+					Command:   []string{"sleep", "3600"},
+				})
+				require.NoError(t, err)
+
+				err = daemonSet.WaitUntilReady(ctx, 2*time.Minute)
+				require.NoError(t, err)
+
+				err = namespace.DeleteDaemonSetByName(ctx, daemonSetName)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
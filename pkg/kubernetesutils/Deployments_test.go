@@ -2,6 +2,7 @@ package kubernetesutils_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
@@ -156,3 +157,109 @@ func Test_CreateAndDeleteDeployment(t *testing.T) {
 		)
 	}
 }
+
+func Test_DeploymentRollout(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const deploymentName = "rolloutdeployment"
+				const timeout = 2 * time.Minute
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				deployment, err := namespace.CreateDeployment(
+					ctx,
+					&kubernetesparameteroptions.KubernetesRunCommandOptions{
+						Image:                           "busybox:1.36",
+						DeploymentName:                  deploymentName,
+						DeleteAlreadyExistingDeployment: true,
+						RunCommandOptions: &parameteroptions.RunCommandOptions{
+							Command: []string{"sleep", "3600"},
+						},
+					},
+				)
+				require.NoError(t, err)
+				defer deployment.Delete(ctx)
+
+				err = deployment.WaitUntilRolloutComplete(ctx, timeout)
+				require.NoError(t, err)
+
+				revisions, err := deployment.ListRevisions(ctx)
+				require.NoError(t, err)
+				require.Len(t, revisions, 1)
+				require.EqualValues(t, []string{"busybox:1.36"}, revisions[0].Images)
+
+				// Rolling back without previous revision is not possible:
+				err = deployment.Rollback(ctx, 0)
+				require.Error(t, err)
+
+				// Scale up:
+				err = deployment.Scale(ctx, 2)
+				require.NoError(t, err)
+				err = deployment.WaitUntilRolloutComplete(ctx, timeout)
+				require.NoError(t, err)
+
+				// Scaling does not create a new revision:
+				revisions, err = deployment.ListRevisions(ctx)
+				require.NoError(t, err)
+				require.Len(t, revisions, 1)
+
+				// Update the image:
+				err = deployment.SetContainerImage(ctx, deploymentName, "busybox:1.37")
+				require.NoError(t, err)
+				err = deployment.WaitUntilRolloutComplete(ctx, timeout)
+				require.NoError(t, err)
+
+				// Setting the same image again is a no-op:
+				err = deployment.SetContainerImage(ctx, deploymentName, "busybox:1.37")
+				require.NoError(t, err)
+
+				err = deployment.SetContainerImage(ctx, "nonexisting", "busybox:1.37")
+				require.Error(t, err)
+
+				revisions, err = deployment.ListRevisions(ctx)
+				require.NoError(t, err)
+				require.Len(t, revisions, 2)
+				require.EqualValues(t, 2, revisions[1].Revision)
+				require.EqualValues(t, []string{"busybox:1.37"}, revisions[1].Images)
+
+				// Roll back to the previous image. The rolled back revision gets the next revision number:
+				err = deployment.Rollback(ctx, 0)
+				require.NoError(t, err)
+				err = deployment.WaitUntilRolloutComplete(ctx, timeout)
+				require.NoError(t, err)
+
+				revisions, err = deployment.ListRevisions(ctx)
+				require.NoError(t, err)
+				require.Len(t, revisions, 2)
+				require.EqualValues(t, 3, revisions[1].Revision)
+				require.EqualValues(t, []string{"busybox:1.36"}, revisions[1].Images)
+
+				// Restart creates a new revision with the same image:
+				err = deployment.RolloutRestart(ctx)
+				require.NoError(t, err)
+				err = deployment.WaitUntilRolloutComplete(ctx, timeout)
+				require.NoError(t, err)
+
+				revisions, err = deployment.ListRevisions(ctx)
+				require.NoError(t, err)
+				require.Len(t, revisions, 3)
+				require.EqualValues(t, 4, revisions[2].Revision)
+				require.EqualValues(t, []string{"busybox:1.36"}, revisions[2].Images)
+			},
+		)
+	}
+}
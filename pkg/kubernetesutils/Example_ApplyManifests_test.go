@@ -0,0 +1,57 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+)
+
+func Test_Example_ApplyManifests(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	// Multi document YAML with all objects we want to manage together.
+	// Objects without a namespace are applied to the "default" namespace.
+	const manifests = `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: example-apply
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example-config
+  namespace: example-apply
+data:
+  greeting: hello
+`
+
+	// Apply the manifests using server side apply.
+	// All applied objects get the prune labels. Objects carrying these labels which are not part of the manifests anymore are deleted on the next apply.
+	result, err := cluster.ApplyManifests(ctx, &kubernetesparameteroptions.ApplyManifestsOptions{
+		YamlString:       manifests,
+		FieldManager:     "example-apply",
+		PruneLabels:      map[string]string{"app.kubernetes.io/managed-by": "example-apply"},
+		Prune:            true,
+		WaitForReadiness: true,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"example-apply", "example-config"}, result.GetAppliedObjectNames())
+
+	exists, err := cluster.ConfigMapByNameExists(ctx, "example-apply", "example-config")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Cleanup
+	err = cluster.DeleteNamespaceByName(ctx, "example-apply")
+	require.NoError(t, err)
+}
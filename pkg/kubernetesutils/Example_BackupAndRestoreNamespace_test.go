@@ -0,0 +1,72 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/gnupgoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/nativegnupg"
+)
+
+func Test_Example_BackupAndRestoreNamespace(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	// Create a namespace with some objects to backup:
+	namespace, err := cluster.CreateNamespaceByName(ctx, "example-backup")
+	require.NoError(t, err)
+	defer namespace.Delete(ctx)
+
+	_, err = namespace.CreateConfigMap(ctx, "example-config", &kubernetesparameteroptions.CreateConfigMapOptions{
+		ConfigMapData: map[string]string{"key": "value"},
+	})
+	require.NoError(t, err)
+
+	_, err = namespace.CreateSecret(ctx, "example-secret", &kubernetesparameteroptions.CreateSecretOptions{
+		SecretData: map[string][]byte{"password": []byte("example-password")},
+	})
+	require.NoError(t, err)
+
+	// Secrets in the backup are encrypted using PGP. Usually an existing key pair is used:
+	privateKey, publicKey, err := nativegnupg.GenerateKeyPair(ctx, &gnupgoptions.GenerateKeyPairOptions{
+		Name:    "backup",
+		Email:   "backup@example.com",
+		Comment: "Example backup key",
+		RSABits: 2048,
+	})
+	require.NoError(t, err)
+
+	// Backup all objects of the namespace. Every object is stored as YAML file like 'ConfigMap_example-config.yaml':
+	backupDirectory, err := tempfilesoo.CreateEmptyTemporaryDirectory(ctx)
+	require.NoError(t, err)
+	defer backupDirectory.Delete(ctx, &filesoptions.DeleteOptions{})
+
+	_, err = namespace.Backup(ctx, backupDirectory, &kubernetesparameteroptions.BackupNamespaceOptions{
+		SecretEncryptionPublicKeys: [][]byte{publicKey},
+	})
+	require.NoError(t, err)
+
+	// Restore the backup into another namespace:
+	restoredNamespace, err := cluster.GetNamespaceByName("example-backup-restored")
+	require.NoError(t, err)
+	defer restoredNamespace.Delete(ctx)
+
+	_, err = restoredNamespace.Restore(ctx, backupDirectory, &kubernetesparameteroptions.RestoreNamespaceOptions{
+		SecretDecryptionPrivateKey: privateKey,
+	})
+	require.NoError(t, err)
+
+	exists, err := restoredNamespace.SecretByNameExists(ctx, "example-secret")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
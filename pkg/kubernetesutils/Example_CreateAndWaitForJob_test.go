@@ -0,0 +1,56 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+)
+
+func Test_Example_CreateAndWaitForJob(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	// Define the namespace and job name we use for testing
+	const namespaceName = "testnamespace"
+	const jobName = "example-job"
+
+	namespace, err := cluster.CreateNamespaceByName(ctx, namespaceName)
+	require.NoError(t, err)
+
+	// Ensure the job is absent
+	err = namespace.DeleteJobByName(ctx, jobName)
+	require.NoError(t, err)
+
+	// Create the job. Creating an already existing job is a no-op.
+	job, err := namespace.CreateJob(ctx, &kubernetesparameteroptions.CreateJobOptions{
+		Name:      jobName,
+		ImageName: "busybox",
+		Command:   []string{"echo", "Hello World!"},
+	})
+	require.NoError(t, err)
+
+	// Wait until the job completed successfully:
+	err = job.WaitUntilReady(ctx, 2*time.Minute)
+	require.NoError(t, err)
+
+	// The job is listed in the namespace:
+	names, err := namespace.ListJobNames(ctx)
+	require.NoError(t, err)
+	require.Contains(t, names, jobName)
+
+	// Delete the job. Delete waits until the job is gone:
+	err = job.Delete(ctx)
+	require.NoError(t, err)
+	exists, err := job.Exists(ctx)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
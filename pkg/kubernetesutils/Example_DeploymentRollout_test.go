@@ -0,0 +1,69 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+)
+
+func Test_Example_DeploymentRollout(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	const namespaceName = "testnamespace"
+	const deploymentName = "example-rollout"
+
+	namespace, err := cluster.CreateNamespaceByName(ctx, namespaceName)
+	require.NoError(t, err)
+
+	// Create a deployment. The container name defaults to the deployment name:
+	deployment, err := namespace.CreateDeployment(ctx, &kubernetesparameteroptions.KubernetesRunCommandOptions{
+		Image:                           "busybox:1.36",
+		DeploymentName:                  deploymentName,
+		DeleteAlreadyExistingDeployment: true,
+		RunCommandOptions: &parameteroptions.RunCommandOptions{
+			Command: []string{"sleep", "3600"},
+		},
+	})
+	require.NoError(t, err)
+	defer deployment.Delete(ctx)
+
+	// Scale to 3 replicas and wait until all of them are available. The rollout progress is logged:
+	err = deployment.Scale(ctx, 3)
+	require.NoError(t, err)
+	err = deployment.WaitUntilRolloutComplete(ctx, 2*time.Minute)
+	require.NoError(t, err)
+
+	// Update the image which triggers a rolling update:
+	err = deployment.SetContainerImage(ctx, deploymentName, "busybox:1.37")
+	require.NoError(t, err)
+	err = deployment.WaitUntilRolloutComplete(ctx, 2*time.Minute)
+	require.NoError(t, err)
+
+	// The rollout history contains one revision per pod template:
+	revisions, err := deployment.ListRevisions(ctx)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+
+	// Roll back to the previous revision (use an explicit revision number instead of 0 to roll back to a specific one):
+	err = deployment.Rollback(ctx, 0)
+	require.NoError(t, err)
+	err = deployment.WaitUntilRolloutComplete(ctx, 2*time.Minute)
+	require.NoError(t, err)
+
+	// Restart all pods, e.g. to pick up changed ConfigMaps:
+	err = deployment.RolloutRestart(ctx)
+	require.NoError(t, err)
+	err = deployment.WaitUntilRolloutComplete(ctx, 2*time.Minute)
+	require.NoError(t, err)
+}
@@ -0,0 +1,40 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+)
+
+func Test_Example_NamespaceDiagnostics(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	namespace, err := cluster.GetNamespaceByName("kube-system")
+	require.NoError(t, err)
+
+	// Collect pod states, restarts, last termination reasons, warning events of the last 30 minutes and pending PVCs.
+	// The CPU and memory usage is only included if the metrics-server is installed:
+	diagnostics, err := namespace.GetDiagnostics(ctx, &kubernetesparameteroptions.NamespaceDiagnosticsOptions{
+		EventsSince: 30 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	// The control plane pods of kind are healthy:
+	require.Empty(t, diagnostics.GetUnhealthyPodNames())
+
+	// Print the summary tables as shown by 'kubernetes diagnose':
+	rendered, err := diagnostics.RenderAsString()
+	require.NoError(t, err)
+	fmt.Print(rendered)
+}
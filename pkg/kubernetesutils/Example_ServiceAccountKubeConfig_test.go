@@ -0,0 +1,53 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+)
+
+func Test_Example_ServiceAccountKubeConfig(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	namespace, err := cluster.CreateNamespaceByName(ctx, "example-ci")
+	require.NoError(t, err)
+	defer namespace.Delete(ctx)
+
+	// The kubeconfig is written to this file which is only readable by the owner:
+	kubeConfigFile, err := tempfilesoo.CreateEmptyTemporaryFile(ctx)
+	require.NoError(t, err)
+	defer kubeConfigFile.Delete(ctx, &filesoptions.DeleteOptions{})
+
+	options := &kubernetesparameteroptions.ServiceAccountKubeConfigOptions{
+		ServiceAccountName: "ci-job",
+		// The ClusterRole "edit" is bound using a RoleBinding and therefore only grants access to the namespace "example-ci".
+		ClusterRoleName: "edit",
+		TokenExpiration: 2 * time.Hour,
+		OutputFile:      kubeConfigFile,
+	}
+
+	// Create the ServiceAccount, the RoleBinding and the kubeconfig containing a token valid for 2 hours:
+	kubeConfig, err := namespace.CreateServiceAccountKubeConfig(ctx, options)
+	require.NoError(t, err)
+
+	currentContext, err := kubeConfig.GetCurrentContext(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, "ci-job@kind-"+testClusterName, currentContext)
+
+	// Remove the ServiceAccount, the RoleBinding and the kubeconfig file again.
+	// The issued token becomes invalid:
+	err = namespace.RevokeServiceAccountKubeConfig(ctx, options)
+	require.NoError(t, err)
+}
@@ -0,0 +1,63 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetesoo"
+)
+
+func Test_Example_StreamLogs(t *testing.T) {
+	// Enable verbose output
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	// Get Kubernetes cluster:
+	cluster, err := nativekubernetesoo.GetClusterByName(ctx, "kind-"+testClusterName)
+	require.NoError(t, err)
+
+	const namespaceName = "testnamespace"
+	const statefulSetName = "example-logs"
+
+	namespace, err := cluster.CreateNamespaceByName(ctx, namespaceName)
+	require.NoError(t, err)
+
+	// Start some pods writing a log line every second:
+	statefulSet, err := namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+		Name:      statefulSetName,
+		ImageName: "busybox",
+		Command:   []string{"sh", "-c", "while true; do date; sleep 1; done"},
+		Replicas:  2,
+	})
+	require.NoError(t, err)
+	defer statefulSet.Delete(ctx)
+
+	err = statefulSet.WaitUntilReady(ctx, 2*time.Minute)
+	require.NoError(t, err)
+
+	// Follow the logs of all pods for 5 seconds. Cancelling the context stops following:
+	followCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	reader, err := namespace.StreamLogsByLabelSelector(followCtx, &kubernetesparameteroptions.StreamLogsOptions{
+		LabelSelector: "app=" + statefulSetName,
+		Follow:        true,
+		Timestamps:    true,
+	})
+	require.NoError(t, err)
+
+	// Every line is prefixed by "[pod-name/container-name] ":
+	nLines := 0
+	err = kubernetesimplementationindependend.ForEachLogLine(followCtx, reader, func(line string) error {
+		fmt.Println(line)
+		nLines++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Greater(t, nLines, 2)
+}
@@ -0,0 +1,121 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_IngressByNameExists(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const ingressName = "ingressname"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteIngressByName(ctx, ingressName)
+				require.NoError(t, err)
+
+				exists, err := namespace.IngressByNameExists(ctx, ingressName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				for i := 0; i < 2; i++ {
+					ingress, err := namespace.CreateIngress(ctx, &kubernetesparameteroptions.CreateIngressOptions{
+						Name:        ingressName,
+						ServiceName: "example-service",
+						ServicePort: 80,
+					})
+					require.NoError(t, err)
+
+					exists, err = ingress.Exists(ctx)
+					require.NoError(t, err)
+					require.True(t, exists)
+				}
+
+				exists, err = namespace.IngressByNameExists(ctx, ingressName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				for i := 0; i < 2; i++ {
+					err = namespace.DeleteIngressByName(ctx, ingressName)
+					require.NoError(t, err)
+
+					exists, err := namespace.IngressByNameExists(ctx, ingressName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+			},
+		)
+	}
+}
+
+func Test_ListIngressNames(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const ingressName1 = "ingress1"
+				const ingressName2 = "ingress2"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				_, err = namespace.CreateIngress(ctx, &kubernetesparameteroptions.CreateIngressOptions{
+					Name:        ingressName1,
+					ServiceName: "example-service",
+					ServicePort: 80,
+				})
+				require.NoError(t, err)
+				_, err = namespace.CreateIngress(ctx, &kubernetesparameteroptions.CreateIngressOptions{
+					Name:        ingressName2,
+					ServiceName: "example-service",
+					ServicePort: 80,
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListIngressNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, ingressName1)
+				require.Contains(t, names, ingressName2)
+
+				err = namespace.DeleteIngressByName(ctx, ingressName1)
+				require.NoError(t, err)
+				err = namespace.DeleteIngressByName(ctx, ingressName2)
+				require.NoError(t, err)
+
+				names, err = namespace.ListIngressNames(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, names, ingressName1)
+				require.NotContains(t, names, ingressName2)
+			},
+		)
+	}
+}
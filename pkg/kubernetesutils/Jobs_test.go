@@ -0,0 +1,163 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_JobByNameExists(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const jobName = "jobname"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteJobByName(ctx, jobName)
+				require.NoError(t, err)
+
+				exists, err := namespace.JobByNameExists(ctx, jobName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				for i := 0; i < 2; i++ {
+					job, err := namespace.CreateJob(ctx, &kubernetesparameteroptions.CreateJobOptions{
+						Name:      jobName,
+						ImageName: "busybox",
+						Command:   []string{"echo", "hello"},
+					})
+					require.NoError(t, err)
+
+					exists, err = job.Exists(ctx)
+					require.NoError(t, err)
+					require.True(t, exists)
+				}
+
+				exists, err = namespace.JobByNameExists(ctx, jobName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				for i := 0; i < 2; i++ {
+					err = namespace.DeleteJobByName(ctx, jobName)
+					require.NoError(t, err)
+
+					exists, err := namespace.JobByNameExists(ctx, jobName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+			},
+		)
+	}
+}
+
+func Test_ListJobNames(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const jobName1 = "job1"
+				const jobName2 = "job2"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				_, err = namespace.CreateJob(ctx, &kubernetesparameteroptions.CreateJobOptions{
+					Name:      jobName1,
+					ImageName: "busybox",
+					Command:   []string{"echo", "hello"},
+				})
+				require.NoError(t, err)
+				_, err = namespace.CreateJob(ctx, &kubernetesparameteroptions.CreateJobOptions{
+					Name:      jobName2,
+					ImageName: "busybox",
+					Command:   []string{"echo", "hello"},
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListJobNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, jobName1)
+				require.Contains(t, names, jobName2)
+
+				err = namespace.DeleteJobByName(ctx, jobName1)
+				require.NoError(t, err)
+				err = namespace.DeleteJobByName(ctx, jobName2)
+				require.NoError(t, err)
+
+				names, err = namespace.ListJobNames(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, names, jobName1)
+				require.NotContains(t, names, jobName2)
+			},
+		)
+	}
+}
+
+func Test_JobWaitUntilReady(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const jobName = "jobwaitready"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteJobByName(ctx, jobName)
+				require.NoError(t, err)
+
+				job, err := namespace.CreateJob(ctx, &kubernetesparameteroptions.CreateJobOptions{
+					Name:      jobName,
+					ImageName: "busybox",
+					Command:   []string{"echo", "hello"},
+				})
+				require.NoError(t, err)
+
+				err = job.WaitUntilReady(ctx, 2*time.Minute)
+				require.NoError(t, err)
+
+				err = namespace.DeleteJobByName(ctx, jobName)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
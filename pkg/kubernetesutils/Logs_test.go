@@ -0,0 +1,96 @@
+package kubernetesutils_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_StreamLogs(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const statefulSetName = "streamlogs"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+				require.NoError(t, err)
+
+				statefulSet, err := namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+					Name:      statefulSetName,
+					ImageName: "busybox",
+					Command:   []string{"sh", "-c", "echo first; echo second; sleep 3600"},
+					Replicas:  2,
+				})
+				require.NoError(t, err)
+				defer namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+
+				err = statefulSet.WaitUntilReady(ctx, 2*time.Minute)
+				require.NoError(t, err)
+
+				t.Run("single pod", func(t *testing.T) {
+					pod, err := namespace.GetPodByName(statefulSetName + "-0")
+					require.NoError(t, err)
+
+					tailLines := int64(1)
+					reader, err := pod.StreamLogs(ctx, &kubernetesparameteroptions.StreamLogsOptions{TailLines: &tailLines})
+					require.NoError(t, err)
+					defer reader.Close()
+
+					content, err := io.ReadAll(reader)
+					require.NoError(t, err)
+					require.EqualValues(t, "second\n", string(content))
+				})
+
+				t.Run("follow by label selector", func(t *testing.T) {
+					followCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+					defer cancel()
+
+					reader, err := namespace.StreamLogsByLabelSelector(followCtx, &kubernetesparameteroptions.StreamLogsOptions{
+						LabelSelector: "app=" + statefulSetName,
+						Follow:        true,
+					})
+					require.NoError(t, err)
+
+					lines := []string{}
+					err = kubernetesimplementationindependend.ForEachLogLine(followCtx, reader, func(line string) error {
+						lines = append(lines, line)
+						if len(lines) == 4 {
+							// All expected lines received. Stop following:
+							cancel()
+						}
+						return nil
+					})
+					require.NoError(t, err)
+
+					joined := strings.Join(lines, "\n")
+					for _, podName := range []string{statefulSetName + "-0", statefulSetName + "-1"} {
+						require.Contains(t, joined, "["+podName+"/"+statefulSetName+"] first")
+						require.Contains(t, joined, "["+podName+"/"+statefulSetName+"] second")
+					}
+				})
+			},
+		)
+	}
+}
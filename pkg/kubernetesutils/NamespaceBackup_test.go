@@ -0,0 +1,120 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/gnupgoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/nativegnupg"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_BackupAndRestoreNamespace(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const sourceNamespaceName = "testbackup-source"
+				const restoredNamespaceName = "testbackup-restored"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				for _, name := range []string{sourceNamespaceName, restoredNamespaceName} {
+					err := kubernetes.DeleteNamespaceByName(ctx, name)
+					require.NoError(t, err)
+				}
+				defer kubernetes.DeleteNamespaceByName(ctx, sourceNamespaceName)
+				defer kubernetes.DeleteNamespaceByName(ctx, restoredNamespaceName)
+
+				source, err := kubernetes.CreateNamespaceByName(ctx, sourceNamespaceName)
+				require.NoError(t, err)
+
+				_, err = source.CreateConfigMap(ctx, "config", &kubernetesparameteroptions.CreateConfigMapOptions{
+					ConfigMapData: map[string]string{"key": "value"},
+				})
+				require.NoError(t, err)
+
+				_, err = source.CreateSecret(ctx, "secret", &kubernetesparameteroptions.CreateSecretOptions{
+					SecretData: map[string][]byte{"password": []byte("secret-password")},
+				})
+				require.NoError(t, err)
+
+				_, err = source.CreateService(ctx, &kubernetesparameteroptions.CreateServiceOptions{
+					Name: "app",
+					Ports: []kubernetesparameteroptions.ServicePort{{Name: "http", Port: 80}},
+				})
+				require.NoError(t, err)
+
+				// The generated selector and pod template labels of the Job must not be part of the backup:
+				_, err = source.CreateJob(ctx, &kubernetesparameteroptions.CreateJobOptions{
+					Name:      "job",
+					ImageName: "ubuntu",
+					Command:   []string{"true"},
+				})
+				require.NoError(t, err)
+
+				privateKey, publicKey, err := nativegnupg.GenerateKeyPair(ctx, &gnupgoptions.GenerateKeyPairOptions{
+					Name:    "backup",
+					Email:   "backup@example.com",
+					Comment: "Backup key",
+					RSABits: 1024,
+				})
+				require.NoError(t, err)
+
+				directory, err := tempfilesoo.CreateEmptyTemporaryDirectory(ctx)
+				require.NoError(t, err)
+				defer directory.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				exported, err := source.Backup(ctx, directory, &kubernetesparameteroptions.BackupNamespaceOptions{
+					SecretEncryptionPublicKeys: [][]byte{publicKey},
+				})
+				require.NoError(t, err)
+				require.Len(t, exported, 4)
+
+				for _, fileName := range []string{"ConfigMap_config.yaml", "Secret_secret.yaml.asc", "Service_app.yaml", "Job_job.yaml"} {
+					exists, err := directory.FileInDirectoryExists(ctx, fileName)
+					require.NoError(t, err)
+					require.True(t, exists, fileName)
+				}
+
+				restored, err := kubernetes.GetNamespaceByName(restoredNamespaceName)
+				require.NoError(t, err)
+
+				_, err = restored.Restore(ctx, directory, &kubernetesparameteroptions.RestoreNamespaceOptions{
+					SecretDecryptionPrivateKey: privateKey,
+					WaitForReadiness:           true,
+					WaitTimeout:                time.Minute,
+				})
+				require.NoError(t, err)
+
+				exists, err := restored.ConfigMapByNameExists(ctx, "config")
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				exists, err = restored.ServiceByNameExists(ctx, "app")
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				exists, err = restored.JobByNameExists(ctx, "job")
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				secretData, err := kubernetes.ReadSecret(ctx, restoredNamespaceName, "secret")
+				require.NoError(t, err)
+				require.EqualValues(t, "secret-password", string(secretData["password"]))
+			},
+		)
+	}
+}
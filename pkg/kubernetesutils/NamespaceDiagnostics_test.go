@@ -0,0 +1,58 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_GetNamespaceDiagnostics(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespacediagnostics"
+				const persistentVolumeClaimName = "pending-claim"
+
+				kubernetes := getKubernetesByImplementationName(ctx, t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+				defer namespace.Delete(ctx)
+
+				diagnostics, err := namespace.GetDiagnostics(ctx, &kubernetesparameteroptions.NamespaceDiagnosticsOptions{})
+				require.NoError(t, err)
+				require.EqualValues(t, namespaceName, diagnostics.NamespaceName)
+				require.Len(t, diagnostics.Pods, 0)
+				require.Len(t, diagnostics.PendingPersistentVolumeClaims, 0)
+
+				// The default storage class of kind only binds a claim when a pod uses it:
+				_, err = namespace.CreatePersistentVolumeClaim(ctx, &kubernetesparameteroptions.CreatePersistentVolumeClaimOptions{
+					Name: persistentVolumeClaimName,
+				})
+				require.NoError(t, err)
+
+				diagnostics, err = namespace.GetDiagnostics(ctx, &kubernetesparameteroptions.NamespaceDiagnosticsOptions{SkipResourceUsage: true})
+				require.NoError(t, err)
+				require.False(t, diagnostics.ResourceUsageAvailable)
+				require.Len(t, diagnostics.PendingPersistentVolumeClaims, 1)
+				require.EqualValues(t, persistentVolumeClaimName, diagnostics.PendingPersistentVolumeClaims[0].Name)
+
+				rendered, err := diagnostics.RenderAsString()
+				require.NoError(t, err)
+				require.Contains(t, rendered, "Namespace '"+namespaceName+"': 0 pods, 0 unhealthy.")
+				require.Contains(t, rendered, persistentVolumeClaimName)
+			},
+		)
+	}
+}
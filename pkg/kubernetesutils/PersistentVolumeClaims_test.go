@@ -0,0 +1,115 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_PersistentVolumeClaimByNameExists(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const persistentVolumeClaimName = "persistentvolumeclaimname"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeletePersistentVolumeClaimByName(ctx, persistentVolumeClaimName)
+				require.NoError(t, err)
+
+				exists, err := namespace.PersistentVolumeClaimByNameExists(ctx, persistentVolumeClaimName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				for i := 0; i < 2; i++ {
+					persistentVolumeClaim, err := namespace.CreatePersistentVolumeClaim(ctx, &kubernetesparameteroptions.CreatePersistentVolumeClaimOptions{
+						Name: persistentVolumeClaimName,
+					})
+					require.NoError(t, err)
+
+					exists, err = persistentVolumeClaim.Exists(ctx)
+					require.NoError(t, err)
+					require.True(t, exists)
+				}
+
+				exists, err = namespace.PersistentVolumeClaimByNameExists(ctx, persistentVolumeClaimName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				for i := 0; i < 2; i++ {
+					err = namespace.DeletePersistentVolumeClaimByName(ctx, persistentVolumeClaimName)
+					require.NoError(t, err)
+
+					exists, err := namespace.PersistentVolumeClaimByNameExists(ctx, persistentVolumeClaimName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+			},
+		)
+	}
+}
+
+func Test_ListPersistentVolumeClaimNames(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const persistentVolumeClaimName1 = "persistentvolumeclaim1"
+				const persistentVolumeClaimName2 = "persistentvolumeclaim2"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				_, err = namespace.CreatePersistentVolumeClaim(ctx, &kubernetesparameteroptions.CreatePersistentVolumeClaimOptions{
+					Name: persistentVolumeClaimName1,
+				})
+				require.NoError(t, err)
+				_, err = namespace.CreatePersistentVolumeClaim(ctx, &kubernetesparameteroptions.CreatePersistentVolumeClaimOptions{
+					Name: persistentVolumeClaimName2,
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListPersistentVolumeClaimNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, persistentVolumeClaimName1)
+				require.Contains(t, names, persistentVolumeClaimName2)
+
+				err = namespace.DeletePersistentVolumeClaimByName(ctx, persistentVolumeClaimName1)
+				require.NoError(t, err)
+				err = namespace.DeletePersistentVolumeClaimByName(ctx, persistentVolumeClaimName2)
+				require.NoError(t, err)
+
+				names, err = namespace.ListPersistentVolumeClaimNames(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, names, persistentVolumeClaimName1)
+				require.NotContains(t, names, persistentVolumeClaimName2)
+			},
+		)
+	}
+}
@@ -472,6 +472,51 @@ func Test_ListSecretNames(t *testing.T) {
 	}
 }
 
+func Test_ListSecretNamesByType(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const secretName = "secretname-by-type"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteSecretByName(ctx, secretName)
+				require.NoError(t, err)
+
+				// Secrets created by CreateSecret are of type 'Opaque':
+				_, err = namespace.CreateSecret(ctx, secretName, &kubernetesparameteroptions.CreateSecretOptions{
+					SecretData: map[string][]byte{"key": []byte("value")},
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListSecretNamesByType(ctx, "Opaque")
+				require.NoError(t, err)
+				require.Contains(t, names, secretName)
+
+				names, err = namespace.ListSecretNamesByType(ctx, "kubernetes.io/tls")
+				require.NoError(t, err)
+				require.NotContains(t, names, secretName)
+
+				err = namespace.DeleteSecretByName(ctx, secretName)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
+
 func Test_ListSecrets(t *testing.T) {
 	tests := []struct {
 		implementationName string
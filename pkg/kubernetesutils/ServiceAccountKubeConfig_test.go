@@ -0,0 +1,93 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/mustutils"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func Test_CreateAndRevokeServiceAccountKubeConfig(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+
+				const namespaceName = "testserviceaccountkubeconfig"
+				const serviceAccountName = "ci-viewer"
+
+				kubernetesCluster := getKubernetesByImplementationName(ctx, t, tt.implementationName)
+				namespace, err := kubernetesCluster.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+				defer namespace.Delete(ctx)
+
+				// Revoking an absent ServiceAccount is not an error:
+				err = namespace.RevokeServiceAccountKubeConfig(ctx, &kubernetesparameteroptions.ServiceAccountKubeConfigOptions{ServiceAccountName: serviceAccountName})
+				require.NoError(t, err)
+
+				outputFile, err := tempfilesoo.CreateEmptyTemporaryFile(ctx)
+				require.NoError(t, err)
+				defer outputFile.Delete(ctx, &filesoptions.DeleteOptions{})
+
+				options := &kubernetesparameteroptions.ServiceAccountKubeConfigOptions{
+					ServiceAccountName: serviceAccountName,
+					ClusterRoleName:    "view",
+					TokenExpiration:    15 * time.Minute,
+					OutputFile:         outputFile,
+				}
+
+				for i := 0; i < 2; i++ {
+					_, err = namespace.CreateServiceAccountKubeConfig(ctx, options)
+					require.NoError(t, err)
+				}
+
+				require.True(t, mustutils.Must(namespace.RoleBindingByNameExists(ctx, serviceAccountName)))
+
+				outputPath, err := outputFile.GetPath()
+				require.NoError(t, err)
+
+				config, err := clientcmd.BuildConfigFromFlags("", outputPath)
+				require.NoError(t, err)
+
+				clientset, err := kubernetes.NewForConfig(config)
+				require.NoError(t, err)
+
+				// The ClusterRole 'view' is only bound in the namespace:
+				_, err = clientset.CoreV1().ConfigMaps(namespaceName).List(ctx, metav1.ListOptions{})
+				require.NoError(t, err)
+
+				_, err = clientset.CoreV1().ConfigMaps("default").List(ctx, metav1.ListOptions{})
+				require.True(t, apierrors.IsForbidden(err))
+
+				for i := 0; i < 2; i++ {
+					err = namespace.RevokeServiceAccountKubeConfig(ctx, options)
+					require.NoError(t, err)
+				}
+
+				require.False(t, mustutils.Must(namespace.RoleBindingByNameExists(ctx, serviceAccountName)))
+				require.False(t, mustutils.Must(outputFile.Exists(ctx)))
+
+				// The issued token is invalid after the ServiceAccount is deleted:
+				_, err = clientset.CoreV1().ConfigMaps(namespaceName).List(ctx, metav1.ListOptions{})
+				require.True(t, apierrors.IsUnauthorized(err))
+			},
+		)
+	}
+}
@@ -0,0 +1,173 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_ServiceByNameExists(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const serviceName = "servicename"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteServiceByName(ctx, serviceName)
+				require.NoError(t, err)
+
+				exists, err := namespace.ServiceByNameExists(ctx, serviceName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				for i := 0; i < 2; i++ {
+					service, err := namespace.CreateService(ctx, &kubernetesparameteroptions.CreateServiceOptions{
+						Name:  serviceName,
+						Ports: []kubernetesparameteroptions.ServicePort{{Port: 80}},
+					})
+					require.NoError(t, err)
+
+					exists, err = service.Exists(ctx)
+					require.NoError(t, err)
+					require.True(t, exists)
+				}
+
+				exists, err = namespace.ServiceByNameExists(ctx, serviceName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				for i := 0; i < 2; i++ {
+					err = namespace.DeleteServiceByName(ctx, serviceName)
+					require.NoError(t, err)
+
+					exists, err := namespace.ServiceByNameExists(ctx, serviceName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+			},
+		)
+	}
+}
+
+func Test_ListServiceNames(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const serviceName1 = "service1"
+				const serviceName2 = "service2"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				_, err = namespace.CreateService(ctx, &kubernetesparameteroptions.CreateServiceOptions{
+					Name:  serviceName1,
+					Ports: []kubernetesparameteroptions.ServicePort{{Port: 80}},
+				})
+				require.NoError(t, err)
+				_, err = namespace.CreateService(ctx, &kubernetesparameteroptions.CreateServiceOptions{
+					Name:  serviceName2,
+					Ports: []kubernetesparameteroptions.ServicePort{{Port: 80}},
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListServiceNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, serviceName1)
+				require.Contains(t, names, serviceName2)
+
+				err = namespace.DeleteServiceByName(ctx, serviceName1)
+				require.NoError(t, err)
+				err = namespace.DeleteServiceByName(ctx, serviceName2)
+				require.NoError(t, err)
+
+				names, err = namespace.ListServiceNames(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, names, serviceName1)
+				require.NotContains(t, names, serviceName2)
+			},
+		)
+	}
+}
+
+func Test_ServiceWaitUntilReady(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const serviceName = "servicewaitready"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteServiceByName(ctx, serviceName)
+				require.NoError(t, err)
+
+				// The service needs ready pods to get an endpoint:
+				const statefulSetName = "servicebackend"
+				_, err = namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+					Name:        statefulSetName,
+					ImageName:   "busybox",
+					Command:     []string{"sleep", "3600"},
+					ServiceName: serviceName,
+				})
+				require.NoError(t, err)
+
+				service, err := namespace.CreateService(ctx, &kubernetesparameteroptions.CreateServiceOptions{
+					Name:     serviceName,
+					Selector: map[string]string{"app": statefulSetName},
+					Ports:    []kubernetesparameteroptions.ServicePort{{Port: 80}},
+				})
+				require.NoError(t, err)
+
+				err = service.WaitUntilReady(ctx, 2*time.Minute)
+				require.NoError(t, err)
+
+				err = namespace.DeleteServiceByName(ctx, serviceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,163 @@
+package kubernetesutils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/testutils"
+)
+
+func Test_StatefulSetByNameExists(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const statefulSetName = "statefulsetname"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+				require.NoError(t, err)
+
+				exists, err := namespace.StatefulSetByNameExists(ctx, statefulSetName)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				for i := 0; i < 2; i++ {
+					statefulSet, err := namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+						Name:      statefulSetName,
+						ImageName: "busybox",
+						Command:   []string{"sleep", "3600"},
+					})
+					require.NoError(t, err)
+
+					exists, err = statefulSet.Exists(ctx)
+					require.NoError(t, err)
+					require.True(t, exists)
+				}
+
+				exists, err = namespace.StatefulSetByNameExists(ctx, statefulSetName)
+				require.NoError(t, err)
+				require.True(t, exists)
+
+				for i := 0; i < 2; i++ {
+					err = namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+					require.NoError(t, err)
+
+					exists, err := namespace.StatefulSetByNameExists(ctx, statefulSetName)
+					require.NoError(t, err)
+					require.False(t, exists)
+				}
+			},
+		)
+	}
+}
+
+func Test_ListStatefulSetNames(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const statefulSetName1 = "statefulset1"
+				const statefulSetName2 = "statefulset2"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				_, err = namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+					Name:      statefulSetName1,
+					ImageName: "busybox",
+					Command:   []string{"sleep", "3600"},
+				})
+				require.NoError(t, err)
+				_, err = namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+					Name:      statefulSetName2,
+					ImageName: "busybox",
+					Command:   []string{"sleep", "3600"},
+				})
+				require.NoError(t, err)
+
+				names, err := namespace.ListStatefulSetNames(ctx)
+				require.NoError(t, err)
+				require.Contains(t, names, statefulSetName1)
+				require.Contains(t, names, statefulSetName2)
+
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName1)
+				require.NoError(t, err)
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName2)
+				require.NoError(t, err)
+
+				names, err = namespace.ListStatefulSetNames(ctx)
+				require.NoError(t, err)
+				require.NotContains(t, names, statefulSetName1)
+				require.NotContains(t, names, statefulSetName2)
+			},
+		)
+	}
+}
+
+func Test_StatefulSetWaitUntilReady(t *testing.T) {
+	tests := []struct {
+		implementationName string
+	}{
+		{"nativeKubernetes"},
+		{"commandExecutorKubernetes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			testutils.MustFormatAsTestname(tt),
+			func(t *testing.T) {
+				ctx := getCtx()
+				const namespaceName = "testnamespace"
+				const statefulSetName = "statefulsetwaitready"
+
+				kubernetes := getKubernetesByImplementationName(getCtx(), t, tt.implementationName)
+
+				namespace, err := kubernetes.CreateNamespaceByName(ctx, namespaceName)
+				require.NoError(t, err)
+
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+				require.NoError(t, err)
+
+				statefulSet, err := namespace.CreateStatefulSet(ctx, &kubernetesparameteroptions.CreateStatefulSetOptions{
+					Name:      statefulSetName,
+					ImageName: "busybox",
+					Command:   []string{"sleep", "3600"},
+				})
+				require.NoError(t, err)
+
+				err = statefulSet.WaitUntilReady(ctx, 2*time.Minute)
+				require.NoError(t, err)
+
+				err = namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+				require.NoError(t, err)
+			},
+		)
+	}
+}
@@ -742,6 +742,61 @@ func (c *CommandExecutorNamespace) ListSecretNames(ctx context.Context) ([]strin
 	return names, err
 }
 
+func (c *CommandExecutorNamespace) ListSecretNamesByType(ctx context.Context, secretType string) ([]string, error) {
+	if secretType == "" {
+		return nil, tracederrors.TracedErrorEmptyString("secretType")
+	}
+
+	contextName, err := c.GetCachedKubectlContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "List names of secrets of type '%s' in namespace '%s' of kubernetes '%s' started.", secretType, namespaceName, contextName)
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		ctx,
+		&parameteroptions.RunCommandOptions{
+			Command: []string{
+				"kubectl",
+				"--context",
+				contextName,
+				"--namespace",
+				namespaceName,
+				"get",
+				"secrets",
+				"--field-selector",
+				"type=" + secretType,
+				"-o",
+				"name",
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, l := range lines {
+		if !strings.HasPrefix(l, "secret/") {
+			continue
+		}
+
+		names = append(names, strings.TrimPrefix(l, "secret/"))
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "List names of secrets of type '%s' in namespace '%s' of kubernetes '%s' finished.", secretType, namespaceName, contextName)
+
+	return names, nil
+}
+
 func (c *CommandExecutorNamespace) GetSecretByName(name string) (secret kubernetesinterfaces.Secret, err error) {
 	if name == "" {
 		return nil, tracederrors.TracedErrorEmptyString("name")
@@ -2229,12 +2284,22 @@ func (c *CommandExecutorNamespace) CreateRoleBinding(ctx context.Context, create
 			return nil, err
 		}
 
+		roleRefKind, err := createOptions.GetRoleRefKindOrDefault()
+		if err != nil {
+			return nil, err
+		}
+
 		command := []string{
 			"kubectl",
 			"--context", contextName,
 			"--namespace", namespaceName,
 			"create", "rolebinding", bindingName,
-			"--role=" + roleRef,
+		}
+
+		if roleRefKind == "ClusterRole" {
+			command = append(command, "--clusterrole="+roleRef)
+		} else {
+			command = append(command, "--role="+roleRef)
 		}
 
 		for _, subject := range subjects {
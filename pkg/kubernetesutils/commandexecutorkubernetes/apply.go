@@ -0,0 +1,466 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes"
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/yamlutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Returns the kubectl base command including the explicit '--context' unless in cluster authentication is used.
+func (c *CommandExecutorKubernetes) getKubectlCommand(ctx context.Context) ([]string, error) {
+	cmd := []string{"kubectl"}
+
+	if kubernetesutils.IsInClusterAuthenticationAvailable(ctx) {
+		logging.LogInfoByCtxf(ctx, "Kubernetes in cluster authentication is used. cluster context is not used.")
+	} else {
+		kubectlContext, err := c.GetCachedKubectlContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cmd = append(cmd, "--context", kubectlContext)
+	}
+
+	return cmd, nil
+}
+
+// Returns the kinds which are not namespaced like 'Namespace' or 'ClusterRole'.
+func (c *CommandExecutorKubernetes) listClusterScopedKinds(ctx context.Context) ([]string, error) {
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd = append(cmd, "api-resources", "--namespaced=false", "--no-headers")
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := []string{}
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		// The kind is always the last column. The optional SHORTNAMES column makes the other column indices unreliable.
+		kinds = append(kinds, fields[len(fields)-1])
+	}
+
+	return kinds, nil
+}
+
+// Resources kubectl prunes by default when '--prune' is used without '--prune-allowlist'.
+var kubectlDefaultPruneResources = []string{
+	"configmaps",
+	"endpoints",
+	"namespaces",
+	"persistentvolumeclaims",
+	"persistentvolumes",
+	"pods",
+	"replicationcontrollers",
+	"secrets",
+	"services",
+	"jobs.batch",
+	"cronjobs.batch",
+	"ingresses.networking.k8s.io",
+	"daemonsets.apps",
+	"deployments.apps",
+	"replicasets.apps",
+	"statefulsets.apps",
+}
+
+// Returns the objects matching labelSelector which kubectl considers for pruning.
+// 'namespaceName' is optional. If empty the objects of all namespaces are returned.
+//
+// kubectl reports pruned objects only as '<kind>[.<group>]/<name>'. The returned references are used to resolve their namespaces.
+func (c *CommandExecutorKubernetes) listPruneCandidates(ctx context.Context, namespaceName string, labelSelector string) ([]*kubernetesimplementationindependend.ObjectReference, error) {
+	if labelSelector == "" {
+		return nil, tracederrors.TracedErrorEmptyString("labelSelector")
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespaceName == "" {
+		cmd = append(cmd, "get", strings.Join(kubectlDefaultPruneResources, ","), "--all-namespaces")
+	} else {
+		cmd = append(cmd, "--namespace", namespaceName, "get", strings.Join(kubectlDefaultPruneResources, ","))
+	}
+
+	cmd = append(
+		cmd,
+		"--selector", labelSelector,
+		"--no-headers",
+		"-o", "custom-columns=APIVERSION:.apiVersion,KIND:.kind,NAMESPACE:.metadata.namespace,NAME:.metadata.name",
+	)
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*kubernetesimplementationindependend.ObjectReference{}
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			continue
+		}
+
+		namespace := fields[2]
+		if namespace == "<none>" {
+			// Cluster scoped object like a namespace:
+			namespace = ""
+		}
+
+		candidates = append(candidates, &kubernetesimplementationindependend.ObjectReference{
+			ApiVersion: fields[0],
+			Kind:       fields[1],
+			Namespace:  namespace,
+			Name:       fields[3],
+		})
+	}
+
+	return candidates, nil
+}
+
+// Returns the reference of the object kubectl reported as '<kind>[.<group>]/<name> pruned'.
+// The namespace is resolved using the candidates listed by listPruneCandidates before applying. Matched candidates are removed.
+func getPrunedObjectReference(kindAndGroup string, name string, candidates *[]*kubernetesimplementationindependend.ObjectReference, appliedObjects []*kubernetesimplementationindependend.ObjectReference, namespaceName string) *kubernetesimplementationindependend.ObjectReference {
+	kind := strings.SplitN(kindAndGroup, ".", 2)[0]
+
+	isApplied := func(candidate *kubernetesimplementationindependend.ObjectReference) bool {
+		for _, applied := range appliedObjects {
+			if applied.Kind == candidate.Kind && applied.Namespace == candidate.Namespace && applied.Name == candidate.Name {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, candidate := range *candidates {
+		if strings.ToLower(candidate.Kind) != kind || candidate.Name != name || isApplied(candidate) {
+			continue
+		}
+
+		*candidates = slices.Delete(*candidates, i, i+1)
+		return candidate
+	}
+
+	return &kubernetesimplementationindependend.ObjectReference{
+		Kind:      kind,
+		Namespace: namespaceName,
+		Name:      name,
+	}
+}
+
+func (c *CommandExecutorKubernetes) ApplyManifests(ctx context.Context, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	return c.applyManifests(ctx, "", options)
+}
+
+// Applies the manifests using `kubectl apply --server-side`.
+//
+// 'namespaceName' is optional. If set all namespaced objects are applied into this namespace and objects
+// specifying another namespace are rejected. Otherwise namespaced objects without a namespace are applied to the "default" namespace.
+func (c *CommandExecutorKubernetes) applyManifests(ctx context.Context, namespaceName string, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	err := options.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := options.GetManifestsYamlString()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := kubernetesimplementationindependend.UnmarshalObjectYaml(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, tracederrors.TracedError("No objects found in manifests")
+	}
+
+	kubernetesimplementationindependend.SortObjectYamlEntriesForApply(entries)
+
+	fieldManager := options.GetFieldManagerOrDefault()
+
+	logging.LogInfoByCtxf(ctx, "Apply '%d' objects using field manager '%s' in kubernetes cluster '%s' started.", len(entries), fieldManager, clusterName)
+
+	clusterScopedKinds, err := c.listClusterScopedKinds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultNamespace := namespaceName
+	if defaultNamespace == "" {
+		defaultNamespace = "default"
+	}
+
+	result := &kubernetesimplementationindependend.ApplyManifestsResult{}
+	declaredNamespaces := []string{}
+	namespacesToEnsure := []string{}
+
+	for _, entry := range entries {
+		err = entry.AddLabels(options.PruneLabels)
+		if err != nil {
+			return nil, err
+		}
+
+		kind := entry.Kind()
+		if kind == "Namespace" {
+			declaredNamespaces = append(declaredNamespaces, entry.Name())
+		}
+
+		if slices.Contains(clusterScopedKinds, kind) {
+			result.AppliedObjects = append(result.AppliedObjects, entry.GetObjectReference(""))
+			continue
+		}
+
+		objectNamespace := entry.Namespace()
+		if objectNamespace == "" {
+			objectNamespace = defaultNamespace
+
+			entry.Content, err = yamlutils.RunYqQueryAginstYamlStringAsString(entry.Content, ".metadata.namespace=\""+objectNamespace+"\"")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if namespaceName != "" && objectNamespace != namespaceName {
+			return nil, tracederrors.TracedErrorf(
+				"Object '%s/%s' specifies namespace '%s' but is applied to namespace '%s' in kubernetes cluster '%s'.",
+				kind, entry.Name(), objectNamespace, namespaceName, clusterName,
+			)
+		}
+
+		if !slices.Contains(namespacesToEnsure, objectNamespace) {
+			namespacesToEnsure = append(namespacesToEnsure, objectNamespace)
+		}
+
+		result.AppliedObjects = append(result.AppliedObjects, entry.GetObjectReference(objectNamespace))
+	}
+
+	if options.SkipNamespaceCreation {
+		logging.LogInfoByCtxf(ctx, "Skip ensure namespaces exist when applying manifests in kubernetes cluster '%s'.", clusterName)
+	} else {
+		for _, n := range namespacesToEnsure {
+			if slices.Contains(declaredNamespaces, n) {
+				continue
+			}
+
+			_, err = c.CreateNamespaceByName(ctx, n)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	yamlString, err := kubernetesimplementationindependend.MarshalObjectYaml(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespaceName != "" {
+		cmd = append(cmd, "--namespace", namespaceName)
+	}
+
+	cmd = append(cmd, "apply", "--server-side", "--field-manager", fieldManager)
+
+	if options.ForceConflicts {
+		cmd = append(cmd, "--force-conflicts")
+	}
+
+	pruneCandidates := []*kubernetesimplementationindependend.ObjectReference{}
+	if options.Prune {
+		labelSelector, err := options.GetPruneLabelSelector()
+		if err != nil {
+			return nil, err
+		}
+
+		pruneCandidates, err = c.listPruneCandidates(ctx, namespaceName, labelSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		cmd = append(cmd, "--prune", "--selector", labelSelector)
+	}
+
+	cmd = append(cmd, "-f", "-")
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		ctx,
+		&parameteroptions.RunCommandOptions{
+			Command:     cmd,
+			StdinString: yamlString,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		// kubectl reports objects as '<resource>[.<group>]/<name> <action>'.
+		resourceAndName := strings.SplitN(fields[0], "/", 2)
+		if len(resourceAndName) != 2 {
+			continue
+		}
+
+		action := fields[1]
+		if action == "pruned" {
+			ref := getPrunedObjectReference(resourceAndName[0], resourceAndName[1], &pruneCandidates, result.AppliedObjects, namespaceName)
+
+			logging.LogChangedByCtxf(ctx, "Pruned %s in kubernetes cluster '%s'.", ref, clusterName)
+			result.PrunedObjects = append(result.PrunedObjects, ref)
+		} else {
+			logging.LogChangedByCtxf(ctx, "Applied '%s' in kubernetes cluster '%s': %s", fields[0], clusterName, action)
+		}
+	}
+
+	if options.WaitForReadiness {
+		for _, ref := range result.AppliedObjects {
+			err = c.waitForObjectReady(ctx, ref, options)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Apply '%d' objects using field manager '%s' in kubernetes cluster '%s' finished.", len(entries), fieldManager, clusterName)
+
+	return result, nil
+}
+
+func (c *CommandExecutorKubernetes) waitForObjectReady(ctx context.Context, ref *kubernetesimplementationindependend.ObjectReference, options *kubernetesparameteroptions.ApplyManifestsOptions) error {
+	if ref == nil {
+		return tracederrors.TracedErrorNil("ref")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	clusterName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	if !kubernetesimplementationindependend.IsReadinessCheckSupportedForKind(ref.Kind) {
+		logging.LogInfoByCtxf(ctx, "No readiness check for kind '%s' available. Skip waiting for %s in kubernetes cluster '%s'.", ref.Kind, ref, clusterName)
+		return nil
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ref.IsNamespaced() {
+		cmd = append(cmd, "--namespace", ref.Namespace)
+	}
+
+	objectName := strings.ToLower(ref.Kind) + "/" + ref.Name
+	timeout := fmt.Sprintf("--timeout=%ds", int(options.GetWaitTimeoutOrDefault().Seconds()))
+
+	switch ref.Kind {
+	case "Deployment", "StatefulSet", "DaemonSet":
+		cmd = append(cmd, "rollout", "status", objectName, timeout)
+	case "Job":
+		cmd = append(cmd, "wait", "--for=condition=Complete", objectName, timeout)
+	case "Pod":
+		cmd = append(cmd, "wait", "--for=condition=Ready", objectName, timeout)
+	case "CustomResourceDefinition":
+		cmd = append(cmd, "wait", "--for=condition=Established", objectName, timeout)
+	default:
+		return tracederrors.TracedErrorf("No readiness check implemented for kind '%s'", ref.Kind)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait for %s in kubernetes cluster '%s' to be ready started.", ref, clusterName)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed waiting for %s in kubernetes cluster '%s' to be ready: %w", ref, clusterName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait for %s in kubernetes cluster '%s' to be ready finished.", ref, clusterName)
+
+	return nil
+}
+
+func (c *CommandExecutorNamespace) ApplyManifests(ctx context.Context, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	kubernetes, err := c.GetKubernetesCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	commandExecutorKubernetes, ok := kubernetes.(*CommandExecutorKubernetes)
+	if !ok {
+		typeName, err := datatypes.GetTypeName(kubernetes)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, tracederrors.TracedErrorf(
+			"Unable to apply manifests. unexpected kubernetes type '%s'",
+			typeName,
+		)
+	}
+
+	return commandExecutorKubernetes.applyManifests(ctx, namespaceName, options)
+}
@@ -0,0 +1,178 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CommandExecutorDaemonSet struct {
+	name      string
+	namespace kubernetesinterfaces.Namespace
+}
+
+func (c *CommandExecutorDaemonSet) GetName() (string, error) {
+	if c.name == "" {
+		return "", tracederrors.TracedError("name not set")
+	}
+	return c.name, nil
+}
+
+func (c *CommandExecutorDaemonSet) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	return c.namespace, nil
+}
+
+func (c *CommandExecutorDaemonSet) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+	return namespace, nil
+}
+
+func (c *CommandExecutorDaemonSet) Exists(ctx context.Context) (bool, error) {
+	daemonSetName, err := c.GetName()
+	if err != nil {
+		return false, err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return false, err
+	}
+	return namespace.DaemonSetByNameExists(ctx, daemonSetName)
+}
+
+func (c *CommandExecutorDaemonSet) Delete(ctx context.Context) error {
+	daemonSetName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.DeleteDaemonSetByName(ctx, daemonSetName)
+}
+
+func (c *CommandExecutorDaemonSet) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	daemonSetName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.waitUntilDaemonSetReady(ctx, daemonSetName, timeout)
+}
+
+func (c *CommandExecutorNamespace) CreateDaemonSet(ctx context.Context, options *kubernetesparameteroptions.CreateDaemonSetOptions) (kubernetesinterfaces.DaemonSet, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	daemonSetName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	imageName, err := options.GetImageName()
+	if err != nil {
+		return nil, err
+	}
+
+	container := map[string]interface{}{
+		"name":  daemonSetName,
+		"image": imageName,
+	}
+	if len(options.Command) > 0 {
+		container["command"] = options.Command
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "DaemonSet",
+		"metadata": map[string]interface{}{
+			"name":   daemonSetName,
+			"labels": options.GetLabels(),
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					"app": daemonSetName,
+				},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"app": daemonSetName,
+					},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{container},
+				},
+			},
+		},
+	}
+
+	err = c.createNamespacedObject(ctx, "DaemonSet", "daemonsets.apps", daemonSetName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetDaemonSetByName(daemonSetName)
+}
+
+func (c *CommandExecutorNamespace) GetDaemonSetByName(name string) (kubernetesinterfaces.DaemonSet, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &CommandExecutorDaemonSet{
+		name:      name,
+		namespace: c,
+	}, nil
+}
+
+func (c *CommandExecutorNamespace) DaemonSetByNameExists(ctx context.Context, daemonSetName string) (bool, error) {
+	if daemonSetName == "" {
+		return false, tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	return c.namespacedObjectExists(ctx, "DaemonSet", "daemonsets.apps", daemonSetName)
+}
+
+func (c *CommandExecutorNamespace) DeleteDaemonSetByName(ctx context.Context, daemonSetName string) error {
+	if daemonSetName == "" {
+		return tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	return c.deleteNamespacedObject(ctx, "DaemonSet", "daemonsets.apps", daemonSetName)
+}
+
+func (c *CommandExecutorNamespace) ListDaemonSetNames(ctx context.Context) ([]string, error) {
+	return c.listNamespacedObjectNames(ctx, "daemonsets.apps")
+}
+
+// Waits until the pods of the DaemonSet on all scheduled nodes are updated and ready.
+func (c *CommandExecutorNamespace) waitUntilDaemonSetReady(ctx context.Context, daemonSetName string, timeout time.Duration) error {
+	if daemonSetName == "" {
+		return tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	description, err := c.getObjectDescription(ctx, "DaemonSet", daemonSetName)
+	if err != nil {
+		return err
+	}
+
+	return c.waitForRolloutStatus(ctx, description+" is ready", "daemonset/"+daemonSetName, timeout)
+}
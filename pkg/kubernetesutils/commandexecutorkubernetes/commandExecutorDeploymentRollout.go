@@ -0,0 +1,353 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (c *CommandExecutorDeployment) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+
+	return namespace, nil
+}
+
+// Returns the CommandExecutorNamespace, the deployment name and the description used for logging.
+func (c *CommandExecutorDeployment) getNamespaceNameAndDescription(ctx context.Context) (*CommandExecutorNamespace, string, string, error) {
+	deploymentName, err := c.GetName()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	description, err := namespace.getObjectDescription(ctx, "Deployment", deploymentName)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return namespace, deploymentName, description, nil
+}
+
+// Runs 'kubectl <args>' in the namespace of the deployment and returns stdout.
+func (c *CommandExecutorDeployment) runKubectl(ctx context.Context, args ...string) (string, error) {
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return "", err
+	}
+
+	cmd, err := namespace.getKubectlCommand(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	cmd = append(cmd, args...)
+
+	output, err := namespace.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return output.GetStdoutAsString()
+}
+
+func (c *CommandExecutorDeployment) RolloutRestart(ctx context.Context) error {
+	_, deploymentName, description, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.runKubectl(ctx, "rollout", "restart", "deployment/"+deploymentName)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to restart %s: %w", description, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Rollout restart of %s triggered.", description)
+
+	return nil
+}
+
+func (c *CommandExecutorDeployment) Scale(ctx context.Context, replicas int32) error {
+	if replicas < 0 {
+		return tracederrors.TracedErrorf("Invalid number of replicas '%d'.", replicas)
+	}
+
+	namespace, deploymentName, description, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return err
+	}
+
+	currentReplicas, err := namespace.getJsonPath(ctx, []string{"deployment", deploymentName}, "{.spec.replicas}")
+	if err != nil {
+		return err
+	}
+
+	if currentReplicas == strconv.Itoa(int(replicas)) {
+		logging.LogInfoByCtxf(ctx, "%s is already scaled to '%d' replicas.", description, replicas)
+		return nil
+	}
+
+	_, err = c.runKubectl(ctx, "scale", "deployment/"+deploymentName, fmt.Sprintf("--replicas=%d", replicas))
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to scale %s: %w", description, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "%s scaled to '%d' replicas.", description, replicas)
+
+	return nil
+}
+
+func (c *CommandExecutorDeployment) SetContainerImage(ctx context.Context, containerName string, imageName string) error {
+	if containerName == "" {
+		return tracederrors.TracedErrorEmptyString("containerName")
+	}
+
+	if imageName == "" {
+		return tracederrors.TracedErrorEmptyString("imageName")
+	}
+
+	namespace, deploymentName, description, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return err
+	}
+
+	containerNames, err := namespace.getJsonPath(ctx, []string{"deployment", deploymentName}, "{.spec.template.spec.containers[*].name}")
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, name := range strings.Fields(containerNames) {
+		if name == containerName {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return tracederrors.TracedErrorf("%s has no container '%s'. Available containers: '%v'", description, containerName, strings.Fields(containerNames))
+	}
+
+	currentImage, err := namespace.getJsonPath(
+		ctx,
+		[]string{"deployment", deploymentName},
+		fmt.Sprintf("{.spec.template.spec.containers[?(@.name==\"%s\")].image}", containerName),
+	)
+	if err != nil {
+		return err
+	}
+
+	if currentImage == imageName {
+		logging.LogInfoByCtxf(ctx, "Container '%s' of %s already uses image '%s'.", containerName, description, imageName)
+		return nil
+	}
+
+	_, err = c.runKubectl(ctx, "set", "image", "deployment/"+deploymentName, containerName+"="+imageName)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to update image of container '%s' of %s: %w", containerName, description, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Image of container '%s' of %s set to '%s'.", containerName, description, imageName)
+
+	return nil
+}
+
+// Returns the rollout status of the deployment using a single 'kubectl get' call.
+func (c *CommandExecutorDeployment) getRolloutStatus(ctx context.Context) (*kubernetesimplementationindependend.DeploymentRolloutStatus, error) {
+	namespace, deploymentName, _, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldPaths := []string{
+		".metadata.generation",
+		".status.observedGeneration",
+		".spec.replicas",
+		".status.replicas",
+		".status.updatedReplicas",
+		".status.availableReplicas",
+		".status.conditions[?(@.type==\"Progressing\")].reason",
+	}
+
+	jsonPath := ""
+	for i, f := range fieldPaths {
+		if i > 0 {
+			jsonPath += "{\"|\"}"
+		}
+		jsonPath += "{" + f + "}"
+	}
+
+	output, err := namespace.getJsonPath(ctx, []string{"deployment", deploymentName}, jsonPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(output, "|")
+	if len(fields) != len(fieldPaths) {
+		return nil, tracederrors.TracedErrorf("Unexpected rollout status output '%s' for deployment '%s'.", output, deploymentName)
+	}
+
+	// Fields not set yet by the controller are returned as empty strings:
+	numbers := make([]int64, len(fieldPaths)-1)
+	for i := range numbers {
+		if fields[i] == "" {
+			continue
+		}
+
+		numbers[i], err = strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Unable to parse '%s' of deployment '%s' as number: %w", fieldPaths[i], deploymentName, err)
+		}
+	}
+
+	return &kubernetesimplementationindependend.DeploymentRolloutStatus{
+		DeploymentName:     deploymentName,
+		Generation:         numbers[0],
+		ObservedGeneration: numbers[1],
+		DesiredReplicas:    int32(numbers[2]),
+		Replicas:           int32(numbers[3]),
+		UpdatedReplicas:    int32(numbers[4]),
+		AvailableReplicas:  int32(numbers[5]),
+		ProgressingReason:  fields[6],
+	}, nil
+}
+
+func (c *CommandExecutorDeployment) WaitUntilRolloutComplete(ctx context.Context, timeout time.Duration) error {
+	namespace, _, description, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastMessage := ""
+	return namespace.pollUntil(
+		ctx,
+		"rollout of "+description+" is complete",
+		timeout,
+		func(ctx context.Context) (bool, error) {
+			status, err := c.getRolloutStatus(ctx)
+			if err != nil {
+				return false, err
+			}
+
+			done, message, err := status.Evaluate()
+			if err != nil {
+				return false, err
+			}
+
+			if message != lastMessage {
+				logging.LogInfoByCtxf(ctx, "%s", message)
+				lastMessage = message
+			}
+
+			return done, nil
+		},
+	)
+}
+
+func (c *CommandExecutorDeployment) ListRevisions(ctx context.Context) ([]*kubernetesimplementationindependend.DeploymentRevision, error) {
+	namespace, deploymentName, description, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := namespace.getJsonPath(
+		ctx,
+		[]string{"replicasets"},
+		"{range .items[*]}"+
+			"{.metadata.ownerReferences[0].kind}{\"\\t\"}"+
+			"{.metadata.ownerReferences[0].name}{\"\\t\"}"+
+			"{.metadata.name}{\"\\t\"}"+
+			"{.metadata.annotations.deployment\\.kubernetes\\.io/revision}{\"\\t\"}"+
+			"{.metadata.annotations.kubernetes\\.io/change-cause}{\"\\t\"}"+
+			// Images are never empty and therefore last since surrounding whitespace is trimmed from the output:
+			"{.spec.template.spec.containers[*].image}{\"\\n\"}"+
+			"{end}",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []*kubernetesimplementationindependend.DeploymentRevision{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(line, "\t", 6)
+		if len(fields) != 6 {
+			continue
+		}
+
+		if fields[0] != "Deployment" || fields[1] != deploymentName || fields[3] == "" {
+			continue
+		}
+
+		revision, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Invalid revision '%s' of ReplicaSet '%s': %w", fields[3], fields[2], err)
+		}
+
+		revisions = append(revisions, &kubernetesimplementationindependend.DeploymentRevision{
+			Revision:       revision,
+			ReplicaSetName: fields[2],
+			ChangeCause:    fields[4],
+			Images:         strings.Fields(fields[5]),
+		})
+	}
+
+	kubernetesimplementationindependend.SortDeploymentRevisions(revisions)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' revisions of %s.", len(revisions), description)
+
+	return revisions, nil
+}
+
+func (c *CommandExecutorDeployment) Rollback(ctx context.Context, toRevision int64) error {
+	_, deploymentName, description, err := c.getNamespaceNameAndDescription(ctx)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := c.ListRevisions(contextutils.WithSilent(ctx))
+	if err != nil {
+		return err
+	}
+
+	target, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions, toRevision)
+	if err != nil {
+		return tracederrors.TracedErrorf("Unable to roll back %s: %w", description, err)
+	}
+
+	stdout, err := c.runKubectl(ctx, "rollout", "undo", "deployment/"+deploymentName, fmt.Sprintf("--to-revision=%d", target.Revision))
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to roll back %s to revision '%d': %w", description, target.Revision, err)
+	}
+
+	if strings.Contains(stdout, "skipped rollback") {
+		logging.LogInfoByCtxf(ctx, "%s already matches revision '%d'. Skip rollback.", description, target.Revision)
+		return nil
+	}
+
+	logging.LogChangedByCtxf(ctx, "%s rolled back to revision '%d'.", description, target.Revision)
+
+	return nil
+}
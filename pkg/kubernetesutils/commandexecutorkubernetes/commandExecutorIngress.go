@@ -0,0 +1,195 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CommandExecutorIngress struct {
+	name      string
+	namespace kubernetesinterfaces.Namespace
+}
+
+func (c *CommandExecutorIngress) GetName() (string, error) {
+	if c.name == "" {
+		return "", tracederrors.TracedError("name not set")
+	}
+	return c.name, nil
+}
+
+func (c *CommandExecutorIngress) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	return c.namespace, nil
+}
+
+func (c *CommandExecutorIngress) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+	return namespace, nil
+}
+
+func (c *CommandExecutorIngress) Exists(ctx context.Context) (bool, error) {
+	ingressName, err := c.GetName()
+	if err != nil {
+		return false, err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return false, err
+	}
+	return namespace.IngressByNameExists(ctx, ingressName)
+}
+
+func (c *CommandExecutorIngress) Delete(ctx context.Context) error {
+	ingressName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.DeleteIngressByName(ctx, ingressName)
+}
+
+func (c *CommandExecutorIngress) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	ingressName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.waitUntilIngressAddressAssigned(ctx, ingressName, timeout)
+}
+
+func (c *CommandExecutorNamespace) CreateIngress(ctx context.Context, options *kubernetesparameteroptions.CreateIngressOptions) (kubernetesinterfaces.Ingress, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	ingressName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName, err := options.GetServiceName()
+	if err != nil {
+		return nil, err
+	}
+
+	servicePort, err := options.GetServicePort()
+	if err != nil {
+		return nil, err
+	}
+
+	rule := map[string]interface{}{
+		"http": map[string]interface{}{
+			"paths": []interface{}{
+				map[string]interface{}{
+					"path":     options.GetPathOrDefault(),
+					"pathType": "Prefix",
+					"backend": map[string]interface{}{
+						"service": map[string]interface{}{
+							"name": serviceName,
+							"port": map[string]interface{}{
+								"number": servicePort,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if options.Host != "" {
+		rule["host"] = options.Host
+	}
+
+	spec := map[string]interface{}{
+		"rules": []interface{}{rule},
+	}
+	if options.IngressClassName != "" {
+		spec["ingressClassName"] = options.IngressClassName
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata": map[string]interface{}{
+			"name":   ingressName,
+			"labels": options.GetLabels(),
+		},
+		"spec": spec,
+	}
+
+	err = c.createNamespacedObject(ctx, "Ingress", "ingresses.networking.k8s.io", ingressName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetIngressByName(ingressName)
+}
+
+func (c *CommandExecutorNamespace) GetIngressByName(name string) (kubernetesinterfaces.Ingress, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &CommandExecutorIngress{
+		name:      name,
+		namespace: c,
+	}, nil
+}
+
+func (c *CommandExecutorNamespace) IngressByNameExists(ctx context.Context, ingressName string) (bool, error) {
+	if ingressName == "" {
+		return false, tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	return c.namespacedObjectExists(ctx, "Ingress", "ingresses.networking.k8s.io", ingressName)
+}
+
+func (c *CommandExecutorNamespace) DeleteIngressByName(ctx context.Context, ingressName string) error {
+	if ingressName == "" {
+		return tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	return c.deleteNamespacedObject(ctx, "Ingress", "ingresses.networking.k8s.io", ingressName)
+}
+
+func (c *CommandExecutorNamespace) ListIngressNames(ctx context.Context) ([]string, error) {
+	return c.listNamespacedObjectNames(ctx, "ingresses.networking.k8s.io")
+}
+
+// Waits until the ingress controller assigned a load balancer address to the Ingress.
+func (c *CommandExecutorNamespace) waitUntilIngressAddressAssigned(ctx context.Context, ingressName string, timeout time.Duration) error {
+	if ingressName == "" {
+		return tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	description, err := c.getObjectDescription(ctx, "Ingress", ingressName)
+	if err != nil {
+		return err
+	}
+
+	return c.pollUntil(ctx, description+" has an address assigned", timeout, func(ctx context.Context) (bool, error) {
+		loadBalancerIngress, err := c.getJsonPath(ctx, []string{"ingress", ingressName}, "{.status.loadBalancer.ingress}")
+		if err != nil {
+			return false, err
+		}
+
+		return loadBalancerIngress != "" && loadBalancerIngress != "[]", nil
+	})
+}
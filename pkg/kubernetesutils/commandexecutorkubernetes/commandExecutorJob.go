@@ -0,0 +1,194 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CommandExecutorJob struct {
+	name      string
+	namespace kubernetesinterfaces.Namespace
+}
+
+func (c *CommandExecutorJob) GetName() (string, error) {
+	if c.name == "" {
+		return "", tracederrors.TracedError("name not set")
+	}
+	return c.name, nil
+}
+
+func (c *CommandExecutorJob) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	return c.namespace, nil
+}
+
+func (c *CommandExecutorJob) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+	return namespace, nil
+}
+
+func (c *CommandExecutorJob) Exists(ctx context.Context) (bool, error) {
+	jobName, err := c.GetName()
+	if err != nil {
+		return false, err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return false, err
+	}
+	return namespace.JobByNameExists(ctx, jobName)
+}
+
+func (c *CommandExecutorJob) Delete(ctx context.Context) error {
+	jobName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.DeleteJobByName(ctx, jobName)
+}
+
+func (c *CommandExecutorJob) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	jobName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.waitUntilJobCompleted(ctx, jobName, timeout)
+}
+
+func (c *CommandExecutorNamespace) CreateJob(ctx context.Context, options *kubernetesparameteroptions.CreateJobOptions) (kubernetesinterfaces.Job, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	jobName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	imageName, err := options.GetImageName()
+	if err != nil {
+		return nil, err
+	}
+
+	backoffLimit, err := options.GetBackoffLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	container := map[string]interface{}{
+		"name":  jobName,
+		"image": imageName,
+	}
+	if len(options.Command) > 0 {
+		container["command"] = options.Command
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"name":   jobName,
+			"labels": options.GetLabels(),
+		},
+		"spec": map[string]interface{}{
+			"backoffLimit": backoffLimit,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers":    []interface{}{container},
+					"restartPolicy": "Never",
+				},
+			},
+		},
+	}
+
+	err = c.createNamespacedObject(ctx, "Job", "jobs.batch", jobName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetJobByName(jobName)
+}
+
+func (c *CommandExecutorNamespace) GetJobByName(name string) (kubernetesinterfaces.Job, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &CommandExecutorJob{
+		name:      name,
+		namespace: c,
+	}, nil
+}
+
+func (c *CommandExecutorNamespace) JobByNameExists(ctx context.Context, jobName string) (bool, error) {
+	if jobName == "" {
+		return false, tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	return c.namespacedObjectExists(ctx, "Job", "jobs.batch", jobName)
+}
+
+func (c *CommandExecutorNamespace) DeleteJobByName(ctx context.Context, jobName string) error {
+	if jobName == "" {
+		return tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	return c.deleteNamespacedObject(ctx, "Job", "jobs.batch", jobName)
+}
+
+func (c *CommandExecutorNamespace) ListJobNames(ctx context.Context) ([]string, error) {
+	return c.listNamespacedObjectNames(ctx, "jobs.batch")
+}
+
+// Waits until the Job completed successfully.
+// An error is returned as soon as the Job failed.
+func (c *CommandExecutorNamespace) waitUntilJobCompleted(ctx context.Context, jobName string, timeout time.Duration) error {
+	if jobName == "" {
+		return tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	description, err := c.getObjectDescription(ctx, "Job", jobName)
+	if err != nil {
+		return err
+	}
+
+	return c.pollUntil(ctx, description+" is completed", timeout, func(ctx context.Context) (bool, error) {
+		conditions, err := c.getJsonPath(
+			ctx,
+			[]string{"job", jobName},
+			"{.status.conditions[?(@.status==\"True\")].type}",
+		)
+		if err != nil {
+			return false, err
+		}
+
+		activeConditions := strings.Fields(conditions)
+		if slices.Contains(activeConditions, "Failed") {
+			return false, tracederrors.TracedErrorf("%s failed", description)
+		}
+
+		return slices.Contains(activeConditions, "Complete"), nil
+	})
+}
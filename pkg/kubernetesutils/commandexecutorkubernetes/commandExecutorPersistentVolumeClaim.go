@@ -0,0 +1,169 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CommandExecutorPersistentVolumeClaim struct {
+	name      string
+	namespace kubernetesinterfaces.Namespace
+}
+
+func (c *CommandExecutorPersistentVolumeClaim) GetName() (string, error) {
+	if c.name == "" {
+		return "", tracederrors.TracedError("name not set")
+	}
+	return c.name, nil
+}
+
+func (c *CommandExecutorPersistentVolumeClaim) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	return c.namespace, nil
+}
+
+func (c *CommandExecutorPersistentVolumeClaim) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+	return namespace, nil
+}
+
+func (c *CommandExecutorPersistentVolumeClaim) Exists(ctx context.Context) (bool, error) {
+	persistentVolumeClaimName, err := c.GetName()
+	if err != nil {
+		return false, err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return false, err
+	}
+	return namespace.PersistentVolumeClaimByNameExists(ctx, persistentVolumeClaimName)
+}
+
+func (c *CommandExecutorPersistentVolumeClaim) Delete(ctx context.Context) error {
+	persistentVolumeClaimName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.DeletePersistentVolumeClaimByName(ctx, persistentVolumeClaimName)
+}
+
+func (c *CommandExecutorPersistentVolumeClaim) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	persistentVolumeClaimName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.waitUntilPersistentVolumeClaimBound(ctx, persistentVolumeClaimName, timeout)
+}
+
+func (c *CommandExecutorNamespace) CreatePersistentVolumeClaim(ctx context.Context, options *kubernetesparameteroptions.CreatePersistentVolumeClaimOptions) (kubernetesinterfaces.PersistentVolumeClaim, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	persistentVolumeClaimName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]interface{}{
+		"accessModes": []interface{}{options.GetAccessModeOrDefault()},
+		"resources": map[string]interface{}{
+			"requests": map[string]interface{}{
+				"storage": options.GetStorageSizeOrDefault(),
+			},
+		},
+	}
+	if options.StorageClassName != "" {
+		spec["storageClassName"] = options.StorageClassName
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata": map[string]interface{}{
+			"name":   persistentVolumeClaimName,
+			"labels": options.GetLabels(),
+		},
+		"spec": spec,
+	}
+
+	err = c.createNamespacedObject(ctx, "PersistentVolumeClaim", "persistentvolumeclaims", persistentVolumeClaimName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetPersistentVolumeClaimByName(persistentVolumeClaimName)
+}
+
+func (c *CommandExecutorNamespace) GetPersistentVolumeClaimByName(name string) (kubernetesinterfaces.PersistentVolumeClaim, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &CommandExecutorPersistentVolumeClaim{
+		name:      name,
+		namespace: c,
+	}, nil
+}
+
+func (c *CommandExecutorNamespace) PersistentVolumeClaimByNameExists(ctx context.Context, persistentVolumeClaimName string) (bool, error) {
+	if persistentVolumeClaimName == "" {
+		return false, tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	return c.namespacedObjectExists(ctx, "PersistentVolumeClaim", "persistentvolumeclaims", persistentVolumeClaimName)
+}
+
+func (c *CommandExecutorNamespace) DeletePersistentVolumeClaimByName(ctx context.Context, persistentVolumeClaimName string) error {
+	if persistentVolumeClaimName == "" {
+		return tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	return c.deleteNamespacedObject(ctx, "PersistentVolumeClaim", "persistentvolumeclaims", persistentVolumeClaimName)
+}
+
+func (c *CommandExecutorNamespace) ListPersistentVolumeClaimNames(ctx context.Context) ([]string, error) {
+	return c.listNamespacedObjectNames(ctx, "persistentvolumeclaims")
+}
+
+// Waits until the PersistentVolumeClaim is bound to a volume.
+// Storage classes using 'WaitForFirstConsumer' only bind after a pod using the claim was scheduled.
+func (c *CommandExecutorNamespace) waitUntilPersistentVolumeClaimBound(ctx context.Context, persistentVolumeClaimName string, timeout time.Duration) error {
+	if persistentVolumeClaimName == "" {
+		return tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	description, err := c.getObjectDescription(ctx, "PersistentVolumeClaim", persistentVolumeClaimName)
+	if err != nil {
+		return err
+	}
+
+	return c.pollUntil(ctx, description+" is bound", timeout, func(ctx context.Context) (bool, error) {
+		phase, err := c.getJsonPath(ctx, []string{"persistentvolumeclaim", persistentVolumeClaimName}, "{.status.phase}")
+		if err != nil {
+			return false, err
+		}
+
+		return phase == "Bound", nil
+	})
+}
@@ -0,0 +1,188 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CommandExecutorService struct {
+	name      string
+	namespace kubernetesinterfaces.Namespace
+}
+
+func (c *CommandExecutorService) GetName() (string, error) {
+	if c.name == "" {
+		return "", tracederrors.TracedError("name not set")
+	}
+	return c.name, nil
+}
+
+func (c *CommandExecutorService) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	return c.namespace, nil
+}
+
+func (c *CommandExecutorService) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+	return namespace, nil
+}
+
+func (c *CommandExecutorService) Exists(ctx context.Context) (bool, error) {
+	serviceName, err := c.GetName()
+	if err != nil {
+		return false, err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return false, err
+	}
+	return namespace.ServiceByNameExists(ctx, serviceName)
+}
+
+func (c *CommandExecutorService) Delete(ctx context.Context) error {
+	serviceName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.DeleteServiceByName(ctx, serviceName)
+}
+
+func (c *CommandExecutorService) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	serviceName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.waitUntilServiceReady(ctx, serviceName, timeout)
+}
+
+func (c *CommandExecutorNamespace) CreateService(ctx context.Context, options *kubernetesparameteroptions.CreateServiceOptions) (kubernetesinterfaces.Service, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	serviceName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	servicePorts, err := options.GetPorts()
+	if err != nil {
+		return nil, err
+	}
+
+	ports := []interface{}{}
+	for _, p := range servicePorts {
+		port := map[string]interface{}{
+			"port":       p.Port,
+			"targetPort": p.GetTargetPortOrDefault(),
+			"protocol":   p.GetProtocolOrDefault(),
+		}
+		if p.Name != "" {
+			port["name"] = p.Name
+		}
+		ports = append(ports, port)
+	}
+
+	spec := map[string]interface{}{
+		"type":  options.GetTypeOrDefault(),
+		"ports": ports,
+	}
+	if len(options.GetSelector()) > 0 {
+		spec["selector"] = options.GetSelector()
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":   serviceName,
+			"labels": options.GetLabels(),
+		},
+		"spec": spec,
+	}
+
+	err = c.createNamespacedObject(ctx, "Service", "services", serviceName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetServiceByName(serviceName)
+}
+
+func (c *CommandExecutorNamespace) GetServiceByName(name string) (kubernetesinterfaces.Service, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &CommandExecutorService{
+		name:      name,
+		namespace: c,
+	}, nil
+}
+
+func (c *CommandExecutorNamespace) ServiceByNameExists(ctx context.Context, serviceName string) (bool, error) {
+	if serviceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	return c.namespacedObjectExists(ctx, "Service", "services", serviceName)
+}
+
+func (c *CommandExecutorNamespace) DeleteServiceByName(ctx context.Context, serviceName string) error {
+	if serviceName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	return c.deleteNamespacedObject(ctx, "Service", "services", serviceName)
+}
+
+func (c *CommandExecutorNamespace) ListServiceNames(ctx context.Context) ([]string, error) {
+	return c.listNamespacedObjectNames(ctx, "services")
+}
+
+// Waits until at least one ready endpoint is available for the service.
+func (c *CommandExecutorNamespace) waitUntilServiceReady(ctx context.Context, serviceName string, timeout time.Duration) error {
+	if serviceName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	description, err := c.getObjectDescription(ctx, "Service", serviceName)
+	if err != nil {
+		return err
+	}
+
+	return c.pollUntil(ctx, description+" has a ready endpoint", timeout, func(ctx context.Context) (bool, error) {
+		readyStates, err := c.getJsonPath(
+			ctx,
+			[]string{"endpointslices", "--selector", "kubernetes.io/service-name=" + serviceName},
+			"{.items[*].endpoints[*].conditions.ready}",
+		)
+		if err != nil {
+			return false, err
+		}
+
+		return slices.Contains(strings.Fields(readyStates), "true"), nil
+	})
+}
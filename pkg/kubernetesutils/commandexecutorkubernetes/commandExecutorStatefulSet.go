@@ -0,0 +1,185 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CommandExecutorStatefulSet struct {
+	name      string
+	namespace kubernetesinterfaces.Namespace
+}
+
+func (c *CommandExecutorStatefulSet) GetName() (string, error) {
+	if c.name == "" {
+		return "", tracederrors.TracedError("name not set")
+	}
+	return c.name, nil
+}
+
+func (c *CommandExecutorStatefulSet) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	return c.namespace, nil
+}
+
+func (c *CommandExecutorStatefulSet) GetCommandExecutorNamespace() (*CommandExecutorNamespace, error) {
+	if c.namespace == nil {
+		return nil, tracederrors.TracedError("namespace not set")
+	}
+	namespace, ok := c.namespace.(*CommandExecutorNamespace)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("namespace is not of type *CommandExecutorNamespace but '%T'", c.namespace)
+	}
+	return namespace, nil
+}
+
+func (c *CommandExecutorStatefulSet) Exists(ctx context.Context) (bool, error) {
+	statefulSetName, err := c.GetName()
+	if err != nil {
+		return false, err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return false, err
+	}
+	return namespace.StatefulSetByNameExists(ctx, statefulSetName)
+}
+
+func (c *CommandExecutorStatefulSet) Delete(ctx context.Context) error {
+	statefulSetName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+}
+
+func (c *CommandExecutorStatefulSet) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	statefulSetName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+	namespace, err := c.GetCommandExecutorNamespace()
+	if err != nil {
+		return err
+	}
+	return namespace.waitUntilStatefulSetReady(ctx, statefulSetName, timeout)
+}
+
+func (c *CommandExecutorNamespace) CreateStatefulSet(ctx context.Context, options *kubernetesparameteroptions.CreateStatefulSetOptions) (kubernetesinterfaces.StatefulSet, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	statefulSetName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	imageName, err := options.GetImageName()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName, err := options.GetServiceName()
+	if err != nil {
+		return nil, err
+	}
+
+	container := map[string]interface{}{
+		"name":  statefulSetName,
+		"image": imageName,
+	}
+	if len(options.Command) > 0 {
+		container["command"] = options.Command
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "StatefulSet",
+		"metadata": map[string]interface{}{
+			"name":   statefulSetName,
+			"labels": options.GetLabels(),
+		},
+		"spec": map[string]interface{}{
+			"replicas":    options.GetReplicas(),
+			"serviceName": serviceName,
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					"app": statefulSetName,
+				},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"app": statefulSetName,
+					},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{container},
+				},
+			},
+		},
+	}
+
+	err = c.createNamespacedObject(ctx, "StatefulSet", "statefulsets.apps", statefulSetName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetStatefulSetByName(statefulSetName)
+}
+
+func (c *CommandExecutorNamespace) GetStatefulSetByName(name string) (kubernetesinterfaces.StatefulSet, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &CommandExecutorStatefulSet{
+		name:      name,
+		namespace: c,
+	}, nil
+}
+
+func (c *CommandExecutorNamespace) StatefulSetByNameExists(ctx context.Context, statefulSetName string) (bool, error) {
+	if statefulSetName == "" {
+		return false, tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	return c.namespacedObjectExists(ctx, "StatefulSet", "statefulsets.apps", statefulSetName)
+}
+
+func (c *CommandExecutorNamespace) DeleteStatefulSetByName(ctx context.Context, statefulSetName string) error {
+	if statefulSetName == "" {
+		return tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	return c.deleteNamespacedObject(ctx, "StatefulSet", "statefulsets.apps", statefulSetName)
+}
+
+func (c *CommandExecutorNamespace) ListStatefulSetNames(ctx context.Context) ([]string, error) {
+	return c.listNamespacedObjectNames(ctx, "statefulsets.apps")
+}
+
+// Waits until all replicas of the StatefulSet are updated and ready.
+func (c *CommandExecutorNamespace) waitUntilStatefulSetReady(ctx context.Context, statefulSetName string, timeout time.Duration) error {
+	if statefulSetName == "" {
+		return tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	description, err := c.getObjectDescription(ctx, "StatefulSet", statefulSetName)
+	if err != nil {
+		return err
+	}
+
+	return c.waitForRolloutStatus(ctx, description+" is ready", "statefulset/"+statefulSetName, timeout)
+}
@@ -0,0 +1,104 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Returns the items of 'kubectl get <resource> -o json' in this namespace.
+func (c *CommandExecutorNamespace) listUnstructured(ctx context.Context, resource string) ([]map[string]interface{}, error) {
+	stdout, err := c.runKubectlAndGetStdout(ctx, "get", resource, "-o", "json")
+	if err != nil {
+		return nil, err
+	}
+
+	list := struct {
+		Items []map[string]interface{} `json:"items"`
+	}{}
+
+	err = json.Unmarshal([]byte(stdout), &list)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse '%s' list: %w", resource, err)
+	}
+
+	return list.Items, nil
+}
+
+// Returns the CPU and memory usage per pod as reported by the metrics-server.
+// Returns nil without an error if the metrics-server is not available. All other errors are returned.
+func (c *CommandExecutorNamespace) getResourceUsageByPodName(ctx context.Context) (map[string]*kubernetesimplementationindependend.ResourceUsage, error) {
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := c.runKubectlAndGetStdout(ctx, "get", "--raw", "/apis/metrics.k8s.io/v1beta1/namespaces/"+namespaceName+"/pods")
+	if err != nil {
+		// NotFound or ServiceUnavailable means the metrics-server is not installed or not running:
+		if strings.Contains(err.Error(), "(NotFound)") || strings.Contains(err.Error(), "(ServiceUnavailable)") {
+			logging.LogInfoByCtxf(ctx, "Pod metrics of namespace '%s' not available: %v", namespaceName, err)
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	podMetricsList := map[string]interface{}{}
+	err = json.Unmarshal([]byte(stdout), &podMetricsList)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse pod metrics of namespace '%s': %w", namespaceName, err)
+	}
+
+	return kubernetesimplementationindependend.GetResourceUsageByPodName(podMetricsList)
+}
+
+func (c *CommandExecutorNamespace) GetDiagnostics(ctx context.Context, options *kubernetesparameteroptions.NamespaceDiagnosticsOptions) (*kubernetesimplementationindependend.NamespaceDiagnostics, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collect diagnostics of namespace '%s' started.", namespaceName)
+
+	pods, err := c.listUnstructured(ctx, "pods")
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := c.listUnstructured(ctx, "events")
+	if err != nil {
+		return nil, err
+	}
+
+	persistentVolumeClaims, err := c.listUnstructured(ctx, "persistentvolumeclaims")
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceUsageByPodName map[string]*kubernetesimplementationindependend.ResourceUsage
+	if !options.SkipResourceUsage {
+		resourceUsageByPodName, err = c.getResourceUsageByPodName(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	diagnostics, err := kubernetesimplementationindependend.GetNamespaceDiagnostics(namespaceName, pods, events, persistentVolumeClaims, resourceUsageByPodName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collect diagnostics of namespace '%s' finished. Found '%d' unhealthy pods.", namespaceName, len(diagnostics.GetUnhealthyPodNames()))
+
+	return diagnostics, nil
+}
@@ -0,0 +1,260 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Pod name and the names of its containers.
+type podContainerNames struct {
+	podName        string
+	containerNames []string
+}
+
+// Opens the log stream of a single container using `kubectl logs`. The caller has to close the returned reader.
+func (c *CommandExecutorNamespace) streamContainerLogs(ctx context.Context, podName string, containerName string, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if podName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("podName")
+	}
+
+	if containerName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("containerName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd = append(cmd, "logs", podName, "--container", containerName)
+
+	if options.Follow {
+		cmd = append(cmd, "--follow")
+	}
+
+	if options.IsSinceSet() {
+		sinceSeconds, err := options.GetSinceSeconds()
+		if err != nil {
+			return nil, err
+		}
+
+		cmd = append(cmd, fmt.Sprintf("--since=%ds", sinceSeconds))
+	}
+
+	if options.IsTailLinesSet() {
+		tailLines, err := options.GetTailLines()
+		if err != nil {
+			return nil, err
+		}
+
+		cmd = append(cmd, fmt.Sprintf("--tail=%d", tailLines))
+	}
+
+	if options.Timestamps {
+		cmd = append(cmd, "--timestamps")
+	}
+
+	commandExecutor, err := c.GetCommandExecutor()
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := commandExecutor.RunCommandAndGetStdoutAsIoReadCloser(
+		ctx,
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+			// kubectl runs forever when following logs and has to be stopped when the stream is closed:
+			KillOnStdoutClose: true,
+		},
+	)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to open log stream of container '%s' in pod '%s' in namespace '%s': %w", containerName, podName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Opened log stream of container '%s' in pod '%s' in namespace '%s' (follow=%t).", containerName, podName, namespaceName, options.Follow)
+
+	return ret, nil
+}
+
+// Returns the pods and their container names. 'getArgs' selects the pods like ["pods", "--selector", "app=example"].
+func (c *CommandExecutorNamespace) listPodContainerNames(ctx context.Context, getArgs []string, options *kubernetesparameteroptions.StreamLogsOptions) ([]*podContainerNames, error) {
+	output, err := c.getJsonPath(
+		ctx,
+		getArgs,
+		`{range .items[*]}{.metadata.name}{" "}{.spec.containers[*].name}{"\n"}{end}`,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []*podContainerNames{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pod := &podContainerNames{podName: fields[0]}
+		for _, containerName := range fields[1:] {
+			if options.IsContainerNameSet() && containerName != options.ContainerName {
+				continue
+			}
+
+			pod.containerNames = append(pod.containerNames, containerName)
+		}
+
+		ret = append(ret, pod)
+	}
+
+	return ret, nil
+}
+
+func (c *CommandExecutorNamespace) openContainerLogStreams(ctx context.Context, pods []*podContainerNames, options *kubernetesparameteroptions.StreamLogsOptions) ([]*kubernetesimplementationindependend.ContainerLogStream, error) {
+	streams := []*kubernetesimplementationindependend.ContainerLogStream{}
+
+	closeStreams := func() {
+		for _, s := range streams {
+			s.Reader.Close()
+		}
+	}
+
+	for _, pod := range pods {
+		for _, containerName := range pod.containerNames {
+			reader, err := c.streamContainerLogs(ctx, pod.podName, containerName, options)
+			if err != nil {
+				closeStreams()
+				return nil, err
+			}
+
+			streams = append(streams, &kubernetesimplementationindependend.ContainerLogStream{
+				PodName:       pod.podName,
+				ContainerName: containerName,
+				Reader:        reader,
+			})
+		}
+	}
+
+	return streams, nil
+}
+
+// Streams the logs of the pod. If options.ContainerName is not set and the pod has multiple containers
+// the logs of all containers are aggregated and every line is prefixed by "[pod-name/container-name] ".
+func (c *CommandExecutorNamespace) streamPodLogs(ctx context.Context, podName string, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if podName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("podName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	// Listing by field selector returns a list like the label selector does which allows to reuse the same jsonpath.
+	pods, err := c.listPodContainerNames(ctx, []string{"pods", "--field-selector", "metadata.name=" + podName}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pods) == 0 {
+		return nil, tracederrors.TracedErrorf("Pod '%s' in namespace '%s' not found to stream logs.", podName, namespaceName)
+	}
+
+	containerNames := pods[0].containerNames
+	switch len(containerNames) {
+	case 0:
+		return nil, tracederrors.TracedErrorf("Container '%s' not found in pod '%s' in namespace '%s'.", options.ContainerName, podName, namespaceName)
+	case 1:
+		return c.streamContainerLogs(ctx, podName, containerNames[0], options)
+	}
+
+	streams, err := c.openContainerLogStreams(ctx, pods, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetesimplementationindependend.MergeContainerLogStreams(streams)
+}
+
+// Aggregates the logs of all pods in the namespace matching options.LabelSelector. The caller has to close the returned reader.
+// Every line is prefixed by "[pod-name/container-name] ".
+//
+// The pods are selected once when the stream is opened. Pods created afterwards are not added even if options.Follow is set.
+func (c *CommandExecutorNamespace) StreamLogsByLabelSelector(ctx context.Context, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	labelSelector, err := options.GetLabelSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := c.listPodContainerNames(ctx, []string{"pods", "--selector", labelSelector, "--sort-by", ".metadata.name"}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pods) == 0 {
+		return nil, tracederrors.TracedErrorf("No pods matching label selector '%s' found in namespace '%s'.", labelSelector, namespaceName)
+	}
+
+	streams, err := c.openContainerLogStreams(ctx, pods, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(streams) == 0 {
+		return nil, tracederrors.TracedErrorf("No container '%s' found in pods matching label selector '%s' in namespace '%s'.", options.ContainerName, labelSelector, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Aggregate logs of '%d' containers in '%d' pods matching label selector '%s' in namespace '%s'.", len(streams), len(pods), labelSelector, namespaceName)
+
+	return kubernetesimplementationindependend.MergeContainerLogStreams(streams)
+}
+
+func (c *CommandExecutorPod) StreamLogs(ctx context.Context, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	podName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := c.GetNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	commandExecutorNamespace, ok := namespace.(*CommandExecutorNamespace)
+	if !ok {
+		typeName, _ := datatypes.GetTypeName(namespace)
+		return nil, tracederrors.TracedErrorf("Only implemented for '*commandexecutorkubernetes.CommandExecutorNamespace' but got '%s'", typeName)
+	}
+
+	return commandExecutorNamespace.streamPodLogs(ctx, podName, options)
+}
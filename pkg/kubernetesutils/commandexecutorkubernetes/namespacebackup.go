@@ -0,0 +1,234 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Returns the fully qualified resource names like "deployments.apps" of all listable namespaced kinds.
+// 'isKindIncluded' is optional and allows to limit the returned resources.
+func (c *CommandExecutorNamespace) listNamespacedResources(ctx context.Context, isKindIncluded func(kind string) bool) ([]string, error) {
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd = append(cmd, "api-resources", "--namespaced=true", "--verbs=list", "--no-headers")
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []string{}
+	for _, line := range lines {
+		fields := strings.Fields(line)
+
+		// The columns are NAME [SHORTNAMES] APIVERSION NAMESPACED KIND. Only SHORTNAMES is optional.
+		if len(fields) < 4 {
+			continue
+		}
+
+		name := fields[0]
+		kind := fields[len(fields)-1]
+		apiVersion := fields[len(fields)-3]
+
+		if isKindIncluded != nil && !isKindIncluded(kind) {
+			continue
+		}
+
+		resource := name
+		if strings.Contains(apiVersion, "/") {
+			resource += "." + strings.SplitN(apiVersion, "/", 2)[0]
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+// Returns the objects of all listable namespaced kinds in this namespace as unstructured maps.
+func (c *CommandExecutorNamespace) listNamespacedObjects(ctx context.Context, isKindIncluded func(kind string) bool) ([]map[string]interface{}, error) {
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := c.listNamespacedResources(ctx, isKindIncluded)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) == 0 {
+		logging.LogInfoByCtxf(ctx, "No resources to list in namespace '%s'.", namespaceName)
+		return []map[string]interface{}{}, nil
+	}
+
+	objects := []map[string]interface{}{}
+	for _, resource := range resources {
+		items, err := c.listNamespacedObjectsOfResource(ctx, resource)
+		if err != nil {
+			if isSkippableListError(err) {
+				logging.LogWarnByCtxf(ctx, "Unable to list '%s' in namespace '%s'. Skipped: %v", resource, namespaceName, err)
+				continue
+			}
+
+			return nil, err
+		}
+
+		objects = append(objects, items...)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' objects in namespace '%s'.", len(objects), namespaceName)
+
+	return objects, nil
+}
+
+// Returns the objects of the given resource like "deployments.apps" in this namespace as unstructured maps.
+func (c *CommandExecutorNamespace) listNamespacedObjectsOfResource(ctx context.Context, resource string) ([]map[string]interface{}, error) {
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd = append(cmd, "get", resource, "-o", "json")
+
+	output, err := c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := output.GetStdoutAsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	list := struct {
+		Items []map[string]interface{} `json:"items"`
+	}{}
+
+	err = json.Unmarshal(stdout, &list)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse '%s' in namespace '%s': %w", resource, namespaceName, err)
+	}
+
+	return list.Items, nil
+}
+
+// Returns true if listing a resource failed because it is forbidden, not found or does not support listing.
+// These resources are skipped during backup the same way the native implementation does.
+func isSkippableListError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, toCheck := range []string{
+		"(Forbidden)",
+		"(NotFound)",
+		"(MethodNotAllowed)",
+		"the server doesn't have a resource type",
+	} {
+		if strings.Contains(err.Error(), toCheck) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *CommandExecutorNamespace) Backup(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.BackupNamespaceOptions) ([]*kubernetesimplementationindependend.ObjectReference, error) {
+	if directory == nil {
+		return nil, tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Backup namespace '%s' started.", namespaceName)
+
+	objects, err := c.listNamespacedObjects(ctx, func(kind string) bool {
+		return kubernetesimplementationindependend.IsKindIncludedInBackup(kind, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exported, err := kubernetesimplementationindependend.WriteNamespaceBackup(ctx, objects, directory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range exported {
+		e.Namespace = namespaceName
+	}
+
+	logging.LogInfoByCtxf(ctx, "Backup namespace '%s' finished.", namespaceName)
+
+	return exported, nil
+}
+
+func (c *CommandExecutorNamespace) Restore(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.RestoreNamespaceOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	if directory == nil {
+		return nil, tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Restore namespace '%s' started.", namespaceName)
+
+	yamlString, err := kubernetesimplementationindependend.ReadNamespaceBackup(ctx, directory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.ApplyManifests(ctx, &kubernetesparameteroptions.ApplyManifestsOptions{
+		YamlString:       yamlString,
+		WaitForReadiness: options.WaitForReadiness,
+		WaitTimeout:      options.WaitTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Restore namespace '%s' finished.", namespaceName)
+
+	return result, nil
+}
@@ -0,0 +1,352 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/yamlutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Maximum time to wait until a deleted object is gone.
+const defaultDeleteTimeout = 60 * time.Second
+
+// Returns the kubectl base command for this namespace including the explicit '--context' unless in cluster authentication is used.
+func (c *CommandExecutorNamespace) getKubectlCommand(ctx context.Context) ([]string, error) {
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := []string{"kubectl"}
+
+	if kubernetesutils.IsInClusterAuthenticationAvailable(ctx) {
+		logging.LogInfoByCtxf(ctx, "Kubernetes in cluster authentication is used. cluster context is not used.")
+	} else {
+		kubectlContext, err := c.GetCachedKubectlContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cmd = append(cmd, "--context", kubectlContext)
+	}
+
+	cmd = append(cmd, "--namespace", namespaceName)
+
+	return cmd, nil
+}
+
+// Returns a description like "Service 'example' in namespace 'default' of kubernetes 'kind-example'" used for logging.
+func (c *CommandExecutorNamespace) getObjectDescription(ctx context.Context, kind string, name string) (string, error) {
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return "", err
+	}
+
+	contextName, err := c.GetCachedKubectlContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s '%s' in namespace '%s' of kubernetes '%s'", kind, name, namespaceName, contextName), nil
+}
+
+// Checks if the object 'name' of the given 'resource' like "services" exists in this namespace.
+func (c *CommandExecutorNamespace) namespacedObjectExists(ctx context.Context, kind string, resource string, name string) (bool, error) {
+	if kind == "" {
+		return false, tracederrors.TracedErrorEmptyString("kind")
+	}
+
+	if resource == "" {
+		return false, tracederrors.TracedErrorEmptyString("resource")
+	}
+
+	if name == "" {
+		return false, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	description, err := c.getObjectDescription(ctx, kind, name)
+	if err != nil {
+		return false, err
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	cmd = append(cmd, "get", resource, name, "--ignore-not-found", "-o", "name")
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	exists := false
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			exists = true
+			break
+		}
+	}
+
+	if exists {
+		logging.LogInfoByCtxf(ctx, "%s exists.", description)
+	} else {
+		logging.LogInfoByCtxf(ctx, "%s does not exist.", description)
+	}
+
+	return exists, nil
+}
+
+// Creates the object described by 'manifest' if it does not already exist.
+// An existing object is left untouched to behave like the native implementation.
+func (c *CommandExecutorNamespace) createNamespacedObject(ctx context.Context, kind string, resource string, name string, manifest map[string]interface{}) error {
+	if manifest == nil {
+		return tracederrors.TracedErrorNil("manifest")
+	}
+
+	description, err := c.getObjectDescription(ctx, kind, name)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create %s started.", description)
+
+	exists, err := c.namespacedObjectExists(contextutils.WithSilent(ctx), kind, resource, name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		logging.LogInfoByCtxf(ctx, "%s already exists.", description)
+	} else {
+		yamlString, err := yamlutils.DataToYamlString(manifest)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.ApplyManifests(
+			contextutils.WithSilent(ctx),
+			&kubernetesparameteroptions.ApplyManifestsOptions{
+				YamlString:            yamlString,
+				SkipNamespaceCreation: true,
+			},
+		)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to create %s: %w", description, err)
+		}
+
+		logging.LogChangedByCtxf(ctx, "%s created.", description)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create %s finished.", description)
+
+	return nil
+}
+
+// Deletes the object and waits until it is gone. Deleting a non existing object is not an error.
+func (c *CommandExecutorNamespace) deleteNamespacedObject(ctx context.Context, kind string, resource string, name string) error {
+	description, err := c.getObjectDescription(ctx, kind, name)
+	if err != nil {
+		return err
+	}
+
+	exists, err := c.namespacedObjectExists(contextutils.WithSilent(ctx), kind, resource, name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logging.LogInfoByCtxf(ctx, "%s does not exist. Skip delete.", description)
+		return nil
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return err
+	}
+
+	cmd = append(
+		cmd,
+		"delete", resource, name,
+		"--ignore-not-found",
+		"--wait=true",
+		fmt.Sprintf("--timeout=%ds", int(defaultDeleteTimeout.Seconds())),
+	)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to delete %s: %w", description, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "%s deleted.", description)
+
+	return nil
+}
+
+// Returns the sorted names of all objects of the given 'resource' like "services" in this namespace.
+func (c *CommandExecutorNamespace) listNamespacedObjectNames(ctx context.Context, resource string) ([]string, error) {
+	if resource == "" {
+		return nil, tracederrors.TracedErrorEmptyString("resource")
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd = append(cmd, "get", resource, "-o", "name")
+
+	lines, err := c.RunCommandAndGetStdoutAsLines(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.SplitN(line, "/", 2)
+		if len(parts) == 2 {
+			names = append(names, parts[1])
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Returns the result of the 'jsonPath' query like "{.status.phase}" evaluated against the output of 'kubectl get <getArgs>'.
+func (c *CommandExecutorNamespace) getJsonPath(ctx context.Context, getArgs []string, jsonPath string) (string, error) {
+	if len(getArgs) == 0 {
+		return "", tracederrors.TracedError("getArgs not set")
+	}
+
+	if jsonPath == "" {
+		return "", tracederrors.TracedErrorEmptyString("jsonPath")
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	cmd = append(cmd, "get")
+	cmd = append(cmd, getArgs...)
+	cmd = append(cmd, "-o", "jsonpath="+jsonPath)
+
+	output, err := c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	stdout, err := output.GetStdoutAsString()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
+
+// Runs 'kubectl rollout status' which waits until all pods of the given workload like "statefulset/example" are updated and ready.
+func (c *CommandExecutorNamespace) waitForRolloutStatus(ctx context.Context, description string, objectName string, timeout time.Duration) error {
+	if objectName == "" {
+		return tracederrors.TracedErrorEmptyString("objectName")
+	}
+
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return err
+	}
+
+	cmd = append(cmd, "rollout", "status", objectName, fmt.Sprintf("--timeout=%ds", int(timeout.Seconds())))
+
+	logging.LogInfoByCtxf(ctx, "Wait until %s started.", description)
+
+	_, err = c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to wait until %s: %w", description, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait until %s finished.", description)
+
+	return nil
+}
+
+// Calls 'isDone' every second until it returns true, returns an error or 'timeout' is reached.
+// 'description' describes the awaited state like "Job 'example' in namespace 'default' is completed" and is used for logging.
+func (c *CommandExecutorNamespace) pollUntil(ctx context.Context, description string, timeout time.Duration, isDone func(ctx context.Context) (bool, error)) error {
+	if description == "" {
+		return tracederrors.TracedErrorEmptyString("description")
+	}
+
+	if isDone == nil {
+		return tracederrors.TracedErrorNil("isDone")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait until %s started.", description)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := isDone(ctx)
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to wait until %s: %w", description, err)
+		}
+
+		if done {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return tracederrors.TracedErrorf("Timeout after %v waiting until %s.", timeout, description)
+		}
+
+		select {
+		case <-ctx.Done():
+			return tracederrors.TracedErrorf("Context cancelled while waiting until %s: %w", description, ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait until %s finished.", description)
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package commandexecutorkubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubeconfigutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Runs 'kubectl <args>' in this namespace and returns the trimmed stdout.
+func (c *CommandExecutorNamespace) runKubectlAndGetStdout(ctx context.Context, args ...string) (string, error) {
+	cmd, err := c.getKubectlCommand(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	cmd = append(cmd, args...)
+
+	output, err := c.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: cmd,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	stdout, err := output.GetStdoutAsString()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
+
+// Returns the API server URL and the PEM encoded certificate authority of the kubectl context used by this namespace.
+func (c *CommandExecutorNamespace) getServerUrlAndCertificateAuthority(ctx context.Context) (string, []byte, error) {
+	// '--flatten' embeds a certificate authority referenced by file path as data:
+	output, err := c.runKubectlAndGetStdout(
+		ctx,
+		"config", "view", "--minify", "--raw", "--flatten",
+		"-o", "jsonpath={.clusters[0].cluster.server}{\"|\"}{.clusters[0].cluster.certificate-authority-data}",
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	serverUrl, certificateAuthorityData, found := strings.Cut(output, "|")
+	if !found || serverUrl == "" {
+		return "", nil, tracederrors.TracedErrorf("Unable to get server URL from kubectl config. Output was '%s'.", output)
+	}
+
+	if certificateAuthorityData == "" {
+		return "", nil, tracederrors.TracedErrorf("No certificate authority set in kubectl config for server '%s'.", serverUrl)
+	}
+
+	certificateAuthority, err := base64.StdEncoding.DecodeString(certificateAuthorityData)
+	if err != nil {
+		return "", nil, tracederrors.TracedErrorf("Unable to decode certificate authority data of server '%s': %w", serverUrl, err)
+	}
+
+	return serverUrl, certificateAuthority, nil
+}
+
+func (c *CommandExecutorNamespace) CreateServiceAccountKubeConfig(ctx context.Context, options *kubernetesparameteroptions.ServiceAccountKubeConfigOptions) (*kubeconfigutils.KubeConfig, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	serviceAccountName, err := options.GetServiceAccountName()
+	if err != nil {
+		return nil, err
+	}
+
+	roleRefKind, roleRefName, err := options.GetRoleRefKindAndName()
+	if err != nil {
+		return nil, err
+	}
+
+	expiration, err := options.GetTokenExpirationOrDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.GetKubernetesCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName, err := cluster.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := c.getObjectDescription(ctx, "ServiceAccount", serviceAccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create kubeconfig for %s started.", description)
+
+	err = c.createNamespacedObject(
+		ctx,
+		"ServiceAccount",
+		"serviceaccounts",
+		serviceAccountName,
+		map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata": map[string]interface{}{
+				"name":      serviceAccountName,
+				"namespace": namespaceName,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ClusterWide {
+		bindingName, err := options.GetClusterRoleBindingName(namespaceName)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = cluster.CreateClusterRoleBinding(ctx, &kubernetesparameteroptions.CreateClusterRoleBindingOptions{
+			Name:             bindingName,
+			RoleRef:          roleRefName,
+			Subjects:         []string{serviceAccountName},
+			SubjectKind:      "ServiceAccount",
+			SubjectNamespace: namespaceName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		bindingName, err := options.GetRoleBindingName()
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = c.CreateRoleBinding(ctx, &kubernetesparameteroptions.CreateRoleBindingOptions{
+			Name:        bindingName,
+			RoleRef:     roleRefName,
+			RoleRefKind: roleRefKind,
+			Subjects:    []string{serviceAccountName},
+			SubjectKind: "ServiceAccount",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := c.runKubectlAndGetStdout(ctx, "create", "token", serviceAccountName, fmt.Sprintf("--duration=%ds", int64(expiration.Seconds())))
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create token for %s: %w", description, err)
+	}
+
+	if token == "" {
+		return nil, tracederrors.TracedErrorf("Got empty token for %s.", description)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Token for %s valid for '%s' issued.", description, expiration)
+
+	serverUrl, certificateAuthority, err := c.getServerUrlAndCertificateAuthority(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig, err := kubeconfigutils.NewServiceAccountKubeConfig(clusterName, serverUrl, certificateAuthority, namespaceName, serviceAccountName, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.OutputFile != nil {
+		err = kubeConfig.WriteToFileReadableByOwnerOnly(ctx, options.OutputFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create kubeconfig for %s finished.", description)
+
+	return kubeConfig, nil
+}
+
+func (c *CommandExecutorNamespace) RevokeServiceAccountKubeConfig(ctx context.Context, options *kubernetesparameteroptions.ServiceAccountKubeConfigOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	serviceAccountName, err := options.GetServiceAccountName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := c.GetName()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := c.GetKubernetesCluster()
+	if err != nil {
+		return err
+	}
+
+	description, err := c.getObjectDescription(ctx, "ServiceAccount", serviceAccountName)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Revoke kubeconfig of %s started.", description)
+
+	// Both possible bindings are removed since the ClusterWide setting may differ from the one used on creation:
+	clusterRoleBindingName, err := options.GetClusterRoleBindingName(namespaceName)
+	if err != nil {
+		return err
+	}
+
+	err = cluster.DeleteClusterRoleBindingByName(ctx, clusterRoleBindingName)
+	if err != nil {
+		return err
+	}
+
+	roleBindingName, err := options.GetRoleBindingName()
+	if err != nil {
+		return err
+	}
+
+	err = c.DeleteRoleBindingByName(ctx, roleBindingName)
+	if err != nil {
+		return err
+	}
+
+	// Deleting the ServiceAccount invalidates all tokens issued for it:
+	err = c.deleteNamespacedObject(ctx, "ServiceAccount", "serviceaccounts", serviceAccountName)
+	if err != nil {
+		return err
+	}
+
+	if options.OutputFile != nil {
+		err = options.OutputFile.Delete(ctx, &filesoptions.DeleteOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Revoke kubeconfig of %s finished.", description)
+
+	return nil
+}
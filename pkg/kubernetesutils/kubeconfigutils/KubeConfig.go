@@ -432,10 +432,19 @@ func (k *KubeConfig) WriteToFileByPath(ctx context.Context, path string) (err er
 }
 
 func (k *KubeConfig) WriteToFile(ctx context.Context, outFile filesinterfaces.File) (err error) {
+	return k.WriteToFileWithOptions(ctx, outFile, &filesoptions.WriteOptions{})
+}
+
+// Like WriteToFile but allows to set e.g. restrictive permissions for configs containing credentials.
+func (k *KubeConfig) WriteToFileWithOptions(ctx context.Context, outFile filesinterfaces.File, options *filesoptions.WriteOptions) (err error) {
 	if outFile == nil {
 		return tracederrors.TracedErrorNil("outfile")
 	}
 
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
 	path, err := outFile.GetPath()
 	if err != nil {
 		return err
@@ -446,7 +455,7 @@ func (k *KubeConfig) WriteToFile(ctx context.Context, outFile filesinterfaces.Fi
 		return err
 	}
 
-	err = outFile.WriteString(ctx, content, &filesoptions.WriteOptions{})
+	err = outFile.WriteString(ctx, content, options)
 	if err != nil {
 		return err
 	}
@@ -456,6 +465,23 @@ func (k *KubeConfig) WriteToFile(ctx context.Context, outFile filesinterfaces.Fi
 	return nil
 }
 
+// Writes the KubeConfig to 'outFile' which is only readable by the owner afterwards.
+// Use this for configs containing credentials like tokens.
+func (k *KubeConfig) WriteToFileReadableByOwnerOnly(ctx context.Context, outFile filesinterfaces.File) (err error) {
+	if outFile == nil {
+		return tracederrors.TracedErrorNil("outfile")
+	}
+
+	perm := os.FileMode(0600)
+	err = k.WriteToFileWithOptions(ctx, outFile, &filesoptions.WriteOptions{Perm: &perm})
+	if err != nil {
+		return err
+	}
+
+	// Not all file implementations honor the permissions of the WriteOptions for already existing files:
+	return outFile.Chmod(ctx, &filesoptions.ChmodOptions{PermissionsString: "u=rw,g=,o="})
+}
+
 // Use exec to invoke a "kubectl config get-context" with the given config "path".
 // Useful to validate if the config is understood correctly by kubectl.
 func ListContextNamesUsingKubectl(ctx context.Context, path string) (contextNames []string, err error) {
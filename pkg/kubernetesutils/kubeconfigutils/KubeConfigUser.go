@@ -9,6 +9,7 @@ type KubeConfigUser struct {
 		ClientKeyData         string `yaml:"client-key-data"`
 		Username              string `yaml:"username"`
 		Password              string `yaml:"password"`
+		Token                 string `yaml:"token,omitempty"`
 	} `yaml:"user"`
 }
 
@@ -19,3 +20,11 @@ func (k *KubeConfigUser) GetClientKeyData() (string, error) {
 
 	return k.User.ClientKeyData, nil
 }
+
+func (k *KubeConfigUser) GetToken() (string, error) {
+	if k.User.Token == "" {
+		return "", tracederrors.TracedError("Token not set")
+	}
+
+	return k.User.Token, nil
+}
@@ -0,0 +1,66 @@
+package kubeconfigutils
+
+import (
+	"encoding/base64"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Creates a standalone KubeConfig authenticating as the ServiceAccount 'serviceAccountName' in 'namespaceName' using the given bearer 'token'.
+//
+// The 'certificateAuthority' is the PEM encoded CA of the cluster and is embedded into the returned config.
+// The cluster, context and user entries are all named "<serviceAccountName>@<clusterName>" so the config can be merged
+// into an existing one using MergeConfig without replacing the entries of 'clusterName'.
+// The context is set as current context and uses 'namespaceName' by default.
+func NewServiceAccountKubeConfig(clusterName string, serverUrl string, certificateAuthority []byte, namespaceName string, serviceAccountName string, token string) (*KubeConfig, error) {
+	if clusterName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("clusterName")
+	}
+
+	if serverUrl == "" {
+		return nil, tracederrors.TracedErrorEmptyString("serverUrl")
+	}
+
+	if len(certificateAuthority) == 0 {
+		return nil, tracederrors.TracedError("certificateAuthority is empty")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceAccountName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("serviceAccountName")
+	}
+
+	if token == "" {
+		return nil, tracederrors.TracedErrorEmptyString("token")
+	}
+
+	name := serviceAccountName + "@" + clusterName
+
+	kubeConfigContext := KubeConfigContext{Name: name}
+	kubeConfigContext.Context.Cluster = name
+	kubeConfigContext.Context.Namespace = namespaceName
+	kubeConfigContext.Context.User = name
+
+	user := KubeConfigUser{Name: name}
+	user.User.Token = token
+
+	return &KubeConfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []KubeConfigCluster{
+			{
+				Name: name,
+				Cluster: KubeConfigClusterCluster{
+					Server:                   serverUrl,
+					CertificateAuthorityData: base64.StdEncoding.EncodeToString(certificateAuthority),
+				},
+			},
+		},
+		Contexts:       []KubeConfigContext{kubeConfigContext},
+		CurrentContext: name,
+		Users:          []KubeConfigUser{user},
+	}, nil
+}
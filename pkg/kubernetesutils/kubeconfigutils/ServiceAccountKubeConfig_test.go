@@ -0,0 +1,41 @@
+package kubeconfigutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubeconfigutils"
+)
+
+func Test_NewServiceAccountKubeConfig(t *testing.T) {
+	t.Run("empty token", func(t *testing.T) {
+		kubeConfig, err := kubeconfigutils.NewServiceAccountKubeConfig("kind-example", "https://127.0.0.1:6443", []byte("ca"), "ci", "deployer", "")
+		require.Error(t, err)
+		require.Nil(t, kubeConfig)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		ctx := getCtx()
+
+		kubeConfig, err := kubeconfigutils.NewServiceAccountKubeConfig("kind-example", "https://127.0.0.1:6443", []byte("ca"), "ci", "deployer", "secret-token")
+		require.NoError(t, err)
+
+		currentContext, err := kubeConfig.GetCurrentContext(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, "deployer@kind-example", currentContext)
+
+		cluster, kubeConfigContext, user, err := kubeConfig.GetClusterAndContextAndUserEntryByName("deployer@kind-example")
+		require.NoError(t, err)
+		require.EqualValues(t, "https://127.0.0.1:6443", cluster.Cluster.Server)
+		require.EqualValues(t, "ci", kubeConfigContext.Context.Namespace)
+
+		token, err := user.GetToken()
+		require.NoError(t, err)
+		require.EqualValues(t, "secret-token", token)
+
+		yamlString, err := kubeConfig.GetAsYamlString()
+		require.NoError(t, err)
+		require.Contains(t, yamlString, "certificate-authority-data: Y2E=")
+		require.Contains(t, yamlString, "token: secret-token")
+	})
+}
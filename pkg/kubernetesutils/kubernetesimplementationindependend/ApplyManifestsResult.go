@@ -0,0 +1,28 @@
+package kubernetesimplementationindependend
+
+// Result of applying multi document YAML manifests.
+type ApplyManifestsResult struct {
+	// All objects of the applied manifests, also the ones which were already up to date.
+	AppliedObjects []*ObjectReference
+
+	// Objects deleted since they are not part of the applied manifests anymore.
+	PrunedObjects []*ObjectReference
+}
+
+func (a *ApplyManifestsResult) GetAppliedObjectNames() []string {
+	ret := []string{}
+	for _, o := range a.AppliedObjects {
+		ret = append(ret, o.Name)
+	}
+
+	return ret
+}
+
+func (a *ApplyManifestsResult) GetPrunedObjectNames() []string {
+	ret := []string{}
+	for _, o := range a.PrunedObjects {
+		ret = append(ret, o.Name)
+	}
+
+	return ret
+}
@@ -0,0 +1,111 @@
+package kubernetesimplementationindependend
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Annotation set on the pod template to trigger a rolling restart. Same key as used by 'kubectl rollout restart'.
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// Annotation on the ReplicaSets of a Deployment holding the revision number.
+const DeploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// Annotation describing the cause of a change, shown in the rollout history.
+const ChangeCauseAnnotation = "kubernetes.io/change-cause"
+
+// One entry of the rollout history of a Deployment.
+type DeploymentRevision struct {
+	Revision       int64
+	ReplicaSetName string
+	ChangeCause    string
+	Images         []string
+}
+
+// Sorts the revisions ascending by revision number.
+func SortDeploymentRevisions(revisions []*DeploymentRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+}
+
+// Returns the revision to roll back to.
+//
+// If 'toRevision' is 0 the revision before the current (highest) one is returned like 'kubectl rollout undo' does.
+func GetDeploymentRevisionToRollbackTo(revisions []*DeploymentRevision, toRevision int64) (*DeploymentRevision, error) {
+	if toRevision < 0 {
+		return nil, tracederrors.TracedErrorf("Invalid revision '%d' to roll back to.", toRevision)
+	}
+
+	if len(revisions) == 0 {
+		return nil, tracederrors.TracedError("No revisions available to roll back to.")
+	}
+
+	sorted := make([]*DeploymentRevision, len(revisions))
+	copy(sorted, revisions)
+	SortDeploymentRevisions(sorted)
+
+	if toRevision == 0 {
+		if len(sorted) < 2 {
+			return nil, tracederrors.TracedError("No previous revision available to roll back to.")
+		}
+
+		return sorted[len(sorted)-2], nil
+	}
+
+	for _, r := range sorted {
+		if r.Revision == toRevision {
+			return r, nil
+		}
+	}
+
+	return nil, tracederrors.TracedErrorf("Revision '%d' not found in rollout history.", toRevision)
+}
+
+// The fields of a Deployment needed to evaluate the rollout progress.
+type DeploymentRolloutStatus struct {
+	DeploymentName     string
+	Generation         int64
+	ObservedGeneration int64
+
+	// Desired number of replicas as specified in '.spec.replicas'.
+	DesiredReplicas int32
+
+	// Total number of replicas as reported in '.status.replicas' including old ones pending termination.
+	Replicas          int32
+	UpdatedReplicas   int32
+	AvailableReplicas int32
+
+	// Reason of the 'Progressing' condition.
+	ProgressingReason string
+}
+
+// Evaluates the rollout progress the same way 'kubectl rollout status' does.
+//
+// Returns true if the rollout is complete and a human readable progress message.
+// An error is returned if the progress deadline of the Deployment is exceeded.
+func (d *DeploymentRolloutStatus) Evaluate() (done bool, message string, err error) {
+	if d.Generation > d.ObservedGeneration {
+		return false, fmt.Sprintf("Waiting for deployment '%s' spec update to be observed.", d.DeploymentName), nil
+	}
+
+	if d.ProgressingReason == "ProgressDeadlineExceeded" {
+		return false, "", tracederrors.TracedErrorf("Deployment '%s' exceeded its progress deadline.", d.DeploymentName)
+	}
+
+	if d.UpdatedReplicas < d.DesiredReplicas {
+		return false, fmt.Sprintf("Waiting for deployment '%s' rollout to finish: %d out of %d new replicas have been updated.", d.DeploymentName, d.UpdatedReplicas, d.DesiredReplicas), nil
+	}
+
+	if d.Replicas > d.UpdatedReplicas {
+		return false, fmt.Sprintf("Waiting for deployment '%s' rollout to finish: %d old replicas are pending termination.", d.DeploymentName, d.Replicas-d.UpdatedReplicas), nil
+	}
+
+	if d.AvailableReplicas < d.UpdatedReplicas {
+		return false, fmt.Sprintf("Waiting for deployment '%s' rollout to finish: %d of %d updated replicas are available.", d.DeploymentName, d.AvailableReplicas, d.UpdatedReplicas), nil
+	}
+
+	return true, fmt.Sprintf("Deployment '%s' successfully rolled out.", d.DeploymentName), nil
+}
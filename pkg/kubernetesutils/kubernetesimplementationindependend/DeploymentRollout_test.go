@@ -0,0 +1,114 @@
+package kubernetesimplementationindependend_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+)
+
+func Test_GetDeploymentRevisionToRollbackTo(t *testing.T) {
+	revisions := []*kubernetesimplementationindependend.DeploymentRevision{
+		{Revision: 3, ReplicaSetName: "rs-3"},
+		{Revision: 1, ReplicaSetName: "rs-1"},
+		{Revision: 2, ReplicaSetName: "rs-2"},
+	}
+
+	t.Run("previous revision", func(t *testing.T) {
+		revision, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, "rs-2", revision.ReplicaSetName)
+	})
+
+	t.Run("explicit revision", func(t *testing.T) {
+		revision, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions, 1)
+		require.NoError(t, err)
+		require.EqualValues(t, "rs-1", revision.ReplicaSetName)
+	})
+
+	t.Run("unknown revision", func(t *testing.T) {
+		_, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions, 4)
+		require.Error(t, err)
+	})
+
+	t.Run("negative revision", func(t *testing.T) {
+		_, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions, -1)
+		require.Error(t, err)
+	})
+
+	t.Run("only one revision", func(t *testing.T) {
+		_, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions[:1], 0)
+		require.Error(t, err)
+	})
+
+	t.Run("no revisions", func(t *testing.T) {
+		_, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(nil, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("input order unchanged", func(t *testing.T) {
+		require.EqualValues(t, 3, revisions[0].Revision)
+	})
+}
+
+func Test_DeploymentRolloutStatus_Evaluate(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          kubernetesimplementationindependend.DeploymentRolloutStatus
+		expectedDone    bool
+		expectedMessage string
+		expectError     bool
+	}{
+		{
+			name:            "spec update not observed",
+			status:          kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 2, ObservedGeneration: 1},
+			expectedMessage: "Waiting for deployment 'd' spec update to be observed.",
+		},
+		{
+			name:        "progress deadline exceeded",
+			status:      kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 1, ObservedGeneration: 1, ProgressingReason: "ProgressDeadlineExceeded"},
+			expectError: true,
+		},
+		{
+			name:            "replicas not updated",
+			status:          kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 1, ObservedGeneration: 1, DesiredReplicas: 3, Replicas: 3, UpdatedReplicas: 1},
+			expectedMessage: "Waiting for deployment 'd' rollout to finish: 1 out of 3 new replicas have been updated.",
+		},
+		{
+			name:            "old replicas pending termination",
+			status:          kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 1, ObservedGeneration: 1, DesiredReplicas: 3, Replicas: 4, UpdatedReplicas: 3},
+			expectedMessage: "Waiting for deployment 'd' rollout to finish: 1 old replicas are pending termination.",
+		},
+		{
+			name:            "updated replicas not available",
+			status:          kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 1, ObservedGeneration: 1, DesiredReplicas: 3, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+			expectedMessage: "Waiting for deployment 'd' rollout to finish: 2 of 3 updated replicas are available.",
+		},
+		{
+			name:            "rolled out",
+			status:          kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 2, ObservedGeneration: 2, DesiredReplicas: 3, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			expectedDone:    true,
+			expectedMessage: "Deployment 'd' successfully rolled out.",
+		},
+		{
+			name:            "scaled to zero",
+			status:          kubernetesimplementationindependend.DeploymentRolloutStatus{DeploymentName: "d", Generation: 1, ObservedGeneration: 1},
+			expectedDone:    true,
+			expectedMessage: "Deployment 'd' successfully rolled out.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done, message, err := tt.status.Evaluate()
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedDone, done)
+			require.EqualValues(t, tt.expectedMessage, message)
+		})
+	}
+}
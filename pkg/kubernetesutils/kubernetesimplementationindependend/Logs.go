@@ -0,0 +1,182 @@
+package kubernetesimplementationindependend
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Maximum length of a single log line. Longer lines fail the stream.
+const maxLogLineLength = 1024 * 1024
+
+// The log stream of a single container in a pod.
+type ContainerLogStream struct {
+	PodName       string
+	ContainerName string
+	Reader        io.ReadCloser
+}
+
+// Returns the prefix added to every line when aggregating the logs of multiple containers like "[pod-name/container-name] ".
+func (c *ContainerLogStream) GetPrefix() string {
+	return "[" + c.PodName + "/" + c.ContainerName + "] "
+}
+
+// Merges the given container log streams line by line into one stream.
+// Every line is prefixed by ContainerLogStream.GetPrefix to identify its source.
+// Lines of different containers are interleaved in the order they are read, a single line is never split.
+//
+// Closing the returned reader closes all given streams.
+func MergeContainerLogStreams(streams []*ContainerLogStream) (io.ReadCloser, error) {
+	if len(streams) == 0 {
+		return nil, tracederrors.TracedError("No streams to merge given")
+	}
+
+	for _, s := range streams {
+		if s == nil {
+			return nil, tracederrors.TracedErrorNil("stream")
+		}
+
+		if s.Reader == nil {
+			return nil, tracederrors.TracedErrorf("Reader of stream %s not set", s.GetPrefix())
+		}
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+
+	var writeLock sync.Mutex
+	var waitGroup sync.WaitGroup
+	var firstErr error
+	var errLock sync.Mutex
+
+	for _, s := range streams {
+		waitGroup.Add(1)
+
+		go func(s *ContainerLogStream) {
+			defer waitGroup.Done()
+
+			prefix := s.GetPrefix()
+
+			scanner := bufio.NewScanner(s.Reader)
+			scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineLength)
+			for scanner.Scan() {
+				writeLock.Lock()
+				_, err := pipeWriter.Write([]byte(prefix + scanner.Text() + "\n"))
+				writeLock.Unlock()
+
+				if err != nil {
+					// The merged reader was closed.
+					return
+				}
+			}
+
+			err := scanner.Err()
+			if err != nil {
+				errLock.Lock()
+				if firstErr == nil {
+					firstErr = tracederrors.TracedErrorf("Failed to read logs %s: %w", prefix, err)
+				}
+				errLock.Unlock()
+			}
+		}(s)
+	}
+
+	go func() {
+		waitGroup.Wait()
+
+		errLock.Lock()
+		defer errLock.Unlock()
+
+		// A nil error results in io.EOF for the reader.
+		pipeWriter.CloseWithError(firstErr)
+	}()
+
+	var closeOnce sync.Once
+	var closeErr error
+
+	return &mergedLogStream{
+		reader: pipeReader,
+		closeFunc: func() error {
+			closeOnce.Do(func() {
+				errs := []error{pipeReader.Close()}
+				for _, s := range streams {
+					errs = append(errs, s.Reader.Close())
+				}
+
+				closeErr = errors.Join(errs...)
+			})
+
+			return closeErr
+		},
+	}, nil
+}
+
+type mergedLogStream struct {
+	reader    io.Reader
+	closeFunc func() error
+}
+
+func (m *mergedLogStream) Read(p []byte) (int, error) {
+	return m.reader.Read(p)
+}
+
+func (m *mergedLogStream) Close() error {
+	return m.closeFunc()
+}
+
+// Reads the given log stream line by line and calls 'callback' for every line.
+//
+// Returns nil when the end of the stream is reached or 'ctx' is done.
+// The reader is closed in any case so a followed stream stops as soon as 'ctx' is done.
+func ForEachLogLine(ctx context.Context, reader io.ReadCloser, callback func(line string) error) error {
+	if reader == nil {
+		return tracederrors.TracedErrorNil("reader")
+	}
+
+	if callback == nil {
+		return tracederrors.TracedErrorNil("callback")
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	var closeOnce sync.Once
+	closeReader := func() {
+		closeOnce.Do(func() {
+			reader.Close()
+		})
+	}
+	defer closeReader()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			// Unblock the scanner waiting for new lines:
+			closeReader()
+		case <-done:
+		}
+	}()
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineLength)
+	for scanner.Scan() {
+		err := callback(scanner.Text())
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to read log lines: %w", err)
+	}
+
+	return nil
+}
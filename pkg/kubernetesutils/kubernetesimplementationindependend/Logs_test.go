@@ -0,0 +1,107 @@
+package kubernetesimplementationindependend_test
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+)
+
+func Test_MergeContainerLogStreams(t *testing.T) {
+	t.Run("no streams", func(t *testing.T) {
+		merged, err := kubernetesimplementationindependend.MergeContainerLogStreams(nil)
+		require.Error(t, err)
+		require.Nil(t, merged)
+	})
+
+	t.Run("two pods", func(t *testing.T) {
+		merged, err := kubernetesimplementationindependend.MergeContainerLogStreams(
+			[]*kubernetesimplementationindependend.ContainerLogStream{
+				{PodName: "pod-a", ContainerName: "app", Reader: io.NopCloser(strings.NewReader("a1\na2\n"))},
+				{PodName: "pod-b", ContainerName: "app", Reader: io.NopCloser(strings.NewReader("b1\nb2 without newline"))},
+			},
+		)
+		require.NoError(t, err)
+		defer merged.Close()
+
+		content, err := io.ReadAll(merged)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+		sort.Strings(lines)
+
+		require.EqualValues(
+			t,
+			[]string{
+				"[pod-a/app] a1",
+				"[pod-a/app] a2",
+				"[pod-b/app] b1",
+				"[pod-b/app] b2 without newline",
+			},
+			lines,
+		)
+	})
+
+	t.Run("close stops followed streams", func(t *testing.T) {
+		// The pipe never gets any data written, like a followed log stream of an idle container:
+		pipeReader, pipeWriter := io.Pipe()
+		defer pipeWriter.Close()
+
+		merged, err := kubernetesimplementationindependend.MergeContainerLogStreams(
+			[]*kubernetesimplementationindependend.ContainerLogStream{
+				{PodName: "pod", ContainerName: "app", Reader: pipeReader},
+			},
+		)
+		require.NoError(t, err)
+
+		err = merged.Close()
+		require.NoError(t, err)
+
+		_, err = merged.Read(make([]byte, 10))
+		require.Error(t, err)
+	})
+}
+
+func Test_ForEachLogLine(t *testing.T) {
+	t.Run("read until end of stream", func(t *testing.T) {
+		lines := []string{}
+		err := kubernetesimplementationindependend.ForEachLogLine(
+			context.Background(),
+			io.NopCloser(strings.NewReader("line1\nline2\n")),
+			func(line string) error {
+				lines = append(lines, line)
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"line1", "line2"}, lines)
+	})
+
+	t.Run("stop when context is done", func(t *testing.T) {
+		pipeReader, pipeWriter := io.Pipe()
+		defer pipeWriter.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			pipeWriter.Write([]byte("first line\n"))
+		}()
+
+		lines := []string{}
+		err := kubernetesimplementationindependend.ForEachLogLine(
+			ctx,
+			pipeReader,
+			func(line string) error {
+				lines = append(lines, line)
+				cancel()
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"first line"}, lines)
+	})
+}
@@ -0,0 +1,456 @@
+package kubernetesimplementationindependend
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/yamlutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/nativegnupg"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Suffix of the files containing a plain text object in a namespace backup.
+const BackupFileSuffix = ".yaml"
+
+// Suffix of the files containing a PGP encrypted object in a namespace backup.
+const EncryptedBackupFileSuffix = ".yaml.asc"
+
+// Kinds which are not exported unless explicitly requested since kubernetes creates and updates them on its own.
+func GetKindsExcludedFromBackup() []string {
+	return []string{
+		"ControllerRevision",
+		"EndpointSlice",
+		"Endpoints",
+		"Event",
+		"Lease",
+		"PodMetrics",
+	}
+}
+
+// Returns true if objects of the given 'kind' are exported.
+// If no Kinds are set in the options all kinds except the ones returned by GetKindsExcludedFromBackup are exported.
+func IsKindIncludedInBackup(kind string, options *kubernetesparameteroptions.BackupNamespaceOptions) bool {
+	if options == nil || !options.IsKindsSet() {
+		return !slices.Contains(GetKindsExcludedFromBackup(), kind)
+	}
+
+	return slices.Contains(options.Kinds, kind)
+}
+
+// Order in which the kinds are restored. Objects referenced by others come first.
+// Kinds not listed here are restored afterwards.
+func GetBackupRestoreKindOrder() []string {
+	return []string{
+		"ResourceQuota",
+		"LimitRange",
+		"NetworkPolicy",
+		"PodDisruptionBudget",
+		"ServiceAccount",
+		"Secret",
+		"ConfigMap",
+		"PersistentVolumeClaim",
+		"Role",
+		"RoleBinding",
+		"Service",
+		"Pod",
+		"ReplicationController",
+		"ReplicaSet",
+		"Deployment",
+		"HorizontalPodAutoscaler",
+		"StatefulSet",
+		"DaemonSet",
+		"Job",
+		"CronJob",
+		"Ingress",
+	}
+}
+
+// Sorts the objects in the order given by GetBackupRestoreKindOrder.
+// The order of objects of the same kind or of kinds not listed is kept.
+func SortObjectYamlEntriesByDependencyOrder(objects []*ObjectYamlEntry) {
+	kindOrder := GetBackupRestoreKindOrder()
+
+	getPriority := func(o *ObjectYamlEntry) int {
+		index := slices.Index(kindOrder, o.Kind())
+		if index < 0 {
+			return len(kindOrder)
+		}
+
+		return index
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return getPriority(objects[i]) < getPriority(objects[j])
+	})
+}
+
+// Returns the file name like "Deployment_example.yaml" used to store the object in a namespace backup.
+func GetBackupFileName(kind string, name string, encrypted bool) (string, error) {
+	if kind == "" {
+		return "", tracederrors.TracedErrorEmptyString("kind")
+	}
+
+	if name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if encrypted {
+		return kind + "_" + name + EncryptedBackupFileSuffix, nil
+	}
+
+	return kind + "_" + name + BackupFileSuffix, nil
+}
+
+func getNestedMap(object map[string]interface{}, key string) map[string]interface{} {
+	value, ok := object[key].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return value
+}
+
+func getObjectKindAndName(object map[string]interface{}) (kind string, name string) {
+	kind, _ = object["kind"].(string)
+
+	metadata := getNestedMap(object, "metadata")
+	if metadata != nil {
+		name, _ = metadata["name"].(string)
+	}
+
+	return kind, name
+}
+
+// Returns false for objects which are recreated automatically and therefore must not be part of a namespace backup:
+//   - Objects owned by another object like Pods of a ReplicaSet or ReplicaSets of a Deployment.
+//   - The "kube-root-ca.crt" ConfigMap and the "default" ServiceAccount present in every namespace.
+//   - ServiceAccount token Secrets.
+func IsObjectIncludedInBackup(object map[string]interface{}) bool {
+	if object == nil {
+		return false
+	}
+
+	kind, name := getObjectKindAndName(object)
+
+	metadata := getNestedMap(object, "metadata")
+	if metadata != nil {
+		ownerReferences, _ := metadata["ownerReferences"].([]interface{})
+		if len(ownerReferences) > 0 {
+			return false
+		}
+	}
+
+	switch kind {
+	case "ConfigMap":
+		return name != "kube-root-ca.crt"
+	case "ServiceAccount":
+		return name != "default"
+	case "Secret":
+		secretType, _ := object["type"].(string)
+		return secretType != "kubernetes.io/service-account-token"
+	}
+
+	return true
+}
+
+// Removes all fields from the object which are set by the server and would prevent restoring the object to another namespace or cluster:
+// the status, managedFields, resourceVersion, uid, creationTimestamp, generation, the namespace and the last-applied-configuration annotation.
+// For Services the cluster IPs assigned by the cluster are removed as well, for Pods the node name and for Jobs the generated selector.
+func CleanObjectForBackup(object map[string]interface{}) error {
+	if object == nil {
+		return tracederrors.TracedErrorNil("object")
+	}
+
+	delete(object, "status")
+
+	metadata := getNestedMap(object, "metadata")
+	if metadata != nil {
+		for _, field := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "selfLink", "namespace"} {
+			delete(metadata, field)
+		}
+
+		annotations := getNestedMap(metadata, "annotations")
+		if annotations != nil {
+			delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+
+			// Binding annotations like "pv.kubernetes.io/bind-completed" pin PVCs to the volumes of the source cluster:
+			for annotation := range annotations {
+				if strings.HasPrefix(annotation, "pv.kubernetes.io/") {
+					delete(annotations, annotation)
+				}
+			}
+
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+
+	kind, _ := getObjectKindAndName(object)
+	if kind == "PersistentVolumeClaim" {
+		spec := getNestedMap(object, "spec")
+		if spec != nil {
+			// The bound volume only exists in the source cluster. Let the PVC bind a new volume on restore:
+			delete(spec, "volumeName")
+		}
+	}
+
+	if kind == "Pod" {
+		spec := getNestedMap(object, "spec")
+		if spec != nil {
+			// The node only exists in the source cluster. Let the scheduler choose a node on restore:
+			delete(spec, "nodeName")
+		}
+	}
+
+	if kind == "Job" {
+		cleanJobForBackup(object)
+	}
+
+	if kind == "Service" {
+		spec := getNestedMap(object, "spec")
+		if spec != nil {
+			clusterIP, _ := spec["clusterIP"].(string)
+
+			// Headless services keep their "None" cluster IP:
+			if clusterIP != "None" {
+				delete(spec, "clusterIP")
+				delete(spec, "clusterIPs")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Labels added by the job controller to the pod template of a Job. They contain the uid of the source Job.
+func getJobControllerLabels() []string {
+	return []string{
+		"controller-uid",
+		"batch.kubernetes.io/controller-uid",
+		"job-name",
+		"batch.kubernetes.io/job-name",
+	}
+}
+
+// Removes the selector and the pod template labels generated by the job controller.
+// The API server rejects Jobs with a selector unless 'manualSelector' is set.
+func cleanJobForBackup(object map[string]interface{}) {
+	spec := getNestedMap(object, "spec")
+	if spec == nil {
+		return
+	}
+
+	manualSelector, _ := spec["manualSelector"].(bool)
+	if manualSelector {
+		return
+	}
+
+	delete(spec, "selector")
+
+	template := getNestedMap(spec, "template")
+	if template == nil {
+		return
+	}
+
+	templateMetadata := getNestedMap(template, "metadata")
+	if templateMetadata == nil {
+		return
+	}
+
+	labels := getNestedMap(templateMetadata, "labels")
+	if labels == nil {
+		return
+	}
+
+	for _, label := range getJobControllerLabels() {
+		delete(labels, label)
+	}
+
+	if len(labels) == 0 {
+		delete(templateMetadata, "labels")
+	}
+}
+
+// Writes every object as cleaned YAML into its own file in 'directory'.
+// Secrets are PGP encrypted if SecretEncryptionPublicKeys are set in the options.
+//
+// The given objects are modified in place. Returns the references of the exported objects.
+func WriteNamespaceBackup(ctx context.Context, objects []map[string]interface{}, directory filesinterfaces.Directory, options *kubernetesparameteroptions.BackupNamespaceOptions) ([]*ObjectReference, error) {
+	if directory == nil {
+		return nil, tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	path, hostDescription, err := directory.GetPathAndHostDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := directory.Exists(contextutils.WithSilent(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		isEmpty, err := directory.IsEmptyDirectory(contextutils.WithSilent(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		if !isEmpty {
+			if !options.OverwriteExistingBackup {
+				return nil, tracederrors.TracedErrorf("Backup directory '%s' on '%s' is not empty. Use OverwriteExistingBackup to replace the existing backup.", path, hostDescription)
+			}
+
+			err = directory.Delete(ctx, &filesoptions.DeleteOptions{})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = directory.Create(contextutils.WithSilent(ctx), &filesoptions.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	exported := []*ObjectReference{}
+	for _, object := range objects {
+		if !IsObjectIncludedInBackup(object) {
+			continue
+		}
+
+		kind, name := getObjectKindAndName(object)
+		if !IsKindIncludedInBackup(kind, options) {
+			continue
+		}
+
+		err = CleanObjectForBackup(object)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := yamlutils.DataToYamlString(object)
+		if err != nil {
+			return nil, err
+		}
+
+		encrypt := kind == "Secret" && options.IsSecretEncryptionEnabled()
+		if encrypt {
+			encrypted, err := nativegnupg.Encrypt(contextutils.WithSilent(ctx), []byte(content), options.SecretEncryptionPublicKeys)
+			if err != nil {
+				return nil, err
+			}
+
+			content = string(encrypted)
+		}
+
+		fileName, err := GetBackupFileName(kind, name, encrypt)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = directory.WriteStringToFile(contextutils.WithSilent(ctx), fileName, content, &filesoptions.WriteOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		apiVersion, _ := object["apiVersion"].(string)
+		exported = append(exported, &ObjectReference{ApiVersion: apiVersion, Kind: kind, Name: name})
+	}
+
+	logging.LogChangedByCtxf(ctx, "Wrote '%d' objects into backup directory '%s' on '%s'.", len(exported), path, hostDescription)
+
+	return exported, nil
+}
+
+// Reads all objects of a namespace backup written by WriteNamespaceBackup.
+// Encrypted Secrets are decrypted using the SecretDecryptionPrivateKey of the options.
+//
+// Returns the objects as multi document YAML sorted by GetBackupRestoreKindOrder.
+func ReadNamespaceBackup(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.RestoreNamespaceOptions) (string, error) {
+	if directory == nil {
+		return "", tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return "", tracederrors.TracedErrorNil("options")
+	}
+
+	path, hostDescription, err := directory.GetPathAndHostDescription()
+	if err != nil {
+		return "", err
+	}
+
+	fileNames, err := directory.ListFilePaths(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.ListFileOptions{
+			NonRecursive:        true,
+			OnlyFiles:           true,
+			ReturnRelativePaths: true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	sort.Strings(fileNames)
+
+	entries := []*ObjectYamlEntry{}
+	for _, fileName := range fileNames {
+		isEncrypted := strings.HasSuffix(fileName, EncryptedBackupFileSuffix)
+		if !isEncrypted && !strings.HasSuffix(fileName, BackupFileSuffix) {
+			logging.LogInfoByCtxf(ctx, "Skip '%s' in backup directory '%s' on '%s' since it is not a backup file.", fileName, path, hostDescription)
+			continue
+		}
+
+		content, err := directory.ReadFileInDirectoryAsString(contextutils.WithSilent(ctx), fileName)
+		if err != nil {
+			return "", err
+		}
+
+		if isEncrypted {
+			privateKey, err := options.GetSecretDecryptionPrivateKey()
+			if err != nil {
+				return "", tracederrors.TracedErrorf("Unable to decrypt '%s' in backup directory '%s' on '%s': %w", fileName, path, hostDescription, err)
+			}
+
+			decrypted, err := nativegnupg.Decrypt(contextutils.WithSilent(ctx), []byte(content), privateKey)
+			if err != nil {
+				return "", err
+			}
+
+			content = string(decrypted)
+		}
+
+		entry := &ObjectYamlEntry{Content: content}
+		err = entry.Validate()
+		if err != nil {
+			return "", tracederrors.TracedErrorf("Invalid object in '%s' of backup directory '%s' on '%s': %w", fileName, path, hostDescription, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return "", tracederrors.TracedErrorf("No objects found in backup directory '%s' on '%s'.", path, hostDescription)
+	}
+
+	SortObjectYamlEntriesByDependencyOrder(entries)
+
+	logging.LogInfoByCtxf(ctx, "Read '%d' objects from backup directory '%s' on '%s'.", len(entries), path, hostDescription)
+
+	return MarshalObjectYaml(entries)
+}
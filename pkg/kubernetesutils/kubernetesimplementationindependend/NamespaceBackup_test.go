@@ -0,0 +1,364 @@
+package kubernetesimplementationindependend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/gnupgoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/nativegnupg"
+)
+
+func getBackupTestObjects() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":              "app",
+				"namespace":         "source",
+				"uid":               "1234",
+				"resourceVersion":   "42",
+				"creationTimestamp": "2024-01-01T00:00:00Z",
+				"generation":        int64(3),
+				"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+				"annotations": map[string]interface{}{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(1),
+			},
+			"status": map[string]interface{}{
+				"readyReplicas": int64(1),
+			},
+		},
+		{
+			"apiVersion": "apps/v1",
+			"kind":       "ReplicaSet",
+			"metadata": map[string]interface{}{
+				"name":            "app-abc",
+				"ownerReferences": []interface{}{map[string]interface{}{"kind": "Deployment", "name": "app"}},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name": "app",
+			},
+			"spec": map[string]interface{}{
+				"clusterIP":  "10.0.0.1",
+				"clusterIPs": []interface{}{"10.0.0.1"},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": "kube-root-ca.crt",
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": "config",
+			},
+			"data": map[string]interface{}{
+				"key": "value",
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name": "secret",
+			},
+			"data": map[string]interface{}{
+				"password": "c2VjcmV0",
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]interface{}{
+				"name": "app.123",
+			},
+		},
+	}
+}
+
+func Test_CleanObjectForBackup(t *testing.T) {
+	objects := getBackupTestObjects()
+
+	deployment := objects[0]
+	err := kubernetesimplementationindependend.CleanObjectForBackup(deployment)
+	require.NoError(t, err)
+
+	require.EqualValues(
+		t,
+		map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name": "app",
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(1),
+			},
+		},
+		deployment,
+	)
+
+	service := objects[2]
+	err = kubernetesimplementationindependend.CleanObjectForBackup(service)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]interface{}{}, service["spec"])
+
+	headless := map[string]interface{}{
+		"kind": "Service",
+		"spec": map[string]interface{}{"clusterIP": "None"},
+	}
+	err = kubernetesimplementationindependend.CleanObjectForBackup(headless)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]interface{}{"clusterIP": "None"}, headless["spec"])
+
+	pvc := map[string]interface{}{
+		"kind": "PersistentVolumeClaim",
+		"metadata": map[string]interface{}{
+			"name": "data",
+			"annotations": map[string]interface{}{
+				"pv.kubernetes.io/bind-completed":      "yes",
+				"pv.kubernetes.io/bound-by-controller": "yes",
+				"example.com/keep":                     "true",
+			},
+		},
+		"spec": map[string]interface{}{
+			"accessModes": []interface{}{"ReadWriteOnce"},
+			"volumeName":  "pvc-1234",
+		},
+	}
+	err = kubernetesimplementationindependend.CleanObjectForBackup(pvc)
+	require.NoError(t, err)
+	require.EqualValues(
+		t,
+		map[string]interface{}{
+			"kind": "PersistentVolumeClaim",
+			"metadata": map[string]interface{}{
+				"name":        "data",
+				"annotations": map[string]interface{}{"example.com/keep": "true"},
+			},
+			"spec": map[string]interface{}{
+				"accessModes": []interface{}{"ReadWriteOnce"},
+			},
+		},
+		pvc,
+	)
+
+	pod := map[string]interface{}{
+		"kind": "Pod",
+		"metadata": map[string]interface{}{
+			"name": "standalone",
+		},
+		"spec": map[string]interface{}{
+			"nodeName":   "kind-worker",
+			"containers": []interface{}{map[string]interface{}{"name": "main", "image": "ubuntu"}},
+		},
+	}
+	err = kubernetesimplementationindependend.CleanObjectForBackup(pod)
+	require.NoError(t, err)
+	require.EqualValues(
+		t,
+		map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "main", "image": "ubuntu"}},
+		},
+		pod["spec"],
+	)
+
+	job := map[string]interface{}{
+		"kind": "Job",
+		"metadata": map[string]interface{}{
+			"name": "migrate",
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"batch.kubernetes.io/controller-uid": "1234"},
+			},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"app":                                "migrate",
+						"controller-uid":                     "1234",
+						"batch.kubernetes.io/controller-uid": "1234",
+						"job-name":                           "migrate",
+						"batch.kubernetes.io/job-name":       "migrate",
+					},
+				},
+			},
+		},
+	}
+	err = kubernetesimplementationindependend.CleanObjectForBackup(job)
+	require.NoError(t, err)
+	require.EqualValues(
+		t,
+		map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "migrate"},
+				},
+			},
+		},
+		job["spec"],
+	)
+
+	// A manually defined selector is kept since the pod template labels have to match it:
+	manualSelectorJob := map[string]interface{}{
+		"kind": "Job",
+		"spec": map[string]interface{}{
+			"manualSelector": true,
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "migrate"},
+			},
+		},
+	}
+	err = kubernetesimplementationindependend.CleanObjectForBackup(manualSelectorJob)
+	require.NoError(t, err)
+	require.Contains(t, manualSelectorJob["spec"], "selector")
+}
+
+func Test_IsObjectIncludedInBackup(t *testing.T) {
+	objects := getBackupTestObjects()
+
+	included := []string{}
+	for _, o := range objects {
+		if kubernetesimplementationindependend.IsObjectIncludedInBackup(o) {
+			included = append(included, o["kind"].(string)+"/"+o["metadata"].(map[string]interface{})["name"].(string))
+		}
+	}
+
+	require.EqualValues(t, []string{"Deployment/app", "Service/app", "ConfigMap/config", "Secret/secret", "Event/app.123"}, included)
+}
+
+func Test_IsKindIncludedInBackup(t *testing.T) {
+	require.True(t, kubernetesimplementationindependend.IsKindIncludedInBackup("Deployment", &kubernetesparameteroptions.BackupNamespaceOptions{}))
+	require.False(t, kubernetesimplementationindependend.IsKindIncludedInBackup("Event", &kubernetesparameteroptions.BackupNamespaceOptions{}))
+	require.True(t, kubernetesimplementationindependend.IsKindIncludedInBackup("Event", &kubernetesparameteroptions.BackupNamespaceOptions{Kinds: []string{"Event"}}))
+	require.False(t, kubernetesimplementationindependend.IsKindIncludedInBackup("Deployment", &kubernetesparameteroptions.BackupNamespaceOptions{Kinds: []string{"ConfigMap"}}))
+}
+
+func Test_SortObjectYamlEntriesByDependencyOrder(t *testing.T) {
+	entries := []*kubernetesimplementationindependend.ObjectYamlEntry{
+		{Content: "kind: Ingress\nmetadata:\n  name: a\n"},
+		{Content: "kind: MyCustomResource\nmetadata:\n  name: b\n"},
+		{Content: "kind: Deployment\nmetadata:\n  name: c\n"},
+		{Content: "kind: Secret\nmetadata:\n  name: d\n"},
+		{Content: "kind: ServiceAccount\nmetadata:\n  name: e\n"},
+	}
+
+	kubernetesimplementationindependend.SortObjectYamlEntriesByDependencyOrder(entries)
+
+	kinds := []string{}
+	for _, e := range entries {
+		kinds = append(kinds, e.Kind())
+	}
+
+	require.EqualValues(t, []string{"ServiceAccount", "Secret", "Deployment", "Ingress", "MyCustomResource"}, kinds)
+}
+
+func Test_WriteAndReadNamespaceBackup(t *testing.T) {
+	ctx := contextutils.WithVerbose(context.TODO())
+
+	privateKey, publicKey, err := nativegnupg.GenerateKeyPair(ctx, &gnupgoptions.GenerateKeyPairOptions{
+		Name:    "backup",
+		Email:   "backup@example.com",
+		Comment: "Backup key",
+		RSABits: 1024,
+	})
+	require.NoError(t, err)
+
+	t.Run("plain text", func(t *testing.T) {
+		directory, err := tempfilesoo.CreateEmptyTemporaryDirectory(ctx)
+		require.NoError(t, err)
+		defer directory.Delete(ctx, &filesoptions.DeleteOptions{})
+
+		exported, err := kubernetesimplementationindependend.WriteNamespaceBackup(ctx, getBackupTestObjects(), directory, &kubernetesparameteroptions.BackupNamespaceOptions{})
+		require.NoError(t, err)
+		require.Len(t, exported, 4)
+
+		fileNames, err := directory.ListFilePaths(ctx, &parameteroptions.ListFileOptions{ReturnRelativePaths: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"ConfigMap_config.yaml", "Deployment_app.yaml", "Secret_secret.yaml", "Service_app.yaml"}, fileNames)
+
+		secret, err := directory.ReadFileInDirectoryAsString(ctx, "Secret_secret.yaml")
+		require.NoError(t, err)
+		require.Contains(t, secret, "c2VjcmV0")
+
+		yamlString, err := kubernetesimplementationindependend.ReadNamespaceBackup(ctx, directory, &kubernetesparameteroptions.RestoreNamespaceOptions{})
+		require.NoError(t, err)
+
+		entries, err := kubernetesimplementationindependend.UnmarshalObjectYaml(yamlString)
+		require.NoError(t, err)
+
+		kinds := []string{}
+		for _, e := range entries {
+			kinds = append(kinds, e.Kind())
+		}
+		require.EqualValues(t, []string{"Secret", "ConfigMap", "Service", "Deployment"}, kinds)
+	})
+
+	t.Run("not empty directory", func(t *testing.T) {
+		directory, err := tempfilesoo.CreateEmptyTemporaryDirectory(ctx)
+		require.NoError(t, err)
+		defer directory.Delete(ctx, &filesoptions.DeleteOptions{})
+
+		_, err = kubernetesimplementationindependend.WriteNamespaceBackup(ctx, getBackupTestObjects(), directory, &kubernetesparameteroptions.BackupNamespaceOptions{})
+		require.NoError(t, err)
+
+		_, err = kubernetesimplementationindependend.WriteNamespaceBackup(ctx, getBackupTestObjects(), directory, &kubernetesparameteroptions.BackupNamespaceOptions{})
+		require.Error(t, err)
+
+		exported, err := kubernetesimplementationindependend.WriteNamespaceBackup(ctx, getBackupTestObjects(), directory, &kubernetesparameteroptions.BackupNamespaceOptions{
+			Kinds:                   []string{"ConfigMap"},
+			OverwriteExistingBackup: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, exported, 1)
+
+		fileNames, err := directory.ListFilePaths(ctx, &parameteroptions.ListFileOptions{ReturnRelativePaths: true})
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"ConfigMap_config.yaml"}, fileNames)
+	})
+
+	t.Run("encrypted secrets", func(t *testing.T) {
+		directory, err := tempfilesoo.CreateEmptyTemporaryDirectory(ctx)
+		require.NoError(t, err)
+		defer directory.Delete(ctx, &filesoptions.DeleteOptions{})
+
+		_, err = kubernetesimplementationindependend.WriteNamespaceBackup(ctx, getBackupTestObjects(), directory, &kubernetesparameteroptions.BackupNamespaceOptions{
+			SecretEncryptionPublicKeys: [][]byte{publicKey},
+		})
+		require.NoError(t, err)
+
+		secret, err := directory.ReadFileInDirectoryAsString(ctx, "Secret_secret.yaml.asc")
+		require.NoError(t, err)
+		require.Contains(t, secret, "-----BEGIN PGP MESSAGE-----")
+		require.NotContains(t, secret, "c2VjcmV0")
+
+		// Restoring encrypted secrets requires the private key:
+		_, err = kubernetesimplementationindependend.ReadNamespaceBackup(ctx, directory, &kubernetesparameteroptions.RestoreNamespaceOptions{})
+		require.Error(t, err)
+
+		yamlString, err := kubernetesimplementationindependend.ReadNamespaceBackup(ctx, directory, &kubernetesparameteroptions.RestoreNamespaceOptions{
+			SecretDecryptionPrivateKey: privateKey,
+		})
+		require.NoError(t, err)
+		require.Contains(t, yamlString, "c2VjcmV0")
+	})
+}
@@ -0,0 +1,586 @@
+package kubernetesimplementationindependend
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/spreadsheet"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Value rendered for unknown or empty cells in the diagnostics tables.
+const diagnosticsEmptyCell = "-"
+
+// CPU and memory usage as reported by the metrics-server.
+type ResourceUsage struct {
+	CPUMilliCores int64
+	MemoryBytes   int64
+}
+
+func (r *ResourceUsage) Add(other *ResourceUsage) {
+	if other == nil {
+		return
+	}
+
+	r.CPUMilliCores += other.CPUMilliCores
+	r.MemoryBytes += other.MemoryBytes
+}
+
+// Returns the CPU usage like "250m".
+func (r *ResourceUsage) GetCPUAsString() string {
+	return fmt.Sprintf("%dm", r.CPUMilliCores)
+}
+
+// Returns the memory usage like "64Mi".
+func (r *ResourceUsage) GetMemoryAsString() string {
+	return fmt.Sprintf("%dMi", r.MemoryBytes/(1024*1024))
+}
+
+type ContainerDiagnostics struct {
+	Name         string
+	Ready        bool
+	RestartCount int64
+
+	// Reason the container is currently not running like "CrashLoopBackOff" or "ImagePullBackOff". Empty if running.
+	WaitingOrTerminatedReason string
+
+	// Reason and exit code of the last termination like "OOMKilled" and 137. Empty if the container never terminated.
+	LastTerminationReason   string
+	LastTerminationExitCode int64
+}
+
+type PodDiagnostics struct {
+	Name     string
+	Phase    string
+	NodeName string
+
+	// Reason reported on the pod itself like "Evicted" or "Unschedulable".
+	Reason  string
+	Message string
+
+	Containers []*ContainerDiagnostics
+
+	// Nil if the metrics-server is not available.
+	ResourceUsage *ResourceUsage
+}
+
+type WarningEvent struct {
+	InvolvedObjectKind string
+	InvolvedObjectName string
+	Reason             string
+	Message            string
+	Count              int64
+	LastSeen           time.Time
+}
+
+type PendingPersistentVolumeClaim struct {
+	Name             string
+	Phase            string
+	StorageClassName string
+}
+
+// Summary of the state of a namespace used to answer "why is my pod not running".
+type NamespaceDiagnostics struct {
+	NamespaceName                 string
+	Pods                          []*PodDiagnostics
+	WarningEvents                 []*WarningEvent
+	PendingPersistentVolumeClaims []*PendingPersistentVolumeClaim
+
+	// True if the CPU and memory usage was collected from the metrics-server.
+	ResourceUsageAvailable bool
+
+	// True if querying the metrics-server was skipped by NamespaceDiagnosticsOptions.SkipResourceUsage.
+	ResourceUsageSkipped bool
+}
+
+// Values are decoded as int64 by the kubernetes client but as float64 when parsing JSON output of kubectl.
+func getNestedInt64(object map[string]interface{}, fields ...string) int64 {
+	value, found, err := unstructured.NestedFieldNoCopy(object, fields...)
+	if err != nil || !found {
+		return 0
+	}
+
+	switch v := value.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float64:
+		return int64(v)
+	}
+
+	return 0
+}
+
+func getNestedString(object map[string]interface{}, fields ...string) string {
+	value, _, _ := unstructured.NestedString(object, fields...)
+	return value
+}
+
+func getNestedSlice(object map[string]interface{}, fields ...string) []map[string]interface{} {
+	value, found, err := unstructured.NestedFieldNoCopy(object, fields...)
+	if err != nil || !found {
+		return nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	ret := []map[string]interface{}{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if ok {
+			ret = append(ret, m)
+		}
+	}
+
+	return ret
+}
+
+func getContainerDiagnosticsFromStatus(status map[string]interface{}) *ContainerDiagnostics {
+	ready, _, _ := unstructured.NestedBool(status, "ready")
+
+	container := &ContainerDiagnostics{
+		Name:         getNestedString(status, "name"),
+		Ready:        ready,
+		RestartCount: getNestedInt64(status, "restartCount"),
+	}
+
+	if reason := getNestedString(status, "state", "waiting", "reason"); reason != "" {
+		container.WaitingOrTerminatedReason = reason
+	} else if reason := getNestedString(status, "state", "terminated", "reason"); reason != "" {
+		container.WaitingOrTerminatedReason = reason
+	}
+
+	container.LastTerminationReason = getNestedString(status, "lastState", "terminated", "reason")
+	container.LastTerminationExitCode = getNestedInt64(status, "lastState", "terminated", "exitCode")
+
+	return container
+}
+
+// Collects the diagnostics of a pod given as unstructured object.
+func GetPodDiagnostics(pod map[string]interface{}) (*PodDiagnostics, error) {
+	if pod == nil {
+		return nil, tracederrors.TracedErrorNil("pod")
+	}
+
+	name := getNestedString(pod, "metadata", "name")
+	if name == "" {
+		return nil, tracederrors.TracedError("Pod has no name")
+	}
+
+	diagnostics := &PodDiagnostics{
+		Name:     name,
+		Phase:    getNestedString(pod, "status", "phase"),
+		NodeName: getNestedString(pod, "spec", "nodeName"),
+		Reason:   getNestedString(pod, "status", "reason"),
+		Message:  getNestedString(pod, "status", "message"),
+	}
+
+	// Pods which can not be scheduled only report the reason in the PodScheduled condition:
+	if diagnostics.Reason == "" {
+		for _, condition := range getNestedSlice(pod, "status", "conditions") {
+			if getNestedString(condition, "type") == "PodScheduled" && getNestedString(condition, "status") == "False" {
+				diagnostics.Reason = getNestedString(condition, "reason")
+				diagnostics.Message = getNestedString(condition, "message")
+			}
+		}
+	}
+
+	for _, statusField := range []string{"initContainerStatuses", "containerStatuses"} {
+		for _, status := range getNestedSlice(pod, "status", statusField) {
+			diagnostics.Containers = append(diagnostics.Containers, getContainerDiagnosticsFromStatus(status))
+		}
+	}
+
+	return diagnostics, nil
+}
+
+// Returns true if the pod completed successfully or is running with all containers ready.
+func (p *PodDiagnostics) IsHealthy() bool {
+	if p.Phase == "Succeeded" {
+		return true
+	}
+
+	if p.Phase != "Running" {
+		return false
+	}
+
+	for _, c := range p.Containers {
+		if !c.Ready && c.WaitingOrTerminatedReason != "Completed" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *PodDiagnostics) GetRestartCount() int64 {
+	var restarts int64
+	for _, c := range p.Containers {
+		restarts += c.RestartCount
+	}
+
+	return restarts
+}
+
+// Returns the ready containers like "1/2". Init containers which completed count as ready.
+func (p *PodDiagnostics) GetReadyAsString() string {
+	ready := 0
+	for _, c := range p.Containers {
+		if c.Ready || c.WaitingOrTerminatedReason == "Completed" {
+			ready++
+		}
+	}
+
+	return fmt.Sprintf("%d/%d", ready, len(p.Containers))
+}
+
+// Returns the most relevant reason why the pod is not healthy like "CrashLoopBackOff" or "Unschedulable".
+func (p *PodDiagnostics) GetStatusReason() string {
+	for _, c := range p.Containers {
+		if c.WaitingOrTerminatedReason != "" && c.WaitingOrTerminatedReason != "Completed" {
+			return c.WaitingOrTerminatedReason
+		}
+	}
+
+	return p.Reason
+}
+
+// Returns the last termination like "OOMKilled (exit code 137)" of the most recently failing container.
+func (p *PodDiagnostics) GetLastTerminationAsString() string {
+	for _, c := range p.Containers {
+		if c.LastTerminationReason != "" {
+			return fmt.Sprintf("%s (exit code %d)", c.LastTerminationReason, c.LastTerminationExitCode)
+		}
+	}
+
+	return ""
+}
+
+// Returns the warning event given as unstructured object or nil if it is a normal event.
+func GetWarningEvent(event map[string]interface{}) (*WarningEvent, error) {
+	if event == nil {
+		return nil, tracederrors.TracedErrorNil("event")
+	}
+
+	if getNestedString(event, "type") != "Warning" {
+		return nil, nil
+	}
+
+	warning := &WarningEvent{
+		InvolvedObjectKind: getNestedString(event, "involvedObject", "kind"),
+		InvolvedObjectName: getNestedString(event, "involvedObject", "name"),
+		Reason:             getNestedString(event, "reason"),
+		Message:            strings.TrimSpace(getNestedString(event, "message")),
+		Count:              getNestedInt64(event, "count"),
+	}
+
+	// Events created by the events.k8s.io API only set the eventTime:
+	for _, timestampField := range [][]string{{"lastTimestamp"}, {"eventTime"}, {"series", "lastObservedTime"}, {"metadata", "creationTimestamp"}} {
+		timestamp := getNestedString(event, timestampField...)
+		if timestamp == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Unable to parse event timestamp '%s': %w", timestamp, err)
+		}
+
+		if parsed.After(warning.LastSeen) {
+			warning.LastSeen = parsed
+		}
+	}
+
+	if warning.Count == 0 {
+		warning.Count = 1
+	}
+
+	return warning, nil
+}
+
+// Returns the PersistentVolumeClaim given as unstructured object if it is not bound. Otherwise nil is returned.
+func GetPendingPersistentVolumeClaim(persistentVolumeClaim map[string]interface{}) (*PendingPersistentVolumeClaim, error) {
+	if persistentVolumeClaim == nil {
+		return nil, tracederrors.TracedErrorNil("persistentVolumeClaim")
+	}
+
+	phase := getNestedString(persistentVolumeClaim, "status", "phase")
+	if phase == "Bound" {
+		return nil, nil
+	}
+
+	if phase == "" {
+		phase = "Pending"
+	}
+
+	return &PendingPersistentVolumeClaim{
+		Name:             getNestedString(persistentVolumeClaim, "metadata", "name"),
+		Phase:            phase,
+		StorageClassName: getNestedString(persistentVolumeClaim, "spec", "storageClassName"),
+	}, nil
+}
+
+// Sums up the container usages of the "PodMetricsList" returned by the metrics-server per pod name.
+func GetResourceUsageByPodName(podMetricsList map[string]interface{}) (map[string]*ResourceUsage, error) {
+	if podMetricsList == nil {
+		return nil, tracederrors.TracedErrorNil("podMetricsList")
+	}
+
+	usages := map[string]*ResourceUsage{}
+	for _, podMetrics := range getNestedSlice(podMetricsList, "items") {
+		podName := getNestedString(podMetrics, "metadata", "name")
+
+		podUsage := &ResourceUsage{}
+		for _, container := range getNestedSlice(podMetrics, "containers") {
+			for _, r := range []struct {
+				name   string
+				target *int64
+				scale  func(q resource.Quantity) int64
+			}{
+				{"cpu", &podUsage.CPUMilliCores, func(q resource.Quantity) int64 { return q.MilliValue() }},
+				{"memory", &podUsage.MemoryBytes, func(q resource.Quantity) int64 { return q.Value() }},
+			} {
+				value := getNestedString(container, "usage", r.name)
+				if value == "" {
+					continue
+				}
+
+				quantity, err := resource.ParseQuantity(value)
+				if err != nil {
+					return nil, tracederrors.TracedErrorf("Invalid %s usage '%s' of pod '%s': %w", r.name, value, podName, err)
+				}
+
+				*r.target += r.scale(quantity)
+			}
+		}
+
+		usages[podName] = podUsage
+	}
+
+	return usages, nil
+}
+
+// Builds the diagnostics of a namespace from the unstructured pods, events and PersistentVolumeClaims.
+// 'resourceUsageByPodName' is nil if the metrics-server is not available.
+func GetNamespaceDiagnostics(namespaceName string, pods []map[string]interface{}, events []map[string]interface{}, persistentVolumeClaims []map[string]interface{}, resourceUsageByPodName map[string]*ResourceUsage, options *kubernetesparameteroptions.NamespaceDiagnosticsOptions) (*NamespaceDiagnostics, error) {
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	diagnostics := &NamespaceDiagnostics{
+		NamespaceName:          namespaceName,
+		ResourceUsageAvailable: resourceUsageByPodName != nil,
+		ResourceUsageSkipped:   options.SkipResourceUsage,
+	}
+
+	for _, pod := range pods {
+		podDiagnostics, err := GetPodDiagnostics(pod)
+		if err != nil {
+			return nil, err
+		}
+
+		if resourceUsageByPodName != nil {
+			podDiagnostics.ResourceUsage = resourceUsageByPodName[podDiagnostics.Name]
+		}
+
+		diagnostics.Pods = append(diagnostics.Pods, podDiagnostics)
+	}
+
+	sort.SliceStable(diagnostics.Pods, func(i, j int) bool {
+		return diagnostics.Pods[i].Name < diagnostics.Pods[j].Name
+	})
+
+	since := time.Now().Add(-options.GetEventsSinceOrDefault())
+	for _, event := range events {
+		warning, err := GetWarningEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if warning == nil || warning.LastSeen.Before(since) {
+			continue
+		}
+
+		diagnostics.WarningEvents = append(diagnostics.WarningEvents, warning)
+	}
+
+	// Most recent events first:
+	sort.SliceStable(diagnostics.WarningEvents, func(i, j int) bool {
+		return diagnostics.WarningEvents[i].LastSeen.After(diagnostics.WarningEvents[j].LastSeen)
+	})
+
+	for _, persistentVolumeClaim := range persistentVolumeClaims {
+		pending, err := GetPendingPersistentVolumeClaim(persistentVolumeClaim)
+		if err != nil {
+			return nil, err
+		}
+
+		if pending != nil {
+			diagnostics.PendingPersistentVolumeClaims = append(diagnostics.PendingPersistentVolumeClaims, pending)
+		}
+	}
+
+	return diagnostics, nil
+}
+
+func (n *NamespaceDiagnostics) GetUnhealthyPodNames() []string {
+	names := []string{}
+	for _, p := range n.Pods {
+		if !p.IsHealthy() {
+			names = append(names, p.Name)
+		}
+	}
+
+	return names
+}
+
+func orEmptyCell(value string) string {
+	if value == "" {
+		return diagnosticsEmptyCell
+	}
+
+	return value
+}
+
+func newSpreadSheetWithTitles(titles []string) (*spreadsheet.SpreadSheet, error) {
+	s := spreadsheet.NewSpreadSheet()
+
+	err := s.SetColumnTitles(titles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (n *NamespaceDiagnostics) GetPodsSpreadSheet() (*spreadsheet.SpreadSheet, error) {
+	s, err := newSpreadSheetWithTitles([]string{"POD", "PHASE", "READY", "RESTARTS", "STATUS", "LAST_TERMINATION", "CPU", "MEMORY", "NODE"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range n.Pods {
+		cpu, memory := diagnosticsEmptyCell, diagnosticsEmptyCell
+		if p.ResourceUsage != nil {
+			cpu = p.ResourceUsage.GetCPUAsString()
+			memory = p.ResourceUsage.GetMemoryAsString()
+		}
+
+		err = s.AddRow([]string{
+			p.Name,
+			orEmptyCell(p.Phase),
+			p.GetReadyAsString(),
+			fmt.Sprintf("%d", p.GetRestartCount()),
+			orEmptyCell(p.GetStatusReason()),
+			orEmptyCell(p.GetLastTerminationAsString()),
+			cpu,
+			memory,
+			orEmptyCell(p.NodeName),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (n *NamespaceDiagnostics) GetWarningEventsSpreadSheet() (*spreadsheet.SpreadSheet, error) {
+	s, err := newSpreadSheetWithTitles([]string{"LAST_SEEN", "OBJECT", "REASON", "COUNT", "MESSAGE"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range n.WarningEvents {
+		err = s.AddRow([]string{
+			e.LastSeen.Format(time.RFC3339),
+			orEmptyCell(e.InvolvedObjectKind + "/" + e.InvolvedObjectName),
+			orEmptyCell(e.Reason),
+			fmt.Sprintf("%d", e.Count),
+			orEmptyCell(e.Message),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (n *NamespaceDiagnostics) GetPendingPersistentVolumeClaimsSpreadSheet() (*spreadsheet.SpreadSheet, error) {
+	s, err := newSpreadSheetWithTitles([]string{"PERSISTENT_VOLUME_CLAIM", "PHASE", "STORAGE_CLASS"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range n.PendingPersistentVolumeClaims {
+		err = s.AddRow([]string{p.Name, p.Phase, orEmptyCell(p.StorageClassName)})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Renders the pods, the recent warning events and the pending PersistentVolumeClaims as tables.
+func (n *NamespaceDiagnostics) RenderAsString() (string, error) {
+	renderOptions := &spreadsheet.SpreadSheetRenderOptions{
+		SameColumnWidthForAllRows: true,
+		TitleUnderline:            "-",
+		IncludeTitleInColumnWidth: true,
+	}
+
+	rendered := fmt.Sprintf("Namespace '%s': %d pods, %d unhealthy.\n", n.NamespaceName, len(n.Pods), len(n.GetUnhealthyPodNames()))
+	if !n.ResourceUsageAvailable && !n.ResourceUsageSkipped {
+		rendered += "CPU and memory usage not available since the metrics-server is not installed.\n"
+	}
+
+	sections := []struct {
+		title    string
+		nEntries int
+		get      func() (*spreadsheet.SpreadSheet, error)
+	}{
+		{"Pods", len(n.Pods), n.GetPodsSpreadSheet},
+		{"Warning events", len(n.WarningEvents), n.GetWarningEventsSpreadSheet},
+		{"Pending PersistentVolumeClaims", len(n.PendingPersistentVolumeClaims), n.GetPendingPersistentVolumeClaimsSpreadSheet},
+	}
+
+	for _, section := range sections {
+		rendered += "\n" + section.title + ":\n"
+
+		if section.nEntries == 0 {
+			rendered += "  none\n"
+			continue
+		}
+
+		s, err := section.get()
+		if err != nil {
+			return "", err
+		}
+
+		table, err := s.RenderAsString(renderOptions)
+		if err != nil {
+			return "", err
+		}
+
+		rendered += table
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,186 @@
+package kubernetesimplementationindependend_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+)
+
+func getDiagnosticsTestPods() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"metadata": map[string]interface{}{"name": "web-1"},
+			"spec":     map[string]interface{}{"nodeName": "node-a"},
+			"status": map[string]interface{}{
+				"phase": "Running",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{
+						"name":         "web",
+						"ready":        false,
+						"restartCount": int64(4),
+						"state": map[string]interface{}{
+							"waiting": map[string]interface{}{"reason": "CrashLoopBackOff"},
+						},
+						"lastState": map[string]interface{}{
+							"terminated": map[string]interface{}{"reason": "OOMKilled", "exitCode": int64(137)},
+						},
+					},
+					map[string]interface{}{
+						"name":         "sidecar",
+						"ready":        true,
+						"restartCount": float64(1),
+						"state": map[string]interface{}{
+							"running": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
+		{
+			"metadata": map[string]interface{}{"name": "db-0"},
+			"status": map[string]interface{}{
+				"phase": "Pending",
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":    "PodScheduled",
+						"status":  "False",
+						"reason":  "Unschedulable",
+						"message": "0/1 nodes are available: pod has unbound immediate PersistentVolumeClaims.",
+					},
+				},
+			},
+		},
+		{
+			"metadata": map[string]interface{}{"name": "api-1"},
+			"status": map[string]interface{}{
+				"phase": "Running",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{
+						"name":  "api",
+						"ready": true,
+						"state": map[string]interface{}{"running": map[string]interface{}{}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_GetPodDiagnostics(t *testing.T) {
+	pods := getDiagnosticsTestPods()
+
+	web, err := kubernetesimplementationindependend.GetPodDiagnostics(pods[0])
+	require.NoError(t, err)
+	require.False(t, web.IsHealthy())
+	require.EqualValues(t, 5, web.GetRestartCount())
+	require.EqualValues(t, "1/2", web.GetReadyAsString())
+	require.EqualValues(t, "CrashLoopBackOff", web.GetStatusReason())
+	require.EqualValues(t, "OOMKilled (exit code 137)", web.GetLastTerminationAsString())
+
+	db, err := kubernetesimplementationindependend.GetPodDiagnostics(pods[1])
+	require.NoError(t, err)
+	require.False(t, db.IsHealthy())
+	require.EqualValues(t, "Unschedulable", db.GetStatusReason())
+
+	api, err := kubernetesimplementationindependend.GetPodDiagnostics(pods[2])
+	require.NoError(t, err)
+	require.True(t, api.IsHealthy())
+	require.EqualValues(t, "", api.GetStatusReason())
+}
+
+func Test_GetResourceUsageByPodName(t *testing.T) {
+	usages, err := kubernetesimplementationindependend.GetResourceUsageByPodName(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web-1"},
+				"containers": []interface{}{
+					map[string]interface{}{"name": "web", "usage": map[string]interface{}{"cpu": "250000000n", "memory": "65536Ki"}},
+					map[string]interface{}{"name": "sidecar", "usage": map[string]interface{}{"cpu": "5m", "memory": "16Mi"}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	require.EqualValues(t, "255m", usages["web-1"].GetCPUAsString())
+	require.EqualValues(t, "80Mi", usages["web-1"].GetMemoryAsString())
+}
+
+func Test_GetNamespaceDiagnostics(t *testing.T) {
+	now := time.Now()
+
+	events := []map[string]interface{}{
+		{
+			"type":           "Warning",
+			"reason":         "BackOff",
+			"message":        "Back-off restarting failed container web",
+			"count":          int64(12),
+			"lastTimestamp":  now.Add(-time.Minute).UTC().Format(time.RFC3339),
+			"involvedObject": map[string]interface{}{"kind": "Pod", "name": "web-1"},
+		},
+		{
+			"type":           "Warning",
+			"reason":         "FailedScheduling",
+			"message":        "0/1 nodes are available",
+			"eventTime":      now.Add(-2 * time.Minute).UTC().Format(time.RFC3339Nano),
+			"involvedObject": map[string]interface{}{"kind": "Pod", "name": "db-0"},
+		},
+		{
+			"type":           "Warning",
+			"reason":         "Old",
+			"lastTimestamp":  now.Add(-2 * time.Hour).UTC().Format(time.RFC3339),
+			"involvedObject": map[string]interface{}{"kind": "Pod", "name": "web-1"},
+		},
+		{
+			"type":           "Normal",
+			"reason":         "Pulled",
+			"lastTimestamp":  now.UTC().Format(time.RFC3339),
+			"involvedObject": map[string]interface{}{"kind": "Pod", "name": "api-1"},
+		},
+	}
+
+	persistentVolumeClaims := []map[string]interface{}{
+		{
+			"metadata": map[string]interface{}{"name": "data-db-0"},
+			"spec":     map[string]interface{}{"storageClassName": "fast"},
+			"status":   map[string]interface{}{"phase": "Pending"},
+		},
+		{
+			"metadata": map[string]interface{}{"name": "bound"},
+			"status":   map[string]interface{}{"phase": "Bound"},
+		},
+	}
+
+	diagnostics, err := kubernetesimplementationindependend.GetNamespaceDiagnostics(
+		"example",
+		getDiagnosticsTestPods(),
+		events,
+		persistentVolumeClaims,
+		nil,
+		&kubernetesparameteroptions.NamespaceDiagnosticsOptions{},
+	)
+	require.NoError(t, err)
+
+	require.EqualValues(t, []string{"db-0", "web-1"}, diagnostics.GetUnhealthyPodNames())
+
+	require.Len(t, diagnostics.WarningEvents, 2)
+	require.EqualValues(t, "BackOff", diagnostics.WarningEvents[0].Reason)
+	require.EqualValues(t, 12, diagnostics.WarningEvents[0].Count)
+	require.EqualValues(t, "FailedScheduling", diagnostics.WarningEvents[1].Reason)
+	require.EqualValues(t, 1, diagnostics.WarningEvents[1].Count)
+
+	require.Len(t, diagnostics.PendingPersistentVolumeClaims, 1)
+	require.EqualValues(t, "data-db-0", diagnostics.PendingPersistentVolumeClaims[0].Name)
+
+	rendered, err := diagnostics.RenderAsString()
+	require.NoError(t, err)
+	require.Contains(t, rendered, "Namespace 'example': 3 pods, 2 unhealthy.")
+	require.Contains(t, rendered, "metrics-server is not installed")
+	require.Contains(t, rendered, "CrashLoopBackOff")
+	require.Contains(t, rendered, "OOMKilled (exit code 137)")
+	require.Contains(t, rendered, "Back-off restarting failed container web")
+	require.Contains(t, rendered, "data-db-0")
+}
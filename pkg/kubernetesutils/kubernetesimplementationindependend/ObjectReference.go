@@ -0,0 +1,24 @@
+package kubernetesimplementationindependend
+
+import "fmt"
+
+// Identifies a kubernetes object.
+// Namespace is empty for cluster scoped objects.
+type ObjectReference struct {
+	ApiVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+}
+
+func (o *ObjectReference) IsNamespaced() bool {
+	return o.Namespace != ""
+}
+
+func (o *ObjectReference) String() string {
+	if o.IsNamespaced() {
+		return fmt.Sprintf("%s/%s in namespace '%s'", o.Kind, o.Name, o.Namespace)
+	}
+
+	return fmt.Sprintf("%s/%s", o.Kind, o.Name)
+}
@@ -0,0 +1,19 @@
+package kubernetesimplementationindependend
+
+import "slices"
+
+// Kinds for which waiting for readiness is supported after applying them.
+func GetKindsWithReadinessCheck() []string {
+	return []string{
+		"CustomResourceDefinition",
+		"DaemonSet",
+		"Deployment",
+		"Job",
+		"Pod",
+		"StatefulSet",
+	}
+}
+
+func IsReadinessCheckSupportedForKind(kind string) bool {
+	return slices.Contains(GetKindsWithReadinessCheck(), kind)
+}
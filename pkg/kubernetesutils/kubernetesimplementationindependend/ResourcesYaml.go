@@ -99,6 +99,78 @@ func (r *ObjectYamlEntry) Namespace() (namespace string) {
 	return toParse.Metadata.Namespace
 }
 
+// Adds the given labels to the metadata of the object.
+// Already existing labels with the same key are overwritten.
+func (r *ObjectYamlEntry) AddLabels(labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	data := map[string]interface{}{}
+	err := yaml.Unmarshal([]byte(r.Content), &data)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to unmarshal object yaml: %w", err)
+	}
+
+	metadata, ok := data["metadata"].(map[string]interface{})
+	if !ok {
+		return tracederrors.TracedError("Object yaml has no metadata")
+	}
+
+	existingLabels, ok := metadata["labels"].(map[string]interface{})
+	if !ok {
+		existingLabels = map[string]interface{}{}
+	}
+
+	for k, v := range labels {
+		existingLabels[k] = v
+	}
+	metadata["labels"] = existingLabels
+
+	content, err := yaml.Marshal(data)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to marshal object yaml: %w", err)
+	}
+
+	r.Content = string(content)
+
+	return nil
+}
+
+// Returns a reference to the object.
+// 'defaultNamespace' is used if the object yaml does not specify a namespace.
+func (r *ObjectYamlEntry) GetObjectReference(defaultNamespace string) *ObjectReference {
+	namespace := r.Namespace()
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	return &ObjectReference{
+		ApiVersion: r.ApiVersion(),
+		Kind:       r.Kind(),
+		Namespace:  namespace,
+		Name:       r.Name(),
+	}
+}
+
+// Sorts Namespaces and CustomResourceDefinitions to the beginning since other objects may depend on them.
+// The order of all other objects is kept.
+func SortObjectYamlEntriesForApply(objects []*ObjectYamlEntry) {
+	isPrerequisite := func(o *ObjectYamlEntry) bool {
+		kind := o.Kind()
+		return kind == "Namespace" || kind == "CustomResourceDefinition"
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return isPrerequisite(objects[i]) && !isPrerequisite(objects[j])
+	})
+}
+
+// Returns the multi document yaml string of the given objects.
+func MarshalObjectYaml(objects []*ObjectYamlEntry) (string, error) {
+	return marshalObjectYaml(objects)
+}
+
 func UnmarshalObjectYaml(objectYaml string) (objects []*ObjectYamlEntry, err error) {
 	splitted := yamlutils.SplitMultiYaml(objectYaml)
 
@@ -237,3 +237,53 @@ func TestSortObjectsYaml(t *testing.T) {
 		require.EqualValues(t, []string{exampleDeployment1, exampleDeployment2, exampleReplicaSet, exampleDeployment}, splitted)
 	})
 }
+
+func TestObjectYamlEntry_AddLabels(t *testing.T) {
+	objects, err := kubernetesimplementationindependend.UnmarshalObjectYaml(getReplicaSet("frontend", "ns"))
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+
+	err = objects[0].AddLabels(map[string]string{"managed-by": "test", "tier": "backend"})
+	require.NoError(t, err)
+
+	labels, err := yamlutils.RunYqQueryAginstYamlStringAsString(objects[0].Content, ".metadata.labels")
+	require.NoError(t, err)
+	require.Contains(t, labels, "app: guestbook")
+	require.Contains(t, labels, "managed-by: test")
+	require.Contains(t, labels, "tier: backend")
+
+	require.EqualValues(
+		t,
+		&kubernetesimplementationindependend.ObjectReference{ApiVersion: "apps/v1", Kind: "ReplicaSet", Namespace: "ns", Name: "frontend"},
+		objects[0].GetObjectReference("default"),
+	)
+}
+
+func TestObjectYamlEntry_GetObjectReference_defaultNamespace(t *testing.T) {
+	objects, err := kubernetesimplementationindependend.UnmarshalObjectYaml(getReplicaSet("frontend", ""))
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+
+	ref := objects[0].GetObjectReference("default")
+	require.EqualValues(t, "default", ref.Namespace)
+	require.EqualValues(t, "ReplicaSet/frontend in namespace 'default'", ref.String())
+}
+
+func TestSortObjectYamlEntriesForApply(t *testing.T) {
+	objectsYaml := getReplicaSet("b", "ns")
+	objectsYaml += "---\napiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\nmetadata:\n    name: crd\n"
+	objectsYaml += getReplicaSet("a", "ns")
+	objectsYaml += "---\napiVersion: v1\nkind: Namespace\nmetadata:\n    name: ns\n"
+
+	objects, err := kubernetesimplementationindependend.UnmarshalObjectYaml(objectsYaml)
+	require.NoError(t, err)
+
+	kubernetesimplementationindependend.SortObjectYamlEntriesForApply(objects)
+
+	names := []string{}
+	for _, o := range objects {
+		names = append(names, o.Name())
+	}
+
+	require.EqualValues(t, []string{"crd", "ns", "b", "a"}, names)
+}
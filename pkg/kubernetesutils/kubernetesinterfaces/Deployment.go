@@ -2,6 +2,9 @@ package kubernetesinterfaces
 
 import (
 	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
 )
 
 type Deployment interface {
@@ -9,4 +12,10 @@ type Deployment interface {
 	Exists(ctx context.Context) (bool, error)
 	GetName() (name string, err error)
 	GetNamespace() (namespace Namespace, err error)
+	ListRevisions(ctx context.Context) ([]*kubernetesimplementationindependend.DeploymentRevision, error)
+	Rollback(ctx context.Context, toRevision int64) error
+	RolloutRestart(ctx context.Context) error
+	Scale(ctx context.Context, replicas int32) error
+	SetContainerImage(ctx context.Context, containerName string, imageName string) error
+	WaitUntilRolloutComplete(ctx context.Context, timeout time.Duration) error
 }
@@ -0,0 +1,17 @@
+package kubernetesinterfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Represents an ingress in kubernetes.
+type Ingress interface {
+	Delete(ctx context.Context) error
+	Exists(ctx context.Context) (bool, error)
+	GetName() (string, error)
+	GetNamespace() (Namespace, error)
+
+	// Waits until the ingress controller assigned a load balancer address to the ingress.
+	WaitUntilReady(ctx context.Context, timeout time.Duration) error
+}
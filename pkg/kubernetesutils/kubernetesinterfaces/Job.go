@@ -0,0 +1,17 @@
+package kubernetesinterfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Represents a Job in kubernetes.
+type Job interface {
+	Delete(ctx context.Context) error
+	Exists(ctx context.Context) (bool, error)
+	GetName() (string, error)
+	GetNamespace() (Namespace, error)
+
+	// Waits until the job completed successfully. An error is returned immediately if the job failed.
+	WaitUntilReady(ctx context.Context, timeout time.Duration) error
+}
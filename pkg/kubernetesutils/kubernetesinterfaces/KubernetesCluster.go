@@ -9,6 +9,8 @@ import (
 )
 
 type KubernetesCluster interface {
+	// Applies the given multi document YAML manifests using server side apply.
+	ApplyManifests(ctx context.Context, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error)
 	CheckAccessible(ctx context.Context) error
 	CheckNamespaceByNameExists(ctx context.Context, namespaceName string) error
 	CheckSecretByNameExists(ctx context.Context, namespaceName string, secretName string) error
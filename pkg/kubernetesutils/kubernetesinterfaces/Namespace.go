@@ -2,12 +2,20 @@ package kubernetesinterfaces
 
 import (
 	"context"
+	"io"
 	"time"
 
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubeconfigutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
 )
 
 type Namespace interface {
+	// Applies the given multi document YAML manifests into this namespace using server side apply.
+	ApplyManifests(ctx context.Context, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error)
+	// Exports all (or the selected kinds of) objects of this namespace as cleaned YAML files into the given directory.
+	Backup(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.BackupNamespaceOptions) ([]*kubernetesimplementationindependend.ObjectReference, error)
 	CheckNamespaceByNameExists(ctx context.Context) error
 	CheckSecretByNameExists(ctx context.Context, name string) error
 	CheckPodByNameExists(ctx context.Context, podName string) error
@@ -24,6 +32,8 @@ type Namespace interface {
 	CreateRole(ctx context.Context, createOptions *kubernetesparameteroptions.CreateRoleOptions) (createdRole Role, err error)
 	CreateRoleBinding(ctx context.Context, createOptions *kubernetesparameteroptions.CreateRoleBindingOptions) (createdRoleBinding RoleBinding, err error)
 	CreateSecret(ctx context.Context, name string, options *kubernetesparameteroptions.CreateSecretOptions) (createdSecret Secret, err error)
+	// Creates a ServiceAccount bound to the given Role or ClusterRole and returns a standalone kubeconfig using a newly issued token.
+	CreateServiceAccountKubeConfig(ctx context.Context, options *kubernetesparameteroptions.ServiceAccountKubeConfigOptions) (*kubeconfigutils.KubeConfig, error)
 	CreateCronJob(ctx context.Context, cronJobName string, schedule string, image string, command []string, labels map[string]string) (CronJob, error)
 	CronJobByNameExists(ctx context.Context, cronJobName string) (bool, error)
 	Delete(context.Context) error
@@ -41,6 +51,8 @@ type Namespace interface {
 	GetConfigMapByName(name string) (configMap ConfigMap, err error)
 	GetCronJobByName(name string) (CronJob, error)
 	GetDeploymentByName(name string) (Deployment, error)
+	// Collects pod states, recent warning events, pending PersistentVolumeClaims and (if the metrics-server is available) the resource usage of this namespace.
+	GetDiagnostics(ctx context.Context, options *kubernetesparameteroptions.NamespaceDiagnosticsOptions) (*kubernetesimplementationindependend.NamespaceDiagnostics, error)
 	GetKubernetesCluster() (KubernetesCluster, error)
 	GetKubectlContext(ctx context.Context) (contextName string, err error)
 	GetName() (name string, err error)
@@ -60,12 +72,50 @@ type Namespace interface {
 	ListRoleNames(ctx context.Context) ([]string, error)
 	ListSecrets(ctx context.Context) ([]Secret, error)
 	ListSecretNames(ctx context.Context) ([]string, error)
+	// Returns the names of the secrets of the given type like 'kubernetes.io/tls'.
+	ListSecretNamesByType(ctx context.Context, secretType string) ([]string, error)
 	PodByNameExists(ctx context.Context, podName string) (bool, error)
 	ReplicaSetByNameExists(ctx context.Context, replicaSetName string) (bool, error)
+	// Re-applies the objects of a backup created by Backup into this namespace in dependency order.
+	Restore(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.RestoreNamespaceOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error)
+	// Removes the ServiceAccount, its bindings and the written kubeconfig created by CreateServiceAccountKubeConfig.
+	// All tokens issued for the ServiceAccount become invalid.
+	RevokeServiceAccountKubeConfig(ctx context.Context, options *kubernetesparameteroptions.ServiceAccountKubeConfigOptions) error
 	RoleBindingByNameExists(ctx context.Context, name string) (exists bool, err error)
 	RoleByNameExists(ctx context.Context, name string) (exists bool, err error)
 	SecretByNameExists(ctx context.Context, name string) (exits bool, err error)
 	StartPortForwarding(ctx context.Context, podName string, localPort, podPort int) (cancelFunc context.CancelFunc, err error)
+	CreateDaemonSet(ctx context.Context, options *kubernetesparameteroptions.CreateDaemonSetOptions) (DaemonSet, error)
+	DeleteDaemonSetByName(ctx context.Context, name string) error
+	GetDaemonSetByName(name string) (DaemonSet, error)
+	ListDaemonSetNames(ctx context.Context) ([]string, error)
+	DaemonSetByNameExists(ctx context.Context, name string) (bool, error)
+	CreateIngress(ctx context.Context, options *kubernetesparameteroptions.CreateIngressOptions) (Ingress, error)
+	DeleteIngressByName(ctx context.Context, name string) error
+	GetIngressByName(name string) (Ingress, error)
+	ListIngressNames(ctx context.Context) ([]string, error)
+	IngressByNameExists(ctx context.Context, name string) (bool, error)
+	CreateJob(ctx context.Context, options *kubernetesparameteroptions.CreateJobOptions) (Job, error)
+	DeleteJobByName(ctx context.Context, name string) error
+	GetJobByName(name string) (Job, error)
+	ListJobNames(ctx context.Context) ([]string, error)
+	JobByNameExists(ctx context.Context, name string) (bool, error)
+	CreatePersistentVolumeClaim(ctx context.Context, options *kubernetesparameteroptions.CreatePersistentVolumeClaimOptions) (PersistentVolumeClaim, error)
+	DeletePersistentVolumeClaimByName(ctx context.Context, name string) error
+	GetPersistentVolumeClaimByName(name string) (PersistentVolumeClaim, error)
+	ListPersistentVolumeClaimNames(ctx context.Context) ([]string, error)
+	PersistentVolumeClaimByNameExists(ctx context.Context, name string) (bool, error)
+	CreateService(ctx context.Context, options *kubernetesparameteroptions.CreateServiceOptions) (Service, error)
+	DeleteServiceByName(ctx context.Context, name string) error
+	GetServiceByName(name string) (Service, error)
+	ListServiceNames(ctx context.Context) ([]string, error)
+	ServiceByNameExists(ctx context.Context, name string) (bool, error)
+	CreateStatefulSet(ctx context.Context, options *kubernetesparameteroptions.CreateStatefulSetOptions) (StatefulSet, error)
+	DeleteStatefulSetByName(ctx context.Context, name string) error
+	GetStatefulSetByName(name string) (StatefulSet, error)
+	ListStatefulSetNames(ctx context.Context) ([]string, error)
+	StatefulSetByNameExists(ctx context.Context, name string) (bool, error)
+	StreamLogsByLabelSelector(ctx context.Context, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error)
 	WaitUntilAllPodsInNamespaceAreRunning(ctx context.Context, options *kubernetesparameteroptions.WaitForPodsOptions) error
 	WaitUntilPodReady(ctx context.Context, podName string, timeout time.Duration) error
 	WatchConfigMap(ctx context.Context, name string, onCreate func(ConfigMap), onUpdate func(ConfigMap), onDelete func(ConfigMap)) error
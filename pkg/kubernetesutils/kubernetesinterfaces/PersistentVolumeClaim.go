@@ -0,0 +1,18 @@
+package kubernetesinterfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Represents a PersistentVolumeClaim in kubernetes.
+type PersistentVolumeClaim interface {
+	Delete(ctx context.Context) error
+	Exists(ctx context.Context) (bool, error)
+	GetName() (string, error)
+	GetNamespace() (Namespace, error)
+
+	// Waits until the claim is bound to a volume.
+	// Storage classes using 'WaitForFirstConsumer' only bind after a pod using the claim was scheduled.
+	WaitUntilReady(ctx context.Context, timeout time.Duration) error
+}
@@ -2,6 +2,7 @@ package kubernetesinterfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandoutput"
@@ -15,6 +16,7 @@ type Pod interface {
 	GetName() (name string, err error)
 	GetNamespace() (namespace Namespace, err error)
 	GetContainerLogs(ctx context.Context, containerName string) (stdout []byte, stderr []byte, err error)
+	StreamLogs(ctx context.Context, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error)
 	CopyFileToPod(ctx context.Context, localFile string, destPath string, containerName string) error
 	CopyFileFromPod(ctx context.Context, srcPath string, destFile string, containerName string) error
 	RunCommandInContainer(ctx context.Context, options *kubernetesparameteroptions.KubernetesRunCommandOptions) (*commandoutput.CommandOutput, error)
@@ -0,0 +1,17 @@
+package kubernetesinterfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Represents a service in kubernetes.
+type Service interface {
+	Delete(ctx context.Context) error
+	Exists(ctx context.Context) (bool, error)
+	GetName() (string, error)
+	GetNamespace() (Namespace, error)
+
+	// Waits until at least one ready endpoint is available for the service.
+	WaitUntilReady(ctx context.Context, timeout time.Duration) error
+}
@@ -0,0 +1,17 @@
+package kubernetesinterfaces
+
+import (
+	"context"
+	"time"
+)
+
+// Represents a StatefulSet in kubernetes.
+type StatefulSet interface {
+	Delete(ctx context.Context) error
+	Exists(ctx context.Context) (bool, error)
+	GetName() (string, error)
+	GetNamespace() (Namespace, error)
+
+	// Waits until all replicas are updated and ready.
+	WaitUntilReady(ctx context.Context, timeout time.Duration) error
+}
@@ -0,0 +1,134 @@
+package kubernetesparameteroptions
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Field manager used for server side apply if no other one is specified.
+const DefaultApplyFieldManager = "asciichgolangpublic"
+
+// Used if WaitForReadiness is set but no WaitTimeout is specified.
+const DefaultApplyWaitTimeout = 5 * time.Minute
+
+type ApplyManifestsOptions struct {
+	// Multi document YAML string containing the manifests to apply.
+	YamlString string
+
+	// Path to a local file containing the multi document YAML manifests.
+	// Only used if YamlString is not set.
+	FilePath string
+
+	// Field manager used for the server side apply.
+	// Defaults to DefaultApplyFieldManager.
+	FieldManager string
+
+	// Take over fields owned by other field managers instead of failing with a conflict.
+	ForceConflicts bool
+
+	// Labels added to every applied object.
+	// They identify the set of objects managed together and are required for pruning.
+	PruneLabels map[string]string
+
+	// Delete objects which carry all PruneLabels and were applied by the same field manager but are not part of the manifests anymore.
+	Prune bool
+
+	// Wait until supported kinds (Deployment, StatefulSet, DaemonSet, Job, Pod, CustomResourceDefinition) are ready.
+	WaitForReadiness bool
+
+	// Defaults to DefaultApplyWaitTimeout.
+	WaitTimeout time.Duration
+
+	// Do not check nor try to create missing namespaces.
+	SkipNamespaceCreation bool
+}
+
+// Returns the manifests to apply. If YamlString is not set the content of FilePath is read.
+func (a *ApplyManifestsOptions) GetManifestsYamlString() (string, error) {
+	if a.YamlString != "" {
+		return a.YamlString, nil
+	}
+
+	if a.FilePath == "" {
+		return "", tracederrors.TracedError("Neither YamlString nor FilePath set")
+	}
+
+	content, err := os.ReadFile(a.FilePath)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to read manifests file '%s': %w", a.FilePath, err)
+	}
+
+	if strings.TrimSpace(string(content)) == "" {
+		return "", tracederrors.TracedErrorf("Manifests file '%s' is empty", a.FilePath)
+	}
+
+	return string(content), nil
+}
+
+func (a *ApplyManifestsOptions) GetFieldManagerOrDefault() string {
+	if a.FieldManager == "" {
+		return DefaultApplyFieldManager
+	}
+
+	return a.FieldManager
+}
+
+func (a *ApplyManifestsOptions) GetWaitTimeoutOrDefault() time.Duration {
+	if a.WaitTimeout <= 0 {
+		return DefaultApplyWaitTimeout
+	}
+
+	return a.WaitTimeout
+}
+
+func (a *ApplyManifestsOptions) IsPruneLabelsSet() bool {
+	return len(a.PruneLabels) > 0
+}
+
+func (a *ApplyManifestsOptions) GetPruneLabels() (map[string]string, error) {
+	if !a.IsPruneLabelsSet() {
+		return nil, tracederrors.TracedError("PruneLabels not set")
+	}
+
+	ret := map[string]string{}
+	for k, v := range a.PruneLabels {
+		ret[k] = v
+	}
+
+	return ret, nil
+}
+
+// Returns the PruneLabels as label selector string like "app=example,tier=frontend".
+// The labels are sorted by key to get a stable result.
+func (a *ApplyManifestsOptions) GetPruneLabelSelector() (string, error) {
+	labels, err := a.GetPruneLabels()
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	selectors := make([]string, 0, len(keys))
+	for _, k := range keys {
+		selectors = append(selectors, k+"="+labels[k])
+	}
+
+	return strings.Join(selectors, ","), nil
+}
+
+// Validates the combination of the given options.
+func (a *ApplyManifestsOptions) Validate() error {
+	if a.Prune && !a.IsPruneLabelsSet() {
+		return tracederrors.TracedError("Prune requires PruneLabels to be set to limit the objects to prune")
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package kubernetesparameteroptions
+
+type BackupNamespaceOptions struct {
+	// Kinds like "Deployment" or "ConfigMap" to export.
+	// If not set all listable namespaced kinds are exported except the ones which are always recreated by kubernetes itself like Events or EndpointSlices.
+	Kinds []string
+
+	// ASCII armored PGP public keys used to encrypt Secrets.
+	// If not set Secrets are written in plain text.
+	SecretEncryptionPublicKeys [][]byte
+
+	// Remove all files in the backup directory before writing the backup.
+	// Otherwise the backup directory has to be empty or not existing.
+	OverwriteExistingBackup bool
+}
+
+func (b *BackupNamespaceOptions) IsKindsSet() bool {
+	return len(b.Kinds) > 0
+}
+
+func (b *BackupNamespaceOptions) IsSecretEncryptionEnabled() bool {
+	return len(b.SecretEncryptionPublicKeys) > 0
+}
@@ -0,0 +1,36 @@
+package kubernetesparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreateDaemonSetOptions struct {
+	Name      string
+	ImageName string
+	Command   []string
+	Labels    map[string]string
+}
+
+func (c *CreateDaemonSetOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateDaemonSetOptions) GetImageName() (string, error) {
+	if c.ImageName == "" {
+		return "", tracederrors.TracedError("ImageName not set")
+	}
+
+	return c.ImageName, nil
+}
+
+func (c *CreateDaemonSetOptions) GetLabels() map[string]string {
+	if len(c.Labels) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Labels
+}
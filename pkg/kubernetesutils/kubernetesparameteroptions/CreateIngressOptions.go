@@ -0,0 +1,64 @@
+package kubernetesparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Options to create an ingress routing all requests for Host and Path to a single service.
+type CreateIngressOptions struct {
+	Name string
+
+	// Optional. If not set the default ingress class of the cluster is used.
+	IngressClassName string
+
+	// Optional. If not set requests for all hosts are routed.
+	Host string
+
+	// Defaults to "/".
+	Path string
+
+	ServiceName string
+	ServicePort int32
+
+	Labels map[string]string
+}
+
+func (c *CreateIngressOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateIngressOptions) GetPathOrDefault() string {
+	if c.Path == "" {
+		return "/"
+	}
+
+	return c.Path
+}
+
+func (c *CreateIngressOptions) GetServiceName() (string, error) {
+	if c.ServiceName == "" {
+		return "", tracederrors.TracedError("ServiceName not set")
+	}
+
+	return c.ServiceName, nil
+}
+
+func (c *CreateIngressOptions) GetServicePort() (int32, error) {
+	if c.ServicePort <= 0 {
+		return 0, tracederrors.TracedErrorf("Invalid ServicePort '%d'", c.ServicePort)
+	}
+
+	return c.ServicePort, nil
+}
+
+func (c *CreateIngressOptions) GetLabels() map[string]string {
+	if len(c.Labels) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Labels
+}
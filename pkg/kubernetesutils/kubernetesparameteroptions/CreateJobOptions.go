@@ -0,0 +1,49 @@
+package kubernetesparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreateJobOptions struct {
+	Name      string
+	ImageName string
+	Command   []string
+
+	// Number of retries before the job is marked as failed.
+	// The default 0 means the job fails on the first failed pod.
+	BackoffLimit int32
+
+	Labels map[string]string
+}
+
+func (c *CreateJobOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateJobOptions) GetImageName() (string, error) {
+	if c.ImageName == "" {
+		return "", tracederrors.TracedError("ImageName not set")
+	}
+
+	return c.ImageName, nil
+}
+
+func (c *CreateJobOptions) GetBackoffLimit() (int32, error) {
+	if c.BackoffLimit < 0 {
+		return 0, tracederrors.TracedErrorf("Invalid BackoffLimit '%d'", c.BackoffLimit)
+	}
+
+	return c.BackoffLimit, nil
+}
+
+func (c *CreateJobOptions) GetLabels() map[string]string {
+	if len(c.Labels) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Labels
+}
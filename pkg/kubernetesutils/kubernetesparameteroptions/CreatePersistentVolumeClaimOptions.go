@@ -0,0 +1,52 @@
+package kubernetesparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreatePersistentVolumeClaimOptions struct {
+	Name string
+
+	// Requested storage like "1Gi". Defaults to "1Gi".
+	StorageSize string
+
+	// Optional. If not set the default storage class of the cluster is used.
+	StorageClassName string
+
+	// Defaults to "ReadWriteOnce".
+	AccessMode string
+
+	Labels map[string]string
+}
+
+func (c *CreatePersistentVolumeClaimOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreatePersistentVolumeClaimOptions) GetStorageSizeOrDefault() string {
+	if c.StorageSize == "" {
+		return "1Gi"
+	}
+
+	return c.StorageSize
+}
+
+func (c *CreatePersistentVolumeClaimOptions) GetAccessModeOrDefault() string {
+	if c.AccessMode == "" {
+		return "ReadWriteOnce"
+	}
+
+	return c.AccessMode
+}
+
+func (c *CreatePersistentVolumeClaimOptions) GetLabels() map[string]string {
+	if len(c.Labels) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Labels
+}
@@ -9,6 +9,7 @@ type CreateRoleBindingOptions struct {
 	RoleRef     string
 	Subjects    []string
 	SubjectKind string // "User", "Group", or "ServiceAccount"
+	RoleRefKind string // "Role" (default) or "ClusterRole"
 }
 
 func NewCreateRoleBindingOptions() (c *CreateRoleBindingOptions) {
@@ -51,6 +52,18 @@ func (c *CreateRoleBindingOptions) GetSubjectKind() (subjectKind string, err err
 	return c.SubjectKind, nil
 }
 
+// Returns the kind of the referenced role. Defaults to "Role" if not set.
+func (c *CreateRoleBindingOptions) GetRoleRefKindOrDefault() (roleRefKind string, err error) {
+	switch c.RoleRefKind {
+	case "":
+		return "Role", nil
+	case "Role", "ClusterRole":
+		return c.RoleRefKind, nil
+	default:
+		return "", tracederrors.TracedErrorf("Unsupported RoleRefKind '%s'. Use 'Role' or 'ClusterRole'.", c.RoleRefKind)
+	}
+}
+
 func (c *CreateRoleBindingOptions) SetName(name string) (err error) {
 	if name == "" {
 		return tracederrors.TracedErrorf("name is empty string")
@@ -0,0 +1,92 @@
+package kubernetesparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type ServicePort struct {
+	Name string
+	Port int32
+
+	// Port of the selected pods. Defaults to Port.
+	TargetPort int32
+
+	// Defaults to "TCP".
+	Protocol string
+}
+
+func (s *ServicePort) GetTargetPortOrDefault() int32 {
+	if s.TargetPort == 0 {
+		return s.Port
+	}
+
+	return s.TargetPort
+}
+
+func (s *ServicePort) GetProtocolOrDefault() string {
+	if s.Protocol == "" {
+		return "TCP"
+	}
+
+	return s.Protocol
+}
+
+type CreateServiceOptions struct {
+	Name string
+
+	// Defaults to "ClusterIP".
+	Type string
+
+	// Labels of the pods the service routes traffic to.
+	Selector map[string]string
+
+	Ports []ServicePort
+
+	Labels map[string]string
+}
+
+func (c *CreateServiceOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateServiceOptions) GetTypeOrDefault() string {
+	if c.Type == "" {
+		return "ClusterIP"
+	}
+
+	return c.Type
+}
+
+func (c *CreateServiceOptions) GetPorts() ([]ServicePort, error) {
+	if len(c.Ports) <= 0 {
+		return nil, tracederrors.TracedError("Ports not set")
+	}
+
+	for _, p := range c.Ports {
+		if p.Port <= 0 {
+			return nil, tracederrors.TracedErrorf("Invalid port '%d' for service port '%s'", p.Port, p.Name)
+		}
+	}
+
+	return c.Ports, nil
+}
+
+func (c *CreateServiceOptions) GetSelector() map[string]string {
+	if len(c.Selector) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Selector
+}
+
+func (c *CreateServiceOptions) GetLabels() map[string]string {
+	if len(c.Labels) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Labels
+}
@@ -0,0 +1,59 @@
+package kubernetesparameteroptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type CreateStatefulSetOptions struct {
+	Name      string
+	ImageName string
+	Command   []string
+
+	// Number of replicas (default: 1)
+	Replicas int32
+
+	// Name of the governing headless service. Defaults to Name.
+	ServiceName string
+
+	Labels map[string]string
+}
+
+func (c *CreateStatefulSetOptions) GetName() (string, error) {
+	if c.Name == "" {
+		return "", tracederrors.TracedError("Name not set")
+	}
+
+	return c.Name, nil
+}
+
+func (c *CreateStatefulSetOptions) GetImageName() (string, error) {
+	if c.ImageName == "" {
+		return "", tracederrors.TracedError("ImageName not set")
+	}
+
+	return c.ImageName, nil
+}
+
+func (c *CreateStatefulSetOptions) GetReplicas() int32 {
+	if c.Replicas <= 0 {
+		return 1
+	}
+
+	return c.Replicas
+}
+
+func (c *CreateStatefulSetOptions) GetServiceName() (string, error) {
+	if c.ServiceName == "" {
+		return c.GetName()
+	}
+
+	return c.ServiceName, nil
+}
+
+func (c *CreateStatefulSetOptions) GetLabels() map[string]string {
+	if len(c.Labels) <= 0 {
+		return map[string]string{}
+	}
+
+	return c.Labels
+}
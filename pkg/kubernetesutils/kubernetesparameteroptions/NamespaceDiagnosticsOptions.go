@@ -0,0 +1,22 @@
+package kubernetesparameteroptions
+
+import "time"
+
+// Warning events of this age are included in the diagnostics if EventsSince is not set.
+const DefaultDiagnosticsEventsSince = time.Hour
+
+type NamespaceDiagnosticsOptions struct {
+	// Only include warning events seen within this duration. DefaultDiagnosticsEventsSince is used if not set.
+	EventsSince time.Duration
+
+	// Skip querying the metrics-server for CPU and memory usage.
+	SkipResourceUsage bool
+}
+
+func (n *NamespaceDiagnosticsOptions) GetEventsSinceOrDefault() time.Duration {
+	if n.EventsSince <= 0 {
+		return DefaultDiagnosticsEventsSince
+	}
+
+	return n.EventsSince
+}
@@ -0,0 +1,26 @@
+package kubernetesparameteroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type RestoreNamespaceOptions struct {
+	// ASCII armored PGP private key to decrypt the Secrets encrypted during the backup.
+	SecretDecryptionPrivateKey []byte
+
+	// Wait until supported kinds (Deployment, StatefulSet, DaemonSet, Job, Pod) are ready.
+	WaitForReadiness bool
+
+	// Defaults to DefaultApplyWaitTimeout.
+	WaitTimeout time.Duration
+}
+
+func (r *RestoreNamespaceOptions) GetSecretDecryptionPrivateKey() ([]byte, error) {
+	if len(r.SecretDecryptionPrivateKey) == 0 {
+		return nil, tracederrors.TracedError("SecretDecryptionPrivateKey not set")
+	}
+
+	return r.SecretDecryptionPrivateKey, nil
+}
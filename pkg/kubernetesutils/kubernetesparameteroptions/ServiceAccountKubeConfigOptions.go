@@ -0,0 +1,96 @@
+package kubernetesparameteroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Token lifetime used if no TokenExpiration is set.
+const DefaultServiceAccountTokenExpiration = time.Hour
+
+// The kubernetes API does not issue tokens valid for less than 10 minutes.
+const MinServiceAccountTokenExpiration = 10 * time.Minute
+
+type ServiceAccountKubeConfigOptions struct {
+	// Name of the ServiceAccount to create in the namespace.
+	ServiceAccountName string
+
+	// Name of a Role in the namespace the ServiceAccount is bound to.
+	RoleName string
+
+	// Name of a ClusterRole the ServiceAccount is bound to.
+	// The ClusterRole is bound using a RoleBinding and is therefore limited to the namespace unless ClusterWide is set.
+	ClusterRoleName string
+
+	// Bind the ClusterRole using a ClusterRoleBinding to grant the permissions in all namespaces.
+	ClusterWide bool
+
+	// Lifetime of the issued token. DefaultServiceAccountTokenExpiration is used if not set.
+	TokenExpiration time.Duration
+
+	// Optional file the kubeconfig is written to. Revoking deletes this file.
+	OutputFile filesinterfaces.File
+}
+
+func (s *ServiceAccountKubeConfigOptions) GetServiceAccountName() (string, error) {
+	if s.ServiceAccountName == "" {
+		return "", tracederrors.TracedError("ServiceAccountName not set")
+	}
+
+	return s.ServiceAccountName, nil
+}
+
+func (s *ServiceAccountKubeConfigOptions) GetTokenExpirationOrDefault() (time.Duration, error) {
+	if s.TokenExpiration == 0 {
+		return DefaultServiceAccountTokenExpiration, nil
+	}
+
+	if s.TokenExpiration < MinServiceAccountTokenExpiration {
+		return 0, tracederrors.TracedErrorf("TokenExpiration '%s' is shorter than the minimum of '%s'.", s.TokenExpiration, MinServiceAccountTokenExpiration)
+	}
+
+	return s.TokenExpiration, nil
+}
+
+// Returns the kind ("Role" or "ClusterRole") and the name of the role to bind.
+func (s *ServiceAccountKubeConfigOptions) GetRoleRefKindAndName() (kind string, name string, err error) {
+	if s.RoleName != "" && s.ClusterRoleName != "" {
+		return "", "", tracederrors.TracedErrorf("Only one of RoleName '%s' and ClusterRoleName '%s' can be set.", s.RoleName, s.ClusterRoleName)
+	}
+
+	if s.RoleName != "" {
+		if s.ClusterWide {
+			return "", "", tracederrors.TracedErrorf("ClusterWide requires a ClusterRoleName but RoleName '%s' is set.", s.RoleName)
+		}
+
+		return "Role", s.RoleName, nil
+	}
+
+	if s.ClusterRoleName != "" {
+		return "ClusterRole", s.ClusterRoleName, nil
+	}
+
+	return "", "", tracederrors.TracedError("Neither RoleName nor ClusterRoleName set")
+}
+
+// Returns the name of the RoleBinding in the namespace which is equal to the ServiceAccount name.
+func (s *ServiceAccountKubeConfigOptions) GetRoleBindingName() (string, error) {
+	return s.GetServiceAccountName()
+}
+
+// Returns the name "<namespaceName>-<serviceAccountName>" of the ClusterRoleBinding used if ClusterWide is set.
+// The namespace is part of the name since ClusterRoleBindings are not namespaced.
+func (s *ServiceAccountKubeConfigOptions) GetClusterRoleBindingName(namespaceName string) (string, error) {
+	if namespaceName == "" {
+		return "", tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	serviceAccountName, err := s.GetServiceAccountName()
+	if err != nil {
+		return "", err
+	}
+
+	return namespaceName + "-" + serviceAccountName, nil
+}
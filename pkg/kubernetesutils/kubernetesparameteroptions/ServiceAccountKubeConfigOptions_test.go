@@ -0,0 +1,55 @@
+package kubernetesparameteroptions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+)
+
+func Test_ServiceAccountKubeConfigOptions_GetRoleRefKindAndName(t *testing.T) {
+	tests := []struct {
+		options      kubernetesparameteroptions.ServiceAccountKubeConfigOptions
+		expectedKind string
+		expectedName string
+		expectError  bool
+	}{
+		{kubernetesparameteroptions.ServiceAccountKubeConfigOptions{RoleName: "deployer"}, "Role", "deployer", false},
+		{kubernetesparameteroptions.ServiceAccountKubeConfigOptions{ClusterRoleName: "view"}, "ClusterRole", "view", false},
+		{kubernetesparameteroptions.ServiceAccountKubeConfigOptions{ClusterRoleName: "view", ClusterWide: true}, "ClusterRole", "view", false},
+		{kubernetesparameteroptions.ServiceAccountKubeConfigOptions{RoleName: "deployer", ClusterWide: true}, "", "", true},
+		{kubernetesparameteroptions.ServiceAccountKubeConfigOptions{RoleName: "deployer", ClusterRoleName: "view"}, "", "", true},
+		{kubernetesparameteroptions.ServiceAccountKubeConfigOptions{}, "", "", true},
+	}
+
+	for _, tt := range tests {
+		kind, name, err := tt.options.GetRoleRefKindAndName()
+		if tt.expectError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, tt.expectedKind, kind)
+		require.EqualValues(t, tt.expectedName, name)
+	}
+}
+
+func Test_ServiceAccountKubeConfigOptions_GetTokenExpirationOrDefault(t *testing.T) {
+	expiration, err := (&kubernetesparameteroptions.ServiceAccountKubeConfigOptions{}).GetTokenExpirationOrDefault()
+	require.NoError(t, err)
+	require.EqualValues(t, kubernetesparameteroptions.DefaultServiceAccountTokenExpiration, expiration)
+
+	expiration, err = (&kubernetesparameteroptions.ServiceAccountKubeConfigOptions{TokenExpiration: 24 * time.Hour}).GetTokenExpirationOrDefault()
+	require.NoError(t, err)
+	require.EqualValues(t, 24*time.Hour, expiration)
+
+	_, err = (&kubernetesparameteroptions.ServiceAccountKubeConfigOptions{TokenExpiration: time.Minute}).GetTokenExpirationOrDefault()
+	require.Error(t, err)
+}
+
+func Test_ServiceAccountKubeConfigOptions_GetClusterRoleBindingName(t *testing.T) {
+	name, err := (&kubernetesparameteroptions.ServiceAccountKubeConfigOptions{ServiceAccountName: "deployer"}).GetClusterRoleBindingName("ci")
+	require.NoError(t, err)
+	require.EqualValues(t, "ci-deployer", name)
+}
@@ -0,0 +1,75 @@
+package kubernetesparameteroptions
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type StreamLogsOptions struct {
+	// Only stream the logs of this container. If not set the logs of all containers are streamed.
+	ContainerName string
+
+	// Keep the stream open and forward new log lines as they are written like `kubectl logs --follow`.
+	Follow bool
+
+	// Only return log lines newer than the given duration. All log lines are returned if not set.
+	Since time.Duration
+
+	// Only return the given number of most recent lines per container. All log lines are returned if not set.
+	// Set to 0 to only receive new log lines when following the logs.
+	TailLines *int64
+
+	// Add the timestamp of every log line as written by the container runtime.
+	Timestamps bool
+
+	// Label selector like "app=example" to select the pods to aggregate the logs from.
+	// Only used when streaming the logs of a namespace.
+	LabelSelector string
+}
+
+func (s *StreamLogsOptions) IsContainerNameSet() bool {
+	return s.ContainerName != ""
+}
+
+func (s *StreamLogsOptions) IsSinceSet() bool {
+	return s.Since > 0
+}
+
+// Returns the Since duration as full seconds. Durations below one second are rounded up to one second.
+func (s *StreamLogsOptions) GetSinceSeconds() (int64, error) {
+	if !s.IsSinceSet() {
+		return 0, tracederrors.TracedError("Since not set")
+	}
+
+	seconds := int64(s.Since / time.Second)
+	if s.Since%time.Second != 0 {
+		seconds++
+	}
+
+	return seconds, nil
+}
+
+func (s *StreamLogsOptions) IsTailLinesSet() bool {
+	return s.TailLines != nil
+}
+
+func (s *StreamLogsOptions) GetTailLines() (int64, error) {
+	if s.TailLines == nil {
+		return 0, tracederrors.TracedError("TailLines not set")
+	}
+
+	if *s.TailLines < 0 {
+		return 0, tracederrors.TracedErrorf("Invalid TailLines '%d'. Must be 0 or greater.", *s.TailLines)
+	}
+
+	return *s.TailLines, nil
+}
+
+func (s *StreamLogsOptions) GetLabelSelector() (string, error) {
+	if s.LabelSelector == "" {
+		return "", tracederrors.TracedError("LabelSelector not set")
+	}
+
+	return s.LabelSelector, nil
+}
@@ -0,0 +1,63 @@
+package kubernetesparameteroptions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+)
+
+func Test_StreamLogsOptions_GetSinceSeconds(t *testing.T) {
+	tests := []struct {
+		since    time.Duration
+		expected int64
+	}{
+		{time.Second, 1},
+		{time.Millisecond, 1},
+		{90 * time.Second, 90},
+		{1500 * time.Millisecond, 2},
+		{time.Hour, 3600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.since.String(), func(t *testing.T) {
+			options := &kubernetesparameteroptions.StreamLogsOptions{Since: tt.since}
+
+			seconds, err := options.GetSinceSeconds()
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expected, seconds)
+		})
+	}
+}
+
+func Test_StreamLogsOptions_GetSinceSeconds_notSet(t *testing.T) {
+	_, err := (&kubernetesparameteroptions.StreamLogsOptions{}).GetSinceSeconds()
+	require.Error(t, err)
+}
+
+func Test_StreamLogsOptions_GetTailLines(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		options := &kubernetesparameteroptions.StreamLogsOptions{}
+		require.False(t, options.IsTailLinesSet())
+
+		_, err := options.GetTailLines()
+		require.Error(t, err)
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		tailLines := int64(0)
+		options := &kubernetesparameteroptions.StreamLogsOptions{TailLines: &tailLines}
+		require.True(t, options.IsTailLinesSet())
+
+		lines, err := options.GetTailLines()
+		require.NoError(t, err)
+		require.EqualValues(t, 0, lines)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tailLines := int64(-1)
+		_, err := (&kubernetesparameteroptions.StreamLogsOptions{TailLines: &tailLines}).GetTailLines()
+		require.Error(t, err)
+	})
+}
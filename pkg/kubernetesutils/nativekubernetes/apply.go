@@ -0,0 +1,390 @@
+package nativekubernetes
+
+import (
+	"context"
+	"slices"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+// Kinds checked for objects to prune in addition to the kinds of the applied objects.
+// This matches the default allow list used by `kubectl apply --prune`.
+func getDefaultPruneGroupVersionKinds() []schema.GroupVersionKind {
+	return []schema.GroupVersionKind{
+		{Group: "", Version: "v1", Kind: "ConfigMap"},
+		{Group: "", Version: "v1", Kind: "Namespace"},
+		{Group: "", Version: "v1", Kind: "PersistentVolume"},
+		{Group: "", Version: "v1", Kind: "PersistentVolumeClaim"},
+		{Group: "", Version: "v1", Kind: "Pod"},
+		{Group: "", Version: "v1", Kind: "ReplicationController"},
+		{Group: "", Version: "v1", Kind: "Secret"},
+		{Group: "", Version: "v1", Kind: "Service"},
+		{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+		{Group: "apps", Version: "v1", Kind: "StatefulSet"},
+		{Group: "batch", Version: "v1", Kind: "CronJob"},
+		{Group: "batch", Version: "v1", Kind: "Job"},
+		{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+	}
+}
+
+type appliedObject struct {
+	ref               *kubernetesimplementationindependend.ObjectReference
+	resourceInterface dynamic.ResourceInterface
+}
+
+// Parses the multi document YAML manifests into unstructured objects.
+// Namespaces and CustomResourceDefinitions are sorted to the beginning since other objects may depend on them.
+func parseManifests(manifests string) ([]*unstructured.Unstructured, error) {
+	entries, err := kubernetesimplementationindependend.UnmarshalObjectYaml(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, tracederrors.TracedError("No objects found in manifests")
+	}
+
+	kubernetesimplementationindependend.SortObjectYamlEntriesForApply(entries)
+
+	ret := []*unstructured.Unstructured{}
+	for _, entry := range entries {
+		obj := &unstructured.Unstructured{}
+		err := sigyaml.Unmarshal([]byte(entry.Content), &obj.Object)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to parse manifest of '%s/%s': %w", entry.Kind(), entry.Name(), err)
+		}
+
+		if obj.GetAPIVersion() == "" {
+			return nil, tracederrors.TracedErrorf("Manifest of '%s/%s' has no apiVersion", entry.Kind(), entry.Name())
+		}
+
+		ret = append(ret, obj)
+	}
+
+	return ret, nil
+}
+
+func getRestMapping(mapper *restmapper.DeferredDiscoveryRESTMapper, gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if !meta.IsNoMatchError(err) {
+			return nil, tracederrors.TracedErrorf("Failed to get REST mapping for '%s': %w", gvk, err)
+		}
+
+		// The kind could be defined by a CustomResourceDefinition applied just before.
+		mapper.Reset()
+
+		mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to get REST mapping for '%s': %w", gvk, err)
+		}
+	}
+
+	return mapping, nil
+}
+
+func getObjectKey(groupKind schema.GroupKind, namespace string, name string) string {
+	return groupKind.String() + "/" + namespace + "/" + name
+}
+
+func isAppliedByFieldManager(obj *unstructured.Unstructured, fieldManager string) bool {
+	for _, m := range obj.GetManagedFields() {
+		if m.Manager == fieldManager && m.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Applies the multi document YAML manifests using server side apply.
+//
+// 'namespaceName' is optional. If set all namespaced objects are applied into this namespace and objects
+// specifying another namespace are rejected. Otherwise namespaced objects without a namespace are applied to the "default" namespace.
+//
+// If requested the function waits until the applied objects are ready and prunes objects carrying the PruneLabels
+// which were previously applied by the same field manager but are not part of the manifests anymore.
+func ApplyManifests(ctx context.Context, config *rest.Config, namespaceName string, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	if config == nil {
+		return nil, tracederrors.TracedErrorNil("config")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	err := options.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := options.GetManifestsYamlString()
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := parseManifests(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldManager := options.GetFieldManagerOrDefault()
+
+	defaultNamespace := namespaceName
+	if defaultNamespace == "" {
+		defaultNamespace = "default"
+	}
+
+	logging.LogInfoByCtxf(ctx, "Apply '%d' objects using field manager '%s' started.", len(objects), fieldManager)
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create dynamic client: %w", err)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create discovery client: %w", err)
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	clientSet, err := GetClientSetFromRestConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	declaredNamespaces := []string{}
+	for _, obj := range objects {
+		if obj.GetKind() == "Namespace" {
+			declaredNamespaces = append(declaredNamespaces, obj.GetName())
+		}
+	}
+
+	result := &kubernetesimplementationindependend.ApplyManifestsResult{}
+	appliedObjects := []*appliedObject{}
+	appliedKeys := map[string]bool{}
+	visitedNamespaces := []string{}
+	pruneGvks := getDefaultPruneGroupVersionKinds()
+
+	for _, obj := range objects {
+		if options.IsPruneLabelsSet() {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			for k, v := range options.PruneLabels {
+				labels[k] = v
+			}
+
+			obj.SetLabels(labels)
+		}
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := getRestMapping(mapper, gvk)
+		if err != nil {
+			return nil, err
+		}
+
+		var resourceInterface dynamic.ResourceInterface
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			objectNamespace := obj.GetNamespace()
+			if objectNamespace == "" {
+				objectNamespace = defaultNamespace
+			}
+
+			if namespaceName != "" && objectNamespace != namespaceName {
+				return nil, tracederrors.TracedErrorf(
+					"Object '%s/%s' specifies namespace '%s' but is applied to namespace '%s'.",
+					gvk.Kind, obj.GetName(), objectNamespace, namespaceName,
+				)
+			}
+
+			obj.SetNamespace(objectNamespace)
+
+			if !slices.Contains(visitedNamespaces, objectNamespace) {
+				visitedNamespaces = append(visitedNamespaces, objectNamespace)
+
+				if options.SkipNamespaceCreation {
+					logging.LogInfoByCtxf(ctx, "Skip ensure namespace '%s' exists when applying manifests.", objectNamespace)
+				} else if !slices.Contains(declaredNamespaces, objectNamespace) {
+					err = CreateNamespace(ctx, clientSet, objectNamespace)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			resourceInterface = dynamicClient.Resource(mapping.Resource).Namespace(objectNamespace)
+		} else {
+			obj.SetNamespace("")
+			resourceInterface = dynamicClient.Resource(mapping.Resource)
+		}
+
+		ref := &kubernetesimplementationindependend.ObjectReference{
+			ApiVersion: obj.GetAPIVersion(),
+			Kind:       gvk.Kind,
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		}
+
+		var resourceVersionBefore string
+		current, err := resourceInterface.Get(ctx, ref.Name, metav1.GetOptions{})
+		if err == nil {
+			resourceVersionBefore = current.GetResourceVersion()
+		} else if !apierrors.IsNotFound(err) {
+			return nil, tracederrors.TracedErrorf("Failed to get %s: %w", ref, err)
+		}
+
+		applied, err := resourceInterface.Apply(ctx, ref.Name, obj, metav1.ApplyOptions{
+			FieldManager: fieldManager,
+			Force:        options.ForceConflicts,
+		})
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to apply %s: %w", ref, err)
+		}
+
+		if resourceVersionBefore == "" {
+			logging.LogChangedByCtxf(ctx, "Created %s.", ref)
+		} else if resourceVersionBefore != applied.GetResourceVersion() {
+			logging.LogChangedByCtxf(ctx, "Updated %s.", ref)
+		} else {
+			logging.LogInfoByCtxf(ctx, "%s is already up to date.", ref)
+		}
+
+		appliedObjects = append(appliedObjects, &appliedObject{ref: ref, resourceInterface: resourceInterface})
+		appliedKeys[getObjectKey(gvk.GroupKind(), ref.Namespace, ref.Name)] = true
+		result.AppliedObjects = append(result.AppliedObjects, ref)
+
+		if !slices.Contains(pruneGvks, gvk) {
+			pruneGvks = append(pruneGvks, gvk)
+		}
+	}
+
+	if options.WaitForReadiness {
+		timeout := options.GetWaitTimeoutOrDefault()
+		for _, a := range appliedObjects {
+			err = WaitForObjectReady(ctx, a.resourceInterface, a.ref, timeout)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if options.Prune {
+		result.PrunedObjects, err = pruneObjects(ctx, dynamicClient, mapper, pruneGvks, visitedNamespaces, appliedKeys, fieldManager, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Apply '%d' objects using field manager '%s' finished.", len(objects), fieldManager)
+
+	return result, nil
+}
+
+func pruneObjects(
+	ctx context.Context,
+	dynamicClient *dynamic.DynamicClient,
+	mapper *restmapper.DeferredDiscoveryRESTMapper,
+	gvks []schema.GroupVersionKind,
+	namespaces []string,
+	appliedKeys map[string]bool,
+	fieldManager string,
+	options *kubernetesparameteroptions.ApplyManifestsOptions,
+) ([]*kubernetesimplementationindependend.ObjectReference, error) {
+	labelSelector, err := options.GetPruneLabelSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Prune objects with labels '%s' not part of the applied manifests started.", labelSelector)
+
+	pruned := []*kubernetesimplementationindependend.ObjectReference{}
+
+	for _, gvk := range gvks {
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				// Kind not served by this cluster.
+				continue
+			}
+
+			return nil, tracederrors.TracedErrorf("Failed to get REST mapping for '%s': %w", gvk, err)
+		}
+
+		resourceInterfaces := []dynamic.ResourceInterface{}
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			for _, n := range namespaces {
+				resourceInterfaces = append(resourceInterfaces, dynamicClient.Resource(mapping.Resource).Namespace(n))
+			}
+		} else {
+			resourceInterfaces = append(resourceInterfaces, dynamicClient.Resource(mapping.Resource))
+		}
+
+		for _, resourceInterface := range resourceInterfaces {
+			list, err := resourceInterface.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+			if err != nil {
+				return nil, tracederrors.TracedErrorf("Failed to list '%s' with labels '%s': %w", mapping.Resource.String(), labelSelector, err)
+			}
+
+			for i := range list.Items {
+				item := &list.Items[i]
+
+				if appliedKeys[getObjectKey(gvk.GroupKind(), item.GetNamespace(), item.GetName())] {
+					continue
+				}
+
+				if item.GetDeletionTimestamp() != nil {
+					continue
+				}
+
+				ref := &kubernetesimplementationindependend.ObjectReference{
+					ApiVersion: item.GetAPIVersion(),
+					Kind:       gvk.Kind,
+					Namespace:  item.GetNamespace(),
+					Name:       item.GetName(),
+				}
+
+				if !isAppliedByFieldManager(item, fieldManager) {
+					logging.LogInfoByCtxf(ctx, "%s was not applied by field manager '%s'. Skip pruning.", ref, fieldManager)
+					continue
+				}
+
+				propagationPolicy := metav1.DeletePropagationBackground
+				err = resourceInterface.Delete(ctx, ref.Name, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+				if err != nil {
+					if apierrors.IsNotFound(err) {
+						continue
+					}
+
+					return nil, tracederrors.TracedErrorf("Failed to prune %s: %w", ref, err)
+				}
+
+				logging.LogChangedByCtxf(ctx, "Pruned %s.", ref)
+				pruned = append(pruned, ref)
+			}
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Prune objects with labels '%s' not part of the applied manifests finished. Pruned '%d' objects.", labelSelector, len(pruned))
+
+	return pruned, nil
+}
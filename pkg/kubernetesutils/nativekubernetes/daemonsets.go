@@ -0,0 +1,217 @@
+package nativekubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func CreateDaemonSet(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.CreateDaemonSetOptions) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	daemonSetName, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	imageName, err := options.GetImageName()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create DaemonSet '%s' in namespace '%s' using container image '%s' started.", daemonSetName, namespaceName, imageName)
+
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   daemonSetName,
+			Labels: options.GetLabels(),
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": daemonSetName,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": daemonSetName,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:    daemonSetName,
+							Image:   imageName,
+							Command: options.Command,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, err = clientset.AppsV1().DaemonSets(namespaceName).Create(ctx, daemonSet, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "DaemonSet '%s' in namespace '%s' already exists.", daemonSetName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to create DaemonSet '%s' in namespace '%s': %w", daemonSetName, namespaceName, err)
+		}
+	} else {
+		logging.LogChangedByCtxf(ctx, "DaemonSet '%s' in namespace '%s' created.", daemonSetName, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create DaemonSet '%s' in namespace '%s' using container image '%s' finished.", daemonSetName, namespaceName, imageName)
+
+	return nil
+}
+
+// Waits until the pods of the DaemonSet on all scheduled nodes are updated and ready.
+func WaitForDaemonSetReady(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, daemonSetName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if daemonSetName == "" {
+		return tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	description := fmt.Sprintf("DaemonSet '%s' in namespace '%s' is ready", daemonSetName, namespaceName)
+
+	return pollUntil(ctx, description, timeout, func(ctx context.Context) (bool, error) {
+		daemonSet, err := clientset.AppsV1().DaemonSets(namespaceName).Get(ctx, daemonSetName, metav1.GetOptions{})
+		if err != nil {
+			return false, tracederrors.TracedErrorf("Failed to get DaemonSet '%s' in namespace '%s': %w", daemonSetName, namespaceName, err)
+		}
+
+		if daemonSet.Status.ObservedGeneration < daemonSet.Generation {
+			return false, nil
+		}
+
+		desired := daemonSet.Status.DesiredNumberScheduled
+
+		return desired > 0 && daemonSet.Status.UpdatedNumberScheduled == desired && daemonSet.Status.NumberReady == desired, nil
+	})
+}
+
+func DaemonSetExists(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, daemonSetName string) (bool, error) {
+	if clientset == nil {
+		return false, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if daemonSetName == "" {
+		return false, tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	_, err := clientset.AppsV1().DaemonSets(namespaceName).Get(ctx, daemonSetName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "DaemonSet '%s' in namespace '%s' does not exist.", daemonSetName, namespaceName)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to get DaemonSet '%s' in namespace '%s': %w", daemonSetName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "DaemonSet '%s' in namespace '%s' exists.", daemonSetName, namespaceName)
+
+	return true, nil
+}
+
+func DeleteDaemonSet(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, daemonSetName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if daemonSetName == "" {
+		return tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete DaemonSet '%s' in namespace '%s' started.", daemonSetName, namespaceName)
+
+	deletePolicy := metav1.DeletePropagationBackground
+	err := clientset.AppsV1().DaemonSets(namespaceName).Delete(ctx, daemonSetName, metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err == nil {
+		err = pollUntil(ctx, fmt.Sprintf("DaemonSet '%s' in namespace '%s' is deleted", daemonSetName, namespaceName), defaultDeleteTimeout, func(ctx context.Context) (bool, error) {
+			exists, err := DaemonSetExists(contextutils.WithSilent(ctx), clientset, namespaceName, daemonSetName)
+			return !exists, err
+		})
+		if err != nil {
+			return err
+		}
+
+		logging.LogChangedByCtxf(ctx, "DaemonSet '%s' in namespace '%s' deleted.", daemonSetName, namespaceName)
+	} else {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "DaemonSet '%s' already absent in namespace '%s'.", daemonSetName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to delete DaemonSet '%s' in namespace '%s': %w", daemonSetName, namespaceName, err)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete DaemonSet '%s' in namespace '%s' finished.", daemonSetName, namespaceName)
+
+	return nil
+}
+
+func ListDaemonSetNames(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string) ([]string, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	list, err := clientset.AppsV1().DaemonSets(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list DaemonSets in namespace '%s': %w", namespaceName, err)
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' DaemonSets in namespace '%s'.", len(names), namespaceName)
+
+	return names, nil
+}
@@ -0,0 +1,363 @@
+package nativekubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+func getDeployment(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string) (*appsv1.Deployment, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if deploymentName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("deploymentName")
+	}
+
+	deployment, err := clientset.AppsV1().Deployments(namespaceName).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	return deployment, nil
+}
+
+// Triggers a rolling restart of all pods of the Deployment by updating the restartedAt annotation of the pod template like 'kubectl rollout restart' does.
+func RolloutRestartDeployment(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if deploymentName == "" {
+		return tracederrors.TracedErrorEmptyString("deploymentName")
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						kubernetesimplementationindependend.RestartedAtAnnotation: time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to create restart patch: %w", err)
+	}
+
+	_, err = clientset.AppsV1().Deployments(namespaceName).Patch(ctx, deploymentName, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to restart Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Rollout restart of Deployment '%s' in namespace '%s' triggered.", deploymentName, namespaceName)
+
+	return nil
+}
+
+// Sets the number of replicas of the Deployment.
+func ScaleDeployment(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string, replicas int32) error {
+	if replicas < 0 {
+		return tracederrors.TracedErrorf("Invalid number of replicas '%d'.", replicas)
+	}
+
+	deployment, err := getDeployment(ctx, clientset, namespaceName, deploymentName)
+	if err != nil {
+		return err
+	}
+
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == replicas {
+		logging.LogInfoByCtxf(ctx, "Deployment '%s' in namespace '%s' is already scaled to '%d' replicas.", deploymentName, namespaceName, replicas)
+		return nil
+	}
+
+	scale, err := clientset.AppsV1().Deployments(namespaceName).GetScale(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to get scale of Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	scale.Spec.Replicas = replicas
+
+	_, err = clientset.AppsV1().Deployments(namespaceName).UpdateScale(ctx, deploymentName, scale, metav1.UpdateOptions{})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to scale Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Deployment '%s' in namespace '%s' scaled to '%d' replicas.", deploymentName, namespaceName, replicas)
+
+	return nil
+}
+
+// Updates the image of the container 'containerName' in the pod template of the Deployment which triggers a rollout.
+func SetDeploymentContainerImage(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string, containerName string, imageName string) error {
+	if containerName == "" {
+		return tracederrors.TracedErrorEmptyString("containerName")
+	}
+
+	if imageName == "" {
+		return tracederrors.TracedErrorEmptyString("imageName")
+	}
+
+	deployment, err := getDeployment(ctx, clientset, namespaceName, deploymentName)
+	if err != nil {
+		return err
+	}
+
+	containerNames := []string{}
+	for i, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name != containerName {
+			containerNames = append(containerNames, container.Name)
+			continue
+		}
+
+		if container.Image == imageName {
+			logging.LogInfoByCtxf(ctx, "Container '%s' of Deployment '%s' in namespace '%s' already uses image '%s'.", containerName, deploymentName, namespaceName, imageName)
+			return nil
+		}
+
+		deployment.Spec.Template.Spec.Containers[i].Image = imageName
+
+		_, err = clientset.AppsV1().Deployments(namespaceName).Update(ctx, deployment, metav1.UpdateOptions{})
+		if err != nil {
+			return tracederrors.TracedErrorf("Failed to update image of container '%s' of Deployment '%s' in namespace '%s': %w", containerName, deploymentName, namespaceName, err)
+		}
+
+		logging.LogChangedByCtxf(ctx, "Image of container '%s' of Deployment '%s' in namespace '%s' set to '%s'.", containerName, deploymentName, namespaceName, imageName)
+
+		return nil
+	}
+
+	return tracederrors.TracedErrorf("Deployment '%s' in namespace '%s' has no container '%s'. Available containers: '%v'", deploymentName, namespaceName, containerName, containerNames)
+}
+
+func getDeploymentRolloutStatus(deployment *appsv1.Deployment) (*kubernetesimplementationindependend.DeploymentRolloutStatus, error) {
+	if deployment == nil {
+		return nil, tracederrors.TracedErrorNil("deployment")
+	}
+
+	status := &kubernetesimplementationindependend.DeploymentRolloutStatus{
+		DeploymentName:     deployment.Name,
+		Generation:         deployment.Generation,
+		ObservedGeneration: deployment.Status.ObservedGeneration,
+		DesiredReplicas:    1,
+		Replicas:           deployment.Status.Replicas,
+		UpdatedReplicas:    deployment.Status.UpdatedReplicas,
+		AvailableReplicas:  deployment.Status.AvailableReplicas,
+	}
+
+	if deployment.Spec.Replicas != nil {
+		status.DesiredReplicas = *deployment.Spec.Replicas
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing {
+			status.ProgressingReason = condition.Reason
+		}
+	}
+
+	return status, nil
+}
+
+// Waits until the rollout of the Deployment is complete. Every change of the rollout progress is logged.
+func WaitForDeploymentRolloutComplete(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if deploymentName == "" {
+		return tracederrors.TracedErrorEmptyString("deploymentName")
+	}
+
+	lastMessage := ""
+	return pollUntil(
+		ctx,
+		fmt.Sprintf("rollout of Deployment '%s' in namespace '%s' is complete", deploymentName, namespaceName),
+		timeout,
+		func(ctx context.Context) (bool, error) {
+			deployment, err := getDeployment(ctx, clientset, namespaceName, deploymentName)
+			if err != nil {
+				return false, err
+			}
+
+			status, err := getDeploymentRolloutStatus(deployment)
+			if err != nil {
+				return false, err
+			}
+
+			done, message, err := status.Evaluate()
+			if err != nil {
+				return false, err
+			}
+
+			if message != lastMessage {
+				logging.LogInfoByCtxf(ctx, "%s", message)
+				lastMessage = message
+			}
+
+			return done, nil
+		},
+	)
+}
+
+// Returns the deployment together with the ReplicaSets controlled by it.
+func getDeploymentAndReplicaSets(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string) (*appsv1.Deployment, []*appsv1.ReplicaSet, error) {
+	deployment, err := getDeployment(ctx, clientset, namespaceName, deploymentName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, nil, tracederrors.TracedErrorf("Invalid selector of Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	replicaSetList, err := clientset.AppsV1().ReplicaSets(namespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, nil, tracederrors.TracedErrorf("Failed to list ReplicaSets of Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	replicaSets := []*appsv1.ReplicaSet{}
+	for i := range replicaSetList.Items {
+		if metav1.IsControlledBy(&replicaSetList.Items[i], deployment) {
+			replicaSets = append(replicaSets, &replicaSetList.Items[i])
+		}
+	}
+
+	return deployment, replicaSets, nil
+}
+
+func getDeploymentRevisionFromReplicaSet(replicaSet *appsv1.ReplicaSet) (*kubernetesimplementationindependend.DeploymentRevision, error) {
+	if replicaSet == nil {
+		return nil, tracederrors.TracedErrorNil("replicaSet")
+	}
+
+	revisionString, ok := replicaSet.Annotations[kubernetesimplementationindependend.DeploymentRevisionAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	revision, err := strconv.ParseInt(revisionString, 10, 64)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Invalid revision '%s' of ReplicaSet '%s': %w", revisionString, replicaSet.Name, err)
+	}
+
+	images := []string{}
+	for _, container := range replicaSet.Spec.Template.Spec.Containers {
+		images = append(images, container.Image)
+	}
+
+	return &kubernetesimplementationindependend.DeploymentRevision{
+		Revision:       revision,
+		ReplicaSetName: replicaSet.Name,
+		ChangeCause:    replicaSet.Annotations[kubernetesimplementationindependend.ChangeCauseAnnotation],
+		Images:         images,
+	}, nil
+}
+
+// Returns the revisions of the given ReplicaSets sorted ascending. ReplicaSets without revision annotation are ignored.
+func getDeploymentRevisionsFromReplicaSets(replicaSets []*appsv1.ReplicaSet) ([]*kubernetesimplementationindependend.DeploymentRevision, error) {
+	revisions := []*kubernetesimplementationindependend.DeploymentRevision{}
+	for _, replicaSet := range replicaSets {
+		revision, err := getDeploymentRevisionFromReplicaSet(replicaSet)
+		if err != nil {
+			return nil, err
+		}
+
+		if revision != nil {
+			revisions = append(revisions, revision)
+		}
+	}
+
+	kubernetesimplementationindependend.SortDeploymentRevisions(revisions)
+
+	return revisions, nil
+}
+
+// Returns the rollout history of the Deployment sorted ascending by revision.
+func ListDeploymentRevisions(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string) ([]*kubernetesimplementationindependend.DeploymentRevision, error) {
+	_, replicaSets, err := getDeploymentAndReplicaSets(ctx, clientset, namespaceName, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := getDeploymentRevisionsFromReplicaSets(replicaSets)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' revisions of Deployment '%s' in namespace '%s'.", len(revisions), deploymentName, namespaceName)
+
+	return revisions, nil
+}
+
+// Rolls the Deployment back to the pod template of the given revision like 'kubectl rollout undo' does.
+// If 'toRevision' is 0 the previous revision is used.
+func RollbackDeployment(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, deploymentName string, toRevision int64) error {
+	deployment, replicaSets, err := getDeploymentAndReplicaSets(ctx, clientset, namespaceName, deploymentName)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := getDeploymentRevisionsFromReplicaSets(replicaSets)
+	if err != nil {
+		return err
+	}
+
+	target, err := kubernetesimplementationindependend.GetDeploymentRevisionToRollbackTo(revisions, toRevision)
+	if err != nil {
+		return tracederrors.TracedErrorf("Unable to roll back Deployment '%s' in namespace '%s': %w", deploymentName, namespaceName, err)
+	}
+
+	index := slices.IndexFunc(replicaSets, func(r *appsv1.ReplicaSet) bool { return r.Name == target.ReplicaSetName })
+	if index < 0 {
+		return tracederrors.TracedErrorf("ReplicaSet '%s' of revision '%d' not found.", target.ReplicaSetName, target.Revision)
+	}
+
+	template := replicaSets[index].Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+	if equality.Semantic.DeepEqual(deployment.Spec.Template, *template) {
+		logging.LogInfoByCtxf(ctx, "Deployment '%s' in namespace '%s' already matches revision '%d'. Skip rollback.", deploymentName, namespaceName, target.Revision)
+		return nil
+	}
+
+	deployment.Spec.Template = *template
+
+	_, err = clientset.AppsV1().Deployments(namespaceName).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to roll back Deployment '%s' in namespace '%s' to revision '%d': %w", deploymentName, namespaceName, target.Revision, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Deployment '%s' in namespace '%s' rolled back to revision '%d'.", deploymentName, namespaceName, target.Revision)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package nativekubernetes
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+var podMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+func listUnstructured(ctx context.Context, dynamicClient dynamic.Interface, resource schema.GroupVersionResource, namespaceName string) ([]map[string]interface{}, error) {
+	list, err := dynamicClient.Resource(resource).Namespace(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list '%s' in namespace '%s': %w", resource.Resource, namespaceName, err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(list.Items))
+	for _, item := range list.Items {
+		items = append(items, item.Object)
+	}
+
+	return items, nil
+}
+
+// Returns the CPU and memory usage per pod as reported by the metrics-server.
+// Returns nil without an error if the metrics-server is not available. All other errors are returned.
+func GetResourceUsageByPodName(ctx context.Context, config *rest.Config, namespaceName string) (map[string]*kubernetesimplementationindependend.ResourceUsage, error) {
+	if config == nil {
+		return nil, tracederrors.TracedErrorNil("config")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create dynamic client: %w", err)
+	}
+
+	list, err := dynamicClient.Resource(podMetricsResource).Namespace(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// NotFound or ServiceUnavailable means the metrics-server is not installed or not running:
+		if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+			logging.LogInfoByCtxf(ctx, "Pod metrics of namespace '%s' not available: %v", namespaceName, err)
+			return nil, nil
+		}
+
+		return nil, tracederrors.TracedErrorf("Failed to get pod metrics of namespace '%s': %w", namespaceName, err)
+	}
+
+	return kubernetesimplementationindependend.GetResourceUsageByPodName(list.UnstructuredContent())
+}
+
+// Collects pod states, recent warning events, pending PersistentVolumeClaims and the resource usage of the given namespace.
+func GetNamespaceDiagnostics(ctx context.Context, config *rest.Config, namespaceName string, options *kubernetesparameteroptions.NamespaceDiagnosticsOptions) (*kubernetesimplementationindependend.NamespaceDiagnostics, error) {
+	if config == nil {
+		return nil, tracederrors.TracedErrorNil("config")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collect diagnostics of namespace '%s' started.", namespaceName)
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create dynamic client: %w", err)
+	}
+
+	pods, err := listUnstructured(ctx, dynamicClient, schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := listUnstructured(ctx, dynamicClient, schema.GroupVersionResource{Version: "v1", Resource: "events"}, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	persistentVolumeClaims, err := listUnstructured(ctx, dynamicClient, schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceUsageByPodName map[string]*kubernetesimplementationindependend.ResourceUsage
+	if !options.SkipResourceUsage {
+		resourceUsageByPodName, err = GetResourceUsageByPodName(ctx, config, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	diagnostics, err := kubernetesimplementationindependend.GetNamespaceDiagnostics(namespaceName, pods, events, persistentVolumeClaims, resourceUsageByPodName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Collect diagnostics of namespace '%s' finished. Found '%d' unhealthy pods.", namespaceName, len(diagnostics.GetUnhealthyPodNames()))
+
+	return diagnostics, nil
+}
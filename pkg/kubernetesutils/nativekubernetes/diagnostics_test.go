@@ -0,0 +1,47 @@
+package nativekubernetes_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func Test_GetResourceUsageByPodName_metricsServerErrors(t *testing.T) {
+	tests := []struct {
+		statusCode  int
+		reason      string
+		expectError bool
+	}{
+		{http.StatusNotFound, "NotFound", false},
+		{http.StatusServiceUnavailable, "ServiceUnavailable", false},
+		{http.StatusForbidden, "Forbidden", true},
+		{http.StatusInternalServerError, "InternalError", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				fmt.Fprintf(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"%s","code":%d}`, tt.reason, tt.statusCode)
+			}))
+			defer server.Close()
+
+			usage, err := nativekubernetes.GetResourceUsageByPodName(ctx, &rest.Config{Host: server.URL}, "default")
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Nil(t, usage)
+		})
+	}
+}
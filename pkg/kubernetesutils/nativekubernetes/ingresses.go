@@ -0,0 +1,223 @@
+package nativekubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func CreateIngress(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.CreateIngressOptions) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	ingressName, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	serviceName, err := options.GetServiceName()
+	if err != nil {
+		return err
+	}
+
+	servicePort, err := options.GetServicePort()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create Ingress '%s' in namespace '%s' started.", ingressName, namespaceName)
+
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ingressName,
+			Labels: options.GetLabels(),
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: options.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     options.GetPathOrDefault(),
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: serviceName,
+											Port: networkingv1.ServiceBackendPort{
+												Number: servicePort,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if options.IngressClassName != "" {
+		ingressClassName := options.IngressClassName
+		ingress.Spec.IngressClassName = &ingressClassName
+	}
+
+	_, err = clientset.NetworkingV1().Ingresses(namespaceName).Create(ctx, ingress, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "Ingress '%s' in namespace '%s' already exists.", ingressName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to create Ingress '%s' in namespace '%s': %w", ingressName, namespaceName, err)
+		}
+	} else {
+		logging.LogChangedByCtxf(ctx, "Ingress '%s' in namespace '%s' created.", ingressName, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create Ingress '%s' in namespace '%s' finished.", ingressName, namespaceName)
+
+	return nil
+}
+
+// Waits until the ingress controller assigned a load balancer address to the Ingress.
+func WaitForIngressAddressAssigned(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, ingressName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if ingressName == "" {
+		return tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	description := fmt.Sprintf("Ingress '%s' in namespace '%s' has an address assigned", ingressName, namespaceName)
+
+	return pollUntil(ctx, description, timeout, func(ctx context.Context) (bool, error) {
+		ingress, err := clientset.NetworkingV1().Ingresses(namespaceName).Get(ctx, ingressName, metav1.GetOptions{})
+		if err != nil {
+			return false, tracederrors.TracedErrorf("Failed to get Ingress '%s' in namespace '%s': %w", ingressName, namespaceName, err)
+		}
+
+		return len(ingress.Status.LoadBalancer.Ingress) > 0, nil
+	})
+}
+
+func IngressExists(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, ingressName string) (bool, error) {
+	if clientset == nil {
+		return false, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if ingressName == "" {
+		return false, tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	_, err := clientset.NetworkingV1().Ingresses(namespaceName).Get(ctx, ingressName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Ingress '%s' in namespace '%s' does not exist.", ingressName, namespaceName)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to get Ingress '%s' in namespace '%s': %w", ingressName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Ingress '%s' in namespace '%s' exists.", ingressName, namespaceName)
+
+	return true, nil
+}
+
+func DeleteIngress(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, ingressName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if ingressName == "" {
+		return tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete Ingress '%s' in namespace '%s' started.", ingressName, namespaceName)
+
+	deletePolicy := metav1.DeletePropagationBackground
+	err := clientset.NetworkingV1().Ingresses(namespaceName).Delete(ctx, ingressName, metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err == nil {
+		err = pollUntil(ctx, fmt.Sprintf("Ingress '%s' in namespace '%s' is deleted", ingressName, namespaceName), defaultDeleteTimeout, func(ctx context.Context) (bool, error) {
+			exists, err := IngressExists(contextutils.WithSilent(ctx), clientset, namespaceName, ingressName)
+			return !exists, err
+		})
+		if err != nil {
+			return err
+		}
+
+		logging.LogChangedByCtxf(ctx, "Ingress '%s' in namespace '%s' deleted.", ingressName, namespaceName)
+	} else {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Ingress '%s' already absent in namespace '%s'.", ingressName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to delete Ingress '%s' in namespace '%s': %w", ingressName, namespaceName, err)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete Ingress '%s' in namespace '%s' finished.", ingressName, namespaceName)
+
+	return nil
+}
+
+func ListIngressNames(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string) ([]string, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	list, err := clientset.NetworkingV1().Ingresses(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list Ingresses in namespace '%s': %w", namespaceName, err)
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' Ingresses in namespace '%s'.", len(names), namespaceName)
+
+	return names, nil
+}
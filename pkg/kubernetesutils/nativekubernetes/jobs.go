@@ -0,0 +1,222 @@
+package nativekubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func CreateJob(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.CreateJobOptions) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	jobName, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	imageName, err := options.GetImageName()
+	if err != nil {
+		return err
+	}
+
+	backoffLimit, err := options.GetBackoffLimit()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create Job '%s' in namespace '%s' using container image '%s' started.", jobName, namespaceName, imageName)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   jobName,
+			Labels: options.GetLabels(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:    jobName,
+							Image:   imageName,
+							Command: options.Command,
+						},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+
+	_, err = clientset.BatchV1().Jobs(namespaceName).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "Job '%s' in namespace '%s' already exists.", jobName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to create Job '%s' in namespace '%s': %w", jobName, namespaceName, err)
+		}
+	} else {
+		logging.LogChangedByCtxf(ctx, "Job '%s' in namespace '%s' created.", jobName, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create Job '%s' in namespace '%s' using container image '%s' finished.", jobName, namespaceName, imageName)
+
+	return nil
+}
+
+// Waits until the Job completed successfully.
+// An error is returned as soon as the Job failed.
+func WaitForJobCompleted(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, jobName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if jobName == "" {
+		return tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	description := fmt.Sprintf("Job '%s' in namespace '%s' is completed", jobName, namespaceName)
+
+	return pollUntil(ctx, description, timeout, func(ctx context.Context) (bool, error) {
+		job, err := clientset.BatchV1().Jobs(namespaceName).Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			return false, tracederrors.TracedErrorf("Failed to get Job '%s' in namespace '%s': %w", jobName, namespaceName, err)
+		}
+
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+
+			switch condition.Type {
+			case batchv1.JobComplete:
+				return true, nil
+			case batchv1.JobFailed:
+				return false, tracederrors.TracedErrorf("Job '%s' in namespace '%s' failed: %s", jobName, namespaceName, condition.Message)
+			}
+		}
+
+		return false, nil
+	})
+}
+
+func JobExists(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, jobName string) (bool, error) {
+	if clientset == nil {
+		return false, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if jobName == "" {
+		return false, tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	_, err := clientset.BatchV1().Jobs(namespaceName).Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Job '%s' in namespace '%s' does not exist.", jobName, namespaceName)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to get Job '%s' in namespace '%s': %w", jobName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Job '%s' in namespace '%s' exists.", jobName, namespaceName)
+
+	return true, nil
+}
+
+func DeleteJob(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, jobName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if jobName == "" {
+		return tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete Job '%s' in namespace '%s' started.", jobName, namespaceName)
+
+	deletePolicy := metav1.DeletePropagationBackground
+	err := clientset.BatchV1().Jobs(namespaceName).Delete(ctx, jobName, metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err == nil {
+		err = pollUntil(ctx, fmt.Sprintf("Job '%s' in namespace '%s' is deleted", jobName, namespaceName), defaultDeleteTimeout, func(ctx context.Context) (bool, error) {
+			exists, err := JobExists(contextutils.WithSilent(ctx), clientset, namespaceName, jobName)
+			return !exists, err
+		})
+		if err != nil {
+			return err
+		}
+
+		logging.LogChangedByCtxf(ctx, "Job '%s' in namespace '%s' deleted.", jobName, namespaceName)
+	} else {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Job '%s' already absent in namespace '%s'.", jobName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to delete Job '%s' in namespace '%s': %w", jobName, namespaceName, err)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete Job '%s' in namespace '%s' finished.", jobName, namespaceName)
+
+	return nil
+}
+
+func ListJobNames(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string) ([]string, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	list, err := clientset.BatchV1().Jobs(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list Jobs in namespace '%s': %w", namespaceName, err)
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' Jobs in namespace '%s'.", len(names), namespaceName)
+
+	return names, nil
+}
@@ -0,0 +1,215 @@
+package nativekubernetes
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func getPodLogOptions(containerName string, options *kubernetesparameteroptions.StreamLogsOptions) (*corev1.PodLogOptions, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	podLogOptions := &corev1.PodLogOptions{
+		Container:  containerName,
+		Follow:     options.Follow,
+		Timestamps: options.Timestamps,
+	}
+
+	if options.IsSinceSet() {
+		sinceSeconds, err := options.GetSinceSeconds()
+		if err != nil {
+			return nil, err
+		}
+
+		podLogOptions.SinceSeconds = &sinceSeconds
+	}
+
+	if options.IsTailLinesSet() {
+		tailLines, err := options.GetTailLines()
+		if err != nil {
+			return nil, err
+		}
+
+		podLogOptions.TailLines = &tailLines
+	}
+
+	return podLogOptions, nil
+}
+
+// Opens the log stream of a single container. The caller has to close the returned reader.
+//
+// If options.Follow is set the stream stays open until the container terminates or the reader is closed.
+func StreamContainerLogs(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, podName string, containerName string, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if podName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("podName")
+	}
+
+	if containerName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("containerName")
+	}
+
+	podLogOptions, err := getPodLogOptions(containerName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := clientset.CoreV1().Pods(namespaceName).GetLogs(podName, podLogOptions).Stream(ctx)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to open log stream of container '%s' in pod '%s' in namespace '%s': %w", containerName, podName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Opened log stream of container '%s' in pod '%s' in namespace '%s' (follow=%t).", containerName, podName, namespaceName, options.Follow)
+
+	return ret, nil
+}
+
+// Returns the names of the containers in the pod to stream the logs from.
+// If options.ContainerName is set only this container is returned if it is present in the pod.
+func getContainerNamesToStream(pod *corev1.Pod, options *kubernetesparameteroptions.StreamLogsOptions) []string {
+	ret := []string{}
+	for _, container := range pod.Spec.Containers {
+		if options.IsContainerNameSet() && container.Name != options.ContainerName {
+			continue
+		}
+
+		ret = append(ret, container.Name)
+	}
+
+	return ret
+}
+
+func openContainerLogStreams(ctx context.Context, clientset *kubernetes.Clientset, pods []corev1.Pod, options *kubernetesparameteroptions.StreamLogsOptions) ([]*kubernetesimplementationindependend.ContainerLogStream, error) {
+	streams := []*kubernetesimplementationindependend.ContainerLogStream{}
+
+	closeStreams := func() {
+		for _, s := range streams {
+			s.Reader.Close()
+		}
+	}
+
+	for _, pod := range pods {
+		for _, containerName := range getContainerNamesToStream(&pod, options) {
+			reader, err := StreamContainerLogs(ctx, clientset, pod.Namespace, pod.Name, containerName, options)
+			if err != nil {
+				closeStreams()
+				return nil, err
+			}
+
+			streams = append(streams, &kubernetesimplementationindependend.ContainerLogStream{
+				PodName:       pod.Name,
+				ContainerName: containerName,
+				Reader:        reader,
+			})
+		}
+	}
+
+	return streams, nil
+}
+
+// Streams the logs of the pod. The caller has to close the returned reader.
+//
+// If options.ContainerName is not set and the pod has multiple containers the logs of all containers are aggregated
+// and every line is prefixed by "[pod-name/container-name] ".
+func StreamPodLogs(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, podName string, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if podName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("podName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	pod, err := clientset.CoreV1().Pods(namespaceName).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to get pod '%s' in namespace '%s' to stream logs: %w", podName, namespaceName, err)
+	}
+
+	containerNames := getContainerNamesToStream(pod, options)
+	switch len(containerNames) {
+	case 0:
+		return nil, tracederrors.TracedErrorf("Container '%s' not found in pod '%s' in namespace '%s'.", options.ContainerName, podName, namespaceName)
+	case 1:
+		return StreamContainerLogs(ctx, clientset, namespaceName, podName, containerNames[0], options)
+	}
+
+	streams, err := openContainerLogStreams(ctx, clientset, []corev1.Pod{*pod}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetesimplementationindependend.MergeContainerLogStreams(streams)
+}
+
+// Aggregates the logs of all pods in the namespace matching options.LabelSelector. The caller has to close the returned reader.
+// Every line is prefixed by "[pod-name/container-name] ".
+//
+// The pods are selected once when the stream is opened. Pods created afterwards are not added even if options.Follow is set.
+func StreamLogsByLabelSelector(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	labelSelector, err := options.GetLabelSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	podList, err := clientset.CoreV1().Pods(namespaceName).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list pods matching label selector '%s' in namespace '%s': %w", labelSelector, namespaceName, err)
+	}
+
+	if len(podList.Items) == 0 {
+		return nil, tracederrors.TracedErrorf("No pods matching label selector '%s' found in namespace '%s'.", labelSelector, namespaceName)
+	}
+
+	pods := podList.Items
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+
+	streams, err := openContainerLogStreams(ctx, clientset, pods, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(streams) == 0 {
+		return nil, tracederrors.TracedErrorf("No container '%s' found in pods matching label selector '%s' in namespace '%s'.", options.ContainerName, labelSelector, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Aggregate logs of '%d' containers in '%d' pods matching label selector '%s' in namespace '%s'.", len(streams), len(pods), labelSelector, namespaceName)
+
+	return kubernetesimplementationindependend.MergeContainerLogStreams(streams)
+}
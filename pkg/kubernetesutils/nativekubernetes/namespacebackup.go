@@ -0,0 +1,188 @@
+package nativekubernetes
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+// Lists the objects of all listable namespaced kinds in the given namespace.
+//
+// 'isKindIncluded' is optional and allows to limit the listed kinds. The objects are sorted by kind and name.
+func ListNamespacedObjects(ctx context.Context, config *rest.Config, namespaceName string, isKindIncluded func(kind string) bool) ([]*unstructured.Unstructured, error) {
+	if config == nil {
+		return nil, tracederrors.TracedErrorNil("config")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create discovery client: %w", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create dynamic client: %w", err)
+	}
+
+	resourceLists, err := discoveryClient.ServerPreferredNamespacedResources()
+	if err != nil {
+		// Unavailable aggregated APIs like metrics.k8s.io must not prevent listing all other kinds:
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, tracederrors.TracedErrorf("Failed to discover namespaced resources: %w", err)
+		}
+
+		logging.LogWarnByCtxf(ctx, "Some API groups are unavailable and skipped: %v", err)
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Invalid group version '%s': %w", resourceList.GroupVersion, err)
+		}
+
+		for _, resource := range resourceList.APIResources {
+			// Skip subresources like 'pods/log':
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+
+			if !slices.Contains(resource.Verbs, "list") {
+				continue
+			}
+
+			if isKindIncluded != nil && !isKindIncluded(resource.Kind) {
+				continue
+			}
+
+			list, err := dynamicClient.Resource(groupVersion.WithResource(resource.Name)).Namespace(namespaceName).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) {
+					logging.LogWarnByCtxf(ctx, "Unable to list '%s' in namespace '%s'. Skipped: %v", resource.Name, namespaceName, err)
+					continue
+				}
+
+				return nil, tracederrors.TracedErrorf("Failed to list '%s' in namespace '%s': %w", resource.Name, namespaceName, err)
+			}
+
+			for i := range list.Items {
+				item := &list.Items[i]
+
+				// Items of a list do not always carry their type information:
+				if item.GetKind() == "" {
+					item.SetKind(resource.Kind)
+				}
+
+				if item.GetAPIVersion() == "" {
+					item.SetAPIVersion(groupVersion.String())
+				}
+
+				objects = append(objects, item)
+			}
+		}
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		if objects[i].GetKind() != objects[j].GetKind() {
+			return objects[i].GetKind() < objects[j].GetKind()
+		}
+
+		return objects[i].GetName() < objects[j].GetName()
+	})
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' objects in namespace '%s'.", len(objects), namespaceName)
+
+	return objects, nil
+}
+
+// Exports the objects of the namespace as cleaned YAML files into 'directory'.
+// See kubernetesimplementationindependend.WriteNamespaceBackup for details.
+func BackupNamespace(ctx context.Context, config *rest.Config, namespaceName string, directory filesinterfaces.Directory, options *kubernetesparameteroptions.BackupNamespaceOptions) ([]*kubernetesimplementationindependend.ObjectReference, error) {
+	if directory == nil {
+		return nil, tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Backup namespace '%s' started.", namespaceName)
+
+	objects, err := ListNamespacedObjects(ctx, config, namespaceName, func(kind string) bool {
+		return kubernetesimplementationindependend.IsKindIncludedInBackup(kind, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	toWrite := make([]map[string]interface{}, 0, len(objects))
+	for _, o := range objects {
+		toWrite = append(toWrite, o.Object)
+	}
+
+	exported, err := kubernetesimplementationindependend.WriteNamespaceBackup(ctx, toWrite, directory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range exported {
+		e.Namespace = namespaceName
+	}
+
+	logging.LogInfoByCtxf(ctx, "Backup namespace '%s' finished.", namespaceName)
+
+	return exported, nil
+}
+
+// Re-applies the objects of a namespace backup written by BackupNamespace into the given namespace in dependency order.
+func RestoreNamespace(ctx context.Context, config *rest.Config, namespaceName string, directory filesinterfaces.Directory, options *kubernetesparameteroptions.RestoreNamespaceOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	if directory == nil {
+		return nil, tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Restore namespace '%s' started.", namespaceName)
+
+	yamlString, err := kubernetesimplementationindependend.ReadNamespaceBackup(ctx, directory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ApplyManifests(ctx, config, namespaceName, &kubernetesparameteroptions.ApplyManifestsOptions{
+		YamlString:       yamlString,
+		WaitForReadiness: options.WaitForReadiness,
+		WaitTimeout:      options.WaitTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Restore namespace '%s' finished.", namespaceName)
+
+	return result, nil
+}
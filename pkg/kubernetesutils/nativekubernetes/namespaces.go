@@ -235,6 +235,34 @@ func ListSecretNames(ctx context.Context, clientSet *kubernetes.Clientset, names
 	return names, nil
 }
 
+// ListSecretNamesByType returns the names of the secrets of the given type like 'kubernetes.io/tls' in the namespace.
+// The filtering is done by the API server using a field selector.
+func ListSecretNamesByType(ctx context.Context, clientSet *kubernetes.Clientset, namespaceName string, secretType string) ([]string, error) {
+	if clientSet == nil {
+		return nil, tracederrors.TracedErrorNil("clientSet")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if secretType == "" {
+		return nil, tracederrors.TracedErrorEmptyString("secretType")
+	}
+
+	secretList, err := clientSet.CoreV1().Secrets(namespaceName).List(ctx, metav1.ListOptions{FieldSelector: "type=" + secretType})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list secrets of type '%s' in namespace '%s': %w", secretType, namespaceName, err)
+	}
+
+	names := make([]string, len(secretList.Items))
+	for i, secret := range secretList.Items {
+		names[i] = secret.Name
+	}
+
+	return names, nil
+}
+
 func ListRoleNames(ctx context.Context, clientSet *kubernetes.Clientset, namespaceName string) ([]string, error) {
 	if clientSet == nil {
 		return nil, tracederrors.TracedErrorNil("clientSet")
@@ -0,0 +1,205 @@
+package nativekubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func CreatePersistentVolumeClaim(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.CreatePersistentVolumeClaimOptions) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	persistentVolumeClaimName, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	storageSize := options.GetStorageSizeOrDefault()
+	quantity, err := resource.ParseQuantity(storageSize)
+	if err != nil {
+		return tracederrors.TracedErrorf("Invalid storage size '%s': %w", storageSize, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create PersistentVolumeClaim '%s' in namespace '%s' started.", persistentVolumeClaimName, namespaceName)
+
+	persistentVolumeClaim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   persistentVolumeClaimName,
+			Labels: options.GetLabels(),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.PersistentVolumeAccessMode(options.GetAccessModeOrDefault()),
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
+				},
+			},
+		},
+	}
+
+	if options.StorageClassName != "" {
+		storageClassName := options.StorageClassName
+		persistentVolumeClaim.Spec.StorageClassName = &storageClassName
+	}
+
+	_, err = clientset.CoreV1().PersistentVolumeClaims(namespaceName).Create(ctx, persistentVolumeClaim, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "PersistentVolumeClaim '%s' in namespace '%s' already exists.", persistentVolumeClaimName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to create PersistentVolumeClaim '%s' in namespace '%s': %w", persistentVolumeClaimName, namespaceName, err)
+		}
+	} else {
+		logging.LogChangedByCtxf(ctx, "PersistentVolumeClaim '%s' in namespace '%s' created.", persistentVolumeClaimName, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create PersistentVolumeClaim '%s' in namespace '%s' finished.", persistentVolumeClaimName, namespaceName)
+
+	return nil
+}
+
+// Waits until the PersistentVolumeClaim is bound to a volume.
+// Storage classes using 'WaitForFirstConsumer' only bind after a pod using the claim was scheduled.
+func WaitForPersistentVolumeClaimBound(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, persistentVolumeClaimName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if persistentVolumeClaimName == "" {
+		return tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	description := fmt.Sprintf("PersistentVolumeClaim '%s' in namespace '%s' is bound", persistentVolumeClaimName, namespaceName)
+
+	return pollUntil(ctx, description, timeout, func(ctx context.Context) (bool, error) {
+		persistentVolumeClaim, err := clientset.CoreV1().PersistentVolumeClaims(namespaceName).Get(ctx, persistentVolumeClaimName, metav1.GetOptions{})
+		if err != nil {
+			return false, tracederrors.TracedErrorf("Failed to get PersistentVolumeClaim '%s' in namespace '%s': %w", persistentVolumeClaimName, namespaceName, err)
+		}
+
+		return persistentVolumeClaim.Status.Phase == corev1.ClaimBound, nil
+	})
+}
+
+func PersistentVolumeClaimExists(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, persistentVolumeClaimName string) (bool, error) {
+	if clientset == nil {
+		return false, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if persistentVolumeClaimName == "" {
+		return false, tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	_, err := clientset.CoreV1().PersistentVolumeClaims(namespaceName).Get(ctx, persistentVolumeClaimName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "PersistentVolumeClaim '%s' in namespace '%s' does not exist.", persistentVolumeClaimName, namespaceName)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to get PersistentVolumeClaim '%s' in namespace '%s': %w", persistentVolumeClaimName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "PersistentVolumeClaim '%s' in namespace '%s' exists.", persistentVolumeClaimName, namespaceName)
+
+	return true, nil
+}
+
+func DeletePersistentVolumeClaim(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, persistentVolumeClaimName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if persistentVolumeClaimName == "" {
+		return tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete PersistentVolumeClaim '%s' in namespace '%s' started.", persistentVolumeClaimName, namespaceName)
+
+	deletePolicy := metav1.DeletePropagationBackground
+	err := clientset.CoreV1().PersistentVolumeClaims(namespaceName).Delete(ctx, persistentVolumeClaimName, metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err == nil {
+		err = pollUntil(ctx, fmt.Sprintf("PersistentVolumeClaim '%s' in namespace '%s' is deleted", persistentVolumeClaimName, namespaceName), defaultDeleteTimeout, func(ctx context.Context) (bool, error) {
+			exists, err := PersistentVolumeClaimExists(contextutils.WithSilent(ctx), clientset, namespaceName, persistentVolumeClaimName)
+			return !exists, err
+		})
+		if err != nil {
+			return err
+		}
+
+		logging.LogChangedByCtxf(ctx, "PersistentVolumeClaim '%s' in namespace '%s' deleted.", persistentVolumeClaimName, namespaceName)
+	} else {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "PersistentVolumeClaim '%s' already absent in namespace '%s'.", persistentVolumeClaimName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to delete PersistentVolumeClaim '%s' in namespace '%s': %w", persistentVolumeClaimName, namespaceName, err)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete PersistentVolumeClaim '%s' in namespace '%s' finished.", persistentVolumeClaimName, namespaceName)
+
+	return nil
+}
+
+func ListPersistentVolumeClaimNames(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string) ([]string, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	list, err := clientset.CoreV1().PersistentVolumeClaims(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list PersistentVolumeClaims in namespace '%s': %w", namespaceName, err)
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' PersistentVolumeClaims in namespace '%s'.", len(names), namespaceName)
+
+	return names, nil
+}
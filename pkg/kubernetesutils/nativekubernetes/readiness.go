@@ -0,0 +1,170 @@
+package nativekubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// Evaluates the status of the given object and returns true if it is ready.
+//
+// For kinds without readiness check (see kubernetesimplementationindependend.GetKindsWithReadinessCheck) true is returned.
+// An error is returned if the object reached a state it will not recover from, e.g. a failed Job.
+func IsObjectReady(obj *unstructured.Unstructured) (bool, error) {
+	if obj == nil {
+		return false, tracederrors.TracedErrorNil("obj")
+	}
+
+	kind := obj.GetKind()
+	name := obj.GetName()
+	namespace := obj.GetNamespace()
+
+	switch kind {
+	case "Deployment", "StatefulSet", "DaemonSet":
+		generation, _ := getNestedInt64(obj, "metadata", "generation")
+		observedGeneration, _ := getNestedInt64(obj, "status", "observedGeneration")
+		if observedGeneration < generation {
+			return false, nil
+		}
+
+		if kind == "DaemonSet" {
+			desired, _ := getNestedInt64(obj, "status", "desiredNumberScheduled")
+			updated, _ := getNestedInt64(obj, "status", "updatedNumberScheduled")
+			ready, _ := getNestedInt64(obj, "status", "numberReady")
+			return updated == desired && ready == desired, nil
+		}
+
+		replicas, found := getNestedInt64(obj, "spec", "replicas")
+		if !found {
+			replicas = 1
+		}
+
+		updated, _ := getNestedInt64(obj, "status", "updatedReplicas")
+		ready, _ := getNestedInt64(obj, "status", "readyReplicas")
+
+		if kind == "Deployment" {
+			if getConditionStatus(obj, "ReplicaFailure") == "True" {
+				return false, tracederrors.TracedErrorf("Deployment '%s' in namespace '%s' has replica failure.", name, namespace)
+			}
+
+			available, _ := getNestedInt64(obj, "status", "availableReplicas")
+			return updated == replicas && ready == replicas && available == replicas, nil
+		}
+
+		return updated == replicas && ready == replicas, nil
+	case "Job":
+		if getConditionStatus(obj, "Failed") == "True" {
+			return false, tracederrors.TracedErrorf("Job '%s' in namespace '%s' failed.", name, namespace)
+		}
+
+		return getConditionStatus(obj, "Complete") == "True", nil
+	case "Pod":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		switch phase {
+		case "Succeeded":
+			return true, nil
+		case "Failed":
+			return false, tracederrors.TracedErrorf("Pod '%s' in namespace '%s' failed.", name, namespace)
+		}
+
+		return getConditionStatus(obj, "Ready") == "True", nil
+	case "CustomResourceDefinition":
+		return getConditionStatus(obj, "Established") == "True", nil
+	}
+
+	return true, nil
+}
+
+// Like unstructured.NestedInt64 but also accepts float64 values as produced when the object was decoded from plain JSON or YAML.
+func getNestedInt64(obj *unstructured.Unstructured, fields ...string) (int64, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	if err != nil || !found {
+		return 0, false
+	}
+
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	}
+
+	return 0, false
+}
+
+func getConditionStatus(obj *unstructured.Unstructured, conditionType string) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if condition["type"] == conditionType {
+			status, _ := condition["status"].(string)
+			return status
+		}
+	}
+
+	return ""
+}
+
+// Polls the object until IsObjectReady reports it as ready or the timeout is reached.
+func WaitForObjectReady(ctx context.Context, resourceInterface dynamic.ResourceInterface, ref *kubernetesimplementationindependend.ObjectReference, timeout time.Duration) error {
+	if resourceInterface == nil {
+		return tracederrors.TracedErrorNil("resourceInterface")
+	}
+
+	if ref == nil {
+		return tracederrors.TracedErrorNil("ref")
+	}
+
+	if !kubernetesimplementationindependend.IsReadinessCheckSupportedForKind(ref.Kind) {
+		logging.LogInfoByCtxf(ctx, "No readiness check for kind '%s' available. Skip waiting for %s.", ref.Kind, ref)
+		return nil
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait for %s to be ready started.", ref)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		obj, err := resourceInterface.Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return tracederrors.TracedErrorf("Failed to get %s: %w", ref, err)
+			}
+		} else {
+			ready, err := IsObjectReady(obj)
+			if err != nil {
+				return err
+			}
+
+			if ready {
+				logging.LogInfoByCtxf(ctx, "Wait for %s to be ready finished.", ref)
+				return nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return tracederrors.TracedErrorf("Timeout after %s waiting for %s to be ready.", timeout, ref)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package nativekubernetes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigyaml "sigs.k8s.io/yaml"
+)
+
+func mustParseUnstructured(t *testing.T, yamlString string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	err := sigyaml.Unmarshal([]byte(yamlString), &obj.Object)
+	require.NoError(t, err)
+
+	return obj
+}
+
+func Test_IsObjectReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		yaml          string
+		expectedReady bool
+		expectedError bool
+	}{
+		{
+			name:          "configmap has no readiness",
+			yaml:          "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n",
+			expectedReady: true,
+		},
+		{
+			name:          "deployment not observed yet",
+			yaml:          "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: a\n  generation: 2\nspec:\n  replicas: 1\nstatus:\n  observedGeneration: 1\n  updatedReplicas: 1\n  readyReplicas: 1\n  availableReplicas: 1\n",
+			expectedReady: false,
+		},
+		{
+			name:          "deployment ready",
+			yaml:          "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: a\n  generation: 2\nspec:\n  replicas: 2\nstatus:\n  observedGeneration: 2\n  updatedReplicas: 2\n  readyReplicas: 2\n  availableReplicas: 2\n",
+			expectedReady: true,
+		},
+		{
+			name:          "deployment default replicas not ready",
+			yaml:          "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: a\n  generation: 1\nstatus:\n  observedGeneration: 1\n",
+			expectedReady: false,
+		},
+		{
+			name:          "statefulset ready",
+			yaml:          "apiVersion: apps/v1\nkind: StatefulSet\nmetadata:\n  name: a\n  generation: 1\nspec:\n  replicas: 1\nstatus:\n  observedGeneration: 1\n  updatedReplicas: 1\n  readyReplicas: 1\n",
+			expectedReady: true,
+		},
+		{
+			name:          "daemonset not ready",
+			yaml:          "apiVersion: apps/v1\nkind: DaemonSet\nmetadata:\n  name: a\n  generation: 1\nstatus:\n  observedGeneration: 1\n  desiredNumberScheduled: 3\n  updatedNumberScheduled: 3\n  numberReady: 2\n",
+			expectedReady: false,
+		},
+		{
+			name:          "job complete",
+			yaml:          "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: a\nstatus:\n  conditions:\n  - type: Complete\n    status: \"True\"\n",
+			expectedReady: true,
+		},
+		{
+			name:          "job failed",
+			yaml:          "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: a\nstatus:\n  conditions:\n  - type: Failed\n    status: \"True\"\n",
+			expectedError: true,
+		},
+		{
+			name:          "pod ready",
+			yaml:          "apiVersion: v1\nkind: Pod\nmetadata:\n  name: a\nstatus:\n  phase: Running\n  conditions:\n  - type: Ready\n    status: \"True\"\n",
+			expectedReady: true,
+		},
+		{
+			name:          "crd not established",
+			yaml:          "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\nmetadata:\n  name: a\nstatus:\n  conditions:\n  - type: Established\n    status: \"False\"\n",
+			expectedReady: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, err := nativekubernetes.IsObjectReady(mustParseUnstructured(t, tt.yaml))
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedReady, ready)
+		})
+	}
+}
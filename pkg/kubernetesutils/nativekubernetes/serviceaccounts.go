@@ -0,0 +1,142 @@
+package nativekubernetes
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func CreateServiceAccount(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, serviceAccountName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceAccountName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceAccountName")
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceAccountName,
+		},
+	}
+
+	_, err := clientset.CoreV1().ServiceAccounts(namespaceName).Create(ctx, serviceAccount, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "ServiceAccount '%s' in namespace '%s' already exists.", serviceAccountName, namespaceName)
+			return nil
+		}
+
+		return tracederrors.TracedErrorf("Failed to create ServiceAccount '%s' in namespace '%s': %w", serviceAccountName, namespaceName, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "ServiceAccount '%s' in namespace '%s' created.", serviceAccountName, namespaceName)
+
+	return nil
+}
+
+// Deletes the ServiceAccount. All tokens issued for the ServiceAccount become invalid.
+// Deleting a non existing ServiceAccount is not an error.
+func DeleteServiceAccount(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, serviceAccountName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceAccountName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceAccountName")
+	}
+
+	err := clientset.CoreV1().ServiceAccounts(namespaceName).Delete(ctx, serviceAccountName, metav1.DeleteOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "ServiceAccount '%s' in namespace '%s' already absent.", serviceAccountName, namespaceName)
+			return nil
+		}
+
+		return tracederrors.TracedErrorf("Failed to delete ServiceAccount '%s' in namespace '%s': %w", serviceAccountName, namespaceName, err)
+	}
+
+	logging.LogChangedByCtxf(ctx, "ServiceAccount '%s' in namespace '%s' deleted.", serviceAccountName, namespaceName)
+
+	return nil
+}
+
+// Issues a bound token for the ServiceAccount using the TokenRequest API.
+func CreateServiceAccountToken(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, serviceAccountName string, expiration time.Duration) (string, error) {
+	if clientset == nil {
+		return "", tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return "", tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceAccountName == "" {
+		return "", tracederrors.TracedErrorEmptyString("serviceAccountName")
+	}
+
+	expirationSeconds := int64(expiration.Seconds())
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+
+	created, err := clientset.CoreV1().ServiceAccounts(namespaceName).CreateToken(ctx, serviceAccountName, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to create token for ServiceAccount '%s' in namespace '%s': %w", serviceAccountName, namespaceName, err)
+	}
+
+	if created.Status.Token == "" {
+		return "", tracederrors.TracedErrorf("Got empty token for ServiceAccount '%s' in namespace '%s'.", serviceAccountName, namespaceName)
+	}
+
+	logging.LogChangedByCtxf(ctx, "Token for ServiceAccount '%s' in namespace '%s' valid until '%s' issued.", serviceAccountName, namespaceName, created.Status.ExpirationTimestamp.Format(time.RFC3339))
+
+	return created.Status.Token, nil
+}
+
+// Returns the API server URL and the PEM encoded certificate authority used by the given config.
+func GetServerUrlAndCertificateAuthority(config *rest.Config) (serverUrl string, certificateAuthority []byte, err error) {
+	if config == nil {
+		return "", nil, tracederrors.TracedErrorNil("config")
+	}
+
+	serverUrl = config.Host
+	if serverUrl == "" {
+		return "", nil, tracederrors.TracedError("Host not set in config")
+	}
+
+	certificateAuthority = config.CAData
+	if len(certificateAuthority) == 0 && config.CAFile != "" {
+		certificateAuthority, err = os.ReadFile(config.CAFile)
+		if err != nil {
+			return "", nil, tracederrors.TracedErrorf("Failed to read certificate authority file '%s': %w", config.CAFile, err)
+		}
+	}
+
+	if len(certificateAuthority) == 0 {
+		return "", nil, tracederrors.TracedErrorf("No certificate authority set in config for server '%s'.", serverUrl)
+	}
+
+	return serverUrl, certificateAuthority, nil
+}
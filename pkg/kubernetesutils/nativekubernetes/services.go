@@ -0,0 +1,215 @@
+package nativekubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+func CreateService(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.CreateServiceOptions) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	serviceName, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	ports, err := options.GetPorts()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create Service '%s' in namespace '%s' started.", serviceName, namespaceName)
+
+	servicePorts := []corev1.ServicePort{}
+	for _, p := range ports {
+		servicePorts = append(servicePorts, corev1.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: intstr.FromInt32(p.GetTargetPortOrDefault()),
+			Protocol:   corev1.Protocol(p.GetProtocolOrDefault()),
+		})
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceName,
+			Labels: options.GetLabels(),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceType(options.GetTypeOrDefault()),
+			Selector: options.GetSelector(),
+			Ports:    servicePorts,
+		},
+	}
+
+	_, err = clientset.CoreV1().Services(namespaceName).Create(ctx, service, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "Service '%s' in namespace '%s' already exists.", serviceName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to create Service '%s' in namespace '%s': %w", serviceName, namespaceName, err)
+		}
+	} else {
+		logging.LogChangedByCtxf(ctx, "Service '%s' in namespace '%s' created.", serviceName, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create Service '%s' in namespace '%s' finished.", serviceName, namespaceName)
+
+	return nil
+}
+
+// Waits until at least one ready endpoint is available for the service.
+func WaitForServiceReady(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, serviceName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	description := fmt.Sprintf("Service '%s' in namespace '%s' has a ready endpoint", serviceName, namespaceName)
+
+	return pollUntil(ctx, description, timeout, func(ctx context.Context) (bool, error) {
+		endpointSlices, err := clientset.DiscoveryV1().EndpointSlices(namespaceName).List(ctx, metav1.ListOptions{
+			LabelSelector: discoveryv1.LabelServiceName + "=" + serviceName,
+		})
+		if err != nil {
+			return false, tracederrors.TracedErrorf("Failed to list EndpointSlices of Service '%s' in namespace '%s': %w", serviceName, namespaceName, err)
+		}
+
+		for _, endpointSlice := range endpointSlices.Items {
+			for _, endpoint := range endpointSlice.Endpoints {
+				// A nil value has to be interpreted as ready.
+				if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+					return true, nil
+				}
+			}
+		}
+
+		return false, nil
+	})
+}
+
+func ServiceExists(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, serviceName string) (bool, error) {
+	if clientset == nil {
+		return false, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	_, err := clientset.CoreV1().Services(namespaceName).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Service '%s' in namespace '%s' does not exist.", serviceName, namespaceName)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to get Service '%s' in namespace '%s': %w", serviceName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Service '%s' in namespace '%s' exists.", serviceName, namespaceName)
+
+	return true, nil
+}
+
+func DeleteService(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, serviceName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if serviceName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete Service '%s' in namespace '%s' started.", serviceName, namespaceName)
+
+	deletePolicy := metav1.DeletePropagationBackground
+	err := clientset.CoreV1().Services(namespaceName).Delete(ctx, serviceName, metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err == nil {
+		err = pollUntil(ctx, fmt.Sprintf("Service '%s' in namespace '%s' is deleted", serviceName, namespaceName), defaultDeleteTimeout, func(ctx context.Context) (bool, error) {
+			exists, err := ServiceExists(contextutils.WithSilent(ctx), clientset, namespaceName, serviceName)
+			return !exists, err
+		})
+		if err != nil {
+			return err
+		}
+
+		logging.LogChangedByCtxf(ctx, "Service '%s' in namespace '%s' deleted.", serviceName, namespaceName)
+	} else {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "Service '%s' already absent in namespace '%s'.", serviceName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to delete Service '%s' in namespace '%s': %w", serviceName, namespaceName, err)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete Service '%s' in namespace '%s' finished.", serviceName, namespaceName)
+
+	return nil
+}
+
+func ListServiceNames(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string) ([]string, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	list, err := clientset.CoreV1().Services(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list Services in namespace '%s': %w", namespaceName, err)
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' Services in namespace '%s'.", len(names), namespaceName)
+
+	return names, nil
+}
@@ -0,0 +1,229 @@
+package nativekubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func CreateStatefulSet(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, options *kubernetesparameteroptions.CreateStatefulSetOptions) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	statefulSetName, err := options.GetName()
+	if err != nil {
+		return err
+	}
+
+	imageName, err := options.GetImageName()
+	if err != nil {
+		return err
+	}
+
+	serviceName, err := options.GetServiceName()
+	if err != nil {
+		return err
+	}
+
+	replicas := options.GetReplicas()
+
+	logging.LogInfoByCtxf(ctx, "Create StatefulSet '%s' in namespace '%s' using container image '%s' started.", statefulSetName, namespaceName, imageName)
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   statefulSetName,
+			Labels: options.GetLabels(),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: serviceName,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": statefulSetName,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": statefulSetName,
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:    statefulSetName,
+							Image:   imageName,
+							Command: options.Command,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_, err = clientset.AppsV1().StatefulSets(namespaceName).Create(ctx, statefulSet, metav1.CreateOptions{})
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			logging.LogInfoByCtxf(ctx, "StatefulSet '%s' in namespace '%s' already exists.", statefulSetName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to create StatefulSet '%s' in namespace '%s': %w", statefulSetName, namespaceName, err)
+		}
+	} else {
+		logging.LogChangedByCtxf(ctx, "StatefulSet '%s' in namespace '%s' created.", statefulSetName, namespaceName)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create StatefulSet '%s' in namespace '%s' using container image '%s' finished.", statefulSetName, namespaceName, imageName)
+
+	return nil
+}
+
+// Waits until all replicas of the StatefulSet are updated and ready.
+func WaitForStatefulSetReady(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, statefulSetName string, timeout time.Duration) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if statefulSetName == "" {
+		return tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	description := fmt.Sprintf("StatefulSet '%s' in namespace '%s' is ready", statefulSetName, namespaceName)
+
+	return pollUntil(ctx, description, timeout, func(ctx context.Context) (bool, error) {
+		statefulSet, err := clientset.AppsV1().StatefulSets(namespaceName).Get(ctx, statefulSetName, metav1.GetOptions{})
+		if err != nil {
+			return false, tracederrors.TracedErrorf("Failed to get StatefulSet '%s' in namespace '%s': %w", statefulSetName, namespaceName, err)
+		}
+
+		if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+			return false, nil
+		}
+
+		replicas := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			replicas = *statefulSet.Spec.Replicas
+		}
+
+		return statefulSet.Status.ReadyReplicas == replicas && statefulSet.Status.UpdatedReplicas == replicas, nil
+	})
+}
+
+func StatefulSetExists(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, statefulSetName string) (bool, error) {
+	if clientset == nil {
+		return false, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if statefulSetName == "" {
+		return false, tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	_, err := clientset.AppsV1().StatefulSets(namespaceName).Get(ctx, statefulSetName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "StatefulSet '%s' in namespace '%s' does not exist.", statefulSetName, namespaceName)
+			return false, nil
+		}
+
+		return false, tracederrors.TracedErrorf("Failed to get StatefulSet '%s' in namespace '%s': %w", statefulSetName, namespaceName, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "StatefulSet '%s' in namespace '%s' exists.", statefulSetName, namespaceName)
+
+	return true, nil
+}
+
+func DeleteStatefulSet(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string, statefulSetName string) error {
+	if clientset == nil {
+		return tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	if statefulSetName == "" {
+		return tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete StatefulSet '%s' in namespace '%s' started.", statefulSetName, namespaceName)
+
+	deletePolicy := metav1.DeletePropagationBackground
+	err := clientset.AppsV1().StatefulSets(namespaceName).Delete(ctx, statefulSetName, metav1.DeleteOptions{
+		PropagationPolicy: &deletePolicy,
+	})
+	if err == nil {
+		err = pollUntil(ctx, fmt.Sprintf("StatefulSet '%s' in namespace '%s' is deleted", statefulSetName, namespaceName), defaultDeleteTimeout, func(ctx context.Context) (bool, error) {
+			exists, err := StatefulSetExists(contextutils.WithSilent(ctx), clientset, namespaceName, statefulSetName)
+			return !exists, err
+		})
+		if err != nil {
+			return err
+		}
+
+		logging.LogChangedByCtxf(ctx, "StatefulSet '%s' in namespace '%s' deleted.", statefulSetName, namespaceName)
+	} else {
+		if apierrors.IsNotFound(err) {
+			logging.LogInfoByCtxf(ctx, "StatefulSet '%s' already absent in namespace '%s'.", statefulSetName, namespaceName)
+		} else {
+			return tracederrors.TracedErrorf("Failed to delete StatefulSet '%s' in namespace '%s': %w", statefulSetName, namespaceName, err)
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Delete StatefulSet '%s' in namespace '%s' finished.", statefulSetName, namespaceName)
+
+	return nil
+}
+
+func ListStatefulSetNames(ctx context.Context, clientset *kubernetes.Clientset, namespaceName string) ([]string, error) {
+	if clientset == nil {
+		return nil, tracederrors.TracedErrorNil("clientset")
+	}
+
+	if namespaceName == "" {
+		return nil, tracederrors.TracedErrorEmptyString("namespaceName")
+	}
+
+	list, err := clientset.AppsV1().StatefulSets(namespaceName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to list StatefulSets in namespace '%s': %w", namespaceName, err)
+	}
+
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+
+	sort.Strings(names)
+
+	logging.LogInfoByCtxf(ctx, "Found '%d' StatefulSets in namespace '%s'.", len(names), namespaceName)
+
+	return names, nil
+}
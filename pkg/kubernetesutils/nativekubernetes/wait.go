@@ -0,0 +1,36 @@
+package nativekubernetes
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Maximum time to wait until a deleted object is gone.
+const defaultDeleteTimeout = 60 * time.Second
+
+// Polls 'isDone' every second until it returns true, returns an error or 'timeout' is reached.
+// 'description' describes the awaited state like "Job 'example' in namespace 'default' is completed" and is used for logging.
+func pollUntil(ctx context.Context, description string, timeout time.Duration, isDone func(ctx context.Context) (bool, error)) error {
+	if description == "" {
+		return tracederrors.TracedErrorEmptyString("description")
+	}
+
+	if isDone == nil {
+		return tracederrors.TracedErrorNil("isDone")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait until %s started.", description)
+
+	err := wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, isDone)
+	if err != nil {
+		return tracederrors.TracedErrorf("Failed to wait until %s: %w", description, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wait until %s finished.", description)
+
+	return nil
+}
@@ -624,6 +624,15 @@ func (n *NativeKubernetesCluster) CreateObject(ctx context.Context, options *kub
 	return namespace.CreateObject(ctx, options)
 }
 
+func (n *NativeKubernetesCluster) ApplyManifests(ctx context.Context, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	config, err := n.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ApplyManifests(ctx, config, "", options)
+}
+
 func (n *NativeKubernetesCluster) RunCommandInTemporaryPod(ctx context.Context, namespaceName string, options *kubernetesparameteroptions.KubernetesRunCommandOptions) (*commandoutput.CommandOutput, error) {
 	clientSet, err := n.GetClientSet()
 	if err != nil {
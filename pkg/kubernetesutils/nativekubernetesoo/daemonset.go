@@ -0,0 +1,87 @@
+package nativekubernetesoo
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type NativeDaemonSet struct {
+	namespace *NativeNamespace
+	name      string
+}
+
+func (d *NativeDaemonSet) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	return d.GetNativeNamespace()
+}
+
+func (d *NativeDaemonSet) GetNativeNamespace() (*NativeNamespace, error) {
+	if d.namespace == nil {
+		return nil, tracederrors.TracedErrorNil("namespace")
+	}
+
+	return d.namespace, nil
+}
+
+func (d *NativeDaemonSet) GetName() (string, error) {
+	if d.name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return d.name, nil
+}
+
+func (d *NativeDaemonSet) Exists(ctx context.Context) (bool, error) {
+	daemonSetName, err := d.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, err := d.GetNativeNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	return namespace.DaemonSetByNameExists(ctx, daemonSetName)
+}
+
+func (d *NativeDaemonSet) Delete(ctx context.Context) error {
+	daemonSetName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := d.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	return namespace.DeleteDaemonSetByName(ctx, daemonSetName)
+}
+
+func (d *NativeDaemonSet) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	daemonSetName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := d.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := namespace.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := namespace.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForDaemonSetReady(ctx, clientset, namespaceName, daemonSetName, timeout)
+}
@@ -2,7 +2,9 @@ package nativekubernetesoo
 
 import (
 	"context"
+	"time"
 
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
@@ -109,3 +111,117 @@ func (d *Deployment) Exists(ctx context.Context) (bool, error) {
 
 	return nativekubernetes.DeploymentExists(ctx, clientSet, deploymentName, namespaceName)
 }
+
+func (d *Deployment) ListRevisions(ctx context.Context) ([]*kubernetesimplementationindependend.DeploymentRevision, error) {
+	deploymentName, err := d.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := d.GetNamespaceName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet, err := d.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListDeploymentRevisions(ctx, clientSet, namespaceName, deploymentName)
+}
+
+func (d *Deployment) Rollback(ctx context.Context, toRevision int64) error {
+	deploymentName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := d.GetNamespaceName()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := d.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.RollbackDeployment(ctx, clientSet, namespaceName, deploymentName, toRevision)
+}
+
+func (d *Deployment) RolloutRestart(ctx context.Context) error {
+	deploymentName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := d.GetNamespaceName()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := d.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.RolloutRestartDeployment(ctx, clientSet, namespaceName, deploymentName)
+}
+
+func (d *Deployment) Scale(ctx context.Context, replicas int32) error {
+	deploymentName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := d.GetNamespaceName()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := d.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.ScaleDeployment(ctx, clientSet, namespaceName, deploymentName, replicas)
+}
+
+func (d *Deployment) SetContainerImage(ctx context.Context, containerName string, imageName string) error {
+	deploymentName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := d.GetNamespaceName()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := d.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.SetDeploymentContainerImage(ctx, clientSet, namespaceName, deploymentName, containerName, imageName)
+}
+
+func (d *Deployment) WaitUntilRolloutComplete(ctx context.Context, timeout time.Duration) error {
+	deploymentName, err := d.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := d.GetNamespaceName()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := d.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForDeploymentRolloutComplete(ctx, clientSet, namespaceName, deploymentName, timeout)
+}
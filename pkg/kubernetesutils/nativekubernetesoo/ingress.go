@@ -0,0 +1,87 @@
+package nativekubernetesoo
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type NativeIngress struct {
+	namespace *NativeNamespace
+	name      string
+}
+
+func (i *NativeIngress) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	return i.GetNativeNamespace()
+}
+
+func (i *NativeIngress) GetNativeNamespace() (*NativeNamespace, error) {
+	if i.namespace == nil {
+		return nil, tracederrors.TracedErrorNil("namespace")
+	}
+
+	return i.namespace, nil
+}
+
+func (i *NativeIngress) GetName() (string, error) {
+	if i.name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return i.name, nil
+}
+
+func (i *NativeIngress) Exists(ctx context.Context) (bool, error) {
+	ingressName, err := i.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, err := i.GetNativeNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	return namespace.IngressByNameExists(ctx, ingressName)
+}
+
+func (i *NativeIngress) Delete(ctx context.Context) error {
+	ingressName, err := i.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := i.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	return namespace.DeleteIngressByName(ctx, ingressName)
+}
+
+func (i *NativeIngress) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	ingressName, err := i.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := i.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := namespace.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := namespace.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForIngressAddressAssigned(ctx, clientset, namespaceName, ingressName, timeout)
+}
@@ -0,0 +1,87 @@
+package nativekubernetesoo
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type NativeJob struct {
+	namespace *NativeNamespace
+	name      string
+}
+
+func (j *NativeJob) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	return j.GetNativeNamespace()
+}
+
+func (j *NativeJob) GetNativeNamespace() (*NativeNamespace, error) {
+	if j.namespace == nil {
+		return nil, tracederrors.TracedErrorNil("namespace")
+	}
+
+	return j.namespace, nil
+}
+
+func (j *NativeJob) GetName() (string, error) {
+	if j.name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return j.name, nil
+}
+
+func (j *NativeJob) Exists(ctx context.Context) (bool, error) {
+	jobName, err := j.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, err := j.GetNativeNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	return namespace.JobByNameExists(ctx, jobName)
+}
+
+func (j *NativeJob) Delete(ctx context.Context) error {
+	jobName, err := j.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := j.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	return namespace.DeleteJobByName(ctx, jobName)
+}
+
+func (j *NativeJob) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	jobName, err := j.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := j.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := namespace.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := namespace.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForJobCompleted(ctx, clientset, namespaceName, jobName, timeout)
+}
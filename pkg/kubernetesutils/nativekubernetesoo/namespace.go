@@ -3,12 +3,14 @@ package nativekubernetesoo
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"reflect"
 	"time"
 
 	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesimplementationindependend"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
 	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
@@ -1208,6 +1210,62 @@ func (n *NativeNamespace) CreateObject(ctx context.Context, options *kubernetesp
 	return object, nil
 }
 
+func (n *NativeNamespace) ApplyManifests(ctx context.Context, options *kubernetesparameteroptions.ApplyManifestsOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := n.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ApplyManifests(ctx, config, namespaceName, options)
+}
+
+func (n *NativeNamespace) Backup(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.BackupNamespaceOptions) ([]*kubernetesimplementationindependend.ObjectReference, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := n.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.BackupNamespace(ctx, config, namespaceName, directory, options)
+}
+
+func (n *NativeNamespace) GetDiagnostics(ctx context.Context, options *kubernetesparameteroptions.NamespaceDiagnosticsOptions) (*kubernetesimplementationindependend.NamespaceDiagnostics, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := n.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.GetNamespaceDiagnostics(ctx, config, namespaceName, options)
+}
+
+func (n *NativeNamespace) Restore(ctx context.Context, directory filesinterfaces.Directory, options *kubernetesparameteroptions.RestoreNamespaceOptions) (*kubernetesimplementationindependend.ApplyManifestsResult, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := n.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.RestoreNamespace(ctx, config, namespaceName, directory, options)
+}
+
 func (n *NativeNamespace) ListSecretNames(ctx context.Context) ([]string, error) {
 	clientSet, err := n.GetClientSet()
 	if err != nil {
@@ -1222,6 +1280,20 @@ func (n *NativeNamespace) ListSecretNames(ctx context.Context) ([]string, error)
 	return nativekubernetes.ListSecretNames(ctx, clientSet, namespaceName)
 }
 
+func (n *NativeNamespace) ListSecretNamesByType(ctx context.Context, secretType string) ([]string, error) {
+	clientSet, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListSecretNamesByType(ctx, clientSet, namespaceName, secretType)
+}
+
 func (n *NativeNamespace) ListSecrets(ctx context.Context) ([]kubernetesinterfaces.Secret, error) {
 	clientSet, err := n.GetClientSet()
 	if err != nil {
@@ -1496,13 +1568,20 @@ func (n *NativeNamespace) CreateRoleBinding(ctx context.Context, createOptions *
 		if err != nil {
 			return nil, err
 		}
+		roleRefKind, err := createOptions.GetRoleRefKindOrDefault()
+		if err != nil {
+			return nil, err
+		}
 		subjectList := make([]rbacv1.Subject, len(subjects))
 		for i, subject := range subjects {
 			subjectList[i] = rbacv1.Subject{Kind: subjectKind, Name: subject}
+			if subjectKind == "ServiceAccount" {
+				subjectList[i].Namespace = namespaceName
+			}
 		}
 		binding := &rbacv1.RoleBinding{
 			ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespaceName},
-			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: roleRef},
+			RoleRef:    rbacv1.RoleRef{Kind: roleRefKind, Name: roleRef, APIGroup: "rbac.authorization.k8s.io"},
 			Subjects:   subjectList,
 		}
 		_, err = clientSet.RbacV1().RoleBindings(namespaceName).Create(ctx, binding, metav1.CreateOptions{})
@@ -1617,3 +1696,551 @@ func (n *NativeNamespace) Delete(ctx context.Context) error {
 
 	return nativekubernetes.DeleteNamespace(ctx, clientset, namespaceName)
 }
+
+func (n *NativeNamespace) CreateService(ctx context.Context, options *kubernetesparameteroptions.CreateServiceOptions) (kubernetesinterfaces.Service, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	serviceName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	err = nativekubernetes.CreateService(ctx, clientset, namespaceName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetServiceByName(serviceName)
+}
+
+func (n *NativeNamespace) GetServiceByName(name string) (kubernetesinterfaces.Service, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &NativeService{
+		name:      name,
+		namespace: n,
+	}, nil
+}
+
+func (n *NativeNamespace) ServiceByNameExists(ctx context.Context, serviceName string) (bool, error) {
+	if serviceName == "" {
+		return false, tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	return nativekubernetes.ServiceExists(ctx, clientset, namespaceName, serviceName)
+}
+
+func (n *NativeNamespace) DeleteServiceByName(ctx context.Context, serviceName string) error {
+	if serviceName == "" {
+		return tracederrors.TracedErrorEmptyString("serviceName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.DeleteService(ctx, clientset, namespaceName, serviceName)
+}
+
+func (n *NativeNamespace) ListServiceNames(ctx context.Context) ([]string, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListServiceNames(ctx, clientset, namespaceName)
+}
+
+func (n *NativeNamespace) CreateStatefulSet(ctx context.Context, options *kubernetesparameteroptions.CreateStatefulSetOptions) (kubernetesinterfaces.StatefulSet, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	statefulSetName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	err = nativekubernetes.CreateStatefulSet(ctx, clientset, namespaceName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetStatefulSetByName(statefulSetName)
+}
+
+func (n *NativeNamespace) GetStatefulSetByName(name string) (kubernetesinterfaces.StatefulSet, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &NativeStatefulSet{
+		name:      name,
+		namespace: n,
+	}, nil
+}
+
+func (n *NativeNamespace) StatefulSetByNameExists(ctx context.Context, statefulSetName string) (bool, error) {
+	if statefulSetName == "" {
+		return false, tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	return nativekubernetes.StatefulSetExists(ctx, clientset, namespaceName, statefulSetName)
+}
+
+func (n *NativeNamespace) DeleteStatefulSetByName(ctx context.Context, statefulSetName string) error {
+	if statefulSetName == "" {
+		return tracederrors.TracedErrorEmptyString("statefulSetName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.DeleteStatefulSet(ctx, clientset, namespaceName, statefulSetName)
+}
+
+func (n *NativeNamespace) ListStatefulSetNames(ctx context.Context) ([]string, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListStatefulSetNames(ctx, clientset, namespaceName)
+}
+
+func (n *NativeNamespace) CreateDaemonSet(ctx context.Context, options *kubernetesparameteroptions.CreateDaemonSetOptions) (kubernetesinterfaces.DaemonSet, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	daemonSetName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	err = nativekubernetes.CreateDaemonSet(ctx, clientset, namespaceName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetDaemonSetByName(daemonSetName)
+}
+
+func (n *NativeNamespace) GetDaemonSetByName(name string) (kubernetesinterfaces.DaemonSet, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &NativeDaemonSet{
+		name:      name,
+		namespace: n,
+	}, nil
+}
+
+func (n *NativeNamespace) DaemonSetByNameExists(ctx context.Context, daemonSetName string) (bool, error) {
+	if daemonSetName == "" {
+		return false, tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	return nativekubernetes.DaemonSetExists(ctx, clientset, namespaceName, daemonSetName)
+}
+
+func (n *NativeNamespace) DeleteDaemonSetByName(ctx context.Context, daemonSetName string) error {
+	if daemonSetName == "" {
+		return tracederrors.TracedErrorEmptyString("daemonSetName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.DeleteDaemonSet(ctx, clientset, namespaceName, daemonSetName)
+}
+
+func (n *NativeNamespace) ListDaemonSetNames(ctx context.Context) ([]string, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListDaemonSetNames(ctx, clientset, namespaceName)
+}
+
+func (n *NativeNamespace) CreateJob(ctx context.Context, options *kubernetesparameteroptions.CreateJobOptions) (kubernetesinterfaces.Job, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	jobName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	err = nativekubernetes.CreateJob(ctx, clientset, namespaceName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetJobByName(jobName)
+}
+
+func (n *NativeNamespace) GetJobByName(name string) (kubernetesinterfaces.Job, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &NativeJob{
+		name:      name,
+		namespace: n,
+	}, nil
+}
+
+func (n *NativeNamespace) JobByNameExists(ctx context.Context, jobName string) (bool, error) {
+	if jobName == "" {
+		return false, tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	return nativekubernetes.JobExists(ctx, clientset, namespaceName, jobName)
+}
+
+func (n *NativeNamespace) DeleteJobByName(ctx context.Context, jobName string) error {
+	if jobName == "" {
+		return tracederrors.TracedErrorEmptyString("jobName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.DeleteJob(ctx, clientset, namespaceName, jobName)
+}
+
+func (n *NativeNamespace) ListJobNames(ctx context.Context) ([]string, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListJobNames(ctx, clientset, namespaceName)
+}
+
+func (n *NativeNamespace) CreateIngress(ctx context.Context, options *kubernetesparameteroptions.CreateIngressOptions) (kubernetesinterfaces.Ingress, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	ingressName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	err = nativekubernetes.CreateIngress(ctx, clientset, namespaceName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetIngressByName(ingressName)
+}
+
+func (n *NativeNamespace) GetIngressByName(name string) (kubernetesinterfaces.Ingress, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &NativeIngress{
+		name:      name,
+		namespace: n,
+	}, nil
+}
+
+func (n *NativeNamespace) IngressByNameExists(ctx context.Context, ingressName string) (bool, error) {
+	if ingressName == "" {
+		return false, tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	return nativekubernetes.IngressExists(ctx, clientset, namespaceName, ingressName)
+}
+
+func (n *NativeNamespace) DeleteIngressByName(ctx context.Context, ingressName string) error {
+	if ingressName == "" {
+		return tracederrors.TracedErrorEmptyString("ingressName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.DeleteIngress(ctx, clientset, namespaceName, ingressName)
+}
+
+func (n *NativeNamespace) ListIngressNames(ctx context.Context) ([]string, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListIngressNames(ctx, clientset, namespaceName)
+}
+
+func (n *NativeNamespace) CreatePersistentVolumeClaim(ctx context.Context, options *kubernetesparameteroptions.CreatePersistentVolumeClaimOptions) (kubernetesinterfaces.PersistentVolumeClaim, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	persistentVolumeClaimName, err := options.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	err = nativekubernetes.CreatePersistentVolumeClaim(ctx, clientset, namespaceName, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.GetPersistentVolumeClaimByName(persistentVolumeClaimName)
+}
+
+func (n *NativeNamespace) GetPersistentVolumeClaimByName(name string) (kubernetesinterfaces.PersistentVolumeClaim, error) {
+	if name == "" {
+		return nil, tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return &NativePersistentVolumeClaim{
+		name:      name,
+		namespace: n,
+	}, nil
+}
+
+func (n *NativeNamespace) PersistentVolumeClaimByNameExists(ctx context.Context, persistentVolumeClaimName string) (bool, error) {
+	if persistentVolumeClaimName == "" {
+		return false, tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return false, err
+	}
+
+	return nativekubernetes.PersistentVolumeClaimExists(ctx, clientset, namespaceName, persistentVolumeClaimName)
+}
+
+func (n *NativeNamespace) DeletePersistentVolumeClaimByName(ctx context.Context, persistentVolumeClaimName string) error {
+	if persistentVolumeClaimName == "" {
+		return tracederrors.TracedErrorEmptyString("persistentVolumeClaimName")
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.DeletePersistentVolumeClaim(ctx, clientset, namespaceName, persistentVolumeClaimName)
+}
+
+func (n *NativeNamespace) ListPersistentVolumeClaimNames(ctx context.Context) ([]string, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.ListPersistentVolumeClaimNames(ctx, clientset, namespaceName)
+}
+
+func (n *NativeNamespace) StreamLogsByLabelSelector(ctx context.Context, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.StreamLogsByLabelSelector(ctx, clientset, namespaceName, options)
+}
@@ -0,0 +1,87 @@
+package nativekubernetesoo
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type NativePersistentVolumeClaim struct {
+	namespace *NativeNamespace
+	name      string
+}
+
+func (p *NativePersistentVolumeClaim) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	return p.GetNativeNamespace()
+}
+
+func (p *NativePersistentVolumeClaim) GetNativeNamespace() (*NativeNamespace, error) {
+	if p.namespace == nil {
+		return nil, tracederrors.TracedErrorNil("namespace")
+	}
+
+	return p.namespace, nil
+}
+
+func (p *NativePersistentVolumeClaim) GetName() (string, error) {
+	if p.name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return p.name, nil
+}
+
+func (p *NativePersistentVolumeClaim) Exists(ctx context.Context) (bool, error) {
+	persistentVolumeClaimName, err := p.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, err := p.GetNativeNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	return namespace.PersistentVolumeClaimByNameExists(ctx, persistentVolumeClaimName)
+}
+
+func (p *NativePersistentVolumeClaim) Delete(ctx context.Context) error {
+	persistentVolumeClaimName, err := p.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := p.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	return namespace.DeletePersistentVolumeClaimByName(ctx, persistentVolumeClaimName)
+}
+
+func (p *NativePersistentVolumeClaim) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	persistentVolumeClaimName, err := p.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := p.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := namespace.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := namespace.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForPersistentVolumeClaimBound(ctx, clientset, namespaceName, persistentVolumeClaimName, timeout)
+}
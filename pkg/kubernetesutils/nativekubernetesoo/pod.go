@@ -255,6 +255,25 @@ func (p *Pod) GetContainerLogs(ctx context.Context, containerName string) (stdou
 	return nativekubernetes.GetContainerLogs(ctx, clientSet, namespaceName, podName, containerName)
 }
 
+func (p *Pod) StreamLogs(ctx context.Context, options *kubernetesparameteroptions.StreamLogsOptions) (io.ReadCloser, error) {
+	podName, err := p.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := p.GetNamespaceName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet, err := p.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return nativekubernetes.StreamPodLogs(ctx, clientSet, namespaceName, podName, options)
+}
+
 func (p *Pod) CopyFileToPod(ctx context.Context, localFile string, destPath string, containerName string) error {
 	podName, err := p.GetName()
 	if err != nil {
@@ -0,0 +1,87 @@
+package nativekubernetesoo
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type NativeService struct {
+	namespace *NativeNamespace
+	name      string
+}
+
+func (s *NativeService) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	return s.GetNativeNamespace()
+}
+
+func (s *NativeService) GetNativeNamespace() (*NativeNamespace, error) {
+	if s.namespace == nil {
+		return nil, tracederrors.TracedErrorNil("namespace")
+	}
+
+	return s.namespace, nil
+}
+
+func (s *NativeService) GetName() (string, error) {
+	if s.name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return s.name, nil
+}
+
+func (s *NativeService) Exists(ctx context.Context) (bool, error) {
+	serviceName, err := s.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, err := s.GetNativeNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	return namespace.ServiceByNameExists(ctx, serviceName)
+}
+
+func (s *NativeService) Delete(ctx context.Context) error {
+	serviceName, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := s.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	return namespace.DeleteServiceByName(ctx, serviceName)
+}
+
+func (s *NativeService) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	serviceName, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := s.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := namespace.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := namespace.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForServiceReady(ctx, clientset, namespaceName, serviceName, timeout)
+}
@@ -0,0 +1,196 @@
+package nativekubernetesoo
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubeconfigutils"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesparameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func (n *NativeNamespace) CreateServiceAccountKubeConfig(ctx context.Context, options *kubernetesparameteroptions.ServiceAccountKubeConfigOptions) (*kubeconfigutils.KubeConfig, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	serviceAccountName, err := options.GetServiceAccountName()
+	if err != nil {
+		return nil, err
+	}
+
+	roleRefKind, roleRefName, err := options.GetRoleRefKindAndName()
+	if err != nil {
+		return nil, err
+	}
+
+	expiration, err := options.GetTokenExpirationOrDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := n.GetNativeKubernetesCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName, err := cluster.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := n.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create kubeconfig for ServiceAccount '%s' in namespace '%s' of kubernetes cluster '%s' started.", serviceAccountName, namespaceName, clusterName)
+
+	err = nativekubernetes.CreateServiceAccount(ctx, clientset, namespaceName, serviceAccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ClusterWide {
+		bindingName, err := options.GetClusterRoleBindingName(namespaceName)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = cluster.CreateClusterRoleBinding(ctx, &kubernetesparameteroptions.CreateClusterRoleBindingOptions{
+			Name:             bindingName,
+			RoleRef:          roleRefName,
+			Subjects:         []string{serviceAccountName},
+			SubjectKind:      "ServiceAccount",
+			SubjectNamespace: namespaceName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		bindingName, err := options.GetRoleBindingName()
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = n.CreateRoleBinding(ctx, &kubernetesparameteroptions.CreateRoleBindingOptions{
+			Name:        bindingName,
+			RoleRef:     roleRefName,
+			RoleRefKind: roleRefKind,
+			Subjects:    []string{serviceAccountName},
+			SubjectKind: "ServiceAccount",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := nativekubernetes.CreateServiceAccountToken(ctx, clientset, namespaceName, serviceAccountName, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	serverUrl, certificateAuthority, err := nativekubernetes.GetServerUrlAndCertificateAuthority(config)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig, err := kubeconfigutils.NewServiceAccountKubeConfig(clusterName, serverUrl, certificateAuthority, namespaceName, serviceAccountName, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.OutputFile != nil {
+		err = kubeConfig.WriteToFileReadableByOwnerOnly(ctx, options.OutputFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create kubeconfig for ServiceAccount '%s' in namespace '%s' of kubernetes cluster '%s' finished.", serviceAccountName, namespaceName, clusterName)
+
+	return kubeConfig, nil
+}
+
+func (n *NativeNamespace) RevokeServiceAccountKubeConfig(ctx context.Context, options *kubernetesparameteroptions.ServiceAccountKubeConfigOptions) error {
+	if options == nil {
+		return tracederrors.TracedErrorNil("options")
+	}
+
+	serviceAccountName, err := options.GetServiceAccountName()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := n.GetName()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := n.GetNativeKubernetesCluster()
+	if err != nil {
+		return err
+	}
+
+	clusterName, err := cluster.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := n.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Revoke kubeconfig of ServiceAccount '%s' in namespace '%s' of kubernetes cluster '%s' started.", serviceAccountName, namespaceName, clusterName)
+
+	// Both possible bindings are removed since the ClusterWide setting may differ from the one used on creation:
+	clusterRoleBindingName, err := options.GetClusterRoleBindingName(namespaceName)
+	if err != nil {
+		return err
+	}
+
+	err = cluster.DeleteClusterRoleBindingByName(ctx, clusterRoleBindingName)
+	if err != nil {
+		return err
+	}
+
+	roleBindingName, err := options.GetRoleBindingName()
+	if err != nil {
+		return err
+	}
+
+	err = n.DeleteRoleBindingByName(ctx, roleBindingName)
+	if err != nil {
+		return err
+	}
+
+	// Deleting the ServiceAccount invalidates all tokens issued for it:
+	err = nativekubernetes.DeleteServiceAccount(ctx, clientset, namespaceName, serviceAccountName)
+	if err != nil {
+		return err
+	}
+
+	if options.OutputFile != nil {
+		err = options.OutputFile.Delete(ctx, &filesoptions.DeleteOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Revoke kubeconfig of ServiceAccount '%s' in namespace '%s' of kubernetes cluster '%s' finished.", serviceAccountName, namespaceName, clusterName)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package nativekubernetesoo
+
+import (
+	"context"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/nativekubernetes"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+type NativeStatefulSet struct {
+	namespace *NativeNamespace
+	name      string
+}
+
+func (s *NativeStatefulSet) GetNamespace() (kubernetesinterfaces.Namespace, error) {
+	return s.GetNativeNamespace()
+}
+
+func (s *NativeStatefulSet) GetNativeNamespace() (*NativeNamespace, error) {
+	if s.namespace == nil {
+		return nil, tracederrors.TracedErrorNil("namespace")
+	}
+
+	return s.namespace, nil
+}
+
+func (s *NativeStatefulSet) GetName() (string, error) {
+	if s.name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	return s.name, nil
+}
+
+func (s *NativeStatefulSet) Exists(ctx context.Context) (bool, error) {
+	statefulSetName, err := s.GetName()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, err := s.GetNativeNamespace()
+	if err != nil {
+		return false, err
+	}
+
+	return namespace.StatefulSetByNameExists(ctx, statefulSetName)
+}
+
+func (s *NativeStatefulSet) Delete(ctx context.Context) error {
+	statefulSetName, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := s.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	return namespace.DeleteStatefulSetByName(ctx, statefulSetName)
+}
+
+func (s *NativeStatefulSet) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	statefulSetName, err := s.GetName()
+	if err != nil {
+		return err
+	}
+
+	namespace, err := s.GetNativeNamespace()
+	if err != nil {
+		return err
+	}
+
+	namespaceName, err := namespace.GetName()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := namespace.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return nativekubernetes.WaitForStatefulSetReady(ctx, clientset, namespaceName, statefulSetName, timeout)
+}
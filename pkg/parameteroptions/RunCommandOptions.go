@@ -26,6 +26,11 @@ type RunCommandOptions struct {
 
 	// These env vars are merged to the default env vars.
 	AdditionalEnvVars map[string]string
+
+	// Only used by RunCommandAndGetStdoutAsIoReadCloser:
+	// Kill the command if the returned reader is closed before all output was read.
+	// Needed for commands which never end on their own like following logs.
+	KillOnStdoutClose bool
 }
 
 func NewRunCommandOptions() (runCommandOptions *RunCommandOptions) {
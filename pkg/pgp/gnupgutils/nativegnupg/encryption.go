@@ -0,0 +1,105 @@
+package nativegnupg
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Encrypts 'plaintext' for all given 'recipientPublicKeys' and returns the ASCII armored PGP message.
+// Every recipient is able to decrypt the message using its private key.
+func Encrypt(ctx context.Context, plaintext []byte, recipientPublicKeys [][]byte) (encrypted []byte, err error) {
+	err = contextutils.CheckContextStillAlive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if plaintext == nil {
+		return nil, tracederrors.TracedErrorNil("plaintext")
+	}
+
+	if len(recipientPublicKeys) == 0 {
+		return nil, tracederrors.TracedError("recipientPublicKeys not set")
+	}
+
+	recipients, err := loadTrustedKeys(contextutils.WithSilent(ctx), recipientPublicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	var encryptedBuf bytes.Buffer
+	armorWriter, err := armor.Encode(&encryptedBuf, "PGP MESSAGE", nil)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create armor encoder for encrypted message: %w", err)
+	}
+
+	plaintextWriter, err := openpgp.Encrypt(armorWriter, recipients, nil, nil, nil)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to encrypt: %w", err)
+	}
+
+	_, err = plaintextWriter.Write(plaintext)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to write plaintext to encrypt: %w", err)
+	}
+
+	err = plaintextWriter.Close()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to finish encryption: %w", err)
+	}
+
+	err = armorWriter.Close()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to finish armor encoding: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Encrypted '%d' bytes for '%d' GnuPG recipients.", len(plaintext), len(recipients))
+
+	return encryptedBuf.Bytes(), nil
+}
+
+// Decrypts the ASCII armored PGP message 'encrypted' using 'privateKey'.
+func Decrypt(ctx context.Context, encrypted []byte, privateKey []byte) (plaintext []byte, err error) {
+	err = contextutils.CheckContextStillAlive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if encrypted == nil {
+		return nil, tracederrors.TracedErrorNil("encrypted")
+	}
+
+	if privateKey == nil {
+		return nil, tracederrors.TracedErrorNil("privateKey")
+	}
+
+	entity, err := entityByPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := armor.Decode(bytes.NewReader(encrypted))
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to decode armored PGP message: %w", err)
+	}
+
+	message, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to decrypt PGP message using private key '%s': %w", getFingerprintFromEntity(entity), err)
+	}
+
+	plaintext, err = io.ReadAll(message.UnverifiedBody)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to read decrypted PGP message: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Decrypted '%d' bytes using private key '%s'.", len(plaintext), getFingerprintFromEntity(entity))
+
+	return plaintext, nil
+}
@@ -0,0 +1,51 @@
+package nativegnupg_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/gnupgoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/pgp/gnupgutils/nativegnupg"
+)
+
+// This example shows how to:
+//  1. Generate two key pairs
+//  2. Encrypt a message for the first key pair
+//  3. Decrypt the message again
+func Test_Example_EncryptAndDecrypt(t *testing.T) {
+	// enable verbose output
+	ctx := contextutils.ContextVerbose()
+
+	// Generate two key pairs:
+	privateKey, publicKey, err := nativegnupg.GenerateKeyPair(ctx, &gnupgoptions.GenerateKeyPairOptions{
+		Name:    "reto",
+		Email:   "reto@example.com",
+		Comment: "Example key",
+		RSABits: 1024,
+	})
+	require.NoError(t, err)
+
+	otherPrivateKey, _, err := nativegnupg.GenerateKeyPair(ctx, &gnupgoptions.GenerateKeyPairOptions{
+		Name:    "other",
+		Email:   "other@example.com",
+		Comment: "Other key",
+		RSABits: 1024,
+	})
+	require.NoError(t, err)
+
+	// Encrypt the message:
+	encrypted, err := nativegnupg.Encrypt(ctx, []byte("hello world"), [][]byte{publicKey})
+	require.NoError(t, err)
+	require.Contains(t, string(encrypted), "-----BEGIN PGP MESSAGE-----")
+	require.NotContains(t, string(encrypted), "hello world")
+
+	// Decrypt the message again:
+	plaintext, err := nativegnupg.Decrypt(ctx, encrypted, privateKey)
+	require.NoError(t, err)
+	require.EqualValues(t, "hello world", string(plaintext))
+
+	// Only the recipients are able to decrypt:
+	_, err = nativegnupg.Decrypt(ctx, encrypted, otherPrivateKey)
+	require.Error(t, err)
+}
@@ -253,6 +253,15 @@ func (s *SpreadSheet) GetMinColumnWithsAsSelectedInOptions(options *SpreadSheetR
 		return nil, err
 	}
 
+	if options.IncludeTitleInColumnWidth && s.TitleRow != nil {
+		titleColumnWidths, err := s.TitleRow.GetColumnWidths()
+		if err != nil {
+			return nil, err
+		}
+
+		columnWidths = slicesutils.MaxIntValuePerIndex(columnWidths, titleColumnWidths)
+	}
+
 	return columnWidths, nil
 }
 
@@ -446,6 +455,7 @@ func (s *SpreadSheet) RenderAsString(options *SpreadSheetRenderOptions) (rendere
 	renderRowOptions.Prefix = options.Prefix
 	renderRowOptions.Suffix = options.Suffix
 	renderRowOptions.TitleUnderline = options.TitleUnderline
+	renderRowOptions.UnderlineFullColumnWidth = options.IncludeTitleInColumnWidth
 
 	rendered = ""
 	if !options.SkipTitle {
@@ -518,7 +528,12 @@ func (s *SpreadSheet) RenderTitleUnderlineAsString(options *SpreadSheetRenderRow
 			}
 		}
 
-		rendered += strings.Repeat("-", len(e))
+		width := len(e)
+		if options.UnderlineFullColumnWidth && i < len(options.MinColumnWidths) {
+			width = max(width, options.MinColumnWidths[i])
+		}
+
+		rendered += strings.Repeat("-", width)
 	}
 
 	if options.Suffix != "" {
@@ -12,6 +12,10 @@ type SpreadSheetRenderOptions struct {
 	Prefix                    string
 	Suffix                    string
 	TitleUnderline            string
+
+	// Also consider the title widths when SameColumnWidthForAllRows is set.
+	// This keeps rows aligned with titles which are wider than all values of their column.
+	IncludeTitleInColumnWidth bool
 }
 
 func NewSpreadSheetRenderOptions() (s *SpreadSheetRenderOptions) {
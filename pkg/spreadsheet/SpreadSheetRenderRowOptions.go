@@ -11,6 +11,9 @@ type SpreadSheetRenderRowOptions struct {
 	Prefix          string
 	Suffix          string
 	TitleUnderline  string
+
+	// Extend the title underline to the MinColumnWidths instead of the title length.
+	UnderlineFullColumnWidth bool
 }
 
 func NewSpreadSheetRenderRowOptions() (s *SpreadSheetRenderRowOptions) {
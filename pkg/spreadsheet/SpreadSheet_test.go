@@ -727,3 +727,26 @@ func Test_UpdateRowFoundByFirstColumnValue(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func Test_RenderAsString_IncludeTitleInColumnWidth(t *testing.T) {
+	spreadSheet := NewSpreadSheet()
+
+	err := spreadSheet.SetColumnTitles([]string{"long title", "b"})
+	require.NoError(t, err)
+
+	err = spreadSheet.AddRow([]string{"a", "long value"})
+	require.NoError(t, err)
+
+	rendered, err := spreadSheet.RenderAsString(
+		&SpreadSheetRenderOptions{
+			StringDelimiter:           "|",
+			SameColumnWidthForAllRows: true,
+			TitleUnderline:            "-",
+			IncludeTitleInColumnWidth: true,
+		},
+	)
+	require.NoError(t, err)
+
+	expectedRendered := "long title | b         \n---------- | ----------\na          | long value\n"
+	require.EqualValues(t, expectedRendered, rendered)
+}
@@ -1,4 +1,28 @@
 package sshutils
 
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
 const SSH_KEY_TYPE_ED25519 = "ssh-ed25519"
 const SSH_KEY_TYPE_RSA = "ssh-rsa"
+const SSH_KEY_TYPE_ECDSA_P256 = "ecdsa-sha2-nistp256"
+const SSH_KEY_TYPE_ECDSA_P384 = "ecdsa-sha2-nistp384"
+
+// GetKeyAlgorithmForKeyType returns the key algorithm used to generate keys of the given SSH keyType.
+// RSA keys are generated with 4096 bits.
+func GetKeyAlgorithmForKeyType(keyType string) (string, error) {
+	switch keyType {
+	case SSH_KEY_TYPE_ED25519:
+		return cryptoutils.KEY_ALGORITHM_ED25519, nil
+	case SSH_KEY_TYPE_RSA:
+		return cryptoutils.KEY_ALGORITHM_RSA_4096, nil
+	case SSH_KEY_TYPE_ECDSA_P256:
+		return cryptoutils.KEY_ALGORITHM_ECDSA_P256, nil
+	case SSH_KEY_TYPE_ECDSA_P384:
+		return cryptoutils.KEY_ALGORITHM_ECDSA_P384, nil
+	default:
+		return "", tracederrors.TracedErrorf("Unsupported SSH key type '%s'.", keyType)
+	}
+}
@@ -2,11 +2,11 @@ package sshutils
 
 import (
 	"context"
-	"crypto/ed25519"
-	"crypto/rand"
 	"encoding/pem"
 	"os"
 
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
 	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
@@ -15,9 +15,10 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
-// GenerateSshKeyPair generates an Ed25519 SSH key pair and writes the private
+// GenerateSshKeyPair generates an SSH key pair and writes the private
 // key (OpenSSH PEM format) to privateKeyPath and the public key (authorized_keys
 // format) to publicKeyPath.
+// The key algorithm is selected by options.KeyAlgorithm and defaults to Ed25519.
 // The function respects context cancellation before any I/O is performed.
 func GenerateSshKeyPair(ctx context.Context, options *sshoptions.GenerateKeyOptions) (*SSHKeyPair, error) {
 	if err := ctx.Err(); err != nil {
@@ -28,11 +29,18 @@ func GenerateSshKeyPair(ctx context.Context, options *sshoptions.GenerateKeyOpti
 		options = &sshoptions.GenerateKeyOptions{}
 	}
 
-	logging.LogInfoByCtxf(ctx, "Generate SSH key pair started.")
+	keyAlgorithm := options.GetKeyAlgorithmOrDefault()
 
-	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	logging.LogInfoByCtxf(ctx, "Generate '%s' SSH key pair started.", keyAlgorithm)
+
+	privKey, err := cryptoutils.GeneratePrivateKey(contextutils.WithSilent(ctx), keyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := cryptoutils.GetPublicKeyFromPrivateKey(privKey)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("keygen: failed to generate ed25519 key pair: %w", err)
+		return nil, err
 	}
 
 	privPEM, err := ssh.MarshalPrivateKey(privKey, "" /* no passphrase */)
@@ -45,6 +53,7 @@ func GenerateSshKeyPair(ctx context.Context, options *sshoptions.GenerateKeyOpti
 		return nil, tracederrors.TracedErrorf("keygen: failed to create ssh public key: %w", err)
 	}
 	authorizedKey := ssh.MarshalAuthorizedKey(sshPubKey)
+	keyType := sshPubKey.Type()
 
 	if err := ctx.Err(); err != nil {
 		return nil, tracederrors.TracedErrorf("keygen: context cancelled before writing files: %w", err)
@@ -83,34 +92,38 @@ func GenerateSshKeyPair(ctx context.Context, options *sshoptions.GenerateKeyOpti
 
 	return &SSHKeyPair{
 		PublicKey: &SSHPublicKey{
-			KeyType:     SSH_KEY_TYPE_ED25519,
+			KeyType:     keyType,
 			KeyMaterial: string(authorizedKey),
 		},
 		PrivateKey: &SSHPrivateKey{
-			KeyType:     SSH_KEY_TYPE_ED25519,
+			KeyType:     keyType,
 			KeyMaterial: string(encodedPrivateKey),
 		},
 	}, nil
 }
 
-// GenerateKeyPair generates an Ed25519 SSH key pair in memory without writing to disk.
+// GenerateKeyPair generates an SSH key pair of the given keyType in memory without writing to disk.
+// Supported key types are SSH_KEY_TYPE_ED25519 (default if keyType is empty), SSH_KEY_TYPE_RSA,
+// SSH_KEY_TYPE_ECDSA_P256 and SSH_KEY_TYPE_ECDSA_P384.
 // This is a convenience wrapper around GenerateSshKeyPair for in-memory key generation.
 func GenerateKeyPair(keyType string, options *sshoptions.GenerateKeyOptions) (*SSHKeyPair, error) {
 	ctx := context.Background()
 
-	// Always pass nil options to ensure keys are generated in memory only
-	keyPair, err := GenerateSshKeyPair(ctx, nil)
-	if err != nil {
-		return nil, err
+	keyAlgorithm := ""
+	if options != nil {
+		keyAlgorithm = options.KeyAlgorithm
 	}
 
-	// Override key type if specified (default is ED25519)
-	if keyType != "" && keyType != SSH_KEY_TYPE_ED25519 {
-		// For now, only ED25519 is supported in the generation logic
-		// The key type constants are set in the returned key pair
-		keyPair.PublicKey.KeyType = keyType
-		keyPair.PrivateKey.KeyType = keyType
+	if keyType != "" {
+		var err error
+		keyAlgorithm, err = GetKeyAlgorithmForKeyType(keyType)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return keyPair, nil
+	// Paths are never passed to ensure keys are generated in memory only
+	return GenerateSshKeyPair(ctx, &sshoptions.GenerateKeyOptions{
+		KeyAlgorithm: keyAlgorithm,
+	})
 }
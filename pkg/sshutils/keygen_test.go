@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
 	"github.com/asciich/asciichgolangpublic/pkg/sshutils"
 	"github.com/asciich/asciichgolangpublic/pkg/sshutils/sshoptions"
 	"golang.org/x/crypto/ssh"
@@ -274,3 +275,75 @@ func TestGenerateKeyPair_KeysAreUnique(t *testing.T) {
 	require.NotEqual(t, keyPair1.PrivateKey.KeyMaterial, keyPair2.PrivateKey.KeyMaterial,
 		"successive calls should produce different keys")
 }
+
+// TestGenerateSshKeyPair_KeyAlgorithms verifies that all supported key algorithms
+// produce a valid, matching SSH key pair of the expected type.
+func TestGenerateSshKeyPair_KeyAlgorithms(t *testing.T) {
+	tests := []struct {
+		keyAlgorithm    string
+		expectedKeyType string
+	}{
+		{cryptoutils.KEY_ALGORITHM_ED25519, sshutils.SSH_KEY_TYPE_ED25519},
+		{cryptoutils.KEY_ALGORITHM_ECDSA_P256, sshutils.SSH_KEY_TYPE_ECDSA_P256},
+		{cryptoutils.KEY_ALGORITHM_ECDSA_P384, sshutils.SSH_KEY_TYPE_ECDSA_P384},
+		{cryptoutils.KEY_ALGORITHM_RSA_2048, sshutils.SSH_KEY_TYPE_RSA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyAlgorithm, func(t *testing.T) {
+			ctx := getCtx()
+
+			privPath, pubPath := tempPaths(t)
+
+			keyPair, err := sshutils.GenerateSshKeyPair(ctx, &sshoptions.GenerateKeyOptions{
+				PrivateKeyPath: privPath,
+				PublicKeyPath:  pubPath,
+				KeyAlgorithm:   tt.keyAlgorithm,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeyType, keyPair.PublicKey.KeyType)
+			require.Equal(t, tt.expectedKeyType, keyPair.PrivateKey.KeyType)
+
+			privData, err := os.ReadFile(privPath)
+			require.NoError(t, err)
+
+			signer, err := ssh.ParsePrivateKey(privData)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeyType, signer.PublicKey().Type())
+
+			pubData, err := os.ReadFile(pubPath)
+			require.NoError(t, err)
+
+			parsedPub, _, _, _, err := ssh.ParseAuthorizedKey(pubData)
+			require.NoError(t, err)
+			require.Equal(t, signer.PublicKey().Marshal(), parsedPub.Marshal(), "public key file does not match the private key")
+		})
+	}
+}
+
+func TestGenerateSshKeyPair_UnsupportedKeyAlgorithm(t *testing.T) {
+	keyPair, err := sshutils.GenerateSshKeyPair(getCtx(), &sshoptions.GenerateKeyOptions{KeyAlgorithm: "dsa-1024"})
+	require.Error(t, err)
+	require.Nil(t, keyPair)
+}
+
+// TestGenerateKeyPair_KeyTypes verifies that GenerateKeyPair generates keys of the requested type.
+func TestGenerateKeyPair_KeyTypes(t *testing.T) {
+	for _, keyType := range []string{sshutils.SSH_KEY_TYPE_ED25519, sshutils.SSH_KEY_TYPE_ECDSA_P256, sshutils.SSH_KEY_TYPE_ECDSA_P384, sshutils.SSH_KEY_TYPE_RSA} {
+		t.Run(keyType, func(t *testing.T) {
+			keyPair, err := sshutils.GenerateKeyPair(keyType, nil)
+			require.NoError(t, err)
+			require.Equal(t, keyType, keyPair.PublicKey.KeyType)
+
+			parsedPub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyPair.PublicKey.KeyMaterial))
+			require.NoError(t, err)
+			require.Equal(t, keyType, parsedPub.Type())
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		keyPair, err := sshutils.GenerateKeyPair("ssh-dss", nil)
+		require.Error(t, err)
+		require.Nil(t, keyPair)
+	})
+}
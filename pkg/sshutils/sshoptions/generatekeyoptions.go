@@ -1,6 +1,20 @@
 package sshoptions
 
+import "github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+
 type GenerateKeyOptions struct {
 	PrivateKeyPath string
 	PublicKeyPath  string
+
+	// Algorithm of the generated key like "ed25519", "ecdsa-p256", "ecdsa-p384" or "rsa-4096".
+	// See cryptoutils.GetSupportedKeyAlgorithms. Defaults to "ed25519".
+	KeyAlgorithm string
+}
+
+func (g *GenerateKeyOptions) GetKeyAlgorithmOrDefault() string {
+	if g.KeyAlgorithm == "" {
+		return cryptoutils.KEY_ALGORITHM_ED25519
+	}
+
+	return g.KeyAlgorithm
 }
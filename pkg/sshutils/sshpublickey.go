@@ -173,7 +173,7 @@ func (k *SSHPublicKey) SetFromString(keyMaterial string) (err error) {
 		splittedAllElements := strings.Split(keyMaterial, " ")
 		splitted := slicesutils.TrimSpace(splittedAllElements)
 
-		for _, possibleKeyType := range []string{"ssh-rsa", "ssh-ed25519", "ecdsa-sha2-nistp256"} {
+		for _, possibleKeyType := range []string{SSH_KEY_TYPE_RSA, SSH_KEY_TYPE_ED25519, SSH_KEY_TYPE_ECDSA_P256, SSH_KEY_TYPE_ECDSA_P384} {
 			if slices.Contains(splitted, possibleKeyType) {
 				k.KeyType = possibleKeyType
 				splitted = slicesutils.RemoveMatchingStrings(splitted, possibleKeyType)
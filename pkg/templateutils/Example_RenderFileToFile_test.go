@@ -0,0 +1,100 @@
+package templateutils_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/templateutils"
+	"github.com/asciich/asciichgolangpublic/pkg/templateutils/templateoptions"
+)
+
+// ExampleRenderFileToFile demonstrates how to render a config file from a template.
+//
+// The destination is only written if the rendered content differs. This makes it safe to run
+// the rendering repeatedly e.g. as part of an automation. Instead of a local file any other
+// `filesinterfaces.File` implementation can be used as destination to write to a remote host.
+func ExampleRenderFileToFile() {
+	ctx := contextutils.ContextSilent()
+
+	tmpDir, err := os.MkdirTemp("", "templateutils-example-*")
+	if err != nil {
+		fmt.Printf("Failed to create temp dir: %v\n", err)
+		return
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Prepare the template and a variables file. Usually they are already part of your repository:
+	templatePath := filepath.Join(tmpDir, "app.conf.tmpl")
+	err = os.WriteFile(templatePath, []byte("listen={{ .host }}:{{ .port }}\nlog_level={{ .log_level | default \"info\" | upper }}\n"), 0644)
+	if err != nil {
+		fmt.Printf("Failed to write template: %v\n", err)
+		return
+	}
+
+	variablesPath := filepath.Join(tmpDir, "vars.yaml")
+	err = os.WriteFile(variablesPath, []byte("host: 0.0.0.0\nport: 80\n"), 0644)
+	if err != nil {
+		fmt.Printf("Failed to write variables: %v\n", err)
+		return
+	}
+
+	templateFile, err := nativefilesoo.NewFileByPath(templatePath)
+	if err != nil {
+		fmt.Printf("Failed to get template file: %v\n", err)
+		return
+	}
+
+	variablesFile, err := nativefilesoo.NewFileByPath(variablesPath)
+	if err != nil {
+		fmt.Printf("Failed to get variables file: %v\n", err)
+		return
+	}
+
+	destFile, err := nativefilesoo.NewFileByPath(filepath.Join(tmpDir, "app.conf"))
+	if err != nil {
+		fmt.Printf("Failed to get destination file: %v\n", err)
+		return
+	}
+
+	options := &templateoptions.RenderToFileOptions{
+		RenderOptions: templateoptions.RenderOptions{
+			VariableFiles: []filesinterfaces.File{variablesFile},
+			// Variables given directly take precedence over the ones loaded from files:
+			Variables: map[string]interface{}{"port": 8080},
+		},
+		PermissionsString: "u=rw,g=r,o=",
+	}
+
+	// The first rendering writes the file:
+	changeSummary, err := templateutils.RenderFileToFile(ctx, templateFile, destFile, options)
+	if err != nil {
+		fmt.Printf("Failed to render: %v\n", err)
+		return
+	}
+	fmt.Printf("First rendering changed file: %v\n", changeSummary.IsChanged())
+
+	// Rendering again with the same input leaves the file untouched:
+	changeSummary, err = templateutils.RenderFileToFile(ctx, templateFile, destFile, options)
+	if err != nil {
+		fmt.Printf("Failed to render: %v\n", err)
+		return
+	}
+	fmt.Printf("Second rendering changed file: %v\n", changeSummary.IsChanged())
+
+	content, err := destFile.ReadAsString(ctx)
+	if err != nil {
+		fmt.Printf("Failed to read rendered file: %v\n", err)
+		return
+	}
+	fmt.Print(content)
+
+	// Output:
+	// First rendering changed file: true
+	// Second rendering changed file: false
+	// listen=0.0.0.0:8080
+	// log_level=INFO
+}
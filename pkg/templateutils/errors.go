@@ -0,0 +1,6 @@
+package templateutils
+
+import "errors"
+
+var ErrRequiredValueMissing = errors.New("required value missing")
+var ErrTemplateFailed = errors.New("template failed")
@@ -0,0 +1,236 @@
+package templateutils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"gopkg.in/yaml.v3"
+)
+
+// GetFuncMap returns the helper functions available in every template.
+//
+// The function names and argument order follow the widely used sprig library (as known from Helm) to make
+// existing templates easy to reuse. Like in sprig the value to process is always the last argument which allows to use the
+// functions in pipelines, e.g. `{{ .name | default "world" | upper }}`.
+func GetFuncMap() template.FuncMap {
+	return template.FuncMap{
+		// strings
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"title":      toTitle,
+		"trim":       strings.TrimSpace,
+		"trimPrefix": func(prefix string, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix string, s string) string { return strings.TrimSuffix(s, suffix) },
+		"replace":    func(old string, new string, s string) string { return strings.ReplaceAll(s, old, new) },
+		"contains":   func(substr string, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix string, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix string, s string) bool { return strings.HasSuffix(s, suffix) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"quote":      func(v interface{}) string { return fmt.Sprintf("%q", toString(v)) },
+		"squote":     func(v interface{}) string { return "'" + toString(v) + "'" },
+		"indent":     indent,
+		"nindent":    func(spaces int, s string) string { return "\n" + indent(spaces, s) },
+		"split":      func(sep string, s string) []string { return strings.Split(s, sep) },
+		"join":       join,
+		"toString":   toString,
+
+		// defaults and flow control
+		"default":  defaultValue,
+		"empty":    isEmpty,
+		"coalesce": coalesce,
+		"ternary":  ternary,
+		"required": required,
+		"fail":     fail,
+
+		// lists and dicts
+		"list":   func(v ...interface{}) []interface{} { return v },
+		"dict":   dict,
+		"keys":   keys,
+		"hasKey": func(d map[string]interface{}, key string) bool { _, ok := d[key]; return ok },
+
+		// encoding
+		"toYaml":    toYaml,
+		"toJson":    toJson,
+		"b64enc":    func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec":    b64dec,
+		"sha256sum": func(s string) string { sum := sha256.Sum256([]byte(s)); return hex.EncodeToString(sum[:]) },
+
+		// environment
+		"env": os.Getenv,
+	}
+}
+
+func toString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	case error:
+		return s.Error()
+	case fmt.Stringer:
+		return s.String()
+	}
+
+	return fmt.Sprintf("%v", v)
+}
+
+func toTitle(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		runes := []rune(w)
+		words[i] = strings.ToUpper(string(runes[0])) + string(runes[1:])
+	}
+
+	return strings.Join(words, " ")
+}
+
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+}
+
+func join(sep string, v interface{}) string {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return toString(v)
+	}
+
+	elements := make([]string, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		elements = append(elements, toString(value.Index(i).Interface()))
+	}
+
+	return strings.Join(elements, sep)
+}
+
+func isEmpty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	}
+
+	return false
+}
+
+func defaultValue(defaultValue interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || isEmpty(given[0]) {
+		return defaultValue
+	}
+
+	return given[0]
+}
+
+func coalesce(v ...interface{}) interface{} {
+	for _, value := range v {
+		if !isEmpty(value) {
+			return value
+		}
+	}
+
+	return nil
+}
+
+func ternary(trueValue interface{}, falseValue interface{}, condition bool) interface{} {
+	if condition {
+		return trueValue
+	}
+
+	return falseValue
+}
+
+// required only rejects nil and empty strings like sprig does. Values like false or 0 are valid.
+func required(message string, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, tracederrors.TracedErrorf("%w: %s", ErrRequiredValueMissing, message)
+	}
+
+	if s, ok := v.(string); ok && s == "" {
+		return nil, tracederrors.TracedErrorf("%w: %s", ErrRequiredValueMissing, message)
+	}
+
+	return v, nil
+}
+
+func fail(message string) (string, error) {
+	return "", tracederrors.TracedErrorf("%w: %s", ErrTemplateFailed, message)
+}
+
+func dict(v ...interface{}) (map[string]interface{}, error) {
+	if len(v)%2 != 0 {
+		return nil, tracederrors.TracedErrorf("dict expects an even number of arguments but got '%d'", len(v))
+	}
+
+	ret := map[string]interface{}{}
+	for i := 0; i < len(v); i += 2 {
+		ret[toString(v[i])] = v[i+1]
+	}
+
+	return ret, nil
+}
+
+func keys(d map[string]interface{}) []string {
+	ret := make([]string, 0, len(d))
+	for k := range d {
+		ret = append(ret, k)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+func toYaml(v interface{}) (string, error) {
+	yamlBytes, err := yaml.Marshal(v)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to marshal data to yaml: %w", err)
+	}
+
+	return strings.TrimSuffix(string(yamlBytes), "\n"), nil
+}
+
+func toJson(v interface{}) (string, error) {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to marshal data to json: %w", err)
+	}
+
+	return string(jsonBytes), nil
+}
+
+func b64dec(s string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to decode base64: %w", err)
+	}
+
+	return string(decoded), nil
+}
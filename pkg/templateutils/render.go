@@ -0,0 +1,111 @@
+package templateutils
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/templateutils/templateoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// RenderString renders the go text/template given as 'templateString'.
+//
+// Besides the go text/template builtins the functions returned by GetFuncMap and `include` are available.
+// `include` renders another template and returns the result as string so it can be used in pipelines:
+//
+//	{{ include "header.tmpl" . | indent 4 }}
+func RenderString(ctx context.Context, templateString string, options *templateoptions.RenderOptions) (string, error) {
+	return renderString(ctx, "template", templateString, options)
+}
+
+// RenderFile renders the go text/template stored in 'templateFile'.
+func RenderFile(ctx context.Context, templateFile filesinterfaces.File, options *templateoptions.RenderOptions) (string, error) {
+	if templateFile == nil {
+		return "", tracederrors.TracedErrorNil("templateFile")
+	}
+
+	name, err := templateFile.GetBaseName()
+	if err != nil {
+		return "", err
+	}
+
+	templateString, err := templateFile.ReadAsString(contextutils.WithSilent(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	return renderString(ctx, name, templateString, options)
+}
+
+func renderString(ctx context.Context, name string, templateString string, options *templateoptions.RenderOptions) (string, error) {
+	if name == "" {
+		return "", tracederrors.TracedErrorEmptyString("name")
+	}
+
+	if options == nil {
+		options = &templateoptions.RenderOptions{}
+	}
+
+	variables, err := getVariables(ctx, options.VariableFiles, options.Variables)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl := template.New(name)
+
+	funcMap := GetFuncMap()
+	funcMap["include"] = func(includeName string, data interface{}) (string, error) {
+		var buf bytes.Buffer
+		err := tmpl.ExecuteTemplate(&buf, includeName, data)
+		if err != nil {
+			return "", tracederrors.TracedErrorf("Failed to include template '%s': %w", includeName, err)
+		}
+
+		return buf.String(), nil
+	}
+	tmpl = tmpl.Funcs(funcMap)
+
+	if options.FailOnMissingKey {
+		tmpl = tmpl.Option("missingkey=error")
+	}
+
+	_, err = tmpl.Parse(templateString)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to parse template '%s': %w", name, err)
+	}
+
+	for _, includeFile := range options.IncludeFiles {
+		if includeFile == nil {
+			return "", tracederrors.TracedErrorNil("includeFile")
+		}
+
+		includeName, err := includeFile.GetBaseName()
+		if err != nil {
+			return "", err
+		}
+
+		includeContent, err := includeFile.ReadAsString(contextutils.WithSilent(ctx))
+		if err != nil {
+			return "", err
+		}
+
+		_, err = tmpl.New(includeName).Parse(includeContent)
+		if err != nil {
+			return "", tracederrors.TracedErrorf("Failed to parse include template '%s': %w", includeName, err)
+		}
+	}
+
+	var rendered bytes.Buffer
+	err = tmpl.ExecuteTemplate(&rendered, name, variables)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to render template '%s': %w", name, err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Rendered template '%s'.", name)
+
+	return rendered.String(), nil
+}
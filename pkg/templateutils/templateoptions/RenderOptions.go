@@ -0,0 +1,22 @@
+package templateoptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+)
+
+type RenderOptions struct {
+	// Variables accessible in the template using `.`, e.g. `{{ .hostname }}`.
+	// They take precedence over the variables loaded from `VariableFiles`.
+	Variables map[string]interface{}
+
+	// YAML or JSON files containing variables.
+	// Files are loaded in the given order. Later files override the values of earlier ones.
+	VariableFiles []filesinterfaces.File
+
+	// Additional template files which can be used in the main template.
+	// Every file is available by its base name, e.g. `{{ include "header.tmpl" . }}` or `{{ template "header.tmpl" . }}`.
+	IncludeFiles []filesinterfaces.File
+
+	// Fail on missing variables instead of rendering `<no value>`.
+	FailOnMissingKey bool
+}
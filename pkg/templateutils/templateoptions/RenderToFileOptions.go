@@ -0,0 +1,56 @@
+package templateoptions
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+)
+
+type RenderToFileOptions struct {
+	RenderOptions
+
+	// Permissions of the rendered file using a string like "u=rw,g=r,o=".
+	// If empty the permissions are left untouched.
+	PermissionsString string
+
+	// Owner of the rendered file. If empty the owner is left untouched.
+	UserName string
+
+	// Group of the rendered file. Only used together with `UserName`.
+	GroupName string
+
+	// Use sudo to write the rendered file and set owner and permissions.
+	UseSudo bool
+}
+
+func (r *RenderToFileOptions) GetRenderOptions() *RenderOptions {
+	return &r.RenderOptions
+}
+
+func (r *RenderToFileOptions) IsPermissionsStringSet() bool {
+	return r.PermissionsString != ""
+}
+
+func (r *RenderToFileOptions) IsUserNameSet() bool {
+	return r.UserName != ""
+}
+
+func (r *RenderToFileOptions) GetChmodOptions() *filesoptions.ChmodOptions {
+	return &filesoptions.ChmodOptions{
+		PermissionsString: r.PermissionsString,
+		UseSudo:           r.UseSudo,
+	}
+}
+
+func (r *RenderToFileOptions) GetChownOptions() *parameteroptions.ChownOptions {
+	return &parameteroptions.ChownOptions{
+		UserName:  r.UserName,
+		GroupName: r.GroupName,
+		UseSudo:   r.UseSudo,
+	}
+}
+
+func (r *RenderToFileOptions) GetWriteOptions() *filesoptions.WriteOptions {
+	return &filesoptions.WriteOptions{
+		UseSudo: r.UseSudo,
+	}
+}
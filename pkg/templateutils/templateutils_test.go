@@ -0,0 +1,200 @@
+package templateutils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/tempfilesoo"
+	"github.com/asciich/asciichgolangpublic/pkg/templateutils"
+	"github.com/asciich/asciichgolangpublic/pkg/templateutils/templateoptions"
+	"github.com/stretchr/testify/require"
+)
+
+func getCtx() context.Context {
+	return contextutils.ContextVerbose()
+}
+
+func Test_RenderString(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"plain", "hello world", "hello world"},
+		{"variable", "hello {{ .name }}", "hello world"},
+		{"upper", "{{ .name | upper }}", "WORLD"},
+		{"default", "{{ .missing | default \"fallback\" }}", "fallback"},
+		{"default not used", "{{ .name | default \"fallback\" }}", "world"},
+		{"nested", "{{ .nested.key }}", "value"},
+		{"quote", "{{ .name | quote }}", "\"world\""},
+		{"indent", "{{ \"a\\nb\" | indent 2 }}", "  a\n  b"},
+		{"join", "{{ .list | join \",\" }}", "a,b"},
+		{"toYaml", "{{ .nested | toYaml }}", "key: value"},
+		{"toJson", "{{ .nested | toJson }}", "{\"key\":\"value\"}"},
+		{"b64enc", "{{ .name | b64enc }}", "d29ybGQ="},
+		{"ternary", "{{ ternary \"yes\" \"no\" true }}", "yes"},
+		{"dict", "{{ $d := dict \"a\" \"b\" }}{{ $d.a }}", "b"},
+		{"include", "{{ define \"inner\" }}inner {{ .name }}{{ end }}{{ include \"inner\" . | upper }}", "INNER WORLD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := getCtx()
+
+			rendered, err := templateutils.RenderString(
+				ctx,
+				tt.template,
+				&templateoptions.RenderOptions{
+					Variables: map[string]interface{}{
+						"name":   "world",
+						"list":   []string{"a", "b"},
+						"nested": map[string]interface{}{"key": "value"},
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expected, rendered)
+		})
+	}
+}
+
+func Test_RenderString_Errors(t *testing.T) {
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := templateutils.RenderString(getCtx(), "{{ .name ", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("required", func(t *testing.T) {
+		_, err := templateutils.RenderString(getCtx(), "{{ required \"name is required\" .name }}", nil)
+		require.ErrorIs(t, err, templateutils.ErrRequiredValueMissing)
+	})
+
+	t.Run("required empty string", func(t *testing.T) {
+		_, err := templateutils.RenderString(getCtx(), "{{ required \"name is required\" .name }}", &templateoptions.RenderOptions{Variables: map[string]interface{}{"name": ""}})
+		require.ErrorIs(t, err, templateutils.ErrRequiredValueMissing)
+	})
+
+	t.Run("required accepts false and 0", func(t *testing.T) {
+		rendered, err := templateutils.RenderString(
+			getCtx(),
+			"{{ required \"enabled is required\" .enabled }} {{ required \"count is required\" .count }}",
+			&templateoptions.RenderOptions{Variables: map[string]interface{}{"enabled": false, "count": 0}},
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, "false 0", rendered)
+	})
+
+	t.Run("missing key without FailOnMissingKey", func(t *testing.T) {
+		rendered, err := templateutils.RenderString(getCtx(), "{{ .name }}", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, "<no value>", rendered)
+	})
+
+	t.Run("missing key with FailOnMissingKey", func(t *testing.T) {
+		_, err := templateutils.RenderString(getCtx(), "{{ .name }}", &templateoptions.RenderOptions{FailOnMissingKey: true})
+		require.Error(t, err)
+	})
+}
+
+func Test_RenderFile_VariablesAndIncludes(t *testing.T) {
+	ctx := getCtx()
+
+	tempDir, err := tempfilesoo.CreateEmptyTemporaryDirectory(ctx)
+	require.NoError(t, err)
+	defer tempDir.Delete(ctx, &filesoptions.DeleteOptions{})
+
+	createFile := func(t *testing.T, name string, content string) filesinterfaces.File {
+		file, err := tempDir.WriteStringToFile(ctx, name, content, &filesoptions.WriteOptions{})
+		require.NoError(t, err)
+
+		return file
+	}
+
+	templateFile := createFile(t, "main.tmpl", "{{ template \"header.tmpl\" . }}\nport={{ .port }}\nhost={{ .host }}\nuser={{ .user.name }}/{{ .user.id }}\n")
+	headerFile := createFile(t, "header.tmpl", "# {{ .title }}")
+	yamlVariables := createFile(t, "vars.yaml", "port: 80\nhost: example.com\nuser:\n  name: yaml\n  id: 1\n")
+	jsonVariables := createFile(t, "vars.json", `{"port": 8080, "user": {"name": "json"}}`)
+
+	rendered, err := templateutils.RenderFile(
+		ctx,
+		templateFile,
+		&templateoptions.RenderOptions{
+			VariableFiles: []filesinterfaces.File{yamlVariables, jsonVariables},
+			IncludeFiles:  []filesinterfaces.File{headerFile},
+			Variables:     map[string]interface{}{"title": "config"},
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, "# config\nport=8080\nhost=example.com\nuser=json/1\n", rendered)
+}
+
+func Test_RenderStringToFile(t *testing.T) {
+	ctx := getCtx()
+
+	destFile, err := tempfilesoo.CreateEmptyTemporaryFile(ctx)
+	require.NoError(t, err)
+	defer destFile.Delete(ctx, &filesoptions.DeleteOptions{})
+
+	options := &templateoptions.RenderToFileOptions{
+		RenderOptions: templateoptions.RenderOptions{
+			Variables: map[string]interface{}{"name": "world"},
+		},
+		PermissionsString: "u=rw,g=r,o=",
+	}
+
+	t.Run("first render changes content and permissions", func(t *testing.T) {
+		changeSummary, err := templateutils.RenderStringToFile(ctx, "hello {{ .name }}\n", destFile, options)
+		require.NoError(t, err)
+		require.True(t, changeSummary.IsChanged())
+		require.EqualValues(t, 2, changeSummary.GetNumberOfChanges())
+
+		content, err := destFile.ReadAsString(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, "hello world\n", content)
+
+		permissions, err := destFile.GetAccessPermissionsString()
+		require.NoError(t, err)
+		require.EqualValues(t, "u=rw,g=r,o=", permissions)
+	})
+
+	t.Run("second render is idempotent", func(t *testing.T) {
+		changeSummary, err := templateutils.RenderStringToFile(ctx, "hello {{ .name }}\n", destFile, options)
+		require.NoError(t, err)
+		require.False(t, changeSummary.IsChanged())
+	})
+
+	t.Run("changed variable updates content", func(t *testing.T) {
+		changeSummary, err := templateutils.RenderStringToFile(
+			ctx,
+			"hello {{ .name }}\n",
+			destFile,
+			&templateoptions.RenderToFileOptions{
+				RenderOptions: templateoptions.RenderOptions{
+					Variables: map[string]interface{}{"name": "moon"},
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, changeSummary.GetNumberOfChanges())
+
+		content, err := destFile.ReadAsString(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, "hello moon\n", content)
+	})
+}
+
+func Test_MergeVariables(t *testing.T) {
+	merged := templateutils.MergeVariables(
+		map[string]interface{}{"a": 1, "nested": map[string]interface{}{"b": 2, "c": 3}},
+		map[string]interface{}{"a": 10, "nested": map[string]interface{}{"c": 30}},
+	)
+
+	require.EqualValues(
+		t,
+		map[string]interface{}{"a": 10, "nested": map[string]interface{}{"b": 2, "c": 30}},
+		merged,
+	)
+}
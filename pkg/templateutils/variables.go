@@ -0,0 +1,77 @@
+package templateutils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/jsonutils"
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/yamlutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// LoadVariablesFromFile loads the variables stored in a YAML or JSON file.
+// Files ending with ".json" are parsed as JSON, all other files as YAML.
+func LoadVariablesFromFile(ctx context.Context, variablesFile filesinterfaces.File) (map[string]interface{}, error) {
+	if variablesFile == nil {
+		return nil, tracederrors.TracedErrorNil("variablesFile")
+	}
+
+	path, hostDescription, err := variablesFile.GetPathAndHostDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	var ret map[string]interface{}
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		ret, err = jsonutils.LoadKeyValueInterfaceDictFromJsonFile(ctx, variablesFile)
+	} else {
+		ret, err = yamlutils.LoadKeyValueInterfaceDictFromYamlFile(ctx, variablesFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Loaded '%d' top level template variables from '%s' on host '%s'.", len(ret), path, hostDescription)
+
+	return ret, nil
+}
+
+// MergeVariables merges 'override' into 'base' and returns the result as new map.
+// Nested maps are merged recursively, all other values in 'override' replace the ones in 'base'.
+func MergeVariables(base map[string]interface{}, override map[string]interface{}) map[string]interface{} {
+	ret := map[string]interface{}{}
+
+	for k, v := range base {
+		ret[k] = v
+	}
+
+	for k, v := range override {
+		baseMap, baseIsMap := ret[k].(map[string]interface{})
+		overrideMap, overrideIsMap := v.(map[string]interface{})
+		if baseIsMap && overrideIsMap {
+			ret[k] = MergeVariables(baseMap, overrideMap)
+			continue
+		}
+
+		ret[k] = v
+	}
+
+	return ret
+}
+
+func getVariables(ctx context.Context, variableFiles []filesinterfaces.File, variables map[string]interface{}) (map[string]interface{}, error) {
+	ret := map[string]interface{}{}
+
+	for _, variablesFile := range variableFiles {
+		loaded, err := LoadVariablesFromFile(ctx, variablesFile)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = MergeVariables(ret, loaded)
+	}
+
+	return MergeVariables(ret, variables), nil
+}
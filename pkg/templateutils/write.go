@@ -0,0 +1,133 @@
+package templateutils
+
+import (
+	"context"
+
+	"github.com/asciich/asciichgolangpublic/pkg/changesummary"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/templateutils/templateoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// RenderFileToFile renders 'templateFile' and writes the result to 'destFile'.
+//
+// The destination is only written if the rendered content differs from its current content which makes it safe to call repeatedly.
+// Since any `filesinterfaces.File` implementation is supported the destination can also be a file on a remote host.
+// The returned ChangeSummary reports if the content or the permissions were changed.
+func RenderFileToFile(ctx context.Context, templateFile filesinterfaces.File, destFile filesinterfaces.File, options *templateoptions.RenderToFileOptions) (*changesummary.ChangeSummary, error) {
+	if templateFile == nil {
+		return nil, tracederrors.TracedErrorNil("templateFile")
+	}
+
+	if options == nil {
+		options = &templateoptions.RenderToFileOptions{}
+	}
+
+	rendered, err := RenderFile(ctx, templateFile, options.GetRenderOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return WriteRenderedString(ctx, rendered, destFile, options)
+}
+
+// RenderStringToFile renders 'templateString' and writes the result to 'destFile'.
+//
+// See RenderFileToFile for details.
+func RenderStringToFile(ctx context.Context, templateString string, destFile filesinterfaces.File, options *templateoptions.RenderToFileOptions) (*changesummary.ChangeSummary, error) {
+	if options == nil {
+		options = &templateoptions.RenderToFileOptions{}
+	}
+
+	rendered, err := RenderString(ctx, templateString, options.GetRenderOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return WriteRenderedString(ctx, rendered, destFile, options)
+}
+
+// WriteRenderedString writes an already rendered 'content' to 'destFile' only if the content differs.
+// Afterwards owner and permissions are set as defined in 'options'.
+func WriteRenderedString(ctx context.Context, content string, destFile filesinterfaces.File, options *templateoptions.RenderToFileOptions) (*changesummary.ChangeSummary, error) {
+	if destFile == nil {
+		return nil, tracederrors.TracedErrorNil("destFile")
+	}
+
+	if options == nil {
+		options = &templateoptions.RenderToFileOptions{}
+	}
+
+	path, hostDescription, err := destFile.GetPathAndHostDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	changeSummary := changesummary.NewChangeSummary()
+
+	exists, err := destFile.Exists(contextutils.WithSilent(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	isContentUpToDate := false
+	if exists {
+		currentContent, err := destFile.ReadAsString(contextutils.WithSilent(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		isContentUpToDate = currentContent == content
+	}
+
+	if isContentUpToDate {
+		logging.LogInfoByCtxf(ctx, "Rendered content of '%s' on host '%s' is already up to date.", path, hostDescription)
+	} else {
+		err = destFile.WriteString(contextutils.WithSilent(ctx), content, options.GetWriteOptions())
+		if err != nil {
+			return nil, err
+		}
+
+		changeSummary.IncrementNumberOfChanges()
+		logging.LogChangedByCtxf(ctx, "Wrote rendered content to '%s' on host '%s'.", path, hostDescription)
+	}
+
+	if options.IsUserNameSet() {
+		// The File interface does not allow to read the current owner.
+		// Since chown is idempotent it is therefore always applied but not reported as change.
+		err = destFile.Chown(ctx, options.GetChownOptions())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if options.IsPermissionsStringSet() {
+		chmodOptions := options.GetChmodOptions()
+
+		expectedPermissions, err := chmodOptions.GetPermissions()
+		if err != nil {
+			return nil, err
+		}
+
+		currentPermissions, err := destFile.GetAccessPermissions()
+		if err != nil {
+			return nil, err
+		}
+
+		if currentPermissions == expectedPermissions {
+			logging.LogInfoByCtxf(ctx, "Permissions of '%s' on host '%s' are already '%s'.", path, hostDescription, options.PermissionsString)
+		} else {
+			err = destFile.Chmod(ctx, chmodOptions)
+			if err != nil {
+				return nil, err
+			}
+
+			changeSummary.IncrementNumberOfChanges()
+			logging.LogChangedByCtxf(ctx, "Permissions of '%s' on host '%s' set to '%s'.", path, hostDescription, options.PermissionsString)
+		}
+	}
+
+	return changeSummary, nil
+}
@@ -17,6 +17,12 @@ func LoadExecuctors() ([]testutilsinterfaces.TestCaseExecutor, error) {
 		&TestCaseExecutorKubernetesSecretExists{},
 		&TestCaseExecutorKubernetesDeploymentExists{},
 		&TestCaseExecutorKubernetesCronJobExists{},
+		&TestCaseExecutorKubernetesServiceExists{},
+		&TestCaseExecutorKubernetesStatefulSetExists{},
+		&TestCaseExecutorKubernetesDaemonSetExists{},
+		&TestCaseExecutorKubernetesJobExists{},
+		&TestCaseExecutorKubernetesIngressExists{},
+		&TestCaseExecutorKubernetesPersistentVolumeClaimExists{},
 		&TestCaseExecutorKubernetesValidateSshKeyInSecret{},
 	}, nil
 }
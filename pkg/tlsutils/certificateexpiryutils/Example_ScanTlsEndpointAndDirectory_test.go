@@ -0,0 +1,83 @@
+package certificateexpiryutils_test
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/files"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils/testwebserver"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/certificateexpiryutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/nativex509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_ScanTlsEndpointAndDirectory(t *testing.T) {
+	// Get the default context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Preparation start...
+	// Start a TLS web server to scan:
+	const port int = 9125
+	testServer, err := testwebserver.GetTestWebServerWithTLS(port)
+	require.NoError(t, err)
+	defer testServer.Stop(ctx)
+	err = testServer.StartInBackground(ctx)
+	require.NoError(t, err)
+
+	// Write a certificate into a directory to scan:
+	tempDir := t.TempDir()
+	cert, err := x509utils.CreateSelfSignedCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:     "server.example.net",
+		CountryName:    "CH",
+		PrivateKeySize: 2048,
+	})
+	require.NoError(t, err)
+	err = nativex509utils.WriteCertsToFile([]*x509.Certificate{cert.Cert}, filepath.Join(tempDir, "server.crt"))
+	require.NoError(t, err)
+	// ... preparation end.
+
+	// Define the thresholds used to report certificates as warning or critical:
+	options := &certificateexpiryutils.ScanOptions{
+		WarningThresholdDays:  30,
+		CriticalThresholdDays: 7,
+	}
+
+	// Scan the certificates presented by the TLS endpoint:
+	report, err := certificateexpiryutils.ScanTlsEndpoints(ctx, []string{"localhost:9125"}, options)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Certificates)
+	require.EqualValues(t, "https://localhost:9125", report.Certificates[0].Source)
+
+	// Scan all certificate files in a directory. Any filesinterfaces.Directory works, also on remote hosts:
+	directory, err := files.GetLocalDirectoryByPath(ctx, tempDir)
+	require.NoError(t, err)
+
+	directoryReport, err := certificateexpiryutils.ScanDirectory(ctx, directory, options)
+	require.NoError(t, err)
+	require.Len(t, directoryReport.Certificates, 1)
+
+	// Combine the reports and sort them so the certificates expiring first are listed first:
+	err = report.AddReport(directoryReport)
+	require.NoError(t, err)
+	report.SortByExpiry()
+
+	// Render the report as table, JSON or Prometheus metrics:
+	table, err := report.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_TABLE)
+	require.NoError(t, err)
+	require.Contains(t, table, "CN=server.example.net")
+
+	_, err = report.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_JSON)
+	require.NoError(t, err)
+
+	_, err = report.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_PROMETHEUS)
+	require.NoError(t, err)
+
+	// Get the most severe status of all scanned certificates:
+	worstStatus, err := report.GetWorstStatus()
+	require.NoError(t, err)
+	require.EqualValues(t, certificateexpiryutils.STATUS_OK, worstStatus)
+}
@@ -0,0 +1,139 @@
+package certificateexpiryutils
+
+import (
+	"crypto/x509"
+	"math"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const STATUS_OK = "ok"
+const STATUS_WARNING = "warning"
+const STATUS_CRITICAL = "critical"
+const STATUS_EXPIRED = "expired"
+
+const SOURCE_TYPE_FILE = "file"
+const SOURCE_TYPE_KUBERNETES_SECRET = "kubernetes-secret"
+const SOURCE_TYPE_TLS_ENDPOINT = "tls-endpoint"
+
+// Expiry information about a single certificate found by one of the scans.
+type CertificateExpiryInfo struct {
+	// One of the SOURCE_TYPE_* constants.
+	SourceType string `json:"source_type"`
+
+	// Where the certificate was found: A file path, '<namespace>/<secret>' or the URL of the TLS endpoint.
+	Source string `json:"source"`
+
+	Subject         string    `json:"subject"`
+	Issuer          string    `json:"issuer"`
+	Sans            []string  `json:"sans"`
+	SerialNumber    string    `json:"serial_number"`
+	NotAfter        time.Time `json:"not_after"`
+	DaysUntilExpiry int       `json:"days_until_expiry"`
+
+	// One of the STATUS_* constants.
+	Status string `json:"status"`
+}
+
+// Returns the severity of the given status. Higher values are more severe.
+func GetStatusSeverity(status string) (int, error) {
+	switch status {
+	case STATUS_OK:
+		return 0, nil
+	case STATUS_WARNING:
+		return 1, nil
+	case STATUS_CRITICAL:
+		return 2, nil
+	case STATUS_EXPIRED:
+		return 3, nil
+	default:
+		return 0, tracederrors.TracedErrorf("Unknown certificate expiry status '%s'.", status)
+	}
+}
+
+// Returns the number of full days until the certificate expires. Negative if the certificate is already expired.
+func GetDaysUntilExpiry(cert *x509.Certificate, referenceTime time.Time) (int, error) {
+	if cert == nil {
+		return 0, tracederrors.TracedErrorNil("cert")
+	}
+
+	return int(math.Floor(cert.NotAfter.Sub(referenceTime).Hours() / 24)), nil
+}
+
+func GetStatusForDaysUntilExpiry(daysUntilExpiry int, options *ScanOptions) (string, error) {
+	if options == nil {
+		return "", tracederrors.TracedErrorNil("options")
+	}
+
+	err := options.CheckThresholds()
+	if err != nil {
+		return "", err
+	}
+
+	if daysUntilExpiry < 0 {
+		return STATUS_EXPIRED, nil
+	}
+
+	if daysUntilExpiry < options.GetCriticalThresholdDays() {
+		return STATUS_CRITICAL, nil
+	}
+
+	if daysUntilExpiry < options.GetWarningThresholdDays() {
+		return STATUS_WARNING, nil
+	}
+
+	return STATUS_OK, nil
+}
+
+func GetCertificateExpiryInfo(cert *x509.Certificate, sourceType string, source string, options *ScanOptions) (*CertificateExpiryInfo, error) {
+	if cert == nil {
+		return nil, tracederrors.TracedErrorNil("cert")
+	}
+
+	if sourceType == "" {
+		return nil, tracederrors.TracedErrorEmptyString("sourceType")
+	}
+
+	if source == "" {
+		return nil, tracederrors.TracedErrorEmptyString("source")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	serialNumber, err := genericx509utils.GetSerialNumberAsHexColonSeparated(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	daysUntilExpiry, err := GetDaysUntilExpiry(cert, options.GetReferenceTime())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := GetStatusForDaysUntilExpiry(daysUntilExpiry, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sans := []string{}
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return &CertificateExpiryInfo{
+		SourceType:      sourceType,
+		Source:          source,
+		Subject:         cert.Subject.String(),
+		Issuer:          cert.Issuer.String(),
+		Sans:            sans,
+		SerialNumber:    serialNumber,
+		NotAfter:        cert.NotAfter.UTC(),
+		DaysUntilExpiry: daysUntilExpiry,
+		Status:          status,
+	}, nil
+}
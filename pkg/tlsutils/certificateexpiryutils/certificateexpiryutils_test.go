@@ -0,0 +1,279 @@
+package certificateexpiryutils_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/files"
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/jsonutils"
+	"github.com/asciich/asciichgolangpublic/pkg/prometheusutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/certificateexpiryutils"
+)
+
+func getCtx() context.Context {
+	return contextutils.ContextVerbose()
+}
+
+var referenceTime = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func createTestCertificate(t *testing.T, commonName string, notAfter time.Time) *x509.Certificate {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func encodeAsPem(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func Test_GetStatusForDaysUntilExpiry(t *testing.T) {
+	tests := []struct {
+		daysUntilExpiry int
+		expectedStatus  string
+	}{
+		{-1, certificateexpiryutils.STATUS_EXPIRED},
+		{0, certificateexpiryutils.STATUS_CRITICAL},
+		{6, certificateexpiryutils.STATUS_CRITICAL},
+		{7, certificateexpiryutils.STATUS_WARNING},
+		{29, certificateexpiryutils.STATUS_WARNING},
+		{30, certificateexpiryutils.STATUS_OK},
+		{365, certificateexpiryutils.STATUS_OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expectedStatus, func(t *testing.T) {
+			status, err := certificateexpiryutils.GetStatusForDaysUntilExpiry(tt.daysUntilExpiry, &certificateexpiryutils.ScanOptions{})
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedStatus, status)
+		})
+	}
+
+	t.Run("custom thresholds", func(t *testing.T) {
+		options := &certificateexpiryutils.ScanOptions{WarningThresholdDays: 60, CriticalThresholdDays: 14}
+
+		status, err := certificateexpiryutils.GetStatusForDaysUntilExpiry(45, options)
+		require.NoError(t, err)
+		require.EqualValues(t, certificateexpiryutils.STATUS_WARNING, status)
+
+		status, err = certificateexpiryutils.GetStatusForDaysUntilExpiry(13, options)
+		require.NoError(t, err)
+		require.EqualValues(t, certificateexpiryutils.STATUS_CRITICAL, status)
+	})
+
+	t.Run("critical greater than warning", func(t *testing.T) {
+		_, err := certificateexpiryutils.GetStatusForDaysUntilExpiry(10, &certificateexpiryutils.ScanOptions{WarningThresholdDays: 5, CriticalThresholdDays: 10})
+		require.Error(t, err)
+	})
+}
+
+func Test_GetCertificateExpiryInfo(t *testing.T) {
+	cert := createTestCertificate(t, "expiring.example.net", referenceTime.Add(3*24*time.Hour+time.Hour))
+
+	info, err := certificateexpiryutils.GetCertificateExpiryInfo(cert, certificateexpiryutils.SOURCE_TYPE_FILE, "/tmp/cert.pem", &certificateexpiryutils.ScanOptions{ReferenceTime: referenceTime})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, info.DaysUntilExpiry)
+	require.EqualValues(t, certificateexpiryutils.STATUS_CRITICAL, info.Status)
+	require.EqualValues(t, "CN=expiring.example.net", info.Subject)
+	require.EqualValues(t, "CN=expiring.example.net", info.Issuer)
+	require.EqualValues(t, []string{"expiring.example.net"}, info.Sans)
+	require.NotEmpty(t, info.SerialNumber)
+	require.EqualValues(t, "/tmp/cert.pem", info.Source)
+}
+
+func Test_ScanDirectory(t *testing.T) {
+	ctx := getCtx()
+
+	tempDir := t.TempDir()
+	okCert := createTestCertificate(t, "ok.example.net", referenceTime.Add(100*24*time.Hour))
+	warningCert := createTestCertificate(t, "warning.example.net", referenceTime.Add(20*24*time.Hour))
+	expiredCert := createTestCertificate(t, "expired.example.net", referenceTime.Add(-24*time.Hour))
+
+	// A PEM bundle containing a private key which must be ignored:
+	bundle := append(encodeAsPem(okCert), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("not a key")})...)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "ok.pem"), bundle, 0644))
+
+	// DER encoded certificate in a subdirectory:
+	require.NoError(t, os.MkdirAll(filepath.Join(tempDir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "sub", "warning.der"), warningCert.Raw, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "sub", "expired.crt"), encodeAsPem(expiredCert), 0644))
+
+	// Files not matching the patterns or not containing certificates are skipped:
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "notes.txt"), encodeAsPem(expiredCert), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "empty.pem"), []byte("no certificate here"), 0644))
+
+	directory, err := files.GetLocalDirectoryByPath(ctx, tempDir)
+	require.NoError(t, err)
+
+	report, err := certificateexpiryutils.ScanDirectory(ctx, directory, &certificateexpiryutils.ScanOptions{ReferenceTime: referenceTime})
+	require.NoError(t, err)
+	require.Len(t, report.Certificates, 3)
+
+	report.SortByExpiry()
+	require.EqualValues(t, "CN=expired.example.net", report.Certificates[0].Subject)
+	require.EqualValues(t, certificateexpiryutils.STATUS_EXPIRED, report.Certificates[0].Status)
+	require.EqualValues(t, filepath.Join(tempDir, "sub", "expired.crt"), report.Certificates[0].Source)
+	require.EqualValues(t, "CN=warning.example.net", report.Certificates[1].Subject)
+	require.EqualValues(t, certificateexpiryutils.STATUS_WARNING, report.Certificates[1].Status)
+	require.EqualValues(t, "CN=ok.example.net", report.Certificates[2].Subject)
+	require.EqualValues(t, certificateexpiryutils.STATUS_OK, report.Certificates[2].Status)
+
+	worstStatus, err := report.GetWorstStatus()
+	require.NoError(t, err)
+	require.EqualValues(t, certificateexpiryutils.STATUS_EXPIRED, worstStatus)
+
+	require.Len(t, report.GetCertificatesByStatus(certificateexpiryutils.STATUS_WARNING), 1)
+	require.False(t, report.HasScanErrors())
+}
+
+func Test_ScanDirectory_UnparsableFileIsRecordedAsScanError(t *testing.T) {
+	ctx := getCtx()
+
+	tempDir := t.TempDir()
+	okCert := createTestCertificate(t, "ok.example.net", referenceTime.Add(100*24*time.Hour))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "a-broken.pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a certificate")}), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "b-ok.pem"), encodeAsPem(okCert), 0644))
+
+	directory, err := files.GetLocalDirectoryByPath(ctx, tempDir)
+	require.NoError(t, err)
+
+	report, err := certificateexpiryutils.ScanDirectory(ctx, directory, &certificateexpiryutils.ScanOptions{ReferenceTime: referenceTime})
+	require.NoError(t, err)
+	require.Len(t, report.Certificates, 1)
+	require.EqualValues(t, "CN=ok.example.net", report.Certificates[0].Subject)
+
+	require.True(t, report.HasScanErrors())
+	require.Len(t, report.Errors, 1)
+	require.EqualValues(t, certificateexpiryutils.SOURCE_TYPE_FILE, report.Errors[0].SourceType)
+	require.EqualValues(t, filepath.Join(tempDir, "a-broken.pem"), report.Errors[0].Source)
+	require.Contains(t, report.Errors[0].Error, "Unable to parse x509 certificate")
+}
+
+func Test_ScanTlsEndpoints_UnreachableEndpointIsRecordedAsScanError(t *testing.T) {
+	ctx := getCtx()
+
+	// Nothing listens on port 1:
+	report, err := certificateexpiryutils.ScanTlsEndpoints(ctx, []string{"localhost:1", "127.0.0.1:1"}, &certificateexpiryutils.ScanOptions{ReferenceTime: referenceTime})
+	require.NoError(t, err)
+	require.Empty(t, report.Certificates)
+	require.Len(t, report.Errors, 2)
+	require.EqualValues(t, certificateexpiryutils.SOURCE_TYPE_TLS_ENDPOINT, report.Errors[0].SourceType)
+	require.EqualValues(t, "https://localhost:1", report.Errors[0].Source)
+	require.EqualValues(t, "https://127.0.0.1:1", report.Errors[1].Source)
+	require.NotEmpty(t, report.Errors[0].Error)
+}
+
+func Test_ExpiryReportOutputFormats(t *testing.T) {
+	report, err := certificateexpiryutils.ScanCertificates(
+		[]*x509.Certificate{
+			createTestCertificate(t, "a.example.net", referenceTime.Add(10*24*time.Hour)),
+			createTestCertificate(t, "b.example.net", referenceTime.Add(200*24*time.Hour)),
+		},
+		certificateexpiryutils.SOURCE_TYPE_KUBERNETES_SECRET,
+		"default/my-\"tls\"-secret",
+		&certificateexpiryutils.ScanOptions{ReferenceTime: referenceTime},
+	)
+	require.NoError(t, err)
+
+	t.Run("table", func(t *testing.T) {
+		table, err := report.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_TABLE)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(table), "\n")
+		require.Len(t, lines, 3)
+		require.True(t, strings.HasPrefix(lines[0], "STATUS"))
+		require.True(t, strings.HasPrefix(lines[1], "warning"))
+		require.Contains(t, lines[1], "a.example.net")
+		require.True(t, strings.HasPrefix(lines[2], "ok"))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		jsonString, err := report.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_JSON)
+		require.NoError(t, err)
+
+		data, err := jsonutils.LoadKeyValueInterfaceDictFromJsonString(jsonString)
+		require.NoError(t, err)
+
+		certs := data["certificates"].([]interface{})
+		require.Len(t, certs, 2)
+		first := certs[0].(map[string]interface{})
+		require.EqualValues(t, "CN=a.example.net", first["subject"])
+		require.EqualValues(t, 10, first["days_until_expiry"])
+		require.EqualValues(t, "warning", first["status"])
+	})
+
+	t.Run("prometheus", func(t *testing.T) {
+		metrics, err := report.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_PROMETHEUS)
+		require.NoError(t, err)
+		require.Contains(t, metrics, `source="default/my-\"tls\"-secret"`)
+
+		parsed, err := prometheusutils.PrometheusExpositionFormatParser().ParseString(metrics)
+		require.NoError(t, err)
+
+		metricFamilies, err := parsed.GetNativeMetricFamilies()
+		require.NoError(t, err)
+		require.Len(t, metricFamilies[certificateexpiryutils.PROMETHEUS_METRIC_STATUS].GetMetric(), 2)
+		require.EqualValues(t, 1, metricFamilies[certificateexpiryutils.PROMETHEUS_METRIC_STATUS].GetMetric()[0].GetGauge().GetValue())
+		require.EqualValues(t, 10, metricFamilies[certificateexpiryutils.PROMETHEUS_METRIC_DAYS_UNTIL_EXPIRY].GetMetric()[0].GetGauge().GetValue())
+	})
+
+	t.Run("scan errors", func(t *testing.T) {
+		reportWithErrors := certificateexpiryutils.NewExpiryReport()
+		require.NoError(t, reportWithErrors.AddReport(report))
+		require.NoError(t, reportWithErrors.AddScanError(certificateexpiryutils.SOURCE_TYPE_KUBERNETES_SECRET, "kube-system", errors.New("secrets is forbidden")))
+
+		table, err := reportWithErrors.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_TABLE)
+		require.NoError(t, err)
+		require.Contains(t, table, "secrets is forbidden")
+
+		jsonString, err := reportWithErrors.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_JSON)
+		require.NoError(t, err)
+		data, err := jsonutils.LoadKeyValueInterfaceDictFromJsonString(jsonString)
+		require.NoError(t, err)
+		scanErrors := data["errors"].([]interface{})
+		require.Len(t, scanErrors, 1)
+		require.EqualValues(t, "kube-system", scanErrors[0].(map[string]interface{})["source"])
+
+		metrics, err := reportWithErrors.GetAsString(certificateexpiryutils.OUTPUT_FORMAT_PROMETHEUS)
+		require.NoError(t, err)
+		parsed, err := prometheusutils.PrometheusExpositionFormatParser().ParseString(metrics)
+		require.NoError(t, err)
+		metricFamilies, err := parsed.GetNativeMetricFamilies()
+		require.NoError(t, err)
+		require.Len(t, metricFamilies[certificateexpiryutils.PROMETHEUS_METRIC_SCAN_ERROR].GetMetric(), 1)
+		require.Len(t, metricFamilies[certificateexpiryutils.PROMETHEUS_METRIC_STATUS].GetMetric(), 2)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := report.GetAsString("xml")
+		require.Error(t, err)
+	})
+}
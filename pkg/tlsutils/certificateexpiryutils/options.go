@@ -0,0 +1,82 @@
+package certificateexpiryutils
+
+import (
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const DEFAULT_WARNING_THRESHOLD_DAYS = 30
+const DEFAULT_CRITICAL_THRESHOLD_DAYS = 7
+
+// Basename patterns used to find certificate files in directories if no other patterns are specified.
+var DEFAULT_CERTIFICATE_FILE_PATTERNS = []string{".*\\.pem$", ".*\\.crt$", ".*\\.cer$", ".*\\.cert$", ".*\\.der$"}
+
+type ScanOptions struct {
+	// Certificates expiring in less than WarningThresholdDays are reported as warning.
+	// Defaults to DEFAULT_WARNING_THRESHOLD_DAYS if unset.
+	WarningThresholdDays int
+
+	// Certificates expiring in less than CriticalThresholdDays are reported as critical.
+	// Defaults to DEFAULT_CRITICAL_THRESHOLD_DAYS if unset.
+	CriticalThresholdDays int
+
+	// Point in time used to calculate the days until expiry.
+	// Defaults to time.Now() if unset.
+	ReferenceTime time.Time
+
+	// Regex patterns to match the basenames of the certificate files when scanning directories.
+	// Defaults to DEFAULT_CERTIFICATE_FILE_PATTERNS if unset.
+	CertificateFilePatterns []string
+
+	// Only scan the given namespaces when scanning kubernetes secrets.
+	// If unset all namespaces are scanned.
+	NamespaceNames []string
+}
+
+func (s *ScanOptions) GetWarningThresholdDays() int {
+	if s.WarningThresholdDays <= 0 {
+		return DEFAULT_WARNING_THRESHOLD_DAYS
+	}
+
+	return s.WarningThresholdDays
+}
+
+func (s *ScanOptions) GetCriticalThresholdDays() int {
+	if s.CriticalThresholdDays <= 0 {
+		return DEFAULT_CRITICAL_THRESHOLD_DAYS
+	}
+
+	return s.CriticalThresholdDays
+}
+
+func (s *ScanOptions) GetReferenceTime() time.Time {
+	if s.ReferenceTime.IsZero() {
+		return time.Now()
+	}
+
+	return s.ReferenceTime
+}
+
+func (s *ScanOptions) GetCertificateFilePatterns() []string {
+	if len(s.CertificateFilePatterns) <= 0 {
+		return DEFAULT_CERTIFICATE_FILE_PATTERNS
+	}
+
+	return s.CertificateFilePatterns
+}
+
+func (s *ScanOptions) CheckThresholds() error {
+	warningThresholdDays := s.GetWarningThresholdDays()
+	criticalThresholdDays := s.GetCriticalThresholdDays()
+
+	if criticalThresholdDays > warningThresholdDays {
+		return tracederrors.TracedErrorf(
+			"The critical threshold of %d days must not be greater than the warning threshold of %d days.",
+			criticalThresholdDays,
+			warningThresholdDays,
+		)
+	}
+
+	return nil
+}
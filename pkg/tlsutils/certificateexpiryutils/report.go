@@ -0,0 +1,289 @@
+package certificateexpiryutils
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/asciich/asciichgolangpublic/pkg/fileformats/jsonutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const OUTPUT_FORMAT_TABLE = "table"
+const OUTPUT_FORMAT_JSON = "json"
+const OUTPUT_FORMAT_PROMETHEUS = "prometheus"
+
+const PROMETHEUS_METRIC_DAYS_UNTIL_EXPIRY = "x509_certificate_days_until_expiry"
+const PROMETHEUS_METRIC_NOT_AFTER = "x509_certificate_not_after_timestamp_seconds"
+const PROMETHEUS_METRIC_STATUS = "x509_certificate_expiry_status"
+const PROMETHEUS_METRIC_SCAN_ERROR = "x509_certificate_scan_error"
+
+func GetSupportedOutputFormats() []string {
+	return []string{OUTPUT_FORMAT_TABLE, OUTPUT_FORMAT_JSON, OUTPUT_FORMAT_PROMETHEUS}
+}
+
+// A source which could not be scanned. The scan continues with the remaining sources.
+type ScanError struct {
+	// One of the SOURCE_TYPE_* constants.
+	SourceType string `json:"source_type"`
+
+	// The source which failed: A file path, a namespace name, '<namespace>/<secret>' or the URL of the TLS endpoint.
+	Source string `json:"source"`
+
+	Error string `json:"error"`
+}
+
+// The collected results of one or more certificate expiry scans.
+type ExpiryReport struct {
+	Certificates []*CertificateExpiryInfo `json:"certificates"`
+	Errors       []*ScanError             `json:"errors"`
+}
+
+func NewExpiryReport() *ExpiryReport {
+	return &ExpiryReport{
+		Certificates: []*CertificateExpiryInfo{},
+		Errors:       []*ScanError{},
+	}
+}
+
+func (e *ExpiryReport) Add(toAdd ...*CertificateExpiryInfo) error {
+	for _, info := range toAdd {
+		if info == nil {
+			return tracederrors.TracedErrorNil("info")
+		}
+	}
+
+	e.Certificates = append(e.Certificates, toAdd...)
+
+	return nil
+}
+
+// Record that the given source could not be scanned.
+// For traced errors only the error message without the stack trace is stored.
+func (e *ExpiryReport) AddScanError(sourceType string, source string, scanErr error) error {
+	if sourceType == "" {
+		return tracederrors.TracedErrorEmptyString("sourceType")
+	}
+
+	if source == "" {
+		return tracederrors.TracedErrorEmptyString("source")
+	}
+
+	if scanErr == nil {
+		return tracederrors.TracedErrorNil("scanErr")
+	}
+
+	message := scanErr.Error()
+	if tracederrors.IsTracedError(scanErr) {
+		tracedError, err := tracederrors.GetAsTracedError(scanErr)
+		if err != nil {
+			return err
+		}
+
+		message, err = tracedError.GetErrorMessage()
+		if err != nil {
+			return err
+		}
+	}
+
+	e.Errors = append(e.Errors, &ScanError{
+		SourceType: sourceType,
+		Source:     source,
+		Error:      message,
+	})
+
+	return nil
+}
+
+// Add all certificates and scan errors of the other report.
+func (e *ExpiryReport) AddReport(other *ExpiryReport) error {
+	if other == nil {
+		return tracederrors.TracedErrorNil("other")
+	}
+
+	e.Errors = append(e.Errors, other.Errors...)
+
+	return e.Add(other.Certificates...)
+}
+
+// Returns true if at least one source could not be scanned.
+func (e *ExpiryReport) HasScanErrors() bool {
+	return len(e.Errors) > 0
+}
+
+// Sort the certificates so the ones expiring first are listed first.
+func (e *ExpiryReport) SortByExpiry() {
+	sort.SliceStable(e.Certificates, func(i, j int) bool {
+		return e.Certificates[i].NotAfter.Before(e.Certificates[j].NotAfter)
+	})
+}
+
+// Returns the most severe status of all certificates in the report. STATUS_OK if the report is empty.
+func (e *ExpiryReport) GetWorstStatus() (string, error) {
+	worstStatus := STATUS_OK
+	worstSeverity := 0
+
+	for _, info := range e.Certificates {
+		severity, err := GetStatusSeverity(info.Status)
+		if err != nil {
+			return "", err
+		}
+
+		if severity > worstSeverity {
+			worstStatus = info.Status
+			worstSeverity = severity
+		}
+	}
+
+	return worstStatus, nil
+}
+
+func (e *ExpiryReport) GetCertificatesByStatus(status string) []*CertificateExpiryInfo {
+	matching := []*CertificateExpiryInfo{}
+
+	for _, info := range e.Certificates {
+		if info.Status == status {
+			matching = append(matching, info)
+		}
+	}
+
+	return matching
+}
+
+func (e *ExpiryReport) GetAsTableString() (string, error) {
+	buf := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "STATUS\tDAYS\tNOT AFTER\tSOURCE TYPE\tSOURCE\tSUBJECT\tISSUER\tSANS\tSERIAL")
+	for _, info := range e.Certificates {
+		fmt.Fprintf(
+			writer,
+			"%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			info.Status,
+			info.DaysUntilExpiry,
+			info.NotAfter.Format("2006-01-02 15:04:05 UTC"),
+			info.SourceType,
+			info.Source,
+			info.Subject,
+			info.Issuer,
+			strings.Join(info.Sans, ","),
+			info.SerialNumber,
+		)
+	}
+
+	if e.HasScanErrors() {
+		fmt.Fprintln(writer)
+		fmt.Fprintln(writer, "SCAN ERROR SOURCE TYPE\tSOURCE\tERROR")
+		for _, scanErr := range e.Errors {
+			fmt.Fprintf(writer, "%s\t%s\t%s\n", scanErr.SourceType, scanErr.Source, strings.ReplaceAll(scanErr.Error, "\n", " "))
+		}
+	}
+
+	err := writer.Flush()
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to render certificate expiry table: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+func (e *ExpiryReport) GetAsJsonString() (string, error) {
+	return jsonutils.DataToJsonString(e)
+}
+
+// Returns the report as Prometheus metrics in the text exposition format.
+// Metric families without any metric are omitted.
+func (e *ExpiryReport) GetAsPrometheusMetricsString() (string, error) {
+	daysUntilExpiry := newPrometheusGaugeFamily(PROMETHEUS_METRIC_DAYS_UNTIL_EXPIRY, "Number of full days until the certificate expires. Negative if already expired.")
+	notAfter := newPrometheusGaugeFamily(PROMETHEUS_METRIC_NOT_AFTER, "Unix timestamp in seconds when the certificate expires.")
+	status := newPrometheusGaugeFamily(PROMETHEUS_METRIC_STATUS, "Certificate expiry status: 0=ok, 1=warning, 2=critical, 3=expired.")
+	scanError := newPrometheusGaugeFamily(PROMETHEUS_METRIC_SCAN_ERROR, "Set to 1 for every source which could not be scanned.")
+
+	for _, info := range e.Certificates {
+		severity, err := GetStatusSeverity(info.Status)
+		if err != nil {
+			return "", err
+		}
+
+		labels := getPrometheusCertificateLabels(info)
+		daysUntilExpiry.Metric = append(daysUntilExpiry.Metric, newPrometheusGauge(labels, float64(info.DaysUntilExpiry)))
+		notAfter.Metric = append(notAfter.Metric, newPrometheusGauge(labels, float64(info.NotAfter.Unix())))
+		status.Metric = append(status.Metric, newPrometheusGauge(labels, float64(severity)))
+	}
+
+	for _, scanErr := range e.Errors {
+		labels := newPrometheusLabels("source_type", scanErr.SourceType, "source", scanErr.Source)
+		scanError.Metric = append(scanError.Metric, newPrometheusGauge(labels, 1))
+	}
+
+	var sb strings.Builder
+	for _, family := range []*dto.MetricFamily{daysUntilExpiry, notAfter, status, scanError} {
+		if len(family.Metric) <= 0 {
+			continue
+		}
+
+		_, err := expfmt.MetricFamilyToText(&sb, family)
+		if err != nil {
+			return "", tracederrors.TracedErrorf("Failed to render Prometheus metric family '%s': %w", family.GetName(), err)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func (e *ExpiryReport) GetAsString(outputFormat string) (string, error) {
+	switch outputFormat {
+	case OUTPUT_FORMAT_TABLE:
+		return e.GetAsTableString()
+	case OUTPUT_FORMAT_JSON:
+		return e.GetAsJsonString()
+	case OUTPUT_FORMAT_PROMETHEUS:
+		return e.GetAsPrometheusMetricsString()
+	default:
+		return "", tracederrors.TracedErrorf("Unsupported output format '%s'. Supported are: '%v'", outputFormat, GetSupportedOutputFormats())
+	}
+}
+
+func newPrometheusGaugeFamily(name string, help string) *dto.MetricFamily {
+	return &dto.MetricFamily{
+		Name: proto.String(name),
+		Help: proto.String(help),
+		Type: dto.MetricType_GAUGE.Enum(),
+	}
+}
+
+func newPrometheusGauge(labels []*dto.LabelPair, value float64) *dto.Metric {
+	return &dto.Metric{
+		Label: labels,
+		Gauge: &dto.Gauge{Value: proto.Float64(value)},
+	}
+}
+
+// Returns the label pairs for the given alternating label names and values.
+func newPrometheusLabels(namesAndValues ...string) []*dto.LabelPair {
+	labels := []*dto.LabelPair{}
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		labels = append(labels, &dto.LabelPair{
+			Name:  proto.String(namesAndValues[i]),
+			Value: proto.String(namesAndValues[i+1]),
+		})
+	}
+
+	return labels
+}
+
+func getPrometheusCertificateLabels(info *CertificateExpiryInfo) []*dto.LabelPair {
+	return newPrometheusLabels(
+		"source_type", info.SourceType,
+		"source", info.Source,
+		"subject", info.Subject,
+		"issuer", info.Issuer,
+		"serial_number", info.SerialNumber,
+	)
+}
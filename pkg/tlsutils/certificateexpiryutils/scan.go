@@ -0,0 +1,345 @@
+package certificateexpiryutils
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils/httpoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/kubernetesutils/kubernetesinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Type of the kubernetes secrets scanned by ScanKubernetesTlsSecrets.
+const KUBERNETES_TLS_SECRET_TYPE = "kubernetes.io/tls"
+
+// Data key of the certificate in secrets of type KUBERNETES_TLS_SECRET_TYPE.
+const KUBERNETES_TLS_SECRET_CERTIFICATE_KEY = "tls.crt"
+
+func ScanCertificates(certs []*x509.Certificate, sourceType string, source string, options *ScanOptions) (*ExpiryReport, error) {
+	if certs == nil {
+		return nil, tracederrors.TracedErrorNil("certs")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	report := NewExpiryReport()
+	for _, cert := range certs {
+		info, err := GetCertificateExpiryInfo(cert, sourceType, source, options)
+		if err != nil {
+			return nil, err
+		}
+
+		err = report.Add(info)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// Scan all certificate files in the given directory and its subdirectories.
+// Files matching options.CertificateFilePatterns but not containing any certificate are skipped.
+// Files which can not be read or parsed are recorded as scan errors in the report.
+func ScanDirectory(ctx context.Context, directory filesinterfaces.Directory, options *ScanOptions) (*ExpiryReport, error) {
+	if directory == nil {
+		return nil, tracederrors.TracedErrorNil("directory")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	path, hostDescription, err := directory.GetPathAndHostDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Scan certificate expiry in directory '%s' on host '%s' started.", path, hostDescription)
+
+	files, err := directory.ListFiles(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.ListFileOptions{
+			MatchBasenamePattern:          options.GetCertificateFilePatterns(),
+			OnlyFiles:                     true,
+			AllowEmptyListIfNoFileIsFound: true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report := NewExpiryReport()
+	for _, file := range files {
+		filePath, err := file.GetPath()
+		if err != nil {
+			return nil, err
+		}
+
+		source := filePath
+		if hostDescription != "localhost" {
+			source = hostDescription + ":" + filePath
+		}
+
+		content, err := file.ReadAsBytes(contextutils.WithSilent(ctx))
+		if err != nil {
+			err = addScanErrorAndLogWarning(ctx, report, SOURCE_TYPE_FILE, source, err)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		certs, err := parseCertificates(content)
+		if err != nil {
+			err = addScanErrorAndLogWarning(ctx, report, SOURCE_TYPE_FILE, source, err)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if len(certs) == 0 {
+			logging.LogInfoByCtxf(ctx, "No certificate found in '%s' on host '%s'. Skip.", filePath, hostDescription)
+			continue
+		}
+
+		fileReport, err := ScanCertificates(certs, SOURCE_TYPE_FILE, source, options)
+		if err != nil {
+			return nil, err
+		}
+
+		err = report.AddReport(fileReport)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Scan certificate expiry in directory '%s' on host '%s' finished. Found %d certificates.", path, hostDescription, len(report.Certificates))
+
+	return report, nil
+}
+
+// Scan the certificates stored in secrets of type KUBERNETES_TLS_SECRET_TYPE.
+// If options.NamespaceNames is empty all namespaces are scanned.
+// Namespaces and secrets which can not be read, e.g. due to missing permissions, are recorded as scan errors in the report.
+func ScanKubernetesTlsSecrets(ctx context.Context, cluster kubernetesinterfaces.KubernetesCluster, options *ScanOptions) (*ExpiryReport, error) {
+	if cluster == nil {
+		return nil, tracederrors.TracedErrorNil("cluster")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	clusterName, err := cluster.GetName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Scan certificate expiry in TLS secrets of kubernetes cluster '%s' started.", clusterName)
+
+	namespaceNames := options.NamespaceNames
+	if len(namespaceNames) <= 0 {
+		namespaceNames, err = cluster.ListNamespaceNames(contextutils.WithSilent(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report := NewExpiryReport()
+	for _, namespaceName := range namespaceNames {
+		namespace, err := cluster.GetNamespaceByName(namespaceName)
+		if err != nil {
+			return nil, err
+		}
+
+		secretNames, err := namespace.ListSecretNamesByType(contextutils.WithSilent(ctx), KUBERNETES_TLS_SECRET_TYPE)
+		if err != nil {
+			err = addScanErrorAndLogWarning(ctx, report, SOURCE_TYPE_KUBERNETES_SECRET, namespaceName, err)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for _, secretName := range secretNames {
+			source := namespaceName + "/" + secretName
+
+			secretReport, err := scanKubernetesTlsSecret(ctx, cluster, namespaceName, secretName, options)
+			if err != nil {
+				err = addScanErrorAndLogWarning(ctx, report, SOURCE_TYPE_KUBERNETES_SECRET, source, err)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			err = report.AddReport(secretReport)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Scan certificate expiry in TLS secrets of kubernetes cluster '%s' finished. Found %d certificates.", clusterName, len(report.Certificates))
+
+	return report, nil
+}
+
+func scanKubernetesTlsSecret(ctx context.Context, cluster kubernetesinterfaces.KubernetesCluster, namespaceName string, secretName string, options *ScanOptions) (*ExpiryReport, error) {
+	data, err := cluster.ReadSecret(contextutils.WithSilent(ctx), namespaceName, secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	certData, ok := data[KUBERNETES_TLS_SECRET_CERTIFICATE_KEY]
+	if !ok {
+		return nil, tracederrors.TracedErrorf("Secret '%s' in namespace '%s' has no '%s' key.", secretName, namespaceName, KUBERNETES_TLS_SECRET_CERTIFICATE_KEY)
+	}
+
+	certs, err := parseCertificates(certData)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse certificates in secret '%s' in namespace '%s': %w", secretName, namespaceName, err)
+	}
+
+	return ScanCertificates(certs, SOURCE_TYPE_KUBERNETES_SECRET, namespaceName+"/"+secretName, options)
+}
+
+// Scan the certificate chains presented by the given TLS endpoints.
+// Endpoints are given as URL like 'https://example.com:8443' or as '<host>:<port>'.
+// TLS validation is skipped to also report expired and untrusted certificates.
+// Unreachable endpoints are recorded as scan errors in the report.
+func ScanTlsEndpoints(ctx context.Context, endpoints []string, options *ScanOptions) (*ExpiryReport, error) {
+	if endpoints == nil {
+		return nil, tracederrors.TracedErrorNil("endpoints")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	report := NewExpiryReport()
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			return nil, tracederrors.TracedErrorEmptyString("endpoint")
+		}
+
+		url := endpoint
+		if !strings.Contains(url, "://") {
+			url = "https://" + url
+		}
+
+		logging.LogInfoByCtxf(ctx, "Scan certificate expiry of TLS endpoint '%s' started.", url)
+
+		endpointReport, err := scanTlsEndpoint(ctx, url, options)
+		if err != nil {
+			err = addScanErrorAndLogWarning(ctx, report, SOURCE_TYPE_TLS_ENDPOINT, url, err)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err = report.AddReport(endpointReport)
+		if err != nil {
+			return nil, err
+		}
+
+		logging.LogInfoByCtxf(ctx, "Scan certificate expiry of TLS endpoint '%s' finished. Found %d certificates.", url, len(endpointReport.Certificates))
+	}
+
+	return report, nil
+}
+
+func scanTlsEndpoint(ctx context.Context, url string, options *ScanOptions) (*ExpiryReport, error) {
+	response, err := httputils.SendRequest(
+		contextutils.WithSilent(ctx),
+		&httpoptions.RequestOptions{
+			Url:                 url,
+			CollectCertificates: true,
+			SkipTLSvalidation:   true,
+		},
+	)
+	if response == nil {
+		if err == nil {
+			err = tracederrors.TracedErrorf("No response received from '%s'.", url)
+		}
+		return nil, err
+	}
+
+	// The HTTP status code does not matter as long as the TLS handshake was successful:
+	certs, chainErr := response.GetServerCertificateChain(ctx)
+	if chainErr != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, chainErr
+	}
+
+	return ScanCertificates(certs, SOURCE_TYPE_TLS_ENDPOINT, url, options)
+}
+
+func addScanErrorAndLogWarning(ctx context.Context, report *ExpiryReport, sourceType string, source string, scanErr error) error {
+	err := report.AddScanError(sourceType, source, scanErr)
+	if err != nil {
+		return err
+	}
+
+	logging.LogWarnByCtxf(ctx, "Scan certificate expiry of %s '%s' failed. Continue with the next source: %s", sourceType, source, report.Errors[len(report.Errors)-1].Error)
+
+	return nil
+}
+
+// Parses all PEM encoded certificates in content. Other PEM blocks like private keys are ignored.
+// If content contains no PEM block at all it is parsed as DER encoded certificates.
+func parseCertificates(content []byte) ([]*x509.Certificate, error) {
+	if content == nil {
+		return nil, tracederrors.TracedErrorNil("content")
+	}
+
+	certs := []*x509.Certificate{}
+	foundPemBlock := false
+
+	rest := content
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		foundPemBlock = true
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Unable to parse x509 certificate from PEM block: %w", err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if foundPemBlock || len(content) == 0 {
+		return certs, nil
+	}
+
+	derCerts, err := x509.ParseCertificates(content)
+	if err != nil {
+		// Not a certificate at all.
+		return []*x509.Certificate{}, nil
+	}
+
+	return derCerts, nil
+}
@@ -0,0 +1,65 @@
+package x509utils_test
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_CreateAndSignCertificateSigningRequest(t *testing.T) {
+	// Get the default context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Path where the certificate signing request is stored:
+	csrPath := filepath.Join(t.TempDir(), "server.csr")
+
+	// Create a certificate signing request and the matching private key:
+	csr, privateKey, err := x509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, &x509options.X509CreateCertificateSigningRequestOptions{
+		CommonName:        "server.example.net",
+		Organization:      "Example org",
+		CountryName:       "CH",
+		AdditionalSans:    []string{"www.example.net", "192.168.1.10"},
+		KeyUsages:         []string{"digitalSignature", "keyEncipherment"},
+		ExtendedKeyUsages: []string{"serverAuth"},
+		PrivateKeySize:    2048,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, privateKey)
+
+	// Write the certificate signing request to a file and read it back:
+	err = x509utils.WriteCertificateSigningRequestToFile(ctx, csr, csrPath)
+	require.NoError(t, err)
+
+	csr, err = x509utils.ReadCertificateSigningRequestFromFile(ctx, csrPath)
+	require.NoError(t, err)
+
+	// Print the info about the certificate signing request:
+	infoString, err := x509utils.GetCertificateSigningRequestInfoString(csr)
+	require.NoError(t, err)
+	require.Contains(t, infoString, "CN: server.example.net")
+
+	// Create a root CA used to sign the certificate signing request:
+	rootCa, err := x509utils.CreateRootCaCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:     "Example Root CA",
+		Organization:   "Example org",
+		CountryName:    "CH",
+		PrivateKeySize: 2048,
+	})
+	require.NoError(t, err)
+
+	// Sign the certificate signing request:
+	cert, err := x509utils.SignCertificateSigningRequest(ctx, csr, rootCa, &x509options.X509SignCertificateSigningRequestOptions{
+		ValidityDuration: 90 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	// The signed certificate contains the requested SANs and extended key usages:
+	require.EqualValues(t, []string{"server.example.net", "www.example.net"}, cert.DNSNames)
+	require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+}
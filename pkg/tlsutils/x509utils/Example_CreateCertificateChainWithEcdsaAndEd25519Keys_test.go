@@ -0,0 +1,45 @@
+package x509utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_CreateCertificateChainWithEcdsaAndEd25519Keys(t *testing.T) {
+	// Get the default context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Create a root CA using an Ed25519 key:
+	rootCa, err := x509utils.CreateRootCaCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "Example Root CA",
+		Organization: "Example org",
+		CountryName:  "CH",
+		KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ED25519,
+	})
+	require.NoError(t, err)
+
+	// Create an end entity certificate using an ECDSA P-256 key signed by the root CA:
+	endEntity, err := x509utils.CreateSignedEndEntityCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "server.example.net",
+		Organization: "Example org",
+		CountryName:  "CH",
+		KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ECDSA_P256,
+	}, rootCa)
+	require.NoError(t, err)
+
+	// The private key matches the end entity certificate:
+	isMatching, err := genericx509utils.IsCertificateMatchingPrivateKey(endEntity.Cert, endEntity.Key)
+	require.NoError(t, err)
+	require.True(t, isMatching)
+
+	// The key algorithm can be detected from the private key:
+	keyAlgorithm, err := cryptoutils.GetKeyAlgorithm(endEntity.Key)
+	require.NoError(t, err)
+	require.EqualValues(t, "ecdsa-p256", keyAlgorithm)
+}
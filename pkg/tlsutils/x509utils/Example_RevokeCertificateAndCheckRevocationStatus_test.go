@@ -0,0 +1,67 @@
+package x509utils_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/testocspresponder"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_RevokeCertificateAndCheckRevocationStatus(t *testing.T) {
+	// Get the default context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Path where the certificate revocation list (CRL) is stored:
+	crlPath := filepath.Join(t.TempDir(), "ca.crl")
+
+	// Create a root CA and an end entity certificate signed by the root CA:
+	rootCa, err := x509utils.CreateRootCaCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "Example Root CA",
+		Organization: "Example org",
+		CountryName:  "CH",
+	})
+	require.NoError(t, err)
+
+	endEntity, err := x509utils.CreateSignedEndEntityCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "server.example.net",
+		Organization: "Example org",
+		CountryName:  "CH",
+	}, rootCa)
+	require.NoError(t, err)
+
+	// Revoke the end entity certificate by its serial number. Passing nil as CRL creates a new CRL signed by the root CA:
+	crl, err := x509utils.RevokeCertificate(ctx, nil, rootCa, endEntity.Cert.SerialNumber.String(), "keyCompromise", nil)
+	require.NoError(t, err)
+
+	// Write the CRL as PEM file:
+	err = x509utils.WriteRevocationListToFile(ctx, crl, crlPath)
+	require.NoError(t, err)
+
+	// Check the revocation status against the CRL file:
+	status, err := x509utils.GetRevocationStatusUsingRevocationListFile(ctx, endEntity.Cert, rootCa.Cert, crlPath)
+	require.NoError(t, err)
+	require.True(t, status.IsRevoked())
+	require.EqualValues(t, "keyCompromise", status.Reason)
+
+	// Start a local OCSP responder answering for the root CA using the same CRL:
+	const port int = 9127
+	responder, err := testocspresponder.GetRunningTestOcspResponder(ctx, port, rootCa)
+	require.NoError(t, err)
+	defer responder.Stop(ctx)
+
+	err = responder.SetRevocationList(crl)
+	require.NoError(t, err)
+
+	responderUrl, err := responder.GetUrl()
+	require.NoError(t, err)
+
+	// Check the revocation status using the OCSP responder:
+	status, err = x509utils.GetRevocationStatusUsingOcsp(ctx, endEntity.Cert, rootCa.Cert, responderUrl)
+	require.NoError(t, err)
+	require.True(t, status.IsRevoked())
+	require.EqualValues(t, "keyCompromise", status.Reason)
+}
@@ -0,0 +1,61 @@
+package x509utils_test
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_WriteAndReadPkcs12AndJksTrustStore(t *testing.T) {
+	// Get the default context with verbose output enabled:
+	ctx := contextutils.ContextVerbose()
+
+	// Paths where the PKCS#12 bundle and the JKS truststore are stored:
+	tempDir := t.TempDir()
+	p12Path := filepath.Join(tempDir, "server.p12")
+	jksPath := filepath.Join(tempDir, "truststore.jks")
+
+	// Create a root CA and an end entity certificate signed by the root CA:
+	rootCa, err := x509utils.CreateRootCaCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "Example Root CA",
+		Organization: "Example org",
+		CountryName:  "CH",
+		KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ECDSA_P256,
+	})
+	require.NoError(t, err)
+
+	endEntity, err := x509utils.CreateSignedEndEntityCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "server.example.net",
+		Organization: "Example org",
+		CountryName:  "CH",
+		KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ECDSA_P256,
+	}, rootCa)
+	require.NoError(t, err)
+
+	// Write the end entity certificate, its private key and the chain into a password protected PKCS#12 file:
+	err = x509utils.WritePkcs12ToFile(ctx, endEntity, []*x509.Certificate{rootCa.Cert}, "changeit", p12Path)
+	require.NoError(t, err)
+
+	// Read the PKCS#12 file back:
+	readEndEntity, chain, err := x509utils.ReadPkcs12FromFile(ctx, p12Path, "changeit")
+	require.NoError(t, err)
+	require.True(t, readEndEntity.Cert.Equal(endEntity.Cert))
+	require.Len(t, chain, 1)
+	require.True(t, chain[0].Equal(rootCa.Cert))
+
+	// Write the root CA into a JKS truststore usable by Java applications:
+	err = x509utils.WriteJksTrustStoreToFile(ctx, []*x509.Certificate{rootCa.Cert}, "changeit", jksPath)
+	require.NoError(t, err)
+
+	// Read the JKS truststore back:
+	trustedCerts, err := x509utils.ReadJksTrustStoreFromFile(ctx, jksPath, "changeit")
+	require.NoError(t, err)
+	require.Len(t, trustedCerts, 1)
+	require.True(t, trustedCerts[0].Equal(rootCa.Cert))
+}
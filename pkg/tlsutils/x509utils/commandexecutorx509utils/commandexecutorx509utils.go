@@ -1,6 +1,7 @@
 // Package commandexecutorx509utils provides command-based implementation for X509 certificate operations.
 // This implementation uses the command executor for file I/O (read/write) on remote machines,
 // while certificate generation and signing is handled by genericx509utils using native Go crypto.
+// Certificate signing requests (CSR) are created and signed using openssl on the host of the command executor.
 package commandexecutorx509utils
 
 import (
@@ -80,6 +81,14 @@ func GeneratePrivateKey(ctx context.Context, commandExecutor commandexecutorinte
 	return genericx509utils.GeneratePrivateKey(ctx)
 }
 
+func GeneratePrivateKeyWithAlgorithm(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, keyAlgorithm string) (privateKey crypto.PrivateKey, err error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	return genericx509utils.GeneratePrivateKeyWithAlgorithm(ctx, keyAlgorithm)
+}
+
 func CreateRootCaCertificate(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, options *x509options.X509CreateCertificateOptions) (caCertAndKey *genericx509utils.X509CertKeyPair, err error) {
 	if commandExecutor == nil {
 		return nil, tracederrors.TracedErrorNil("commandExecutor")
@@ -0,0 +1,551 @@
+package commandexecutorx509utils
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutorfile"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutortempfile"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Names of the key usages as used by genericx509utils mapped to the names used in openssl extension configurations:
+var opensslKeyUsageNames = map[string]string{
+	"digitalSignature":  "digitalSignature",
+	"contentCommitment": "nonRepudiation",
+	"keyEncipherment":   "keyEncipherment",
+	"dataEncipherment":  "dataEncipherment",
+	"keyAgreement":      "keyAgreement",
+	"certSign":          "keyCertSign",
+	"crlSign":           "cRLSign",
+	"encipherOnly":      "encipherOnly",
+	"decipherOnly":      "decipherOnly",
+}
+
+// Backslash escapes characters with a special meaning in openssl configuration files:
+var opensslConfigEscaper = strings.NewReplacer(`\`, `\\`, "$", `\$`, "#", `\#`, `"`, `\"`, "'", `\'`)
+
+// CreateCertificateSigningRequest creates a certificate signing request (CSR) signed by the given privateKey using 'openssl req -new' on the host of the command executor.
+func CreateCertificateSigningRequest(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, options *x509options.X509CreateCertificateSigningRequestOptions, privateKey crypto.PrivateKey) (*x509.CertificateRequest, error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	if privateKey == nil {
+		return nil, tracederrors.TracedErrorNil("privateKey")
+	}
+
+	privateKeyPem, err := cryptoutils.EncodePrivateKeyAsPEMString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPath, err := writeTemporaryFile(ctx, commandExecutor, []byte(privateKeyPem))
+	if err != nil {
+		return nil, err
+	}
+	defer deleteTemporaryFile(ctx, commandExecutor, keyPath)
+
+	return createCertificateSigningRequestUsingKeyFile(ctx, commandExecutor, options, keyPath)
+}
+
+// CreateCertificateSigningRequestAndPrivateKey generates a new private key using 'openssl genpkey' and returns it together with the certificate signing request (CSR) created by 'openssl req -new'.
+func CreateCertificateSigningRequestAndPrivateKey(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error) {
+	if commandExecutor == nil {
+		return nil, nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if options == nil {
+		return nil, nil, tracederrors.TracedErrorNil("options")
+	}
+
+	genpkeyArgs, err := getOpensslGenpkeyArgs(options.KeyAlgorithm, options.PrivateKeySize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPath, err := writeTemporaryFile(ctx, commandExecutor, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer deleteTemporaryFile(ctx, commandExecutor, keyPath)
+
+	_, err = runOpenssl(ctx, commandExecutor, append([]string{"genpkey"}, append(genpkeyArgs, "-out", keyPath)...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKeyPem, err := commandexecutorfile.ReadAsString(commandExecutor, keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKey, err := cryptoutils.LoadPrivateKeyFromPEMString(privateKeyPem)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, err := createCertificateSigningRequestUsingKeyFile(ctx, commandExecutor, options, keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return csr, privateKey, nil
+}
+
+// SignCertificateSigningRequest signs the csr using the CA certificate and key in caCertAndKey by running 'openssl x509 -req' on the host of the command executor.
+// The signature of the csr is validated by openssl before it is signed.
+//
+// openssl only supports a validity in full days. The ValidityDuration of the options is rounded up to full days.
+func SignCertificateSigningRequest(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, csr *x509.CertificateRequest, caCertAndKey *genericx509utils.X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if csr == nil {
+		return nil, tracederrors.TracedErrorNil("csr")
+	}
+
+	if caCertAndKey == nil {
+		return nil, tracederrors.TracedErrorNil("caCertAndKey")
+	}
+
+	if options == nil {
+		options = new(x509options.X509SignCertificateSigningRequestOptions)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Sign certificate signing request for '%s' using openssl and command executor started.", csr.Subject.String())
+
+	caCert, err := caCertAndKey.GetX509Certificate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !caCert.IsCA {
+		return nil, tracederrors.TracedErrorf("Certificate '%s' used for signing is not a CA certificate.", caCert.Subject.String())
+	}
+
+	caKeyPem, err := caCertAndKey.GetPrivateKeyAsPEMString()
+	if err != nil {
+		return nil, err
+	}
+
+	caCertPem, err := caCertAndKey.GetCertificateAsPEMBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	csrPem, err := genericx509utils.WriteCertificateSigningRequestAsPEMBytes(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber := options.SerialNumber
+	if serialNumber == "" {
+		serialNumber, err = genericx509utils.GenerateCertificateSerialNumberAsString(contextutils.WithSilent(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	extensions, err := getSignedCertificateExtensions(csr, options)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	defer func() {
+		for _, path := range paths {
+			deleteTemporaryFile(ctx, commandExecutor, path)
+		}
+	}()
+
+	for _, content := range [][]byte{csrPem, caCertPem, []byte(caKeyPem), []byte(extensions), nil} {
+		path, err := writeTemporaryFile(ctx, commandExecutor, content)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+	csrPath, caCertPath, caKeyPath, extensionsPath, certPath := paths[0], paths[1], paths[2], paths[3], paths[4]
+
+	validityDays := int(math.Ceil(options.GetValidityDurationOrDefault().Hours() / 24))
+
+	_, err = runOpenssl(
+		ctx,
+		commandExecutor,
+		[]string{
+			"x509", "-req",
+			"-in", csrPath,
+			"-CA", caCertPath,
+			"-CAkey", caKeyPath,
+			"-set_serial", serialNumber,
+			"-days", strconv.Itoa(validityDays),
+			"-extfile", extensionsPath,
+			"-out", certPath,
+		},
+	)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to sign certificate signing request for '%s': %w", csr.Subject.String(), err)
+	}
+
+	certPem, err := commandexecutorfile.ReadAsBytes(commandExecutor, certPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := genericx509utils.ReadCertFromBytes(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Sign certificate signing request using openssl and command executor finished. Subject: '%s', Issuer: '%s'.", cert.Subject.String(), cert.Issuer.String())
+
+	return cert, nil
+}
+
+func ReadCertificateSigningRequestFromFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, pathToRead string) (*x509.CertificateRequest, error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if pathToRead == "" {
+		return nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Read certificate signing request from file '%s' using command executor started.", pathToRead)
+
+	content, err := commandexecutorfile.ReadAsBytes(commandExecutor, pathToRead)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := genericx509utils.ReadCertificateSigningRequestFromBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Read certificate signing request from file '%s' using command executor finished.", pathToRead)
+
+	return csr, nil
+}
+
+func WriteCertificateSigningRequestToFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, csr *x509.CertificateRequest, pathToWrite string) error {
+	if commandExecutor == nil {
+		return tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if csr == nil {
+		return tracederrors.TracedErrorNil("csr")
+	}
+
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write certificate signing request to file '%s' using command executor started.", pathToWrite)
+
+	csrBytes, err := genericx509utils.WriteCertificateSigningRequestAsPEMBytes(csr)
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.WriteBytes(ctx, commandExecutor, pathToWrite, csrBytes)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write certificate signing request to file '%s' using command executor finished.", pathToWrite)
+
+	return nil
+}
+
+// createCertificateSigningRequestUsingKeyFile runs 'openssl req -new' to create a CSR signed by the private key stored in keyPath on the host of the command executor.
+func createCertificateSigningRequestUsingKeyFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, options *x509options.X509CreateCertificateSigningRequestOptions, keyPath string) (*x509.CertificateRequest, error) {
+	commonName, err := options.GetCommonName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create certificate signing request for '%s' using openssl and command executor started.", commonName)
+
+	config, err := getOpensslRequestConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	configPath, err := writeTemporaryFile(ctx, commandExecutor, []byte(config))
+	if err != nil {
+		return nil, err
+	}
+	defer deleteTemporaryFile(ctx, commandExecutor, configPath)
+
+	csrPem, err := runOpenssl(ctx, commandExecutor, []string{"req", "-new", "-key", keyPath, "-config", configPath})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create certificate signing request for '%s': %w", commonName, err)
+	}
+
+	csr, err := genericx509utils.ReadCertificateSigningRequestFromBytes(csrPem)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create certificate signing request for '%s' using openssl and command executor finished. Subject: '%s'.", commonName, csr.Subject.String())
+
+	return csr, nil
+}
+
+// getSignedCertificateExtensions returns the openssl extension configuration used to sign the csr.
+func getSignedCertificateExtensions(csr *x509.CertificateRequest, options *x509options.X509SignCertificateSigningRequestOptions) (string, error) {
+	requestedKeyUsage, requestedExtKeyUsages, err := genericx509utils.GetCertificateSigningRequestKeyUsages(csr)
+	if err != nil {
+		return "", err
+	}
+
+	keyUsageNames := genericx509utils.GetKeyUsageNames(requestedKeyUsage)
+	if len(options.KeyUsages) > 0 {
+		keyUsageNames = options.KeyUsages
+	}
+
+	if len(keyUsageNames) == 0 {
+		if options.IsCA {
+			keyUsageNames = []string{"certSign", "crlSign"}
+		} else if _, ok := csr.PublicKey.(*rsa.PublicKey); ok {
+			keyUsageNames = []string{"digitalSignature", "keyEncipherment"}
+		} else {
+			// Key encipherment is only possible with RSA keys:
+			keyUsageNames = []string{"digitalSignature"}
+		}
+	}
+
+	extKeyUsageNames := genericx509utils.GetExtendedKeyUsageNames(requestedExtKeyUsages)
+	if len(options.ExtendedKeyUsages) > 0 {
+		extKeyUsageNames = options.ExtendedKeyUsages
+	}
+
+	if len(extKeyUsageNames) == 0 && !options.IsCA {
+		extKeyUsageNames = []string{"serverAuth", "clientAuth"}
+	}
+
+	opensslKeyUsageNames, err := getOpensslKeyUsageNames(keyUsageNames)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = genericx509utils.ParseExtendedKeyUsages(extKeyUsageNames)
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{}
+	if options.IsCA {
+		lines = append(lines, "basicConstraints=critical,CA:TRUE,pathlen:0")
+	} else {
+		lines = append(lines, "basicConstraints=critical,CA:FALSE")
+	}
+
+	lines = append(lines, "keyUsage=critical,"+strings.Join(opensslKeyUsageNames, ","))
+
+	if len(extKeyUsageNames) > 0 {
+		lines = append(lines, "extendedKeyUsage="+strings.Join(extKeyUsageNames, ","))
+	}
+
+	if !options.IgnoreRequestedSans {
+		sans := []string{}
+		for _, dnsName := range csr.DNSNames {
+			sans = append(sans, "DNS:"+dnsName)
+		}
+
+		for _, ip := range csr.IPAddresses {
+			sans = append(sans, "IP:"+ip.String())
+		}
+
+		for _, emailAddress := range csr.EmailAddresses {
+			sans = append(sans, "email:"+emailAddress)
+		}
+
+		for _, uri := range csr.URIs {
+			sans = append(sans, "URI:"+uri.String())
+		}
+
+		if len(sans) > 0 {
+			lines = append(lines, "subjectAltName="+opensslConfigEscaper.Replace(strings.Join(sans, ",")))
+		}
+	}
+
+	lines = append(lines, "subjectKeyIdentifier=hash", "authorityKeyIdentifier=keyid")
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// getOpensslKeyUsageNames converts key usage names like "certSign" into the names used by openssl like "keyCertSign".
+func getOpensslKeyUsageNames(keyUsages []string) ([]string, error) {
+	names := []string{}
+	for _, keyUsage := range keyUsages {
+		name, ok := opensslKeyUsageNames[keyUsage]
+		if !ok {
+			return nil, tracederrors.TracedErrorf("Unknown key usage '%s'.", keyUsage)
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// getOpensslRequestConfig returns the configuration used by 'openssl req -new' containing the subject and the requested extensions.
+// Using a configuration file avoids escaping the subject for 'openssl req -subj' and the shell.
+func getOpensslRequestConfig(options *x509options.X509CreateCertificateSigningRequestOptions) (string, error) {
+	commonName, err := options.GetCommonName()
+	if err != nil {
+		return "", err
+	}
+
+	lines := []string{
+		"[req]",
+		"prompt = no",
+		"distinguished_name = dn",
+		"req_extensions = ext",
+		"",
+		"[dn]",
+	}
+
+	for _, attribute := range []struct {
+		name  string
+		value string
+	}{
+		{"C", options.CountryName},
+		{"L", options.Locality},
+		{"O", options.Organization},
+		{"CN", commonName},
+	} {
+		if attribute.value != "" {
+			lines = append(lines, attribute.name+" = "+opensslConfigEscaper.Replace(attribute.value))
+		}
+	}
+
+	lines = append(lines, "", "[ext]")
+
+	sans := []string{"DNS:" + commonName}
+	for _, san := range options.AdditionalSans {
+		if net.ParseIP(san) != nil {
+			sans = append(sans, "IP:"+san)
+		} else {
+			sans = append(sans, "DNS:"+san)
+		}
+	}
+	lines = append(lines, "subjectAltName = "+opensslConfigEscaper.Replace(strings.Join(sans, ",")))
+
+	if len(options.KeyUsages) > 0 {
+		keyUsages, err := getOpensslKeyUsageNames(options.KeyUsages)
+		if err != nil {
+			return "", err
+		}
+
+		lines = append(lines, "keyUsage = critical,"+strings.Join(keyUsages, ","))
+	}
+
+	if len(options.ExtendedKeyUsages) > 0 {
+		// Validate the names since openssl also accepts names not supported by genericx509utils:
+		_, err := genericx509utils.ParseExtendedKeyUsages(options.ExtendedKeyUsages)
+		if err != nil {
+			return "", err
+		}
+
+		lines = append(lines, "extendedKeyUsage = "+strings.Join(options.ExtendedKeyUsages, ","))
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// getOpensslGenpkeyArgs returns the 'openssl genpkey' arguments to generate a private key using keyAlgorithm.
+// If no keyAlgorithm is set a RSA key of privateKeySize (or 4096 bits if unset) is generated like genericx509utils does.
+func getOpensslGenpkeyArgs(keyAlgorithm string, privateKeySize int) ([]string, error) {
+	switch keyAlgorithm {
+	case "":
+		if privateKeySize <= 0 {
+			privateKeySize = 4096
+		}
+
+		return []string{"-algorithm", "RSA", "-pkeyopt", "rsa_keygen_bits:" + strconv.Itoa(privateKeySize)}, nil
+	case cryptoutils.KEY_ALGORITHM_RSA_2048:
+		return []string{"-algorithm", "RSA", "-pkeyopt", "rsa_keygen_bits:2048"}, nil
+	case cryptoutils.KEY_ALGORITHM_RSA_3072:
+		return []string{"-algorithm", "RSA", "-pkeyopt", "rsa_keygen_bits:3072"}, nil
+	case cryptoutils.KEY_ALGORITHM_RSA_4096:
+		return []string{"-algorithm", "RSA", "-pkeyopt", "rsa_keygen_bits:4096"}, nil
+	case cryptoutils.KEY_ALGORITHM_ECDSA_P256:
+		return []string{"-algorithm", "EC", "-pkeyopt", "ec_paramgen_curve:P-256"}, nil
+	case cryptoutils.KEY_ALGORITHM_ECDSA_P384:
+		return []string{"-algorithm", "EC", "-pkeyopt", "ec_paramgen_curve:P-384"}, nil
+	case cryptoutils.KEY_ALGORITHM_ED25519:
+		return []string{"-algorithm", "ED25519"}, nil
+	default:
+		return nil, tracederrors.TracedErrorf("Unsupported key algorithm '%s'. Supported are: %v", keyAlgorithm, cryptoutils.GetSupportedKeyAlgorithms())
+	}
+}
+
+// runOpenssl runs openssl with the given args on the host of the command executor and returns stdout.
+func runOpenssl(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, args []string) ([]byte, error) {
+	output, err := commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: append([]string{"openssl"}, args...),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.GetStdoutAsBytes()
+}
+
+// writeTemporaryFile writes content into a new temporary file only readable by the owner on the host of the command executor.
+// If content is nil an empty temporary file is created.
+func writeTemporaryFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, content []byte) (string, error) {
+	path, err := commandexecutortempfile.CreateEmptyTemporaryFile(contextutils.WithSilent(ctx), commandExecutor)
+	if err != nil {
+		return "", err
+	}
+
+	// Restrict the permissions before the content is written since private keys are stored in temporary files:
+	err = commandexecutorfile.Chmod(contextutils.WithSilent(ctx), commandExecutor, path, &filesoptions.ChmodOptions{PermissionsString: "u=rw,g=,o="})
+	if err != nil {
+		return "", err
+	}
+
+	if content != nil {
+		err = commandexecutorfile.WriteBytes(contextutils.WithSilent(ctx), commandExecutor, path, content)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return path, nil
+}
+
+func deleteTemporaryFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, path string) {
+	err := commandexecutorfile.Delete(contextutils.WithSilent(ctx), commandExecutor, path, &filesoptions.DeleteOptions{})
+	if err != nil {
+		logging.LogWarnByCtxf(ctx, "Failed to delete temporary file '%s': %v", path, err)
+	}
+}
@@ -0,0 +1,352 @@
+package commandexecutorx509utils_test
+
+import (
+	"crypto"
+	"crypto/x509"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/commandexecutorx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func runOpenssl(t *testing.T, args ...string) string {
+	t.Helper()
+
+	output, err := exec.Command("openssl", args...).CombinedOutput()
+	require.NoError(t, err, "openssl command failed: %s", string(output))
+
+	return string(output)
+}
+
+// generateCaCertAndKey uses openssl to generate a CA certificate and returns it together with its private key.
+func generateCaCertAndKey(t *testing.T, dir string) *genericx509utils.X509CertKeyPair {
+	t.Helper()
+
+	keyPath := filepath.Join(dir, "ca-key.pem")
+	certPath := filepath.Join(dir, "ca-cert.pem")
+
+	runOpenssl(
+		t,
+		"req", "-x509",
+		"-newkey", "rsa:2048",
+		"-keyout", keyPath,
+		"-out", certPath,
+		"-days", "1",
+		"-nodes",
+		"-subj", "/C=CH/O=TestOrg/CN=TestCa",
+		"-addext", "basicConstraints=critical,CA:TRUE",
+		"-addext", "keyUsage=critical,keyCertSign,cRLSign",
+	)
+
+	return readCertKeyPair(t, certPath, keyPath)
+}
+
+func readCertKeyPair(t *testing.T, certPath string, keyPath string) *genericx509utils.X509CertKeyPair {
+	t.Helper()
+
+	certPem, err := os.ReadFile(certPath)
+	require.NoError(t, err)
+	cert, err := genericx509utils.ReadCertFromBytes(certPem)
+	require.NoError(t, err)
+
+	keyPem, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	key, err := cryptoutils.LoadPrivateKeyFromPEMString(string(keyPem))
+	require.NoError(t, err)
+
+	return &genericx509utils.X509CertKeyPair{Cert: cert, Key: key}
+}
+
+// writeAndVerifyCsr writes the csr to a file in dir and verifies its signature using 'openssl req -verify'.
+func writeAndVerifyCsr(t *testing.T, dir string, csr *x509.CertificateRequest) string {
+	t.Helper()
+
+	csrPem, err := genericx509utils.WriteCertificateSigningRequestAsPEMBytes(csr)
+	require.NoError(t, err)
+
+	csrPath := filepath.Join(dir, "request.csr")
+	require.NoError(t, os.WriteFile(csrPath, csrPem, 0600))
+
+	runOpenssl(t, "req", "-verify", "-noout", "-in", csrPath)
+
+	return csrPath
+}
+
+func requireCsrMatchesPrivateKey(t *testing.T, csr *x509.CertificateRequest, privateKey crypto.PrivateKey) {
+	t.Helper()
+
+	publicKey, err := cryptoutils.GetPublicKeyFromPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	expected, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	actual, err := x509.MarshalPKIXPublicKey(csr.PublicKey)
+	require.NoError(t, err)
+
+	require.EqualValues(t, expected, actual)
+}
+
+func Test_CreateCertificateSigningRequest(t *testing.T) {
+	implementations := []string{"exec", "bash"}
+
+	for _, implName := range implementations {
+		t.Run(implName+"_nil private key", func(t *testing.T) {
+			executor := getCommandExecutorImplementationByName(t, implName)
+
+			csr, err := commandexecutorx509utils.CreateCertificateSigningRequest(contextutils.ContextVerbose(), executor, &x509options.X509CreateCertificateSigningRequestOptions{CommonName: "example.com"}, nil)
+			require.Error(t, err)
+			require.Nil(t, csr)
+		})
+
+		t.Run(implName+"_existing openssl key", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			executor := getCommandExecutorImplementationByName(t, implName)
+			tmpDir := t.TempDir()
+
+			keyPath := filepath.Join(tmpDir, "key.pem")
+			runOpenssl(t, "genpkey", "-algorithm", "EC", "-pkeyopt", "ec_paramgen_curve:P-256", "-out", keyPath)
+			keyPem, err := os.ReadFile(keyPath)
+			require.NoError(t, err)
+			key, err := cryptoutils.LoadPrivateKeyFromPEMString(string(keyPem))
+			require.NoError(t, err)
+
+			csr, err := commandexecutorx509utils.CreateCertificateSigningRequest(
+				ctx,
+				executor,
+				&x509options.X509CreateCertificateSigningRequestOptions{
+					CountryName:       "CH",
+					Locality:          "Zurich",
+					Organization:      "Test/Org",
+					CommonName:        "csr.example.com",
+					AdditionalSans:    []string{"www.csr.example.com", "10.0.0.1"},
+					KeyUsages:         []string{"digitalSignature", "certSign"},
+					ExtendedKeyUsages: []string{"clientAuth"},
+				},
+				key,
+			)
+			require.NoError(t, err)
+			writeAndVerifyCsr(t, tmpDir, csr)
+
+			require.EqualValues(t, "csr.example.com", csr.Subject.CommonName)
+			require.EqualValues(t, []string{"Test/Org"}, csr.Subject.Organization)
+			require.EqualValues(t, []string{"Zurich"}, csr.Subject.Locality)
+			require.EqualValues(t, []string{"CH"}, csr.Subject.Country)
+			require.EqualValues(t, []string{"csr.example.com", "www.csr.example.com"}, csr.DNSNames)
+			require.Len(t, csr.IPAddresses, 1)
+			require.EqualValues(t, "10.0.0.1", csr.IPAddresses[0].String())
+
+			keyUsage, extKeyUsages, err := genericx509utils.GetCertificateSigningRequestKeyUsages(csr)
+			require.NoError(t, err)
+			require.EqualValues(t, x509.KeyUsageDigitalSignature|x509.KeyUsageCertSign, keyUsage)
+			require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, extKeyUsages)
+
+			requireCsrMatchesPrivateKey(t, csr, key)
+		})
+
+		t.Run(implName+"_unknown key usage", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			executor := getCommandExecutorImplementationByName(t, implName)
+
+			csr, _, err := commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(
+				ctx,
+				executor,
+				&x509options.X509CreateCertificateSigningRequestOptions{
+					CommonName:   "csr.example.com",
+					KeyUsages:    []string{"keyCertSign"},
+					KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ECDSA_P256,
+				},
+			)
+			require.Error(t, err)
+			require.Nil(t, csr)
+		})
+	}
+}
+
+func Test_CreateCertificateSigningRequestAndPrivateKey(t *testing.T) {
+	implementations := []string{"exec", "bash"}
+
+	for _, implName := range implementations {
+		for _, keyAlgorithm := range []string{cryptoutils.KEY_ALGORITHM_RSA_2048, cryptoutils.KEY_ALGORITHM_ECDSA_P384, cryptoutils.KEY_ALGORITHM_ED25519} {
+			t.Run(implName+"_"+keyAlgorithm, func(t *testing.T) {
+				ctx := contextutils.ContextVerbose()
+				executor := getCommandExecutorImplementationByName(t, implName)
+
+				csr, key, err := commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(
+					ctx,
+					executor,
+					&x509options.X509CreateCertificateSigningRequestOptions{
+						CommonName:   "csr.example.com",
+						KeyAlgorithm: keyAlgorithm,
+					},
+				)
+				require.NoError(t, err)
+				writeAndVerifyCsr(t, t.TempDir(), csr)
+
+				detectedAlgorithm, err := cryptoutils.GetKeyAlgorithm(key)
+				require.NoError(t, err)
+				require.EqualValues(t, keyAlgorithm, detectedAlgorithm)
+
+				requireCsrMatchesPrivateKey(t, csr, key)
+			})
+		}
+
+		t.Run(implName+"_unsupported key algorithm", func(t *testing.T) {
+			executor := getCommandExecutorImplementationByName(t, implName)
+
+			csr, key, err := commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(
+				contextutils.ContextVerbose(),
+				executor,
+				&x509options.X509CreateCertificateSigningRequestOptions{
+					CommonName:   "csr.example.com",
+					KeyAlgorithm: "dsa-1024",
+				},
+			)
+			require.Error(t, err)
+			require.Nil(t, csr)
+			require.Nil(t, key)
+		})
+	}
+}
+
+func Test_SignCertificateSigningRequest(t *testing.T) {
+	implementations := []string{"exec", "bash"}
+
+	for _, implName := range implementations {
+		t.Run(implName+"_end entity certificate from openssl csr", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			executor := getCommandExecutorImplementationByName(t, implName)
+			tmpDir := t.TempDir()
+
+			caPair := generateCaCertAndKey(t, tmpDir)
+
+			csrPath := filepath.Join(tmpDir, "request.csr")
+			runOpenssl(
+				t,
+				"req", "-new",
+				"-newkey", "rsa:2048",
+				"-nodes",
+				"-keyout", filepath.Join(tmpDir, "key.pem"),
+				"-out", csrPath,
+				"-subj", "/O=TestOrg/CN=server.example.com",
+				"-addext", "subjectAltName=DNS:server.example.com,IP:192.168.1.1",
+			)
+
+			csr, err := commandexecutorx509utils.ReadCertificateSigningRequestFromFile(ctx, executor, csrPath)
+			require.NoError(t, err)
+
+			cert, err := commandexecutorx509utils.SignCertificateSigningRequest(
+				ctx,
+				executor,
+				csr,
+				caPair,
+				&x509options.X509SignCertificateSigningRequestOptions{
+					SerialNumber:     "123456789",
+					ValidityDuration: 48 * time.Hour,
+				},
+			)
+			require.NoError(t, err)
+
+			require.EqualValues(t, "server.example.com", cert.Subject.CommonName)
+			require.EqualValues(t, "TestCa", cert.Issuer.CommonName)
+			require.EqualValues(t, big.NewInt(123456789), cert.SerialNumber)
+			require.False(t, cert.IsCA)
+			require.EqualValues(t, []string{"server.example.com"}, cert.DNSNames)
+			require.Len(t, cert.IPAddresses, 1)
+			require.EqualValues(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, cert.KeyUsage)
+			require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+			require.WithinDuration(t, time.Now().Add(48*time.Hour), cert.NotAfter, time.Minute)
+
+			// Verify the signed certificate using openssl:
+			certPath := filepath.Join(tmpDir, "cert.pem")
+			require.NoError(t, commandexecutorx509utils.WriteCertificateToFile(ctx, executor, cert, certPath))
+			caCertPath := filepath.Join(tmpDir, "ca-cert.pem")
+			require.Contains(t, runOpenssl(t, "verify", "-CAfile", caCertPath, certPath), "OK")
+		})
+
+		t.Run(implName+"_intermediate ca certificate without sans", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			executor := getCommandExecutorImplementationByName(t, implName)
+			tmpDir := t.TempDir()
+
+			caPair := generateCaCertAndKey(t, tmpDir)
+
+			csr, _, err := commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(
+				ctx,
+				executor,
+				&x509options.X509CreateCertificateSigningRequestOptions{
+					CommonName:   "intermediate.example.com",
+					KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ECDSA_P256,
+				},
+			)
+			require.NoError(t, err)
+
+			cert, err := commandexecutorx509utils.SignCertificateSigningRequest(
+				ctx,
+				executor,
+				csr,
+				caPair,
+				&x509options.X509SignCertificateSigningRequestOptions{
+					IsCA:                true,
+					IgnoreRequestedSans: true,
+				},
+			)
+			require.NoError(t, err)
+
+			require.True(t, cert.IsCA)
+			require.True(t, cert.MaxPathLenZero)
+			require.Empty(t, cert.DNSNames)
+			require.EqualValues(t, x509.KeyUsageCertSign|x509.KeyUsageCRLSign, cert.KeyUsage)
+			require.Empty(t, cert.ExtKeyUsage)
+
+			isSigned, err := genericx509utils.IsSignedBy(ctx, cert, caPair.Cert)
+			require.NoError(t, err)
+			require.True(t, isSigned)
+		})
+
+		t.Run(implName+"_signing certificate is no ca", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			executor := getCommandExecutorImplementationByName(t, implName)
+			tmpDir := t.TempDir()
+
+			caPair := generateCaCertAndKey(t, tmpDir)
+
+			endEntityCsr, endEntityKey, err := commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(
+				ctx,
+				executor,
+				&x509options.X509CreateCertificateSigningRequestOptions{
+					CommonName:   "end-entity.example.com",
+					KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ECDSA_P256,
+				},
+			)
+			require.NoError(t, err)
+
+			endEntityCert, err := commandexecutorx509utils.SignCertificateSigningRequest(ctx, executor, endEntityCsr, caPair, nil)
+			require.NoError(t, err)
+			notCaPair := &genericx509utils.X509CertKeyPair{Cert: endEntityCert, Key: endEntityKey}
+
+			csr, _, err := commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(
+				ctx,
+				executor,
+				&x509options.X509CreateCertificateSigningRequestOptions{
+					CommonName:   "csr.example.com",
+					KeyAlgorithm: cryptoutils.KEY_ALGORITHM_ED25519,
+				},
+			)
+			require.NoError(t, err)
+
+			cert, err := commandexecutorx509utils.SignCertificateSigningRequest(ctx, executor, csr, notCaPair, nil)
+			require.Error(t, err)
+			require.Nil(t, cert)
+		})
+	}
+}
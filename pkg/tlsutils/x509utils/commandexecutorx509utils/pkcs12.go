@@ -0,0 +1,124 @@
+package commandexecutorx509utils
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutorfile"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// WritePkcs12ToFile writes the certificate, private key and chain as password protected PKCS#12 bundle to pathToWrite.
+// Since the bundle contains the private key the file is restricted to the owner before the content is written.
+func WritePkcs12ToFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string, pathToWrite string) error {
+	if commandExecutor == nil {
+		return tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write PKCS#12 to file '%s' using command executor started.", pathToWrite)
+
+	pfxData, err := genericx509utils.EncodePkcs12(certKeyPair, chain, password)
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.CreateFile(contextutils.WithSilent(ctx), commandExecutor, pathToWrite, nil)
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.Chmod(contextutils.WithSilent(ctx), commandExecutor, pathToWrite, &filesoptions.ChmodOptions{PermissionsString: "u=rw,g=,o="})
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.WriteBytes(ctx, commandExecutor, pathToWrite, pfxData)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write PKCS#12 to file '%s' using command executor finished.", pathToWrite)
+
+	return nil
+}
+
+// ReadPkcs12FromFile reads a password protected PKCS#12 bundle.
+// Returns the certificate and private key as X509CertKeyPair and the included chain certificates.
+func ReadPkcs12FromFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, pathToRead string, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error) {
+	if commandExecutor == nil {
+		return nil, nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if pathToRead == "" {
+		return nil, nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Read PKCS#12 from file '%s' using command executor started.", pathToRead)
+
+	content, err := commandexecutorfile.ReadAsBytes(commandExecutor, pathToRead)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certKeyPair, chain, err := genericx509utils.DecodePkcs12(content, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Read PKCS#12 from file '%s' using command executor finished.", pathToRead)
+
+	return certKeyPair, chain, nil
+}
+
+// WriteJksTrustStoreToFile writes the certs as Java KeyStore (JKS) truststore protected by password to pathToWrite.
+func WriteJksTrustStoreToFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, certs []*x509.Certificate, password string, pathToWrite string) error {
+	if commandExecutor == nil {
+		return tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write JKS truststore to file '%s' using command executor started.", pathToWrite)
+
+	jksData, err := genericx509utils.EncodeJksTrustStore(certs, password)
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.WriteBytes(ctx, commandExecutor, pathToWrite, jksData)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write JKS truststore to file '%s' using command executor finished.", pathToWrite)
+
+	return nil
+}
+
+func ReadJksTrustStoreFromFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, pathToRead string, password string) ([]*x509.Certificate, error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if pathToRead == "" {
+		return nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	content, err := commandexecutorfile.ReadAsBytes(commandExecutor, pathToRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericx509utils.DecodeJksTrustStore(content, password)
+}
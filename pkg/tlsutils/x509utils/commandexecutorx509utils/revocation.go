@@ -0,0 +1,157 @@
+package commandexecutorx509utils
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/commandexecutor/commandexecutorinterfaces"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutorfile"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/commandexecutortempfile"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/parameteroptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func ReadRevocationListFromFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, pathToRead string) (*x509.RevocationList, error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if pathToRead == "" {
+		return nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Read certificate revocation list from file '%s' using command executor started.", pathToRead)
+
+	content, err := commandexecutorfile.ReadAsBytes(commandExecutor, pathToRead)
+	if err != nil {
+		return nil, err
+	}
+
+	crl, err := genericx509utils.ReadRevocationListFromBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Read certificate revocation list from file '%s' using command executor finished.", pathToRead)
+
+	return crl, nil
+}
+
+func WriteRevocationListToFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, crl *x509.RevocationList, pathToWrite string) error {
+	if commandExecutor == nil {
+		return tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if crl == nil {
+		return tracederrors.TracedErrorNil("crl")
+	}
+
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write certificate revocation list to file '%s' using command executor started.", pathToWrite)
+
+	crlBytes, err := genericx509utils.WriteRevocationListAsPEMBytes(crl)
+	if err != nil {
+		return err
+	}
+
+	err = commandexecutorfile.WriteBytes(ctx, commandExecutor, pathToWrite, crlBytes)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Write certificate revocation list to file '%s' using command executor finished.", pathToWrite)
+
+	return nil
+}
+
+// GetRevocationStatusUsingRevocationListFile checks the revocation status of cert against the CRL stored in crlPath.
+func GetRevocationStatusUsingRevocationListFile(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, cert *x509.Certificate, issuerCert *x509.Certificate, crlPath string) (*genericx509utils.RevocationStatus, error) {
+	crl, err := ReadRevocationListFromFile(ctx, commandExecutor, crlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericx509utils.GetRevocationStatusFromRevocationList(ctx, cert, issuerCert, crl)
+}
+
+// GetRevocationStatusUsingOcsp asks the OCSP responder at responderUrl for the revocation status of cert.
+// The request is sent using 'curl' on the host of the command executor.
+// If responderUrl is empty the first OCSP server listed in cert is used.
+func GetRevocationStatusUsingOcsp(ctx context.Context, commandExecutor commandexecutorinterfaces.CommandExecutor, cert *x509.Certificate, issuerCert *x509.Certificate, responderUrl string) (*genericx509utils.RevocationStatus, error) {
+	if commandExecutor == nil {
+		return nil, tracederrors.TracedErrorNil("commandExecutor")
+	}
+
+	if cert == nil {
+		return nil, tracederrors.TracedErrorNil("cert")
+	}
+
+	responderUrl, err := genericx509utils.GetOcspResponderUrl(cert, responderUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Get revocation status of '%s' using OCSP responder '%s' and command executor started.", cert.Subject.String(), responderUrl)
+
+	request, err := genericx509utils.CreateOcspRequest(cert, issuerCert)
+	if err != nil {
+		return nil, err
+	}
+
+	requestPath, err := commandexecutortempfile.CreateEmptyTemporaryFile(contextutils.WithSilent(ctx), commandExecutor)
+	if err != nil {
+		return nil, err
+	}
+	defer commandexecutorfile.Delete(contextutils.WithSilent(ctx), commandExecutor, requestPath, &filesoptions.DeleteOptions{})
+
+	responsePath, err := commandexecutortempfile.CreateEmptyTemporaryFile(contextutils.WithSilent(ctx), commandExecutor)
+	if err != nil {
+		return nil, err
+	}
+	defer commandexecutorfile.Delete(contextutils.WithSilent(ctx), commandExecutor, responsePath, &filesoptions.DeleteOptions{})
+
+	err = commandexecutorfile.WriteBytes(contextutils.WithSilent(ctx), commandExecutor, requestPath, request)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = commandExecutor.RunCommand(
+		contextutils.WithSilent(ctx),
+		&parameteroptions.RunCommandOptions{
+			Command: []string{
+				"curl",
+				"--silent",
+				"--show-error",
+				"--fail",
+				"--header", "Content-Type: " + genericx509utils.OCSP_REQUEST_CONTENT_TYPE,
+				"--data-binary", "@" + requestPath,
+				"--output", responsePath,
+				responderUrl,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBytes, err := commandexecutorfile.ReadAsBytes(commandExecutor, responsePath)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := genericx509utils.ParseOcspResponse(ctx, responseBytes, cert, issuerCert)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Get revocation status of '%s' using OCSP responder '%s' and command executor finished.", cert.Subject.String(), responderUrl)
+
+	return status, nil
+}
@@ -0,0 +1,43 @@
+package x509utils
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/nativex509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func CreateCertificateSigningRequest(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions, privateKey crypto.PrivateKey) (*x509.CertificateRequest, error) {
+	return nativex509utils.CreateCertificateSigningRequest(ctx, options, privateKey)
+}
+
+func CreateCertificateSigningRequestAndPrivateKey(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error) {
+	return nativex509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, options)
+}
+
+func SignCertificateSigningRequest(ctx context.Context, csr *x509.CertificateRequest, caCertAndKey *genericx509utils.X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error) {
+	return nativex509utils.SignCertificateSigningRequest(ctx, csr, caCertAndKey, options)
+}
+
+func ReadCertificateSigningRequestFromFile(ctx context.Context, pathToRead string) (*x509.CertificateRequest, error) {
+	return nativex509utils.ReadCertificateSigningRequestFromFile(ctx, pathToRead)
+}
+
+func WriteCertificateSigningRequestToFile(ctx context.Context, csr *x509.CertificateRequest, pathToWrite string) error {
+	return nativex509utils.WriteCertificateSigningRequestToFile(ctx, csr, pathToWrite)
+}
+
+func ReadCertificateSigningRequestFromString(input string) (*x509.CertificateRequest, error) {
+	return genericx509utils.ReadCertificateSigningRequestFromString(input)
+}
+
+func WriteCertificateSigningRequestAsPEMString(csr *x509.CertificateRequest) (string, error) {
+	return genericx509utils.WriteCertificateSigningRequestAsPEMString(csr)
+}
+
+func GetCertificateSigningRequestInfoString(csr *x509.CertificateRequest) (string, error) {
+	return genericx509utils.GetCertificateSigningRequestInfoString(csr)
+}
@@ -0,0 +1,368 @@
+package genericx509utils
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/bigintutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// CreateRevocationList creates a certificate revocation list (CRL) containing revokedCertificates signed by the CA in caCertAndKey.
+func CreateRevocationList(ctx context.Context, caCertAndKey *X509CertKeyPair, revokedCertificates []x509.RevocationListEntry, options *x509options.X509CreateRevocationListOptions) (*x509.RevocationList, error) {
+	if caCertAndKey == nil {
+		return nil, tracederrors.TracedErrorNil("caCertAndKey")
+	}
+
+	if options == nil {
+		options = new(x509options.X509CreateRevocationListOptions)
+	}
+
+	caCert, err := caCertAndKey.GetX509Certificate()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create certificate revocation list of '%s' with %d revoked certificates started.", caCert.Subject.String(), len(revokedCertificates))
+
+	if !caCert.IsCA {
+		return nil, tracederrors.TracedErrorf("Certificate '%s' used for signing the certificate revocation list is not a CA certificate.", caCert.Subject.String())
+	}
+
+	caKey, err := caCertAndKey.GetPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := caKey.(crypto.Signer)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("Private key of type '%T' can not be used for signing.", caKey)
+	}
+
+	number := big.NewInt(1)
+	if options.Number != "" {
+		number, err = bigintutils.GetFromDecimalString(options.Number)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("parse CRL number '%s' as big int failed: %w", options.Number, err)
+		}
+	}
+
+	thisUpdate := time.Now().Add(-1 * time.Minute)
+	template := &x509.RevocationList{
+		Number:                    number,
+		ThisUpdate:                thisUpdate,
+		NextUpdate:                thisUpdate.Add(options.GetValidityDurationOrDefault()),
+		RevokedCertificateEntries: revokedCertificates,
+	}
+
+	crlDer, err := x509.CreateRevocationList(rand.Reader, template, caCert, signer)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create certificate revocation list: %w", err)
+	}
+
+	crl, err := x509.ParseRevocationList(crlDer)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to parse created certificate revocation list: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create certificate revocation list of '%s' with %d revoked certificates finished. CRL number is '%s'.", caCert.Subject.String(), len(revokedCertificates), number.String())
+
+	return crl, nil
+}
+
+// RevokeCertificate returns a new CRL containing all entries of crl plus the certificate with the given serial number.
+// The serial number is given as decimal string or as colon separated hex string.
+// If crl is nil a new CRL is created.
+// The reason is one of the names returned by GetSupportedRevocationReasons except "removeFromCRL". An empty reason is treated as "unspecified".
+// An existing removeFromCRL entry of the certificate is replaced, as is a certificateHold entry if a final reason is given.
+func RevokeCertificate(ctx context.Context, crl *x509.RevocationList, caCertAndKey *X509CertKeyPair, serialNumber string, reason string, options *x509options.X509CreateRevocationListOptions) (*x509.RevocationList, error) {
+	if caCertAndKey == nil {
+		return nil, tracederrors.TracedErrorNil("caCertAndKey")
+	}
+
+	if serialNumber == "" {
+		return nil, tracederrors.TracedErrorEmptyString("serialNumber")
+	}
+
+	if options == nil {
+		options = new(x509options.X509CreateRevocationListOptions)
+	}
+
+	serial, err := ParseSerialNumber(serialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	reasonCode, err := ParseRevocationReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if reasonCode == revocationReasonCodeRemoveFromCrl {
+		return nil, tracederrors.TracedErrorf("Unable to revoke certificate with serial number '%s': Reason '%s' does not revoke a certificate.", serialNumber, reason)
+	}
+
+	caCert, err := caCertAndKey.GetX509Certificate()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []x509.RevocationListEntry{}
+	if crl != nil {
+		err = CheckRevocationListSignedBy(crl, caCert)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range crl.RevokedCertificateEntries {
+			if bigintutils.EqualsInts(entry.SerialNumber, serial) {
+				// The entry is replaced by the new revocation if it does not revoke the certificate or only puts it on hold:
+				if entry.ReasonCode == revocationReasonCodeRemoveFromCrl {
+					continue
+				}
+
+				if entry.ReasonCode == revocationReasonCodeCertificateHold && reasonCode != revocationReasonCodeCertificateHold {
+					continue
+				}
+
+				logging.LogInfoByCtxf(ctx, "Certificate with serial number '%s' is already revoked by '%s'.", serialNumber, caCert.Subject.String())
+				return crl, nil
+			}
+
+			entries = append(entries, x509.RevocationListEntry{
+				SerialNumber:   entry.SerialNumber,
+				RevocationTime: entry.RevocationTime,
+				ReasonCode:     entry.ReasonCode,
+			})
+		}
+
+		if options.Number == "" && crl.Number != nil {
+			options = options.GetDeepCopy()
+			options.Number = new(big.Int).Add(crl.Number, big.NewInt(1)).String()
+		}
+	}
+
+	entries = append(entries, x509.RevocationListEntry{
+		SerialNumber:   serial,
+		RevocationTime: time.Now().UTC(),
+		ReasonCode:     reasonCode,
+	})
+
+	updated, err := CreateRevocationList(ctx, caCertAndKey, entries, options)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogChangedByCtxf(ctx, "Certificate with serial number '%s' revoked by '%s'.", serialNumber, caCert.Subject.String())
+
+	return updated, nil
+}
+
+func CheckRevocationListSignedBy(crl *x509.RevocationList, caCert *x509.Certificate) error {
+	if crl == nil {
+		return tracederrors.TracedErrorNil("crl")
+	}
+
+	if caCert == nil {
+		return tracederrors.TracedErrorNil("caCert")
+	}
+
+	err := crl.CheckSignatureFrom(caCert)
+	if err != nil {
+		return tracederrors.TracedErrorf("Certificate revocation list is not signed by '%s': %w", caCert.Subject.String(), err)
+	}
+
+	return nil
+}
+
+func IsRevocationListSignedBy(crl *x509.RevocationList, caCert *x509.Certificate) (bool, error) {
+	if crl == nil {
+		return false, tracederrors.TracedErrorNil("crl")
+	}
+
+	if caCert == nil {
+		return false, tracederrors.TracedErrorNil("caCert")
+	}
+
+	return crl.CheckSignatureFrom(caCert) == nil, nil
+}
+
+// GetRevocationStatusFromRevocationList checks if cert is revoked by crl.
+// The signature of the crl is validated against issuerCert, the certificate of the CA which issued cert.
+// An error is returned if the crl is not valid yet or outdated.
+// Entries with reason removeFromCRL do not revoke the certificate.
+func GetRevocationStatusFromRevocationList(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crl *x509.RevocationList) (*RevocationStatus, error) {
+	if cert == nil {
+		return nil, tracederrors.TracedErrorNil("cert")
+	}
+
+	if issuerCert == nil {
+		return nil, tracederrors.TracedErrorNil("issuerCert")
+	}
+
+	if crl == nil {
+		return nil, tracederrors.TracedErrorNil("crl")
+	}
+
+	if !bytes.Equal(cert.RawIssuer, crl.RawIssuer) {
+		return nil, tracederrors.TracedErrorf("Certificate '%s' is issued by '%s' but the certificate revocation list by '%s'.", cert.Subject.String(), cert.Issuer.String(), crl.Issuer.String())
+	}
+
+	err := CheckRevocationListSignedBy(crl, issuerCert)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkRevocationInformationIsCurrent(fmt.Sprintf("Certificate revocation list of '%s'", crl.Issuer.String()), crl.ThisUpdate, crl.NextUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &RevocationStatus{
+		Status:       REVOCATION_STATUS_GOOD,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   crl.ThisUpdate,
+		NextUpdate:   crl.NextUpdate,
+	}
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.ReasonCode == revocationReasonCodeRemoveFromCrl {
+			continue
+		}
+
+		if bigintutils.EqualsInts(entry.SerialNumber, cert.SerialNumber) {
+			reason, err := GetRevocationReasonName(entry.ReasonCode)
+			if err != nil {
+				return nil, err
+			}
+
+			status.Status = REVOCATION_STATUS_REVOKED
+			status.RevokedAt = entry.RevocationTime
+			status.Reason = reason
+			break
+		}
+	}
+
+	logging.LogInfoByCtxf(ctx, "Revocation status of '%s' according to certificate revocation list of '%s' is '%s'.", cert.Subject.String(), crl.Issuer.String(), status.Status)
+
+	return status, nil
+}
+
+func IsCertificateRevokedByRevocationList(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crl *x509.RevocationList) (bool, error) {
+	status, err := GetRevocationStatusFromRevocationList(ctx, cert, issuerCert, crl)
+	if err != nil {
+		return false, err
+	}
+
+	return status.IsRevoked(), nil
+}
+
+func ReadRevocationListFromString(input string) (*x509.RevocationList, error) {
+	if input == "" {
+		return nil, tracederrors.TracedErrorEmptyString("input")
+	}
+
+	return ReadRevocationListFromBytes([]byte(input))
+}
+
+// ReadRevocationListFromBytes parses a PEM or DER encoded certificate revocation list.
+func ReadRevocationListFromBytes(input []byte) (*x509.RevocationList, error) {
+	if input == nil {
+		return nil, tracederrors.TracedErrorNil("input")
+	}
+
+	der := input
+	block, _ := pem.Decode(input)
+	if block != nil {
+		if block.Type != "X509 CRL" {
+			return nil, tracederrors.TracedErrorf("Expected PEM block of type 'X509 CRL' but got '%s'", block.Type)
+		}
+
+		der = block.Bytes
+	}
+
+	crl, err := x509.ParseRevocationList(der)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to parse certificate revocation list: %w", err)
+	}
+
+	return crl, nil
+}
+
+func WriteRevocationListAsPEMString(crl *x509.RevocationList) (string, error) {
+	outputBytes, err := WriteRevocationListAsPEMBytes(crl)
+	if err != nil {
+		return "", err
+	}
+
+	return string(outputBytes), nil
+}
+
+func WriteRevocationListAsPEMBytes(crl *x509.RevocationList) ([]byte, error) {
+	if crl == nil {
+		return nil, tracederrors.TracedErrorNil("crl")
+	}
+
+	if crl.Raw == nil {
+		return nil, tracederrors.TracedError("crl.Raw is nil, cannot encode certificate revocation list")
+	}
+
+	var buf bytes.Buffer
+	pemBlock := &pem.Block{
+		Type:  "X509 CRL",
+		Bytes: crl.Raw,
+	}
+
+	err := pem.Encode(io.Writer(&buf), pemBlock)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to PEM encode certificate revocation list: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func GetRevocationListInfoString(crl *x509.RevocationList) (string, error) {
+	if crl == nil {
+		return "", tracederrors.TracedErrorNil("crl")
+	}
+
+	number := ""
+	if crl.Number != nil {
+		number = crl.Number.String()
+	}
+
+	infoString := fmt.Sprintf(
+		"Issuer: %s, CRL Number: %s, This Update: %s, Next Update: %s, Revoked Certificates: %d",
+		crl.Issuer.String(),
+		number,
+		crl.ThisUpdate.UTC().Format(time.RFC1123),
+		crl.NextUpdate.UTC().Format(time.RFC1123),
+		len(crl.RevokedCertificateEntries),
+	)
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		serialNumber, err := bigintutils.ToHexStringColonSeparated(entry.SerialNumber)
+		if err != nil {
+			return "", err
+		}
+
+		reason, err := GetRevocationReasonName(entry.ReasonCode)
+		if err != nil {
+			return "", err
+		}
+
+		infoString += fmt.Sprintf("\n  Serial Number: %s, Revoked At: %s, Reason: %s", serialNumber, entry.RevocationTime.UTC().Format(time.RFC1123), reason)
+	}
+
+	return infoString, nil
+}
@@ -0,0 +1,394 @@
+package genericx509utils_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_ParseRevocationReason(t *testing.T) {
+	tests := []struct {
+		name         string
+		expectedCode int
+	}{
+		{"", 0},
+		{"unspecified", 0},
+		{"keyCompromise", 1},
+		{"cACompromise", 2},
+		{"superseded", 4},
+		{"cessationOfOperation", 5},
+		{"removeFromCRL", 8},
+		{"aACompromise", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := genericx509utils.ParseRevocationReason(tt.name)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedCode, code)
+
+			if tt.name != "" {
+				name, err := genericx509utils.GetRevocationReasonName(code)
+				require.NoError(t, err)
+				require.EqualValues(t, tt.name, name)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := genericx509utils.ParseRevocationReason("stolen")
+		require.Error(t, err)
+
+		_, err = genericx509utils.GetRevocationReasonName(7)
+		require.Error(t, err)
+	})
+}
+
+func Test_CreateRevocationList(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	rootPair, intPair, eePair := createTestChain(t)
+
+	t.Run("nil ca", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, nil, nil, nil)
+		require.Error(t, err)
+		require.Nil(t, crl)
+	})
+
+	t.Run("not a ca", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, eePair, nil, nil)
+		require.Error(t, err)
+		require.Nil(t, crl)
+	})
+
+	t.Run("empty crl", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, intPair, nil, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, "1", crl.Number.String())
+		require.Empty(t, crl.RevokedCertificateEntries)
+
+		isSigned, err := genericx509utils.IsRevocationListSignedBy(crl, intPair.Cert)
+		require.NoError(t, err)
+		require.True(t, isSigned)
+
+		isSigned, err = genericx509utils.IsRevocationListSignedBy(crl, rootPair.Cert)
+		require.NoError(t, err)
+		require.False(t, isSigned)
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+		require.False(t, status.IsRevoked())
+	})
+
+	t.Run("crl of other ca", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, rootPair, nil, nil)
+		require.NoError(t, err)
+
+		_, err = genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.Error(t, err)
+	})
+}
+
+func createRevocationListWithUpdateTimes(t *testing.T, caPair *genericx509utils.X509CertKeyPair, entries []x509.RevocationListEntry, thisUpdate time.Time, nextUpdate time.Time) *x509.RevocationList {
+	t.Helper()
+
+	der, err := x509.CreateRevocationList(
+		rand.Reader,
+		&x509.RevocationList{
+			Number:                    big.NewInt(1),
+			ThisUpdate:                thisUpdate,
+			NextUpdate:                nextUpdate,
+			RevokedCertificateEntries: entries,
+		},
+		caPair.Cert,
+		caPair.Key.(crypto.Signer),
+	)
+	require.NoError(t, err)
+
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(t, err)
+
+	return crl
+}
+
+func Test_GetRevocationStatusFromRevocationList(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	_, intPair, eePair := createTestChain(t)
+
+	now := time.Now()
+	revokedEntries := []x509.RevocationListEntry{
+		{SerialNumber: eePair.Cert.SerialNumber, RevocationTime: now.Add(-2 * time.Hour), ReasonCode: 1},
+	}
+
+	t.Run("current", func(t *testing.T) {
+		crl := createRevocationListWithUpdateTimes(t, intPair, revokedEntries, now.Add(-time.Hour), now.Add(time.Hour))
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+	})
+
+	t.Run("next update in the past", func(t *testing.T) {
+		crl := createRevocationListWithUpdateTimes(t, intPair, revokedEntries, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.Error(t, err)
+		require.Nil(t, status)
+	})
+
+	t.Run("this update in the future", func(t *testing.T) {
+		crl := createRevocationListWithUpdateTimes(t, intPair, revokedEntries, now.Add(24*time.Hour), now.Add(48*time.Hour))
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.Error(t, err)
+		require.Nil(t, status)
+	})
+
+	t.Run("remove from crl", func(t *testing.T) {
+		entries := []x509.RevocationListEntry{
+			{SerialNumber: eePair.Cert.SerialNumber, RevocationTime: now.Add(-2 * time.Hour), ReasonCode: 8},
+		}
+		crl := createRevocationListWithUpdateTimes(t, intPair, entries, now.Add(-time.Hour), now.Add(time.Hour))
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+		require.False(t, status.IsRevoked())
+	})
+}
+
+func Test_RevokeCertificate(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	rootPair, intPair, eePair := createTestChain(t)
+
+	otherEePair, err := genericx509utils.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), intPair)
+	require.NoError(t, err)
+
+	eeSerial, err := genericx509utils.GetSerialNumberAsHexColonSeparated(eePair.Cert)
+	require.NoError(t, err)
+
+	t.Run("unknown reason", func(t *testing.T) {
+		crl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "stolen", nil)
+		require.Error(t, err)
+		require.Nil(t, crl)
+	})
+
+	t.Run("crl signed by other ca", func(t *testing.T) {
+		rootCrl, err := genericx509utils.CreateRevocationList(ctx, rootPair, nil, nil)
+		require.NoError(t, err)
+
+		crl, err := genericx509utils.RevokeCertificate(ctx, rootCrl, intPair, eeSerial, "keyCompromise", nil)
+		require.Error(t, err)
+		require.Nil(t, crl)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, intPair, nil, nil)
+		require.NoError(t, err)
+
+		crl, err = genericx509utils.RevokeCertificate(ctx, crl, intPair, eeSerial, "keyCompromise", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, "2", crl.Number.String())
+		require.Len(t, crl.RevokedCertificateEntries, 1)
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+		require.EqualValues(t, "keyCompromise", status.Reason)
+		require.False(t, status.RevokedAt.IsZero())
+
+		isRevoked, err := genericx509utils.IsCertificateRevokedByRevocationList(ctx, otherEePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.False(t, isRevoked)
+
+		// Revoking the same certificate again keeps the CRL unchanged:
+		unchanged, err := genericx509utils.RevokeCertificate(ctx, crl, intPair, eeSerial, "superseded", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, crl.Raw, unchanged.Raw)
+
+		// Revoke a second certificate using its decimal serial number:
+		otherSerial, err := genericx509utils.GetSerialNumberAsString(otherEePair.Cert)
+		require.NoError(t, err)
+		crl, err = genericx509utils.RevokeCertificate(ctx, crl, intPair, otherSerial, "", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, "3", crl.Number.String())
+		require.Len(t, crl.RevokedCertificateEntries, 2)
+
+		status, err = genericx509utils.GetRevocationStatusFromRevocationList(ctx, otherEePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+		require.EqualValues(t, "unspecified", status.Reason)
+
+		// The first revocation is still included:
+		isRevoked, err = genericx509utils.IsCertificateRevokedByRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.True(t, isRevoked)
+
+		infoString, err := genericx509utils.GetRevocationListInfoString(crl)
+		require.NoError(t, err)
+		require.Contains(t, infoString, "CRL Number: 3")
+		require.Contains(t, infoString, eeSerial)
+		require.Contains(t, infoString, "keyCompromise")
+	})
+
+	t.Run("removeFromCRL is not a revocation reason", func(t *testing.T) {
+		crl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "removeFromCRL", nil)
+		require.Error(t, err)
+		require.Nil(t, crl)
+	})
+
+	t.Run("revoke after removeFromCRL", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, intPair, []x509.RevocationListEntry{
+			{SerialNumber: eePair.Cert.SerialNumber, RevocationTime: time.Now().UTC(), ReasonCode: 8},
+		}, nil)
+		require.NoError(t, err)
+
+		isRevoked, err := genericx509utils.IsCertificateRevokedByRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.False(t, isRevoked)
+
+		crl, err = genericx509utils.RevokeCertificate(ctx, crl, intPair, eeSerial, "keyCompromise", nil)
+		require.NoError(t, err)
+		require.Len(t, crl.RevokedCertificateEntries, 1)
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+		require.EqualValues(t, "keyCompromise", status.Reason)
+	})
+
+	t.Run("escalate certificateHold", func(t *testing.T) {
+		crl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "certificateHold", nil)
+		require.NoError(t, err)
+
+		// Putting the certificate on hold again keeps the CRL unchanged:
+		unchanged, err := genericx509utils.RevokeCertificate(ctx, crl, intPair, eeSerial, "certificateHold", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, crl.Raw, unchanged.Raw)
+
+		// A final reason replaces the hold:
+		crl, err = genericx509utils.RevokeCertificate(ctx, crl, intPair, eeSerial, "keyCompromise", nil)
+		require.NoError(t, err)
+		require.Len(t, crl.RevokedCertificateEntries, 1)
+
+		status, err := genericx509utils.GetRevocationStatusFromRevocationList(ctx, eePair.Cert, intPair.Cert, crl)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+		require.EqualValues(t, "keyCompromise", status.Reason)
+	})
+}
+
+func Test_ReadAndWriteRevocationList(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	_, intPair, eePair := createTestChain(t)
+
+	eeSerial, err := genericx509utils.GetSerialNumberAsString(eePair.Cert)
+	require.NoError(t, err)
+
+	crl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "cessationOfOperation", &x509options.X509CreateRevocationListOptions{Number: "42"})
+	require.NoError(t, err)
+	require.EqualValues(t, "42", crl.Number.String())
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := genericx509utils.ReadRevocationListFromString("")
+		require.Error(t, err)
+	})
+
+	t.Run("wrong pem type", func(t *testing.T) {
+		certPem, err := genericx509utils.WriteCertificateAsPEMString(eePair.Cert)
+		require.NoError(t, err)
+
+		_, err = genericx509utils.ReadRevocationListFromString(certPem)
+		require.Error(t, err)
+	})
+
+	t.Run("pem round trip", func(t *testing.T) {
+		crlPem, err := genericx509utils.WriteRevocationListAsPEMString(crl)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(crlPem, "-----BEGIN X509 CRL-----"))
+
+		read, err := genericx509utils.ReadRevocationListFromString(crlPem)
+		require.NoError(t, err)
+		require.EqualValues(t, crl.Raw, read.Raw)
+	})
+
+	t.Run("der", func(t *testing.T) {
+		read, err := genericx509utils.ReadRevocationListFromBytes(crl.Raw)
+		require.NoError(t, err)
+		require.EqualValues(t, crl.Raw, read.Raw)
+	})
+}
+
+func Test_RevocationListOpensslInterop(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	rootPair, intPair, eePair := createTestChain(t)
+
+	otherEePair, err := genericx509utils.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), intPair)
+	require.NoError(t, err)
+
+	eeSerial, err := genericx509utils.GetSerialNumberAsString(eePair.Cert)
+	require.NoError(t, err)
+
+	// The CRL of the root CA is needed by openssl as well when checking the whole chain:
+	rootCrl, err := genericx509utils.CreateRevocationList(ctx, rootPair, nil, nil)
+	require.NoError(t, err)
+
+	intCrl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "keyCompromise", nil)
+	require.NoError(t, err)
+
+	tmpDir := t.TempDir()
+	writePem := func(name string, content string) string {
+		path := filepath.Join(tmpDir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	rootPem, err := genericx509utils.WriteCertificateAsPEMString(rootPair.Cert)
+	require.NoError(t, err)
+	intPem, err := genericx509utils.WriteCertificateAsPEMString(intPair.Cert)
+	require.NoError(t, err)
+	eePem, err := genericx509utils.WriteCertificateAsPEMString(eePair.Cert)
+	require.NoError(t, err)
+	otherEePem, err := genericx509utils.WriteCertificateAsPEMString(otherEePair.Cert)
+	require.NoError(t, err)
+	rootCrlPem, err := genericx509utils.WriteRevocationListAsPEMString(rootCrl)
+	require.NoError(t, err)
+	intCrlPem, err := genericx509utils.WriteRevocationListAsPEMString(intCrl)
+	require.NoError(t, err)
+
+	rootPath := writePem("root.pem", rootPem)
+	intPath := writePem("int.pem", intPem)
+	eePath := writePem("ee.pem", eePem)
+	otherEePath := writePem("other-ee.pem", otherEePem)
+	crlPath := writePem("crls.pem", rootCrlPem+intCrlPem)
+	intCrlPath := writePem("int-crl.pem", intCrlPem)
+
+	t.Run("openssl verifies crl signature", func(t *testing.T) {
+		output, err := exec.Command("openssl", "crl", "-in", intCrlPath, "-CAfile", intPath, "-noout", "-text").CombinedOutput()
+		require.NoError(t, err, string(output))
+		require.Contains(t, string(output), "Key Compromise")
+	})
+
+	t.Run("openssl reports revoked certificate", func(t *testing.T) {
+		output, err := exec.Command("openssl", "verify", "-crl_check_all", "-CAfile", rootPath, "-untrusted", intPath, "-CRLfile", crlPath, eePath).CombinedOutput()
+		require.Error(t, err, string(output))
+		require.Contains(t, string(output), "certificate revoked")
+	})
+
+	t.Run("openssl accepts not revoked certificate", func(t *testing.T) {
+		output, err := exec.Command("openssl", "verify", "-crl_check_all", "-CAfile", rootPath, "-untrusted", intPath, "-CRLfile", crlPath, otherEePath).CombinedOutput()
+		require.NoError(t, err, string(output))
+	})
+}
@@ -0,0 +1,358 @@
+package genericx509utils
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/bigintutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// CreateCertificateSigningRequest creates a certificate signing request (CSR) signed by the given privateKey.
+func CreateCertificateSigningRequest(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions, privateKey crypto.PrivateKey) (*x509.CertificateRequest, error) {
+	if options == nil {
+		return nil, tracederrors.TracedErrorNil("options")
+	}
+
+	if privateKey == nil {
+		return nil, tracederrors.TracedErrorNil("privateKey")
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("privateKey of type '%T' can not be used to sign a certificate signing request.", privateKey)
+	}
+
+	commonName, err := options.GetCommonName()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create certificate signing request for '%s' started.", commonName)
+
+	template := &x509.CertificateRequest{
+		Subject: buildPkixName(&x509options.X509CreateCertificateOptions{
+			CommonName:   options.CommonName,
+			CountryName:  options.CountryName,
+			Organization: options.Organization,
+			Locality:     options.Locality,
+		}),
+	}
+
+	template.DNSNames = []string{commonName}
+
+	for _, san := range options.AdditionalSans {
+		ip := net.ParseIP(san)
+		if ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+
+	if len(options.KeyUsages) > 0 {
+		keyUsage, err := ParseKeyUsages(options.KeyUsages)
+		if err != nil {
+			return nil, err
+		}
+
+		extension, err := getKeyUsageExtension(keyUsage)
+		if err != nil {
+			return nil, err
+		}
+
+		template.ExtraExtensions = append(template.ExtraExtensions, extension)
+	}
+
+	if len(options.ExtendedKeyUsages) > 0 {
+		extKeyUsages, err := ParseExtendedKeyUsages(options.ExtendedKeyUsages)
+		if err != nil {
+			return nil, err
+		}
+
+		extension, err := getExtendedKeyUsageExtension(extKeyUsages)
+		if err != nil {
+			return nil, err
+		}
+
+		template.ExtraExtensions = append(template.ExtraExtensions, extension)
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, template, signer)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create certificate signing request: %w", err)
+	}
+
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse created certificate signing request: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Create certificate signing request for '%s' finished. Subject: '%s'.", commonName, csr.Subject.String())
+
+	return csr, nil
+}
+
+// CreateCertificateSigningRequestAndPrivateKey generates a new private key and returns it together with the certificate signing request (CSR).
+func CreateCertificateSigningRequestAndPrivateKey(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error) {
+	if options == nil {
+		return nil, nil, tracederrors.TracedErrorNil("options")
+	}
+
+	privateKey, _, err := generatePrivateKey(ctx, options.KeyAlgorithm, options.PrivateKeySize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, err := CreateCertificateSigningRequest(ctx, options, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return csr, privateKey, nil
+}
+
+func ReadCertificateSigningRequestFromString(input string) (*x509.CertificateRequest, error) {
+	if input == "" {
+		return nil, tracederrors.TracedErrorEmptyString("input")
+	}
+
+	return ReadCertificateSigningRequestFromBytes([]byte(input))
+}
+
+func ReadCertificateSigningRequestFromBytes(input []byte) (*x509.CertificateRequest, error) {
+	if input == nil {
+		return nil, tracederrors.TracedErrorNil("input")
+	}
+
+	block, _ := pem.Decode(input)
+	if block == nil {
+		return nil, tracederrors.TracedError("Failed to decode PEM block from input")
+	}
+
+	if block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST" {
+		return nil, tracederrors.TracedErrorf("Expected PEM block of type 'CERTIFICATE REQUEST' but got '%s'", block.Type)
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Unable to parse certificate signing request from DER bytes: %w", err)
+	}
+
+	return csr, nil
+}
+
+func WriteCertificateSigningRequestAsPEMString(csr *x509.CertificateRequest) (string, error) {
+	outputBytes, err := WriteCertificateSigningRequestAsPEMBytes(csr)
+	if err != nil {
+		return "", err
+	}
+
+	return string(outputBytes), nil
+}
+
+func WriteCertificateSigningRequestAsPEMBytes(csr *x509.CertificateRequest) ([]byte, error) {
+	if csr == nil {
+		return nil, tracederrors.TracedErrorNil("csr")
+	}
+
+	if csr.Raw == nil {
+		return nil, tracederrors.TracedError("csr.Raw is nil, cannot encode certificate signing request")
+	}
+
+	var buf bytes.Buffer
+	pemBlock := &pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: csr.Raw,
+	}
+
+	err := pem.Encode(io.Writer(&buf), pemBlock)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to PEM encode certificate signing request: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GetCertificateSigningRequestKeyUsages returns the key usage and extended key usages requested in the CSR.
+func GetCertificateSigningRequestKeyUsages(csr *x509.CertificateRequest) (x509.KeyUsage, []x509.ExtKeyUsage, error) {
+	if csr == nil {
+		return 0, nil, tracederrors.TracedErrorNil("csr")
+	}
+
+	return getKeyUsagesFromExtensions(csr.Extensions)
+}
+
+// GetCertificateSigningRequestSans returns all Subject Alternative Names requested in the CSR.
+// IP addresses are returned in their string representation.
+func GetCertificateSigningRequestSans(csr *x509.CertificateRequest) ([]string, error) {
+	if csr == nil {
+		return nil, tracederrors.TracedErrorNil("csr")
+	}
+
+	sans := []string{}
+	sans = append(sans, csr.DNSNames...)
+	for _, ip := range csr.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return sans, nil
+}
+
+func GetCertificateSigningRequestInfoString(csr *x509.CertificateRequest) (string, error) {
+	if csr == nil {
+		return "", tracederrors.TracedErrorNil("csr")
+	}
+
+	sans, err := GetCertificateSigningRequestSans(csr)
+	if err != nil {
+		return "", err
+	}
+
+	keyUsage, extKeyUsages, err := GetCertificateSigningRequestKeyUsages(csr)
+	if err != nil {
+		return "", err
+	}
+
+	infoString := fmt.Sprintf(
+		"CN: %s, SANs: %s, Key Usage: %s, Extended Key Usage: %s, Public Key Algorithm: %s",
+		csr.Subject.CommonName,
+		strings.Join(sans, ", "),
+		strings.Join(GetKeyUsageNames(keyUsage), ", "),
+		strings.Join(GetExtendedKeyUsageNames(extKeyUsages), ", "),
+		csr.PublicKeyAlgorithm.String(),
+	)
+
+	return infoString, nil
+}
+
+// SignCertificateSigningRequest signs the csr using the CA certificate and key in caCertAndKey.
+// The signature of the csr is validated before it is signed.
+func SignCertificateSigningRequest(ctx context.Context, csr *x509.CertificateRequest, caCertAndKey *X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error) {
+	if csr == nil {
+		return nil, tracederrors.TracedErrorNil("csr")
+	}
+
+	if caCertAndKey == nil {
+		return nil, tracederrors.TracedErrorNil("caCertAndKey")
+	}
+
+	if options == nil {
+		options = new(x509options.X509SignCertificateSigningRequestOptions)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Sign certificate signing request for '%s' started.", csr.Subject.String())
+
+	err := csr.CheckSignature()
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Invalid signature of certificate signing request for '%s': %w", csr.Subject.String(), err)
+	}
+
+	caCert, err := caCertAndKey.GetX509Certificate()
+	if err != nil {
+		return nil, err
+	}
+
+	if !caCert.IsCA {
+		return nil, tracederrors.TracedErrorf("Certificate '%s' used for signing is not a CA certificate.", caCert.Subject.String())
+	}
+
+	caKey, err := caCertAndKey.GetPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := generateSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	if options.SerialNumber != "" {
+		serialNumber, err = bigintutils.GetFromDecimalString(options.SerialNumber)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("parse serial number '%s' as big int failed: %w", options.SerialNumber, err)
+		}
+	}
+
+	requestedKeyUsage, requestedExtKeyUsages, err := GetCertificateSigningRequestKeyUsages(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	keyUsage := requestedKeyUsage
+	if len(options.KeyUsages) > 0 {
+		keyUsage, err = ParseKeyUsages(options.KeyUsages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	extKeyUsages := requestedExtKeyUsages
+	if len(options.ExtendedKeyUsages) > 0 {
+		extKeyUsages, err = ParseExtendedKeyUsages(options.ExtendedKeyUsages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if keyUsage == 0 {
+		if options.IsCA {
+			keyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+		} else {
+			keyUsage = getDefaultEndEntityKeyUsage(csr.PublicKey)
+		}
+	}
+
+	if len(extKeyUsages) == 0 && !options.IsCA {
+		extKeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	}
+
+	notBefore := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               csr.Subject,
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(options.GetValidityDurationOrDefault()),
+		KeyUsage:              keyUsage,
+		ExtKeyUsage:           extKeyUsages,
+		BasicConstraintsValid: true,
+		IsCA:                  options.IsCA,
+	}
+
+	if options.IsCA {
+		template.MaxPathLen = 0
+		template.MaxPathLenZero = true
+	}
+
+	if !options.IgnoreRequestedSans {
+		template.DNSNames = csr.DNSNames
+		template.IPAddresses = csr.IPAddresses
+		template.EmailAddresses = csr.EmailAddresses
+		template.URIs = csr.URIs
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, csr.PublicKey, caKey)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to sign certificate signing request for '%s': %w", csr.Subject.String(), err)
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse signed certificate: %w", err)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Sign certificate signing request finished. Subject: '%s', Issuer: '%s'.", cert.Subject.String(), cert.Issuer.String())
+
+	return cert, nil
+}
@@ -0,0 +1,235 @@
+package genericx509utils_test
+
+import (
+	"crypto/x509"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func getDefaultCsrOptions() *x509options.X509CreateCertificateSigningRequestOptions {
+	return &x509options.X509CreateCertificateSigningRequestOptions{
+		CountryName:       "CH",
+		Locality:          "Zurich",
+		Organization:      "CsrOrg",
+		CommonName:        "csr.example.com",
+		AdditionalSans:    []string{"alt.example.com", "10.0.0.1"},
+		KeyUsages:         []string{"digitalSignature", "keyEncipherment"},
+		ExtendedKeyUsages: []string{"clientAuth"},
+		PrivateKeySize:    2048,
+	}
+}
+
+func generateOpensslCsrPEM(t *testing.T) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	keyPath := filepath.Join(tmpDir, "key.pem")
+	csrPath := filepath.Join(tmpDir, "request.csr")
+
+	cmd := exec.Command(
+		"openssl", "req", "-new",
+		"-newkey", "rsa:2048",
+		"-keyout", keyPath,
+		"-out", csrPath,
+		"-nodes",
+		"-subj", "/C=CH/O=OpensslOrg/CN=openssl.example.com",
+		"-addext", "subjectAltName=DNS:openssl.example.com,DNS:www.openssl.example.com,IP:192.168.1.1",
+		"-addext", "keyUsage=critical,digitalSignature",
+		"-addext", "extendedKeyUsage=serverAuth",
+	)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "openssl command failed: %s", string(output))
+
+	csrPEM, err := os.ReadFile(csrPath)
+	require.NoError(t, err)
+
+	return string(csrPEM)
+}
+
+func Test_CreateCertificateSigningRequest(t *testing.T) {
+	t.Run("nil options", func(t *testing.T) {
+		csr, _, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(contextutils.ContextVerbose(), nil)
+		require.Error(t, err)
+		require.Nil(t, csr)
+	})
+
+	t.Run("empty common name", func(t *testing.T) {
+		csr, _, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(contextutils.ContextVerbose(), &x509options.X509CreateCertificateSigningRequestOptions{PrivateKeySize: 2048})
+		require.Error(t, err)
+		require.Nil(t, csr)
+	})
+
+	t.Run("unknown key usage", func(t *testing.T) {
+		options := getDefaultCsrOptions()
+		options.KeyUsages = []string{"unknownUsage"}
+		csr, _, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(contextutils.ContextVerbose(), options)
+		require.Error(t, err)
+		require.Nil(t, csr)
+	})
+
+	t.Run("with sans and key usages", func(t *testing.T) {
+		csr, key, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(contextutils.ContextVerbose(), getDefaultCsrOptions())
+		require.NoError(t, err)
+		require.NotNil(t, key)
+		require.NoError(t, csr.CheckSignature())
+
+		sans, err := genericx509utils.GetCertificateSigningRequestSans(csr)
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"csr.example.com", "alt.example.com", "10.0.0.1"}, sans)
+
+		keyUsage, extKeyUsages, err := genericx509utils.GetCertificateSigningRequestKeyUsages(csr)
+		require.NoError(t, err)
+		require.EqualValues(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, keyUsage)
+		require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, extKeyUsages)
+
+		infoString, err := genericx509utils.GetCertificateSigningRequestInfoString(csr)
+		require.NoError(t, err)
+		require.EqualValues(
+			t,
+			"CN: csr.example.com, SANs: csr.example.com, alt.example.com, 10.0.0.1, Key Usage: digitalSignature, keyEncipherment, Extended Key Usage: clientAuth, Public Key Algorithm: RSA",
+			infoString,
+		)
+	})
+}
+
+func Test_CertificateSigningRequestPEMRoundTrip(t *testing.T) {
+	csr, _, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(contextutils.ContextVerbose(), getDefaultCsrOptions())
+	require.NoError(t, err)
+
+	csrPEM, err := genericx509utils.WriteCertificateSigningRequestAsPEMString(csr)
+	require.NoError(t, err)
+	require.Contains(t, csrPEM, "-----BEGIN CERTIFICATE REQUEST-----")
+
+	readCsr, err := genericx509utils.ReadCertificateSigningRequestFromString(csrPEM)
+	require.NoError(t, err)
+	require.EqualValues(t, csr.Raw, readCsr.Raw)
+
+	// Validate openssl accepts the generated certificate signing request:
+	csrPath := filepath.Join(t.TempDir(), "request.csr")
+	require.NoError(t, os.WriteFile(csrPath, []byte(csrPEM), 0o600))
+
+	output, err := exec.Command("openssl", "req", "-in", csrPath, "-noout", "-verify").CombinedOutput()
+	require.NoError(t, err, "openssl verify failed: %s", string(output))
+}
+
+func Test_ReadCertificateSigningRequestFromString(t *testing.T) {
+	t.Run("empty string", func(t *testing.T) {
+		csr, err := genericx509utils.ReadCertificateSigningRequestFromString("")
+		require.Error(t, err)
+		require.Nil(t, csr)
+	})
+
+	t.Run("certificate instead of csr", func(t *testing.T) {
+		csr, err := genericx509utils.ReadCertificateSigningRequestFromString(generateSelfSignedCertPEM(t, "/CN=NotACsr"))
+		require.Error(t, err)
+		require.Nil(t, csr)
+	})
+
+	t.Run("openssl generated csr", func(t *testing.T) {
+		csr, err := genericx509utils.ReadCertificateSigningRequestFromString(generateOpensslCsrPEM(t))
+		require.NoError(t, err)
+		require.EqualValues(t, "openssl.example.com", csr.Subject.CommonName)
+
+		sans, err := genericx509utils.GetCertificateSigningRequestSans(csr)
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"openssl.example.com", "www.openssl.example.com", "192.168.1.1"}, sans)
+
+		keyUsage, extKeyUsages, err := genericx509utils.GetCertificateSigningRequestKeyUsages(csr)
+		require.NoError(t, err)
+		require.EqualValues(t, x509.KeyUsageDigitalSignature, keyUsage)
+		require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, extKeyUsages)
+	})
+}
+
+func Test_SignCertificateSigningRequest(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+
+	rootCaPair, err := genericx509utils.CreateRootCaCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CountryName:    "CH",
+		Organization:   "CsrRootOrg",
+		CommonName:     "CsrRootCA",
+		PrivateKeySize: 2048,
+	})
+	require.NoError(t, err)
+
+	rootCert, err := rootCaPair.GetX509Certificate()
+	require.NoError(t, err)
+
+	t.Run("nil csr", func(t *testing.T) {
+		cert, err := genericx509utils.SignCertificateSigningRequest(ctx, nil, rootCaPair, nil)
+		require.Error(t, err)
+		require.Nil(t, cert)
+	})
+
+	t.Run("use requested values", func(t *testing.T) {
+		csr, key, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, getDefaultCsrOptions())
+		require.NoError(t, err)
+
+		cert, err := genericx509utils.SignCertificateSigningRequest(ctx, csr, rootCaPair, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, []string{"csr.example.com", "alt.example.com"}, cert.DNSNames)
+		require.EqualValues(t, "10.0.0.1", cert.IPAddresses[0].String())
+		require.EqualValues(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, cert.KeyUsage)
+		require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+		require.False(t, cert.IsCA)
+
+		isSigned, err := genericx509utils.IsSignedBy(ctx, cert, rootCert)
+		require.NoError(t, err)
+		require.True(t, isSigned)
+
+		isMatching, err := genericx509utils.IsCertificateMatchingPrivateKey(cert, key)
+		require.NoError(t, err)
+		require.True(t, isMatching)
+	})
+
+	t.Run("override by signing options", func(t *testing.T) {
+		csr, _, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, getDefaultCsrOptions())
+		require.NoError(t, err)
+
+		cert, err := genericx509utils.SignCertificateSigningRequest(ctx, csr, rootCaPair, &x509options.X509SignCertificateSigningRequestOptions{
+			SerialNumber:        "1234",
+			ExtendedKeyUsages:   []string{"serverAuth"},
+			IgnoreRequestedSans: true,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, "1234", cert.SerialNumber.String())
+		require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+		require.Empty(t, cert.IPAddresses)
+	})
+
+	t.Run("openssl generated csr", func(t *testing.T) {
+		csr, err := genericx509utils.ReadCertificateSigningRequestFromString(generateOpensslCsrPEM(t))
+		require.NoError(t, err)
+
+		cert, err := genericx509utils.SignCertificateSigningRequest(ctx, csr, rootCaPair, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, "openssl.example.com", cert.Subject.CommonName)
+		require.EqualValues(t, []string{"openssl.example.com", "www.openssl.example.com"}, cert.DNSNames)
+
+		isSigned, err := genericx509utils.IsSignedBy(ctx, cert, rootCert)
+		require.NoError(t, err)
+		require.True(t, isSigned)
+	})
+
+	t.Run("signing certificate is not a CA", func(t *testing.T) {
+		csr, _, err := genericx509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, getDefaultCsrOptions())
+		require.NoError(t, err)
+
+		endEntityPair, err := genericx509utils.CreateSignedEndEntityCertificate(ctx, &x509options.X509CreateCertificateOptions{
+			CommonName:     "endentity.example.com",
+			PrivateKeySize: 2048,
+		}, rootCaPair)
+		require.NoError(t, err)
+
+		cert, err := genericx509utils.SignCertificateSigningRequest(ctx, csr, endEntityPair, nil)
+		require.Error(t, err)
+		require.Nil(t, cert)
+	})
+}
@@ -10,6 +10,8 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
 	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
@@ -25,11 +27,9 @@ func CreateRootCaCertificate(ctx context.Context, options *x509options.X509Creat
 
 	logging.LogInfoByCtx(ctx, "Create root CA certificate started.")
 
-	privateKeySize := getPrivateKeySize(options)
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	privateKey, publicKey, err := generatePrivateKey(ctx, options.KeyAlgorithm, options.PrivateKeySize)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+		return nil, err
 	}
 
 	serialNumber, err := generateSerialNumber()
@@ -51,7 +51,7 @@ func CreateRootCaCertificate(ctx context.Context, options *x509options.X509Creat
 		MaxPathLen:            -1,
 	}
 
-	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, privateKey)
 	if err != nil {
 		return nil, tracederrors.TracedErrorf("Failed to create root CA certificate: %w", err)
 	}
@@ -76,11 +76,9 @@ func CreateIntermediateCertificate(ctx context.Context, options *x509options.X50
 
 	logging.LogInfoByCtx(ctx, "Create self-signed intermediate certificate started.")
 
-	privateKeySize := getPrivateKeySize(options)
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	privateKey, publicKey, err := generatePrivateKey(ctx, options.KeyAlgorithm, options.PrivateKeySize)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+		return nil, err
 	}
 
 	serialNumber, err := generateSerialNumber()
@@ -103,7 +101,7 @@ func CreateIntermediateCertificate(ctx context.Context, options *x509options.X50
 		MaxPathLenZero:        true,
 	}
 
-	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, privateKey)
 	if err != nil {
 		return nil, tracederrors.TracedErrorf("Failed to create intermediate certificate: %w", err)
 	}
@@ -128,11 +126,9 @@ func CreateSelfSignedCertificate(ctx context.Context, options *x509options.X509C
 
 	logging.LogInfoByCtx(ctx, "Create self-signed certificate started.")
 
-	privateKeySize := getPrivateKeySize(options)
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	privateKey, publicKey, err := generatePrivateKey(ctx, options.KeyAlgorithm, options.PrivateKeySize)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+		return nil, err
 	}
 
 	serialNumber, err := generateSerialNumber()
@@ -148,7 +144,7 @@ func CreateSelfSignedCertificate(ctx context.Context, options *x509options.X509C
 		Issuer:                subject,
 		NotBefore:             time.Now(),
 		NotAfter:              time.Now().Add(time.Duration(defaultValidityDays) * 24 * time.Hour),
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		KeyUsage:              getDefaultEndEntityKeyUsage(publicKey),
 		BasicConstraintsValid: true,
 		IsCA:                  false,
 	}
@@ -157,7 +153,7 @@ func CreateSelfSignedCertificate(ctx context.Context, options *x509options.X509C
 		template.DNSNames = []string{options.CommonName}
 	}
 
-	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, privateKey)
 	if err != nil {
 		return nil, tracederrors.TracedErrorf("Failed to create self-signed certificate: %w", err)
 	}
@@ -196,11 +192,9 @@ func CreateSignedIntermediateCertificate(ctx context.Context, options *x509optio
 		return nil, err
 	}
 
-	privateKeySize := getPrivateKeySize(options)
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	privateKey, publicKey, err := generatePrivateKey(ctx, options.KeyAlgorithm, options.PrivateKeySize)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+		return nil, err
 	}
 
 	serialNumber, err := generateSerialNumber()
@@ -222,7 +216,7 @@ func CreateSignedIntermediateCertificate(ctx context.Context, options *x509optio
 		MaxPathLenZero:        true,
 	}
 
-	certDER, err := x509.CreateCertificate(rand.Reader, template, rootCert, &privateKey.PublicKey, rootKey)
+	certDER, err := x509.CreateCertificate(rand.Reader, template, rootCert, publicKey, rootKey)
 	if err != nil {
 		return nil, tracederrors.TracedErrorf("Failed to create signed intermediate certificate: %w", err)
 	}
@@ -261,11 +255,9 @@ func CreateSignedEndEntityCertificate(ctx context.Context, options *x509options.
 		return nil, err
 	}
 
-	privateKeySize := getPrivateKeySize(options)
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, privateKeySize)
+	privateKey, publicKey, err := generatePrivateKey(ctx, options.KeyAlgorithm, options.PrivateKeySize)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+		return nil, err
 	}
 
 	serialNumber, err := generateSerialNumber()
@@ -280,7 +272,7 @@ func CreateSignedEndEntityCertificate(ctx context.Context, options *x509options.
 		Subject:               subject,
 		NotBefore:             time.Now(),
 		NotAfter:              time.Now().Add(time.Duration(defaultValidityDays) * 24 * time.Hour),
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		KeyUsage:              getDefaultEndEntityKeyUsage(publicKey),
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 		BasicConstraintsValid: true,
 		IsCA:                  false,
@@ -294,7 +286,7 @@ func CreateSignedEndEntityCertificate(ctx context.Context, options *x509options.
 		template.DNSNames = append(template.DNSNames, options.AdditionalSans...)
 	}
 
-	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &privateKey.PublicKey, caKey)
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, publicKey, caKey)
 	if err != nil {
 		return nil, tracederrors.TracedErrorf("Failed to create signed end entity certificate: %w", err)
 	}
@@ -314,12 +306,41 @@ func CreateSignedEndEntityCertificate(ctx context.Context, options *x509options.
 
 // --- Helper functions ---
 
-func getPrivateKeySize(options *x509options.X509CreateCertificateOptions) int {
-	if options.PrivateKeySize > 0 {
-		return options.PrivateKeySize
+// generatePrivateKey generates a private key using keyAlgorithm.
+// If no keyAlgorithm is set a RSA key of privateKeySize (or defaultPrivateKeySize if unset) is generated.
+func generatePrivateKey(ctx context.Context, keyAlgorithm string, privateKeySize int) (crypto.PrivateKey, crypto.PublicKey, error) {
+	var privateKey crypto.PrivateKey
+	var err error
+
+	if keyAlgorithm == "" {
+		if privateKeySize <= 0 {
+			privateKeySize = defaultPrivateKeySize
+		}
+
+		privateKey, err = cryptoutils.GenerateRsaPrivateKey(ctx, privateKeySize)
+	} else {
+		privateKey, err = cryptoutils.GeneratePrivateKey(ctx, keyAlgorithm)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := cryptoutils.GetPublicKeyFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return defaultPrivateKeySize
+	return privateKey, publicKey, nil
+}
+
+// getDefaultEndEntityKeyUsage returns the key usage for end entity certificates.
+// Key encipherment is only possible with RSA keys.
+func getDefaultEndEntityKeyUsage(publicKey crypto.PublicKey) x509.KeyUsage {
+	if _, ok := publicKey.(*rsa.PublicKey); ok {
+		return x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	}
+
+	return x509.KeyUsageDigitalSignature
 }
 
 func buildPkixName(options *x509options.X509CreateCertificateOptions) pkix.Name {
@@ -357,12 +378,31 @@ func generateSerialNumber() (*big.Int, error) {
 func GeneratePrivateKey(ctx context.Context) (crypto.PrivateKey, error) {
 	logging.LogInfoByCtx(ctx, "Generate private key started.")
 
-	privateKey, err := rsa.GenerateKey(rand.Reader, defaultPrivateKeySize)
+	privateKey, err := cryptoutils.GenerateRsaPrivateKey(contextutils.WithSilent(ctx), defaultPrivateKeySize)
 	if err != nil {
-		return nil, tracederrors.TracedErrorf("Failed to generate RSA private key: %w", err)
+		return nil, err
 	}
 
 	logging.LogInfoByCtx(ctx, "Generate private key finished.")
 
 	return privateKey, nil
 }
+
+// GeneratePrivateKeyWithAlgorithm generates a private key using the given keyAlgorithm like "rsa-2048", "ecdsa-p256" or "ed25519".
+// See cryptoutils.GetSupportedKeyAlgorithms for all supported key algorithms.
+func GeneratePrivateKeyWithAlgorithm(ctx context.Context, keyAlgorithm string) (crypto.PrivateKey, error) {
+	if keyAlgorithm == "" {
+		return nil, tracederrors.TracedErrorEmptyString("keyAlgorithm")
+	}
+
+	logging.LogInfoByCtxf(ctx, "Generate '%s' private key started.", keyAlgorithm)
+
+	privateKey, err := cryptoutils.GeneratePrivateKey(contextutils.WithSilent(ctx), keyAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Generate '%s' private key finished.", keyAlgorithm)
+
+	return privateKey, nil
+}
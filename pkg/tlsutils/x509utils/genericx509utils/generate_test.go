@@ -2,11 +2,16 @@ package genericx509utils_test
 
 import (
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
 	"crypto/x509"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
 )
@@ -641,3 +646,84 @@ func Test_GeneratePrivateKey(t *testing.T) {
 		require.NotNil(t, pubKey)
 	})
 }
+
+func Test_GeneratePrivateKeyWithAlgorithm(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+
+	t.Run("empty key algorithm returns error", func(t *testing.T) {
+		key, err := genericx509utils.GeneratePrivateKeyWithAlgorithm(ctx, "")
+		require.Error(t, err)
+		require.Nil(t, key)
+	})
+
+	t.Run("ecdsa p384", func(t *testing.T) {
+		key, err := genericx509utils.GeneratePrivateKeyWithAlgorithm(ctx, cryptoutils.KEY_ALGORITHM_ECDSA_P384)
+		require.NoError(t, err)
+
+		ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+		require.True(t, ok)
+		require.Equal(t, elliptic.P384(), ecdsaKey.Curve)
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		key, err := genericx509utils.GeneratePrivateKeyWithAlgorithm(ctx, cryptoutils.KEY_ALGORITHM_ED25519)
+		require.NoError(t, err)
+
+		_, ok := key.(ed25519.PrivateKey)
+		require.True(t, ok)
+	})
+}
+
+func Test_CreateCertificatesWithKeyAlgorithm(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+
+	t.Run("ecdsa end entity signed by ed25519 root", func(t *testing.T) {
+		rootOptions := getDefaultRootCaOptions()
+		rootOptions.KeyAlgorithm = cryptoutils.KEY_ALGORITHM_ED25519
+		rootPair, err := genericx509utils.CreateRootCaCertificate(ctx, rootOptions)
+		require.NoError(t, err)
+		require.Equal(t, x509.Ed25519, rootPair.Cert.PublicKeyAlgorithm)
+
+		eeOptions := getDefaultEndEntityOptions()
+		eeOptions.KeyAlgorithm = cryptoutils.KEY_ALGORITHM_ECDSA_P256
+		eePair, err := genericx509utils.CreateSignedEndEntityCertificate(ctx, eeOptions, rootPair)
+		require.NoError(t, err)
+		require.Equal(t, x509.ECDSA, eePair.Cert.PublicKeyAlgorithm)
+		require.Equal(t, x509.PureEd25519, eePair.Cert.SignatureAlgorithm)
+
+		// Key encipherment is only set for RSA keys:
+		require.Equal(t, x509.KeyUsageDigitalSignature, eePair.Cert.KeyUsage)
+
+		isSigned, err := genericx509utils.IsSignedBy(ctx, eePair.Cert, rootPair.Cert)
+		require.NoError(t, err)
+		require.True(t, isSigned)
+
+		isMatching, err := genericx509utils.IsCertificateMatchingPrivateKey(eePair.Cert, eePair.Key)
+		require.NoError(t, err)
+		require.True(t, isMatching)
+
+		isMatching, err = genericx509utils.IsCertificateMatchingPrivateKey(eePair.Cert, rootPair.Key)
+		require.NoError(t, err)
+		require.False(t, isMatching)
+	})
+
+	t.Run("rsa 3072 self signed", func(t *testing.T) {
+		options := getDefaultSelfSignedOptions()
+		options.KeyAlgorithm = cryptoutils.KEY_ALGORITHM_RSA_3072
+		pair, err := genericx509utils.CreateSelfSignedCertificate(ctx, options)
+		require.NoError(t, err)
+
+		rsaKey, ok := pair.Key.(*rsa.PrivateKey)
+		require.True(t, ok)
+		require.Equal(t, 3072, rsaKey.N.BitLen())
+		require.Equal(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, pair.Cert.KeyUsage)
+	})
+
+	t.Run("unsupported key algorithm", func(t *testing.T) {
+		options := getDefaultIntermediateOptions()
+		options.KeyAlgorithm = "dsa-1024"
+		pair, err := genericx509utils.CreateIntermediateCertificate(ctx, options)
+		require.Error(t, err)
+		require.Nil(t, pair)
+	})
+}
@@ -0,0 +1,236 @@
+package genericx509utils
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Constants of the Java KeyStore (JKS) file format:
+const jksMagic uint32 = 0xFEEDFEED
+const jksVersion uint32 = 2
+const jksTagPrivateKeyEntry uint32 = 1
+const jksTagTrustedCertificateEntry uint32 = 2
+const jksDigestWhitener = "Mighty Aphrodite"
+
+var jksAliasInvalidCharsRegex = regexp.MustCompile(`[^a-z0-9.\-_]+`)
+
+// EncodeJksTrustStore encodes the given certs as Java KeyStore (JKS) truststore protected by password.
+// The alias of every certificate is derived from its common name.
+func EncodeJksTrustStore(certs []*x509.Certificate, password string) ([]byte, error) {
+	if len(certs) == 0 {
+		return nil, tracederrors.TracedError("No certs to encode as JKS truststore given.")
+	}
+
+	if password == "" {
+		return nil, tracederrors.TracedErrorEmptyString("password")
+	}
+
+	body := new(bytes.Buffer)
+	writeUint32 := func(value uint32) {
+		_ = binary.Write(body, binary.BigEndian, value)
+	}
+
+	writeUint32(jksMagic)
+	writeUint32(jksVersion)
+	writeUint32(uint32(len(certs)))
+
+	timestamp := time.Now().UnixMilli()
+	usedAliases := map[string]bool{}
+
+	for i, cert := range certs {
+		if cert == nil {
+			return nil, tracederrors.TracedErrorf("cert at index %d is nil", i)
+		}
+
+		alias := getJksAlias(cert, i, usedAliases)
+
+		writeUint32(jksTagTrustedCertificateEntry)
+		err := writeJksUTF(body, alias)
+		if err != nil {
+			return nil, err
+		}
+
+		_ = binary.Write(body, binary.BigEndian, timestamp)
+
+		err = writeJksUTF(body, "X.509")
+		if err != nil {
+			return nil, err
+		}
+
+		writeUint32(uint32(len(cert.Raw)))
+		body.Write(cert.Raw)
+	}
+
+	digest := getJksDigest(password, body.Bytes())
+	body.Write(digest)
+
+	return body.Bytes(), nil
+}
+
+// DecodeJksTrustStore decodes a Java KeyStore (JKS) truststore and returns all included trusted certificates.
+// The integrity of the truststore is validated using the password.
+func DecodeJksTrustStore(jksData []byte, password string) ([]*x509.Certificate, error) {
+	if password == "" {
+		return nil, tracederrors.TracedErrorEmptyString("password")
+	}
+
+	if len(jksData) < 12+sha1.Size {
+		return nil, tracederrors.TracedErrorf("jksData with %d bytes is too short for a JKS truststore.", len(jksData))
+	}
+
+	body := jksData[:len(jksData)-sha1.Size]
+	expectedDigest := jksData[len(jksData)-sha1.Size:]
+	if subtle.ConstantTimeCompare(getJksDigest(password, body), expectedDigest) != 1 {
+		return nil, tracederrors.TracedError("JKS truststore integrity check failed. Wrong password or corrupted truststore.")
+	}
+
+	reader := bytes.NewReader(body)
+	var magic, version, count uint32
+	for _, v := range []*uint32{&magic, &version, &count} {
+		err := binary.Read(reader, binary.BigEndian, v)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to read JKS header: %w", err)
+		}
+	}
+
+	if magic != jksMagic {
+		return nil, tracederrors.TracedErrorf("Invalid JKS magic '%x'.", magic)
+	}
+
+	if version != 1 && version != jksVersion {
+		return nil, tracederrors.TracedErrorf("Unsupported JKS version '%d'.", version)
+	}
+
+	certs := []*x509.Certificate{}
+	for i := uint32(0); i < count; i++ {
+		var tag uint32
+		err := binary.Read(reader, binary.BigEndian, &tag)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to read JKS entry tag: %w", err)
+		}
+
+		if tag == jksTagPrivateKeyEntry {
+			return nil, tracederrors.TracedError("JKS contains a private key entry. Only truststores are supported.")
+		}
+
+		if tag != jksTagTrustedCertificateEntry {
+			return nil, tracederrors.TracedErrorf("Unknown JKS entry tag '%d'.", tag)
+		}
+
+		_, err = readJksUTF(reader) // alias
+		if err != nil {
+			return nil, err
+		}
+
+		var timestamp int64
+		err = binary.Read(reader, binary.BigEndian, &timestamp)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to read JKS entry timestamp: %w", err)
+		}
+
+		if version == jksVersion {
+			certType, err := readJksUTF(reader)
+			if err != nil {
+				return nil, err
+			}
+
+			if certType != "X.509" {
+				return nil, tracederrors.TracedErrorf("Unsupported certificate type '%s' in JKS.", certType)
+			}
+		}
+
+		var certLength uint32
+		err = binary.Read(reader, binary.BigEndian, &certLength)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to read JKS certificate length: %w", err)
+		}
+
+		if int64(certLength) > int64(reader.Len()) {
+			return nil, tracederrors.TracedErrorf("JKS certificate length %d exceeds remaining data.", certLength)
+		}
+
+		certDER := make([]byte, certLength)
+		_, err = reader.Read(certDER)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to read JKS certificate: %w", err)
+		}
+
+		cert, err := x509.ParseCertificate(certDER)
+		if err != nil {
+			return nil, tracederrors.TracedErrorf("Failed to parse certificate in JKS: %w", err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	return certs, nil
+}
+
+// getJksDigest calculates the integrity digest of a JKS file as done by the Java keytool.
+func getJksDigest(password string, body []byte) []byte {
+	hash := sha1.New()
+	for _, c := range utf16.Encode([]rune(password)) {
+		hash.Write([]byte{byte(c >> 8), byte(c)})
+	}
+	hash.Write([]byte(jksDigestWhitener))
+	hash.Write(body)
+
+	return hash.Sum(nil)
+}
+
+func getJksAlias(cert *x509.Certificate, index int, usedAliases map[string]bool) string {
+	alias := strings.ToLower(strings.TrimSpace(cert.Subject.CommonName))
+	alias = strings.Trim(jksAliasInvalidCharsRegex.ReplaceAllString(alias, "-"), "-")
+	if alias == "" {
+		alias = fmt.Sprintf("cert-%d", index)
+	}
+
+	uniqueAlias := alias
+	for i := 1; usedAliases[uniqueAlias]; i++ {
+		uniqueAlias = fmt.Sprintf("%s-%d", alias, i)
+	}
+	usedAliases[uniqueAlias] = true
+
+	return uniqueAlias
+}
+
+func writeJksUTF(buf *bytes.Buffer, value string) error {
+	if len(value) > 0xFFFF {
+		return tracederrors.TracedErrorf("String '%s' too long to be written in JKS.", value)
+	}
+
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(value)))
+	buf.WriteString(value)
+
+	return nil
+}
+
+func readJksUTF(reader *bytes.Reader) (string, error) {
+	var length uint16
+	err := binary.Read(reader, binary.BigEndian, &length)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to read JKS string length: %w", err)
+	}
+
+	if int(length) > reader.Len() {
+		return "", tracederrors.TracedErrorf("JKS string length %d exceeds remaining data.", length)
+	}
+
+	value := make([]byte, length)
+	_, err = reader.Read(value)
+	if err != nil {
+		return "", tracederrors.TracedErrorf("Failed to read JKS string: %w", err)
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,70 @@
+package genericx509utils_test
+
+import (
+	"crypto/x509"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+)
+
+func Test_EncodeJksTrustStore(t *testing.T) {
+	rootPair, intPair, _ := createTestChain(t)
+
+	t.Run("no certs", func(t *testing.T) {
+		jksData, err := genericx509utils.EncodeJksTrustStore(nil, "changeit")
+		require.Error(t, err)
+		require.Nil(t, jksData)
+	})
+
+	t.Run("empty password", func(t *testing.T) {
+		jksData, err := genericx509utils.EncodeJksTrustStore([]*x509.Certificate{rootPair.Cert}, "")
+		require.Error(t, err)
+		require.Nil(t, jksData)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		jksData, err := genericx509utils.EncodeJksTrustStore([]*x509.Certificate{rootPair.Cert, intPair.Cert}, "changeit")
+		require.NoError(t, err)
+
+		// JKS magic and version 2:
+		require.EqualValues(t, 0xFEEDFEED, binary.BigEndian.Uint32(jksData[0:4]))
+		require.EqualValues(t, 2, binary.BigEndian.Uint32(jksData[4:8]))
+		require.EqualValues(t, 2, binary.BigEndian.Uint32(jksData[8:12]))
+
+		certs, err := genericx509utils.DecodeJksTrustStore(jksData, "changeit")
+		require.NoError(t, err)
+		require.Len(t, certs, 2)
+		require.True(t, certs[0].Equal(rootPair.Cert))
+		require.True(t, certs[1].Equal(intPair.Cert))
+	})
+
+	t.Run("duplicate common names", func(t *testing.T) {
+		jksData, err := genericx509utils.EncodeJksTrustStore([]*x509.Certificate{rootPair.Cert, rootPair.Cert}, "changeit")
+		require.NoError(t, err)
+
+		certs, err := genericx509utils.DecodeJksTrustStore(jksData, "changeit")
+		require.NoError(t, err)
+		require.Len(t, certs, 2)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		jksData, err := genericx509utils.EncodeJksTrustStore([]*x509.Certificate{rootPair.Cert}, "changeit")
+		require.NoError(t, err)
+
+		certs, err := genericx509utils.DecodeJksTrustStore(jksData, "wrong")
+		require.Error(t, err)
+		require.Nil(t, certs)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		jksData, err := genericx509utils.EncodeJksTrustStore([]*x509.Certificate{rootPair.Cert}, "changeit")
+		require.NoError(t, err)
+
+		jksData[20] ^= 0xFF
+		certs, err := genericx509utils.DecodeJksTrustStore(jksData, "changeit")
+		require.Error(t, err)
+		require.Nil(t, certs)
+	})
+}
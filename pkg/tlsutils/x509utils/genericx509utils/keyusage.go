@@ -0,0 +1,214 @@
+package genericx509utils
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Names of the key usages as used by openssl:
+var keyUsageNames = []struct {
+	name     string
+	keyUsage x509.KeyUsage
+}{
+	{"digitalSignature", x509.KeyUsageDigitalSignature},
+	{"contentCommitment", x509.KeyUsageContentCommitment},
+	{"keyEncipherment", x509.KeyUsageKeyEncipherment},
+	{"dataEncipherment", x509.KeyUsageDataEncipherment},
+	{"keyAgreement", x509.KeyUsageKeyAgreement},
+	{"certSign", x509.KeyUsageCertSign},
+	{"crlSign", x509.KeyUsageCRLSign},
+	{"encipherOnly", x509.KeyUsageEncipherOnly},
+	{"decipherOnly", x509.KeyUsageDecipherOnly},
+}
+
+// Names of the extended key usages as used by openssl:
+var extKeyUsageNames = []struct {
+	name        string
+	extKeyUsage x509.ExtKeyUsage
+	oid         asn1.ObjectIdentifier
+}{
+	{"serverAuth", x509.ExtKeyUsageServerAuth, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 1}},
+	{"clientAuth", x509.ExtKeyUsageClientAuth, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 2}},
+	{"codeSigning", x509.ExtKeyUsageCodeSigning, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 3}},
+	{"emailProtection", x509.ExtKeyUsageEmailProtection, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 4}},
+	{"timeStamping", x509.ExtKeyUsageTimeStamping, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 8}},
+	{"OCSPSigning", x509.ExtKeyUsageOCSPSigning, asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 9}},
+}
+
+var oidExtensionKeyUsage = asn1.ObjectIdentifier{2, 5, 29, 15}
+var oidExtensionExtendedKeyUsage = asn1.ObjectIdentifier{2, 5, 29, 37}
+
+// ParseKeyUsages converts key usage names like "digitalSignature" or "certSign" into the x509.KeyUsage bitmask.
+func ParseKeyUsages(names []string) (x509.KeyUsage, error) {
+	var keyUsage x509.KeyUsage
+
+	for _, name := range names {
+		found := false
+		for _, k := range keyUsageNames {
+			if k.name == name {
+				keyUsage |= k.keyUsage
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return 0, tracederrors.TracedErrorf("Unknown key usage '%s'.", name)
+		}
+	}
+
+	return keyUsage, nil
+}
+
+// GetKeyUsageNames returns the names of all key usages set in keyUsage.
+func GetKeyUsageNames(keyUsage x509.KeyUsage) []string {
+	names := []string{}
+	for _, k := range keyUsageNames {
+		if keyUsage&k.keyUsage != 0 {
+			names = append(names, k.name)
+		}
+	}
+
+	return names
+}
+
+// ParseExtendedKeyUsages converts extended key usage names like "serverAuth" or "clientAuth" into x509.ExtKeyUsage values.
+func ParseExtendedKeyUsages(names []string) ([]x509.ExtKeyUsage, error) {
+	extKeyUsages := []x509.ExtKeyUsage{}
+
+	for _, name := range names {
+		found := false
+		for _, e := range extKeyUsageNames {
+			if e.name == name {
+				extKeyUsages = append(extKeyUsages, e.extKeyUsage)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, tracederrors.TracedErrorf("Unknown extended key usage '%s'.", name)
+		}
+	}
+
+	return extKeyUsages, nil
+}
+
+// GetExtendedKeyUsageNames returns the names of the given extended key usages.
+// Unknown extended key usages are returned as "unknown".
+func GetExtendedKeyUsageNames(extKeyUsages []x509.ExtKeyUsage) []string {
+	names := []string{}
+	for _, extKeyUsage := range extKeyUsages {
+		name := "unknown"
+		for _, e := range extKeyUsageNames {
+			if e.extKeyUsage == extKeyUsage {
+				name = e.name
+				break
+			}
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// getKeyUsageExtension returns the key usage encoded as x509 extension.
+// Needed for certificate signing requests since x509.CertificateRequest has no KeyUsage field.
+func getKeyUsageExtension(keyUsage x509.KeyUsage) (pkix.Extension, error) {
+	// The bits in the DER bit string are numbered from the most significant bit:
+	var encoded [2]byte
+	for i := 0; i < 9; i++ {
+		if keyUsage&(1<<i) != 0 {
+			encoded[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+
+	bitLength := 16
+	for bitLength > 0 && encoded[(bitLength-1)/8]&(0x80>>((bitLength-1)%8)) == 0 {
+		bitLength--
+	}
+
+	value, err := asn1.Marshal(asn1.BitString{Bytes: encoded[:(bitLength+7)/8], BitLength: bitLength})
+	if err != nil {
+		return pkix.Extension{}, tracederrors.TracedErrorf("Failed to marshal key usage extension: %w", err)
+	}
+
+	return pkix.Extension{Id: oidExtensionKeyUsage, Critical: true, Value: value}, nil
+}
+
+// getExtendedKeyUsageExtension returns the extended key usages encoded as x509 extension.
+func getExtendedKeyUsageExtension(extKeyUsages []x509.ExtKeyUsage) (pkix.Extension, error) {
+	oids := []asn1.ObjectIdentifier{}
+	for _, extKeyUsage := range extKeyUsages {
+		found := false
+		for _, e := range extKeyUsageNames {
+			if e.extKeyUsage == extKeyUsage {
+				oids = append(oids, e.oid)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return pkix.Extension{}, tracederrors.TracedErrorf("Unsupported extended key usage '%d'.", extKeyUsage)
+		}
+	}
+
+	value, err := asn1.Marshal(oids)
+	if err != nil {
+		return pkix.Extension{}, tracederrors.TracedErrorf("Failed to marshal extended key usage extension: %w", err)
+	}
+
+	return pkix.Extension{Id: oidExtensionExtendedKeyUsage, Value: value}, nil
+}
+
+// getKeyUsagesFromExtensions returns the key usage and extended key usages found in the given extensions.
+func getKeyUsagesFromExtensions(extensions []pkix.Extension) (x509.KeyUsage, []x509.ExtKeyUsage, error) {
+	var keyUsage x509.KeyUsage
+	extKeyUsages := []x509.ExtKeyUsage{}
+
+	for _, extension := range extensions {
+		if extension.Id.Equal(oidExtensionKeyUsage) {
+			var bitString asn1.BitString
+			_, err := asn1.Unmarshal(extension.Value, &bitString)
+			if err != nil {
+				return 0, nil, tracederrors.TracedErrorf("Failed to unmarshal key usage extension: %w", err)
+			}
+
+			for i := 0; i < 9; i++ {
+				if bitString.At(i) != 0 {
+					keyUsage |= 1 << i
+				}
+			}
+		}
+
+		if extension.Id.Equal(oidExtensionExtendedKeyUsage) {
+			var oids []asn1.ObjectIdentifier
+			_, err := asn1.Unmarshal(extension.Value, &oids)
+			if err != nil {
+				return 0, nil, tracederrors.TracedErrorf("Failed to unmarshal extended key usage extension: %w", err)
+			}
+
+			for _, oid := range oids {
+				found := false
+				for _, e := range extKeyUsageNames {
+					if e.oid.Equal(oid) {
+						extKeyUsages = append(extKeyUsages, e.extKeyUsage)
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					return 0, nil, tracederrors.TracedErrorf("Unsupported extended key usage '%s'.", oid)
+				}
+			}
+		}
+	}
+
+	return keyUsage, extKeyUsages, nil
+}
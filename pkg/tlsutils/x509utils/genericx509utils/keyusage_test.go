@@ -0,0 +1,58 @@
+package genericx509utils_test
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+)
+
+func Test_ParseKeyUsages(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		keyUsage, err := genericx509utils.ParseKeyUsages(nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, keyUsage)
+	})
+
+	t.Run("multiple", func(t *testing.T) {
+		keyUsage, err := genericx509utils.ParseKeyUsages([]string{"digitalSignature", "keyEncipherment"})
+		require.NoError(t, err)
+		require.EqualValues(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, keyUsage)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := genericx509utils.ParseKeyUsages([]string{"unknownUsage"})
+		require.Error(t, err)
+	})
+}
+
+func Test_GetKeyUsageNames(t *testing.T) {
+	require.EqualValues(t, []string{}, genericx509utils.GetKeyUsageNames(0))
+	require.EqualValues(
+		t,
+		[]string{"digitalSignature", "certSign", "crlSign"},
+		genericx509utils.GetKeyUsageNames(x509.KeyUsageCRLSign|x509.KeyUsageDigitalSignature|x509.KeyUsageCertSign),
+	)
+}
+
+func Test_ParseExtendedKeyUsages(t *testing.T) {
+	t.Run("multiple", func(t *testing.T) {
+		extKeyUsages, err := genericx509utils.ParseExtendedKeyUsages([]string{"serverAuth", "clientAuth"})
+		require.NoError(t, err)
+		require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, extKeyUsages)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := genericx509utils.ParseExtendedKeyUsages([]string{"unknownUsage"})
+		require.Error(t, err)
+	})
+}
+
+func Test_GetExtendedKeyUsageNames(t *testing.T) {
+	require.EqualValues(
+		t,
+		[]string{"codeSigning", "OCSPSigning"},
+		genericx509utils.GetExtendedKeyUsageNames([]x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning, x509.ExtKeyUsageOCSPSigning}),
+	)
+}
@@ -0,0 +1,222 @@
+package genericx509utils
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/bigintutils"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"golang.org/x/crypto/ocsp"
+)
+
+// Content types used to transport OCSP requests and responses over HTTP as defined in RFC 6960.
+const OCSP_REQUEST_CONTENT_TYPE = "application/ocsp-request"
+const OCSP_RESPONSE_CONTENT_TYPE = "application/ocsp-response"
+
+// CreateOcspRequest creates a DER encoded OCSP request asking for the revocation status of cert issued by issuerCert.
+func CreateOcspRequest(cert *x509.Certificate, issuerCert *x509.Certificate) ([]byte, error) {
+	if cert == nil {
+		return nil, tracederrors.TracedErrorNil("cert")
+	}
+
+	if issuerCert == nil {
+		return nil, tracederrors.TracedErrorNil("issuerCert")
+	}
+
+	request, err := ocsp.CreateRequest(cert, issuerCert, &ocsp.RequestOptions{Hash: crypto.SHA256})
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create OCSP request for '%s': %w", cert.Subject.String(), err)
+	}
+
+	return request, nil
+}
+
+// CreateOcspResponse answers the DER encoded OCSP request using the revocation information in crl.
+// The response is signed directly by the CA in caCertAndKey which must have issued the crl.
+// Requests for certificates not issued by the CA are answered with the OCSP "unauthorized" error response.
+// Only RSA and ECDSA CA keys are supported.
+func CreateOcspResponse(ctx context.Context, request []byte, caCertAndKey *X509CertKeyPair, crl *x509.RevocationList) ([]byte, error) {
+	if request == nil {
+		return nil, tracederrors.TracedErrorNil("request")
+	}
+
+	if caCertAndKey == nil {
+		return nil, tracederrors.TracedErrorNil("caCertAndKey")
+	}
+
+	if crl == nil {
+		return nil, tracederrors.TracedErrorNil("crl")
+	}
+
+	caCert, err := caCertAndKey.GetX509Certificate()
+	if err != nil {
+		return nil, err
+	}
+
+	caKey, err := caCertAndKey.GetPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := caKey.(crypto.Signer)
+	if !ok {
+		return nil, tracederrors.TracedErrorf("Private key of type '%T' can not be used for signing.", caKey)
+	}
+
+	err = CheckRevocationListSignedBy(crl, caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedRequest, err := ocsp.ParseRequest(request)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse OCSP request: %w", err)
+	}
+
+	isIssuedByCa, err := isOcspRequestForIssuer(parsedRequest, caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isIssuedByCa {
+		logging.LogInfoByCtxf(ctx, "OCSP request for serial number '%s' is not for certificates issued by '%s'. Respond unauthorized.", parsedRequest.SerialNumber.String(), caCert.Subject.String())
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: parsedRequest.SerialNumber,
+		ThisUpdate:   time.Now().Add(-1 * time.Minute),
+		NextUpdate:   crl.NextUpdate,
+		IssuerHash:   parsedRequest.HashAlgorithm,
+	}
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.ReasonCode == revocationReasonCodeRemoveFromCrl {
+			continue
+		}
+
+		if bigintutils.EqualsInts(entry.SerialNumber, parsedRequest.SerialNumber) {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = entry.RevocationTime
+			template.RevocationReason = entry.ReasonCode
+			break
+		}
+	}
+
+	response, err := ocsp.CreateResponse(caCert, caCert, template, signer)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to create OCSP response: %w", err)
+	}
+
+	return response, nil
+}
+
+// ParseOcspResponse parses the DER encoded OCSP response for cert and validates its signature against issuerCert.
+// An error is returned if the response is not valid yet or outdated.
+func ParseOcspResponse(ctx context.Context, response []byte, cert *x509.Certificate, issuerCert *x509.Certificate) (*RevocationStatus, error) {
+	if response == nil {
+		return nil, tracederrors.TracedErrorNil("response")
+	}
+
+	if cert == nil {
+		return nil, tracederrors.TracedErrorNil("cert")
+	}
+
+	if issuerCert == nil {
+		return nil, tracederrors.TracedErrorNil("issuerCert")
+	}
+
+	parsed, err := ocsp.ParseResponseForCert(response, cert, issuerCert)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to parse OCSP response for '%s': %w", cert.Subject.String(), err)
+	}
+
+	err = checkRevocationInformationIsCurrent(fmt.Sprintf("OCSP response for '%s'", cert.Subject.String()), parsed.ThisUpdate, parsed.NextUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &RevocationStatus{
+		SerialNumber: parsed.SerialNumber,
+		ThisUpdate:   parsed.ThisUpdate,
+		NextUpdate:   parsed.NextUpdate,
+	}
+
+	switch parsed.Status {
+	case ocsp.Good:
+		status.Status = REVOCATION_STATUS_GOOD
+	case ocsp.Revoked:
+		if parsed.RevocationReason == revocationReasonCodeRemoveFromCrl {
+			// The certificate was taken off hold and is not revoked:
+			status.Status = REVOCATION_STATUS_GOOD
+			break
+		}
+
+		reason, err := GetRevocationReasonName(parsed.RevocationReason)
+		if err != nil {
+			return nil, err
+		}
+
+		status.Status = REVOCATION_STATUS_REVOKED
+		status.RevokedAt = parsed.RevokedAt
+		status.Reason = reason
+	case ocsp.Unknown:
+		status.Status = REVOCATION_STATUS_UNKNOWN
+	default:
+		return nil, tracederrors.TracedErrorf("Unexpected OCSP status '%d' in response for '%s'.", parsed.Status, cert.Subject.String())
+	}
+
+	logging.LogInfoByCtxf(ctx, "Revocation status of '%s' according to OCSP response is '%s'.", cert.Subject.String(), status.Status)
+
+	return status, nil
+}
+
+func isOcspRequestForIssuer(request *ocsp.Request, issuerCert *x509.Certificate) (bool, error) {
+	if !request.HashAlgorithm.Available() {
+		return false, tracederrors.TracedErrorf("Hash algorithm '%s' used in OCSP request is not available.", request.HashAlgorithm.String())
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	_, err := asn1.Unmarshal(issuerCert.RawSubjectPublicKeyInfo, &publicKeyInfo)
+	if err != nil {
+		return false, tracederrors.TracedErrorf("Failed to parse public key of '%s': %w", issuerCert.Subject.String(), err)
+	}
+
+	h := request.HashAlgorithm.New()
+	h.Write(issuerCert.RawSubject)
+	issuerNameHash := h.Sum(nil)
+
+	h.Reset()
+	h.Write(publicKeyInfo.PublicKey.RightAlign())
+	issuerKeyHash := h.Sum(nil)
+
+	return bytes.Equal(issuerNameHash, request.IssuerNameHash) && bytes.Equal(issuerKeyHash, request.IssuerKeyHash), nil
+}
+
+// GetOcspResponderUrl returns responderUrl if set. Otherwise the first OCSP server listed in cert is returned.
+func GetOcspResponderUrl(cert *x509.Certificate, responderUrl string) (string, error) {
+	if cert == nil {
+		return "", tracederrors.TracedErrorNil("cert")
+	}
+
+	if responderUrl != "" {
+		return responderUrl, nil
+	}
+
+	if len(cert.OCSPServer) <= 0 {
+		return "", tracederrors.TracedErrorf("No OCSP responder URL given and certificate '%s' does not list any OCSP server.", cert.Subject.String())
+	}
+
+	return cert.OCSPServer[0], nil
+}
@@ -0,0 +1,201 @@
+package genericx509utils_test
+
+import (
+	"crypto"
+	"crypto/x509"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"golang.org/x/crypto/ocsp"
+)
+
+func Test_OcspRequestAndResponse(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	rootPair, intPair, eePair := createTestChain(t)
+
+	otherEePair, err := genericx509utils.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), intPair)
+	require.NoError(t, err)
+
+	eeSerial, err := genericx509utils.GetSerialNumberAsString(eePair.Cert)
+	require.NoError(t, err)
+
+	crl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "superseded", nil)
+	require.NoError(t, err)
+
+	t.Run("nil cert", func(t *testing.T) {
+		request, err := genericx509utils.CreateOcspRequest(nil, intPair.Cert)
+		require.Error(t, err)
+		require.Nil(t, request)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		request, err := genericx509utils.CreateOcspRequest(eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+
+		response, err := genericx509utils.CreateOcspResponse(ctx, request, intPair, crl)
+		require.NoError(t, err)
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+		require.EqualValues(t, "superseded", status.Reason)
+		require.True(t, status.IsRevoked())
+		require.EqualValues(t, 0, status.SerialNumber.Cmp(eePair.Cert.SerialNumber))
+	})
+
+	t.Run("good", func(t *testing.T) {
+		request, err := genericx509utils.CreateOcspRequest(otherEePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+
+		response, err := genericx509utils.CreateOcspResponse(ctx, request, intPair, crl)
+		require.NoError(t, err)
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, otherEePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+		require.False(t, status.IsRevoked())
+	})
+
+	t.Run("response signature validated against issuer", func(t *testing.T) {
+		request, err := genericx509utils.CreateOcspRequest(otherEePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+
+		response, err := genericx509utils.CreateOcspResponse(ctx, request, intPair, crl)
+		require.NoError(t, err)
+
+		_, err = genericx509utils.ParseOcspResponse(ctx, response, otherEePair.Cert, rootPair.Cert)
+		require.Error(t, err)
+	})
+
+	t.Run("request for certificate of other ca", func(t *testing.T) {
+		request, err := genericx509utils.CreateOcspRequest(intPair.Cert, rootPair.Cert)
+		require.NoError(t, err)
+
+		response, err := genericx509utils.CreateOcspResponse(ctx, request, intPair, crl)
+		require.NoError(t, err)
+
+		_, err = genericx509utils.ParseOcspResponse(ctx, response, intPair.Cert, rootPair.Cert)
+		require.Error(t, err)
+	})
+
+	t.Run("crl of other ca", func(t *testing.T) {
+		request, err := genericx509utils.CreateOcspRequest(eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+
+		response, err := genericx509utils.CreateOcspResponse(ctx, request, rootPair, crl)
+		require.Error(t, err)
+		require.Nil(t, response)
+	})
+}
+
+func Test_ParseOcspResponse(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	_, intPair, eePair := createTestChain(t)
+
+	now := time.Now()
+	createResponse := func(t *testing.T, template ocsp.Response) []byte {
+		template.SerialNumber = eePair.Cert.SerialNumber
+		response, err := ocsp.CreateResponse(intPair.Cert, intPair.Cert, template, intPair.Key.(crypto.Signer))
+		require.NoError(t, err)
+		return response
+	}
+
+	t.Run("current", func(t *testing.T) {
+		response := createResponse(t, ocsp.Response{Status: ocsp.Good, ThisUpdate: now.Add(-time.Hour), NextUpdate: now.Add(time.Hour)})
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+	})
+
+	t.Run("next update in the past", func(t *testing.T) {
+		response := createResponse(t, ocsp.Response{Status: ocsp.Good, ThisUpdate: now.Add(-48 * time.Hour), NextUpdate: now.Add(-24 * time.Hour)})
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, eePair.Cert, intPair.Cert)
+		require.Error(t, err)
+		require.Nil(t, status)
+	})
+
+	t.Run("this update in the future", func(t *testing.T) {
+		response := createResponse(t, ocsp.Response{Status: ocsp.Good, ThisUpdate: now.Add(24 * time.Hour), NextUpdate: now.Add(48 * time.Hour)})
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, eePair.Cert, intPair.Cert)
+		require.Error(t, err)
+		require.Nil(t, status)
+	})
+
+	t.Run("revoked with reason remove from crl", func(t *testing.T) {
+		response := createResponse(t, ocsp.Response{Status: ocsp.Revoked, RevokedAt: now.Add(-2 * time.Hour), RevocationReason: ocsp.RemoveFromCRL, ThisUpdate: now.Add(-time.Hour), NextUpdate: now.Add(time.Hour)})
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+		require.False(t, status.IsRevoked())
+	})
+
+	t.Run("crl entry with reason remove from crl", func(t *testing.T) {
+		crl, err := genericx509utils.CreateRevocationList(ctx, intPair, []x509.RevocationListEntry{
+			{SerialNumber: eePair.Cert.SerialNumber, RevocationTime: time.Now().UTC(), ReasonCode: 8},
+		}, nil)
+		require.NoError(t, err)
+
+		request, err := genericx509utils.CreateOcspRequest(eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+
+		response, err := genericx509utils.CreateOcspResponse(ctx, request, intPair, crl)
+		require.NoError(t, err)
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, response, eePair.Cert, intPair.Cert)
+		require.NoError(t, err)
+		require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+	})
+}
+
+func Test_OcspOpensslInterop(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	_, intPair, eePair := createTestChain(t)
+
+	eeSerial, err := genericx509utils.GetSerialNumberAsString(eePair.Cert)
+	require.NoError(t, err)
+
+	crl, err := genericx509utils.RevokeCertificate(ctx, nil, intPair, eeSerial, "keyCompromise", nil)
+	require.NoError(t, err)
+
+	tmpDir := t.TempDir()
+	intPath := filepath.Join(tmpDir, "int.pem")
+	eePath := filepath.Join(tmpDir, "ee.pem")
+	requestPath := filepath.Join(tmpDir, "request.der")
+	responsePath := filepath.Join(tmpDir, "response.der")
+
+	intPem, err := genericx509utils.WriteCertificateAsPEMString(intPair.Cert)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(intPath, []byte(intPem), 0644))
+
+	eePem, err := genericx509utils.WriteCertificateAsPEMString(eePair.Cert)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(eePath, []byte(eePem), 0644))
+
+	// Let openssl create the request:
+	output, err := exec.Command("openssl", "ocsp", "-issuer", intPath, "-cert", eePath, "-no_nonce", "-reqout", requestPath).CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	request, err := os.ReadFile(requestPath)
+	require.NoError(t, err)
+
+	response, err := genericx509utils.CreateOcspResponse(ctx, request, intPair, crl)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(responsePath, response, 0644))
+
+	// Let openssl validate and parse the response:
+	output, err = exec.Command("openssl", "ocsp", "-respin", responsePath, "-issuer", intPath, "-cert", eePath, "-VAfile", intPath, "-no_nonce").CombinedOutput()
+	require.NoError(t, err, string(output))
+	require.Contains(t, string(output), "Response verify OK")
+	require.Contains(t, string(output), "revoked")
+	require.Contains(t, string(output), "keyCompromise")
+}
@@ -0,0 +1,73 @@
+package genericx509utils
+
+import (
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// EncodePkcs12 encodes the certificate and private key of certKeyPair together with the optional chain (intermediate and root CA certificates)
+// as password protected PKCS#12 bundle (".p12" or ".pfx" file) as used by e.g. Java services.
+func EncodePkcs12(certKeyPair *X509CertKeyPair, chain []*x509.Certificate, password string) ([]byte, error) {
+	if certKeyPair == nil {
+		return nil, tracederrors.TracedErrorNil("certKeyPair")
+	}
+
+	if password == "" {
+		return nil, tracederrors.TracedErrorEmptyString("password")
+	}
+
+	cert, err := certKeyPair.GetX509Certificate()
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := certKeyPair.GetPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = certKeyPair.CheckKeyMatchingCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range chain {
+		if c == nil {
+			return nil, tracederrors.TracedErrorf("chain certificate at index %d is nil", i)
+		}
+	}
+
+	pfxData, err := pkcs12.Modern.Encode(privateKey, cert, chain, password)
+	if err != nil {
+		return nil, tracederrors.TracedErrorf("Failed to encode PKCS#12 for '%s': %w", cert.Subject.String(), err)
+	}
+
+	return pfxData, nil
+}
+
+// DecodePkcs12 decodes a password protected PKCS#12 bundle.
+// Returns the certificate and private key as X509CertKeyPair and the included chain certificates.
+func DecodePkcs12(pfxData []byte, password string) (*X509CertKeyPair, []*x509.Certificate, error) {
+	if len(pfxData) == 0 {
+		return nil, nil, tracederrors.TracedError("pfxData is empty")
+	}
+
+	privateKey, cert, chain, err := pkcs12.DecodeChain(pfxData, password)
+	if err != nil {
+		return nil, nil, tracederrors.TracedErrorf("Failed to decode PKCS#12: %w", err)
+	}
+
+	certKeyPair := &X509CertKeyPair{
+		Cert: cert,
+		Key:  privateKey,
+	}
+
+	err = certKeyPair.CheckKeyMatchingCertificate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certKeyPair, chain, nil
+}
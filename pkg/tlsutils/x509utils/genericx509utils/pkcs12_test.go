@@ -0,0 +1,137 @@
+package genericx509utils_test
+
+import (
+	"crypto/x509"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/cryptoutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+)
+
+func createTestChain(t *testing.T) (rootPair *genericx509utils.X509CertKeyPair, intPair *genericx509utils.X509CertKeyPair, eePair *genericx509utils.X509CertKeyPair) {
+	t.Helper()
+
+	ctx := contextutils.ContextVerbose()
+
+	rootPair, err := genericx509utils.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+	require.NoError(t, err)
+
+	intPair, err = genericx509utils.CreateSignedIntermediateCertificate(ctx, getDefaultIntermediateOptions(), rootPair)
+	require.NoError(t, err)
+
+	eePair, err = genericx509utils.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), intPair)
+	require.NoError(t, err)
+
+	return rootPair, intPair, eePair
+}
+
+func Test_EncodePkcs12(t *testing.T) {
+	rootPair, intPair, eePair := createTestChain(t)
+
+	t.Run("nil cert key pair", func(t *testing.T) {
+		pfxData, err := genericx509utils.EncodePkcs12(nil, nil, "password")
+		require.Error(t, err)
+		require.Nil(t, pfxData)
+	})
+
+	t.Run("empty password", func(t *testing.T) {
+		pfxData, err := genericx509utils.EncodePkcs12(eePair, nil, "")
+		require.Error(t, err)
+		require.Nil(t, pfxData)
+	})
+
+	t.Run("key not matching certificate", func(t *testing.T) {
+		pfxData, err := genericx509utils.EncodePkcs12(&genericx509utils.X509CertKeyPair{Cert: eePair.Cert, Key: rootPair.Key}, nil, "password")
+		require.Error(t, err)
+		require.Nil(t, pfxData)
+	})
+
+	t.Run("round trip with chain", func(t *testing.T) {
+		pfxData, err := genericx509utils.EncodePkcs12(eePair, []*x509.Certificate{intPair.Cert, rootPair.Cert}, "secret")
+		require.NoError(t, err)
+
+		decoded, chain, err := genericx509utils.DecodePkcs12(pfxData, "secret")
+		require.NoError(t, err)
+		require.True(t, decoded.Cert.Equal(eePair.Cert))
+		require.Len(t, chain, 2)
+		require.True(t, chain[0].Equal(intPair.Cert))
+		require.True(t, chain[1].Equal(rootPair.Cert))
+
+		isEqual, err := cryptoutils.IsPrivateKeyEqual(eePair.Key, decoded.Key)
+		require.NoError(t, err)
+		require.True(t, isEqual)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		pfxData, err := genericx509utils.EncodePkcs12(eePair, nil, "secret")
+		require.NoError(t, err)
+
+		decoded, chain, err := genericx509utils.DecodePkcs12(pfxData, "wrong")
+		require.Error(t, err)
+		require.Nil(t, decoded)
+		require.Nil(t, chain)
+	})
+
+	t.Run("openssl can read encoded PKCS#12", func(t *testing.T) {
+		pfxData, err := genericx509utils.EncodePkcs12(eePair, []*x509.Certificate{intPair.Cert, rootPair.Cert}, "secret")
+		require.NoError(t, err)
+
+		p12Path := filepath.Join(t.TempDir(), "bundle.p12")
+		require.NoError(t, os.WriteFile(p12Path, pfxData, 0o600))
+
+		output, err := exec.Command("openssl", "pkcs12", "-in", p12Path, "-passin", "pass:secret", "-nokeys").CombinedOutput()
+		require.NoError(t, err, "openssl pkcs12 failed: %s", string(output))
+		require.EqualValues(t, 3, strings.Count(string(output), "-----BEGIN CERTIFICATE-----"))
+	})
+}
+
+func Test_DecodePkcs12(t *testing.T) {
+	t.Run("empty data", func(t *testing.T) {
+		decoded, chain, err := genericx509utils.DecodePkcs12(nil, "secret")
+		require.Error(t, err)
+		require.Nil(t, decoded)
+		require.Nil(t, chain)
+	})
+
+	t.Run("openssl generated PKCS#12", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		keyPath := filepath.Join(tmpDir, "key.pem")
+		certPath := filepath.Join(tmpDir, "cert.pem")
+		p12Path := filepath.Join(tmpDir, "bundle.p12")
+
+		output, err := exec.Command(
+			"openssl", "req", "-x509",
+			"-newkey", "rsa:2048",
+			"-keyout", keyPath,
+			"-out", certPath,
+			"-days", "1",
+			"-nodes",
+			"-subj", "/CN=openssl-p12.example.com",
+		).CombinedOutput()
+		require.NoError(t, err, "openssl req failed: %s", string(output))
+
+		output, err = exec.Command(
+			"openssl", "pkcs12", "-export",
+			"-inkey", keyPath,
+			"-in", certPath,
+			"-out", p12Path,
+			"-passout", "pass:secret",
+		).CombinedOutput()
+		require.NoError(t, err, "openssl pkcs12 failed: %s", string(output))
+
+		pfxData, err := os.ReadFile(p12Path)
+		require.NoError(t, err)
+
+		decoded, chain, err := genericx509utils.DecodePkcs12(pfxData, "secret")
+		require.NoError(t, err)
+		require.Empty(t, chain)
+		require.EqualValues(t, "openssl-p12.example.com", decoded.Cert.Subject.CommonName)
+		require.NoError(t, decoded.CheckKeyMatchingCertificate())
+	})
+}
@@ -0,0 +1,134 @@
+package genericx509utils
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/datatypes/bigintutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+const REVOCATION_STATUS_GOOD = "good"
+const REVOCATION_STATUS_REVOKED = "revoked"
+const REVOCATION_STATUS_UNKNOWN = "unknown"
+
+// Tolerated clock difference to the issuer when checking ThisUpdate and NextUpdate of CRLs and OCSP responses.
+const REVOCATION_INFORMATION_MAX_CLOCK_SKEW = 5 * time.Minute
+
+// Entries with this reason code suspend a certificate. The hold can be lifted or made final by a later CRL.
+const revocationReasonCodeCertificateHold = 6
+
+// Entries with this reason code remove a certificate previously put on hold. They do not revoke the certificate.
+const revocationReasonCodeRemoveFromCrl = 8
+
+// Names of the revocation reasons as defined in RFC 5280:
+var revocationReasonNames = []struct {
+	name string
+	code int
+}{
+	{"unspecified", 0},
+	{"keyCompromise", 1},
+	{"cACompromise", 2},
+	{"affiliationChanged", 3},
+	{"superseded", 4},
+	{"cessationOfOperation", 5},
+	{"certificateHold", revocationReasonCodeCertificateHold},
+	{"removeFromCRL", revocationReasonCodeRemoveFromCrl},
+	{"privilegeWithdrawn", 9},
+	{"aACompromise", 10},
+}
+
+// The revocation status of a certificate as reported by a CRL or an OCSP responder.
+type RevocationStatus struct {
+	// One of the REVOCATION_STATUS_* constants.
+	Status       string
+	SerialNumber *big.Int
+
+	// Only set if the certificate is revoked.
+	RevokedAt time.Time
+	Reason    string
+
+	// Validity of the information. NextUpdate is zero if not provided by the source.
+	ThisUpdate time.Time
+	NextUpdate time.Time
+}
+
+func (r *RevocationStatus) IsRevoked() bool {
+	return r.Status == REVOCATION_STATUS_REVOKED
+}
+
+func (r *RevocationStatus) GetInfoString() (string, error) {
+	if r.SerialNumber == nil {
+		return "", tracederrors.TracedError("SerialNumber not set")
+	}
+
+	serialNumber, err := bigintutils.ToHexStringColonSeparated(r.SerialNumber)
+	if err != nil {
+		return "", err
+	}
+
+	if r.IsRevoked() {
+		return fmt.Sprintf(
+			"Serial Number: %s, Status: %s, Revoked At: %s, Reason: %s",
+			serialNumber,
+			r.Status,
+			r.RevokedAt.UTC().Format(time.RFC1123),
+			r.Reason,
+		), nil
+	}
+
+	return fmt.Sprintf("Serial Number: %s, Status: %s", serialNumber, r.Status), nil
+}
+
+func GetSupportedRevocationReasons() []string {
+	names := []string{}
+	for _, r := range revocationReasonNames {
+		names = append(names, r.name)
+	}
+
+	return names
+}
+
+// ParseRevocationReason converts a revocation reason name like "keyCompromise" into the reason code defined in RFC 5280.
+// An empty reason is treated as "unspecified".
+func ParseRevocationReason(reason string) (int, error) {
+	if reason == "" {
+		return 0, nil
+	}
+
+	for _, r := range revocationReasonNames {
+		if r.name == reason {
+			return r.code, nil
+		}
+	}
+
+	return 0, tracederrors.TracedErrorf("Unknown revocation reason '%s'. Supported are: '%v'", reason, GetSupportedRevocationReasons())
+}
+
+// GetRevocationReasonName returns the name of the revocation reason code as defined in RFC 5280.
+func GetRevocationReasonName(reasonCode int) (string, error) {
+	for _, r := range revocationReasonNames {
+		if r.code == reasonCode {
+			return r.name, nil
+		}
+	}
+
+	return "", tracederrors.TracedErrorf("Unknown revocation reason code '%d'.", reasonCode)
+}
+
+// checkRevocationInformationIsCurrent returns an error if the revocation information is not yet valid or already outdated.
+// A zero nextUpdate means the source provides no expiry and is not checked.
+func checkRevocationInformationIsCurrent(sourceDescription string, thisUpdate time.Time, nextUpdate time.Time) error {
+	now := time.Now()
+
+	if thisUpdate.After(now.Add(REVOCATION_INFORMATION_MAX_CLOCK_SKEW)) {
+		return tracederrors.TracedErrorf("%s is not valid yet: ThisUpdate '%s' is in the future.", sourceDescription, thisUpdate.UTC().Format(time.RFC1123))
+	}
+
+	if !nextUpdate.IsZero() && nextUpdate.Before(now.Add(-REVOCATION_INFORMATION_MAX_CLOCK_SKEW)) {
+		return tracederrors.TracedErrorf("%s is outdated: NextUpdate '%s' is in the past.", sourceDescription, nextUpdate.UTC().Format(time.RFC1123))
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package genericx509utils
 import (
 	"context"
 	"math/big"
+	"strings"
 
 	"github.com/asciich/asciichgolangpublic/pkg/datatypes/bigintutils"
 	"github.com/asciich/asciichgolangpublic/pkg/logging"
@@ -43,3 +44,16 @@ func GenerateCertificateSerialNumber(ctx context.Context) (serialNumber *big.Int
 
 	return serialNumber, nil
 }
+
+// ParseSerialNumber parses a serial number given as decimal string or as colon separated hex string like "01:FF".
+func ParseSerialNumber(serialNumber string) (*big.Int, error) {
+	if serialNumber == "" {
+		return nil, tracederrors.TracedErrorEmptyString("serialNumber")
+	}
+
+	if strings.Contains(serialNumber, ":") {
+		return bigintutils.GetFromHexStringColonSeparated(serialNumber)
+	}
+
+	return bigintutils.GetFromDecimalString(serialNumber)
+}
@@ -95,3 +95,29 @@ func Test_GenerateCertificateSerialNumberAsString(t *testing.T) {
 		require.NotEqual(t, serial1, serial2, "two generated serial number strings should not be equal")
 	})
 }
+
+func Test_ParseSerialNumber(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		serial, err := genericx509utils.ParseSerialNumber("")
+		require.Error(t, err)
+		require.Nil(t, serial)
+	})
+
+	t.Run("decimal", func(t *testing.T) {
+		serial, err := genericx509utils.ParseSerialNumber("511")
+		require.NoError(t, err)
+		require.EqualValues(t, 0, serial.Cmp(big.NewInt(511)))
+	})
+
+	t.Run("hex colon separated", func(t *testing.T) {
+		serial, err := genericx509utils.ParseSerialNumber("01:FF")
+		require.NoError(t, err)
+		require.EqualValues(t, 0, serial.Cmp(big.NewInt(511)))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		serial, err := genericx509utils.ParseSerialNumber("abc")
+		require.Error(t, err)
+		require.Nil(t, serial)
+	})
+}
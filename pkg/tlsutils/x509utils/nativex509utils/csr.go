@@ -0,0 +1,62 @@
+package nativex509utils
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func CreateCertificateSigningRequest(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions, privateKey crypto.PrivateKey) (*x509.CertificateRequest, error) {
+	return genericx509utils.CreateCertificateSigningRequest(ctx, options, privateKey)
+}
+
+func CreateCertificateSigningRequestAndPrivateKey(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error) {
+	return genericx509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, options)
+}
+
+func SignCertificateSigningRequest(ctx context.Context, csr *x509.CertificateRequest, caCertAndKey *genericx509utils.X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error) {
+	return genericx509utils.SignCertificateSigningRequest(ctx, csr, caCertAndKey, options)
+}
+
+func ReadCertificateSigningRequestFromFile(ctx context.Context, pathToRead string) (*x509.CertificateRequest, error) {
+	if pathToRead == "" {
+		return nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	content, err := nativefiles.ReadAsBytes(ctx, pathToRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericx509utils.ReadCertificateSigningRequestFromBytes(content)
+}
+
+func WriteCertificateSigningRequestToFile(ctx context.Context, csr *x509.CertificateRequest, pathToWrite string) error {
+	if csr == nil {
+		return tracederrors.TracedErrorNil("csr")
+	}
+
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	csrBytes, err := genericx509utils.WriteCertificateSigningRequestAsPEMBytes(csr)
+	if err != nil {
+		return err
+	}
+
+	err = nativefiles.WriteBytes(ctx, pathToWrite, csrBytes, nil)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wrote certificate signing request for '%s' to '%s'.", csr.Subject.String(), pathToWrite)
+
+	return nil
+}
@@ -29,6 +29,10 @@ func GeneratePrivateKey(ctx context.Context) (privateKey crypto.PrivateKey, err
 	return genericx509utils.GeneratePrivateKey(ctx)
 }
 
+func GeneratePrivateKeyWithAlgorithm(ctx context.Context, keyAlgorithm string) (privateKey crypto.PrivateKey, err error) {
+	return genericx509utils.GeneratePrivateKeyWithAlgorithm(ctx, keyAlgorithm)
+}
+
 func CreateRootCaCertificate(ctx context.Context, options *x509options.X509CreateCertificateOptions) (*genericx509utils.X509CertKeyPair, error) {
 	return genericx509utils.CreateRootCaCertificate(ctx, options)
 }
@@ -0,0 +1,85 @@
+package nativex509utils
+
+import (
+	"context"
+	"crypto/x509"
+	"os"
+
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/filesoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// WritePkcs12ToFile writes the certificate, private key and chain as password protected PKCS#12 bundle to pathToWrite.
+// Since the bundle contains the private key the file is only readable by the owner.
+func WritePkcs12ToFile(ctx context.Context, certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string, pathToWrite string) error {
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	pfxData, err := genericx509utils.EncodePkcs12(certKeyPair, chain, password)
+	if err != nil {
+		return err
+	}
+
+	perm := os.FileMode(0600)
+	err = nativefiles.WriteBytes(ctx, pathToWrite, pfxData, &filesoptions.WriteOptions{Perm: &perm})
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wrote PKCS#12 for '%s' with %d chain certificates to '%s'.", certKeyPair.Cert.Subject.String(), len(chain), pathToWrite)
+
+	return nil
+}
+
+// ReadPkcs12FromFile reads a password protected PKCS#12 bundle.
+// Returns the certificate and private key as X509CertKeyPair and the included chain certificates.
+func ReadPkcs12FromFile(ctx context.Context, pathToRead string, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error) {
+	if pathToRead == "" {
+		return nil, nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	content, err := nativefiles.ReadAsBytes(ctx, pathToRead)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return genericx509utils.DecodePkcs12(content, password)
+}
+
+// WriteJksTrustStoreToFile writes the certs as Java KeyStore (JKS) truststore protected by password to pathToWrite.
+func WriteJksTrustStoreToFile(ctx context.Context, certs []*x509.Certificate, password string, pathToWrite string) error {
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	jksData, err := genericx509utils.EncodeJksTrustStore(certs, password)
+	if err != nil {
+		return err
+	}
+
+	err = nativefiles.WriteBytes(ctx, pathToWrite, jksData, nil)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wrote JKS truststore with %d certificates to '%s'.", len(certs), pathToWrite)
+
+	return nil
+}
+
+func ReadJksTrustStoreFromFile(ctx context.Context, pathToRead string, password string) ([]*x509.Certificate, error) {
+	if pathToRead == "" {
+		return nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	content, err := nativefiles.ReadAsBytes(ctx, pathToRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericx509utils.DecodeJksTrustStore(content, password)
+}
@@ -0,0 +1,108 @@
+package nativex509utils
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/filesutils/nativefiles"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils/httpoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+func ReadRevocationListFromFile(ctx context.Context, pathToRead string) (*x509.RevocationList, error) {
+	if pathToRead == "" {
+		return nil, tracederrors.TracedErrorEmptyString("pathToRead")
+	}
+
+	content, err := nativefiles.ReadAsBytes(ctx, pathToRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericx509utils.ReadRevocationListFromBytes(content)
+}
+
+func WriteRevocationListToFile(ctx context.Context, crl *x509.RevocationList, pathToWrite string) error {
+	if crl == nil {
+		return tracederrors.TracedErrorNil("crl")
+	}
+
+	if pathToWrite == "" {
+		return tracederrors.TracedErrorEmptyString("pathToWrite")
+	}
+
+	crlBytes, err := genericx509utils.WriteRevocationListAsPEMBytes(crl)
+	if err != nil {
+		return err
+	}
+
+	err = nativefiles.WriteBytes(ctx, pathToWrite, crlBytes, nil)
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Wrote certificate revocation list of '%s' to '%s'.", crl.Issuer.String(), pathToWrite)
+
+	return nil
+}
+
+// GetRevocationStatusUsingRevocationListFile checks the revocation status of cert against the CRL stored in crlPath.
+func GetRevocationStatusUsingRevocationListFile(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crlPath string) (*genericx509utils.RevocationStatus, error) {
+	crl, err := ReadRevocationListFromFile(ctx, crlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericx509utils.GetRevocationStatusFromRevocationList(ctx, cert, issuerCert, crl)
+}
+
+// GetRevocationStatusUsingOcsp asks the OCSP responder at responderUrl for the revocation status of cert.
+// If responderUrl is empty the first OCSP server listed in cert is used.
+func GetRevocationStatusUsingOcsp(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, responderUrl string) (*genericx509utils.RevocationStatus, error) {
+	if cert == nil {
+		return nil, tracederrors.TracedErrorNil("cert")
+	}
+
+	responderUrl, err := genericx509utils.GetOcspResponderUrl(cert, responderUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Get revocation status of '%s' using OCSP responder '%s' started.", cert.Subject.String(), responderUrl)
+
+	request, err := genericx509utils.CreateOcspRequest(cert, issuerCert)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := httputils.SendRequest(
+		contextutils.WithSilent(ctx),
+		&httpoptions.RequestOptions{
+			Url:    responderUrl,
+			Method: "POST",
+			Header: map[string]string{"Content-Type": genericx509utils.OCSP_REQUEST_CONTENT_TYPE},
+			Data:   request,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBytes, err := response.GetBodyAsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := genericx509utils.ParseOcspResponse(ctx, responseBytes, cert, issuerCert)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Get revocation status of '%s' using OCSP responder '%s' finished.", cert.Subject.String(), responderUrl)
+
+	return status, nil
+}
@@ -0,0 +1,33 @@
+package x509utils
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/nativex509utils"
+)
+
+func EncodePkcs12(certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string) ([]byte, error) {
+	return genericx509utils.EncodePkcs12(certKeyPair, chain, password)
+}
+
+func DecodePkcs12(pfxData []byte, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error) {
+	return genericx509utils.DecodePkcs12(pfxData, password)
+}
+
+func WritePkcs12ToFile(ctx context.Context, certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string, pathToWrite string) error {
+	return nativex509utils.WritePkcs12ToFile(ctx, certKeyPair, chain, password, pathToWrite)
+}
+
+func ReadPkcs12FromFile(ctx context.Context, pathToRead string, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error) {
+	return nativex509utils.ReadPkcs12FromFile(ctx, pathToRead, password)
+}
+
+func WriteJksTrustStoreToFile(ctx context.Context, certs []*x509.Certificate, password string, pathToWrite string) error {
+	return nativex509utils.WriteJksTrustStoreToFile(ctx, certs, password, pathToWrite)
+}
+
+func ReadJksTrustStoreFromFile(ctx context.Context, pathToRead string, password string) ([]*x509.Certificate, error) {
+	return nativex509utils.ReadJksTrustStoreFromFile(ctx, pathToRead, password)
+}
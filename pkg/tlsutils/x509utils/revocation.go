@@ -0,0 +1,50 @@
+package x509utils
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/nativex509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func CreateRevocationList(ctx context.Context, caCertAndKey *genericx509utils.X509CertKeyPair, revokedCertificates []x509.RevocationListEntry, options *x509options.X509CreateRevocationListOptions) (*x509.RevocationList, error) {
+	return genericx509utils.CreateRevocationList(ctx, caCertAndKey, revokedCertificates, options)
+}
+
+func RevokeCertificate(ctx context.Context, crl *x509.RevocationList, caCertAndKey *genericx509utils.X509CertKeyPair, serialNumber string, reason string, options *x509options.X509CreateRevocationListOptions) (*x509.RevocationList, error) {
+	return genericx509utils.RevokeCertificate(ctx, crl, caCertAndKey, serialNumber, reason, options)
+}
+
+func ReadRevocationListFromString(input string) (*x509.RevocationList, error) {
+	return genericx509utils.ReadRevocationListFromString(input)
+}
+
+func WriteRevocationListAsPEMString(crl *x509.RevocationList) (string, error) {
+	return genericx509utils.WriteRevocationListAsPEMString(crl)
+}
+
+func GetRevocationListInfoString(crl *x509.RevocationList) (string, error) {
+	return genericx509utils.GetRevocationListInfoString(crl)
+}
+
+func ReadRevocationListFromFile(ctx context.Context, pathToRead string) (*x509.RevocationList, error) {
+	return nativex509utils.ReadRevocationListFromFile(ctx, pathToRead)
+}
+
+func WriteRevocationListToFile(ctx context.Context, crl *x509.RevocationList, pathToWrite string) error {
+	return nativex509utils.WriteRevocationListToFile(ctx, crl, pathToWrite)
+}
+
+func GetRevocationStatusFromRevocationList(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crl *x509.RevocationList) (*genericx509utils.RevocationStatus, error) {
+	return genericx509utils.GetRevocationStatusFromRevocationList(ctx, cert, issuerCert, crl)
+}
+
+func GetRevocationStatusUsingRevocationListFile(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crlPath string) (*genericx509utils.RevocationStatus, error) {
+	return nativex509utils.GetRevocationStatusUsingRevocationListFile(ctx, cert, issuerCert, crlPath)
+}
+
+func GetRevocationStatusUsingOcsp(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, responderUrl string) (*genericx509utils.RevocationStatus, error) {
+	return nativex509utils.GetRevocationStatusUsingOcsp(ctx, cert, issuerCert, responderUrl)
+}
@@ -0,0 +1,324 @@
+package testocspresponder
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils/httpgeneric"
+	"github.com/asciich/asciichgolangpublic/pkg/logging"
+	"github.com/asciich/asciichgolangpublic/pkg/netutils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// A simple OCSP responder mostly used for testing.
+// The revocation status is answered based on a CRL signed by the CA which is also used to sign the OCSP responses.
+type TestOcspResponder struct {
+	webServerWaitGroup *sync.WaitGroup
+	port               int
+	server             *http.Server
+	caCertAndKey       *genericx509utils.X509CertKeyPair
+	crl                *x509.RevocationList
+	mutex              sync.Mutex
+}
+
+func NewTestOcspResponder() (t *TestOcspResponder) {
+	return new(TestOcspResponder)
+}
+
+// GetTestOcspResponder returns a not yet started OCSP responder answering for certificates issued by caCertAndKey.
+// Initially no certificate is revoked.
+func GetTestOcspResponder(ctx context.Context, port int, caCertAndKey *genericx509utils.X509CertKeyPair) (*TestOcspResponder, error) {
+	ret := NewTestOcspResponder()
+
+	err := ret.SetPort(port)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ret.SetCaCertAndKey(caCertAndKey)
+	if err != nil {
+		return nil, err
+	}
+
+	crl, err := genericx509utils.CreateRevocationList(contextutils.WithSilent(ctx), caCertAndKey, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ret.SetRevocationList(crl)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func GetRunningTestOcspResponder(ctx context.Context, port int, caCertAndKey *genericx509utils.X509CertKeyPair) (*TestOcspResponder, error) {
+	responder, err := GetTestOcspResponder(ctx, port, caCertAndKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = responder.StartInBackground(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return responder, nil
+}
+
+func (t *TestOcspResponder) GetCaCertAndKey() (*genericx509utils.X509CertKeyPair, error) {
+	if t.caCertAndKey == nil {
+		return nil, tracederrors.TracedError("caCertAndKey not set")
+	}
+
+	return t.caCertAndKey, nil
+}
+
+func (t *TestOcspResponder) GetPort() (port int, err error) {
+	if t.port <= 0 {
+		return -1, tracederrors.TracedError("port not set")
+	}
+
+	return t.port, nil
+}
+
+// GetRevocationList returns the CRL currently used to answer OCSP requests.
+func (t *TestOcspResponder) GetRevocationList() (*x509.RevocationList, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.crl == nil {
+		return nil, tracederrors.TracedError("crl not set")
+	}
+
+	return t.crl, nil
+}
+
+func (t *TestOcspResponder) GetUrl() (string, error) {
+	port, err := t.GetPort()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("http://localhost:%d", port), nil
+}
+
+func (t *TestOcspResponder) SetCaCertAndKey(caCertAndKey *genericx509utils.X509CertKeyPair) error {
+	if caCertAndKey == nil {
+		return tracederrors.TracedErrorNil("caCertAndKey")
+	}
+
+	err := caCertAndKey.CheckKeyMatchingCertificate()
+	if err != nil {
+		return err
+	}
+
+	t.caCertAndKey = caCertAndKey
+
+	return nil
+}
+
+func (t *TestOcspResponder) SetPort(port int) (err error) {
+	if port <= 0 {
+		return tracederrors.TracedErrorf("Invalid value '%d' for port", port)
+	}
+
+	t.port = port
+
+	return nil
+}
+
+// SetRevocationList replaces the CRL used to answer OCSP requests. The CRL must be signed by the CA of the responder.
+func (t *TestOcspResponder) SetRevocationList(crl *x509.RevocationList) error {
+	if crl == nil {
+		return tracederrors.TracedErrorNil("crl")
+	}
+
+	caCertAndKey, err := t.GetCaCertAndKey()
+	if err != nil {
+		return err
+	}
+
+	caCert, err := caCertAndKey.GetX509Certificate()
+	if err != nil {
+		return err
+	}
+
+	err = genericx509utils.CheckRevocationListSignedBy(crl, caCert)
+	if err != nil {
+		return err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.crl = crl
+
+	return nil
+}
+
+// RevokeCertificate revokes the certificate with the given serial number. All following OCSP requests for this serial number are answered as revoked.
+func (t *TestOcspResponder) RevokeCertificate(ctx context.Context, serialNumber string, reason string) error {
+	caCertAndKey, err := t.GetCaCertAndKey()
+	if err != nil {
+		return err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	crl, err := genericx509utils.RevokeCertificate(ctx, t.crl, caCertAndKey, serialNumber, reason, &x509options.X509CreateRevocationListOptions{})
+	if err != nil {
+		return err
+	}
+
+	t.crl = crl
+
+	return nil
+}
+
+func (t *TestOcspResponder) handleOcspRequest(w http.ResponseWriter, r *http.Request) {
+	if r == nil {
+		logging.LogWarn("r is nil")
+		http.Error(w, "500 internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	var request []byte
+	var err error
+	switch r.Method {
+	case http.MethodPost:
+		request, err = io.ReadAll(r.Body)
+	case http.MethodGet:
+		// RFC 6960 appendix A.1: GET requests contain the url and base64 encoded DER request as path.
+		var unescaped string
+		unescaped, err = url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/"))
+		if err == nil {
+			request, err = base64.StdEncoding.DecodeString(unescaped)
+		}
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	crl, err := t.GetRevocationList()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response, err := genericx509utils.CreateOcspResponse(contextutils.ContextSilent(), request, t.caCertAndKey, crl)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", genericx509utils.OCSP_RESPONSE_CONTENT_TYPE)
+	w.Write(response)
+}
+
+func (t *TestOcspResponder) StartInBackground(ctx context.Context) (err error) {
+	port, err := t.GetPort()
+	if err != nil {
+		return err
+	}
+
+	_, err = t.GetRevocationList()
+	if err != nil {
+		return err
+	}
+
+	logging.LogInfoByCtxf(ctx, "Start testOcspResponder in background on port %d started.", port)
+
+	if t.webServerWaitGroup == nil {
+		t.webServerWaitGroup = new(sync.WaitGroup)
+	} else {
+		return tracederrors.TracedError(httpgeneric.ErrWebServerAlreadyRunning)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", t.handleOcspRequest)
+
+	t.server = &http.Server{
+		Addr:    ":" + strconv.Itoa(port),
+		Handler: mux,
+	}
+
+	// This makes it more robust when frequently started and stopped on the same port like in CI.
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+	err = netutils.WaitPortAvailableForListening(ctxTimeout, port)
+	if err != nil {
+		return err
+	}
+
+	t.webServerWaitGroup.Add(1)
+	go func() {
+		defer t.webServerWaitGroup.Done()
+
+		if err := t.server.ListenAndServe(); err != http.ErrServerClosed {
+			log.Fatalf("ListenAndServe(): %v", err)
+		}
+	}()
+
+	// give the responder time to start in the background
+	time.Sleep(time.Millisecond * 200)
+
+	isAvailable, err := netutils.IsTcpPortAvailableForListening(contextutils.WithSilent(ctx), port)
+	if err != nil {
+		return err
+	}
+	if isAvailable {
+		return tracederrors.TracedErrorf("Failed to start testOcspResponder in background. Port '%d' is still open.", port)
+	}
+
+	logging.LogInfoByCtxf(ctx, "Start testOcspResponder in background on port %d finished.", port)
+
+	return nil
+}
+
+func (t *TestOcspResponder) Stop(ctx context.Context) (err error) {
+	logging.LogInfoByCtx(ctx, "Stop TestOcspResponder started.")
+
+	if t.webServerWaitGroup == nil {
+		logging.LogInfoByCtxf(ctx, "TestOcspResponder already stopped")
+		return nil
+	}
+
+	if t.server == nil {
+		return tracederrors.TracedError("Unexpected t.server == nil")
+	}
+
+	err = t.server.Shutdown(context.TODO())
+	if err != nil {
+		return tracederrors.TracedErrorf(
+			"Shutdown TestOcspResponder failed: '%w'",
+			err,
+		)
+	}
+
+	t.webServerWaitGroup.Wait()
+	t.webServerWaitGroup = nil
+
+	logging.LogInfoByCtx(ctx, "Stop TestOcspResponder finished.")
+
+	return nil
+}
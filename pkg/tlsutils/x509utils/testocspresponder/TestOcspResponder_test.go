@@ -0,0 +1,73 @@
+package testocspresponder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/asciich/asciichgolangpublic/pkg/contextutils"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils"
+	"github.com/asciich/asciichgolangpublic/pkg/httputils/httpoptions"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/testocspresponder"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
+)
+
+func Test_TestOcspResponder(t *testing.T) {
+	ctx := contextutils.ContextVerbose()
+	const port int = 9128
+
+	rootCa, err := genericx509utils.CreateRootCaCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "Test Root CA",
+		Organization: "Test org",
+		CountryName:  "CH",
+	})
+	require.NoError(t, err)
+
+	endEntity, err := genericx509utils.CreateSignedEndEntityCertificate(ctx, &x509options.X509CreateCertificateOptions{
+		CommonName:   "server.example.net",
+		Organization: "Test org",
+		CountryName:  "CH",
+	}, rootCa)
+	require.NoError(t, err)
+
+	responder, err := testocspresponder.GetRunningTestOcspResponder(ctx, port, rootCa)
+	require.NoError(t, err)
+	defer responder.Stop(ctx)
+
+	responderUrl, err := responder.GetUrl()
+	require.NoError(t, err)
+
+	request, err := genericx509utils.CreateOcspRequest(endEntity.Cert, rootCa.Cert)
+	require.NoError(t, err)
+
+	getStatus := func() *genericx509utils.RevocationStatus {
+		response, err := httputils.SendRequest(ctx, &httpoptions.RequestOptions{
+			Url:    responderUrl,
+			Method: "POST",
+			Header: map[string]string{"Content-Type": genericx509utils.OCSP_REQUEST_CONTENT_TYPE},
+			Data:   request,
+		})
+		require.NoError(t, err)
+
+		body, err := response.GetBodyAsBytes()
+		require.NoError(t, err)
+
+		status, err := genericx509utils.ParseOcspResponse(ctx, body, endEntity.Cert, rootCa.Cert)
+		require.NoError(t, err)
+
+		return status
+	}
+
+	require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, getStatus().Status)
+
+	err = responder.RevokeCertificate(ctx, endEntity.Cert.SerialNumber.String(), "cessationOfOperation")
+	require.NoError(t, err)
+
+	status := getStatus()
+	require.EqualValues(t, genericx509utils.REVOCATION_STATUS_REVOKED, status.Status)
+	require.EqualValues(t, "cessationOfOperation", status.Reason)
+
+	crl, err := responder.GetRevocationList()
+	require.NoError(t, err)
+	require.Len(t, crl.RevokedCertificateEntries, 1)
+}
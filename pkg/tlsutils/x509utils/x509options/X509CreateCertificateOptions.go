@@ -28,7 +28,10 @@ type X509CreateCertificateOptions struct {
 	SerialNumber string
 
 	// Private key options
-	PrivateKeySize int // eg. 1024, 2048, 4096
+	PrivateKeySize int // eg. 1024, 2048, 4096. Only used for RSA keys if KeyAlgorithm is not set.
+	// Algorithm of the generated private key like "rsa-2048", "ecdsa-p256" or "ed25519".
+	// See cryptoutils.GetSupportedKeyAlgorithms. Defaults to RSA using PrivateKeySize.
+	KeyAlgorithm string
 
 	KeyOutputFilePath         string
 	CertificateOutputFilePath string
@@ -0,0 +1,59 @@
+package x509options
+
+import (
+	"github.com/asciich/asciichgolangpublic/pkg/tracederrors"
+)
+
+// Options to create a certificate signing request (CSR).
+type X509CreateCertificateSigningRequestOptions struct {
+	// Subject attributes:
+	CommonName   string // the CN field
+	CountryName  string // the C field
+	Organization string // the O field
+	Locality     string // the L field
+
+	// Subject Alternative Names (SANs) to request.
+	// Entries which are valid IP addresses are added as IP SANs, all others as DNS SANs.
+	AdditionalSans []string
+
+	// Requested key usages like "digitalSignature", "keyEncipherment", "certSign" or "crlSign".
+	KeyUsages []string
+
+	// Requested extended key usages like "serverAuth" or "clientAuth".
+	ExtendedKeyUsages []string
+
+	// Size of the RSA private key generated for the CSR. Not used if an existing private key is signed.
+	PrivateKeySize int // eg. 2048, 4096
+
+	// Algorithm of the private key generated for the CSR like "rsa-2048", "ecdsa-p256" or "ed25519".
+	// See cryptoutils.GetSupportedKeyAlgorithms. If set PrivateKeySize is ignored.
+	KeyAlgorithm string
+}
+
+func (o *X509CreateCertificateSigningRequestOptions) GetCommonName() (string, error) {
+	if o.CommonName == "" {
+		return "", tracederrors.TracedError("CommonName not set")
+	}
+
+	return o.CommonName, nil
+}
+
+func (o *X509CreateCertificateSigningRequestOptions) GetDeepCopy() *X509CreateCertificateSigningRequestOptions {
+	copy := new(X509CreateCertificateSigningRequestOptions)
+
+	*copy = *o
+
+	if o.AdditionalSans != nil {
+		copy.AdditionalSans = append([]string{}, o.AdditionalSans...)
+	}
+
+	if o.KeyUsages != nil {
+		copy.KeyUsages = append([]string{}, o.KeyUsages...)
+	}
+
+	if o.ExtendedKeyUsages != nil {
+		copy.ExtendedKeyUsages = append([]string{}, o.ExtendedKeyUsages...)
+	}
+
+	return copy
+}
@@ -0,0 +1,31 @@
+package x509options
+
+import (
+	"time"
+)
+
+// Options used when a CA creates or updates a certificate revocation list (CRL).
+type X509CreateRevocationListOptions struct {
+	// Time until the next CRL update is due. Defaults to 7 days if unset.
+	ValidityDuration time.Duration
+
+	// CRL number as decimal string.
+	// If unset 1 is used for new CRLs and the number of the previous CRL is incremented when revoking certificates.
+	Number string
+}
+
+func (o *X509CreateRevocationListOptions) GetValidityDurationOrDefault() time.Duration {
+	if o.ValidityDuration <= 0 {
+		return 7 * 24 * time.Hour
+	}
+
+	return o.ValidityDuration
+}
+
+func (o *X509CreateRevocationListOptions) GetDeepCopy() *X509CreateRevocationListOptions {
+	copy := new(X509CreateRevocationListOptions)
+
+	*copy = *o
+
+	return copy
+}
@@ -0,0 +1,54 @@
+package x509options
+
+import (
+	"time"
+)
+
+// Options used when a CA signs a certificate signing request (CSR).
+type X509SignCertificateSigningRequestOptions struct {
+	// Validity of the signed certificate. Defaults to 365 days if unset.
+	ValidityDuration time.Duration
+
+	// Serial number of the signed certificate as decimal string.
+	// A random serial number is generated if unset.
+	SerialNumber string
+
+	// Sign the CSR as intermediate CA certificate instead of an end entity certificate.
+	IsCA bool
+
+	// Key usages of the signed certificate. Overrides the key usages requested in the CSR.
+	// If neither is set "digitalSignature" and "keyEncipherment" are used for end entity certificates
+	// and "certSign" and "crlSign" for CA certificates.
+	KeyUsages []string
+
+	// Extended key usages of the signed certificate. Overrides the extended key usages requested in the CSR.
+	// If neither is set "serverAuth" and "clientAuth" are used for end entity certificates.
+	ExtendedKeyUsages []string
+
+	// Do not copy the Subject Alternative Names requested in the CSR into the signed certificate.
+	IgnoreRequestedSans bool
+}
+
+func (o *X509SignCertificateSigningRequestOptions) GetValidityDurationOrDefault() time.Duration {
+	if o.ValidityDuration <= 0 {
+		return 365 * 24 * time.Hour
+	}
+
+	return o.ValidityDuration
+}
+
+func (o *X509SignCertificateSigningRequestOptions) GetDeepCopy() *X509SignCertificateSigningRequestOptions {
+	copy := new(X509SignCertificateSigningRequestOptions)
+
+	*copy = *o
+
+	if o.KeyUsages != nil {
+		copy.KeyUsages = append([]string{}, o.KeyUsages...)
+	}
+
+	if o.ExtendedKeyUsages != nil {
+		copy.ExtendedKeyUsages = append([]string{}, o.ExtendedKeyUsages...)
+	}
+
+	return copy
+}
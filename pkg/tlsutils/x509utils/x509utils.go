@@ -18,6 +18,10 @@ func GeneratePrivateKey(ctx context.Context) (crypto.PrivateKey, error) {
 	return genericx509utils.GeneratePrivateKey(ctx)
 }
 
+func GeneratePrivateKeyWithAlgorithm(ctx context.Context, keyAlgorithm string) (crypto.PrivateKey, error) {
+	return nativex509utils.GeneratePrivateKeyWithAlgorithm(ctx, keyAlgorithm)
+}
+
 func CreateRootCaCertificate(ctx context.Context, options *x509options.X509CreateCertificateOptions) (*genericx509utils.X509CertKeyPair, error) {
 	return genericx509utils.CreateRootCaCertificate(ctx, options)
 }
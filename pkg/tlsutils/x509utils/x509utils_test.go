@@ -18,6 +18,7 @@ import (
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/commandexecutorx509utils"
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/genericx509utils"
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/nativex509utils"
+	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/testocspresponder"
 	"github.com/asciich/asciichgolangpublic/pkg/tlsutils/x509utils/x509options"
 )
 
@@ -34,6 +35,22 @@ type x509Implementation struct {
 	CreateSignedIntermediateCertificate func(ctx context.Context, options *x509options.X509CreateCertificateOptions, rootCaCertAndKey *genericx509utils.X509CertKeyPair) (*genericx509utils.X509CertKeyPair, error)
 	CreateSignedEndEntityCertificate    func(ctx context.Context, options *x509options.X509CreateCertificateOptions, caCertAndKey *genericx509utils.X509CertKeyPair) (*genericx509utils.X509CertKeyPair, error)
 	GeneratePrivateKey                  func(ctx context.Context) (crypto.PrivateKey, error)
+	GeneratePrivateKeyWithAlgorithm     func(ctx context.Context, keyAlgorithm string) (crypto.PrivateKey, error)
+
+	CreateCertificateSigningRequestAndPrivateKey func(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error)
+	SignCertificateSigningRequest                func(ctx context.Context, csr *x509.CertificateRequest, caCertAndKey *genericx509utils.X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error)
+	ReadCertificateSigningRequestFromFile        func(ctx context.Context, pathToRead string) (*x509.CertificateRequest, error)
+	WriteCertificateSigningRequestToFile         func(ctx context.Context, csr *x509.CertificateRequest, pathToWrite string) error
+
+	WritePkcs12ToFile         func(ctx context.Context, certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string, pathToWrite string) error
+	ReadPkcs12FromFile        func(ctx context.Context, pathToRead string, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error)
+	WriteJksTrustStoreToFile  func(ctx context.Context, certs []*x509.Certificate, password string, pathToWrite string) error
+	ReadJksTrustStoreFromFile func(ctx context.Context, pathToRead string, password string) ([]*x509.Certificate, error)
+
+	ReadRevocationListFromFile                 func(ctx context.Context, pathToRead string) (*x509.RevocationList, error)
+	WriteRevocationListToFile                  func(ctx context.Context, crl *x509.RevocationList, pathToWrite string) error
+	GetRevocationStatusUsingRevocationListFile func(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crlPath string) (*genericx509utils.RevocationStatus, error)
+	GetRevocationStatusUsingOcsp               func(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, responderUrl string) (*genericx509utils.RevocationStatus, error)
 }
 
 // getX509Implementations returns all implementations to test.
@@ -49,6 +66,22 @@ func getX509Implementations() []x509Implementation {
 			CreateSignedIntermediateCertificate: x509utils.CreateSignedIntermediateCertificate,
 			CreateSignedEndEntityCertificate:    x509utils.CreateSignedEndEntityCertificate,
 			GeneratePrivateKey:                  x509utils.GeneratePrivateKey,
+			GeneratePrivateKeyWithAlgorithm:     x509utils.GeneratePrivateKeyWithAlgorithm,
+
+			CreateCertificateSigningRequestAndPrivateKey: x509utils.CreateCertificateSigningRequestAndPrivateKey,
+			SignCertificateSigningRequest:                x509utils.SignCertificateSigningRequest,
+			ReadCertificateSigningRequestFromFile:        x509utils.ReadCertificateSigningRequestFromFile,
+			WriteCertificateSigningRequestToFile:         x509utils.WriteCertificateSigningRequestToFile,
+
+			WritePkcs12ToFile:         x509utils.WritePkcs12ToFile,
+			ReadPkcs12FromFile:        x509utils.ReadPkcs12FromFile,
+			WriteJksTrustStoreToFile:  x509utils.WriteJksTrustStoreToFile,
+			ReadJksTrustStoreFromFile: x509utils.ReadJksTrustStoreFromFile,
+
+			ReadRevocationListFromFile:                 x509utils.ReadRevocationListFromFile,
+			WriteRevocationListToFile:                  x509utils.WriteRevocationListToFile,
+			GetRevocationStatusUsingRevocationListFile: x509utils.GetRevocationStatusUsingRevocationListFile,
+			GetRevocationStatusUsingOcsp:               x509utils.GetRevocationStatusUsingOcsp,
 		},
 		{
 			Name:                                "nativex509utils",
@@ -59,6 +92,22 @@ func getX509Implementations() []x509Implementation {
 			CreateSignedIntermediateCertificate: nativex509utils.CreateSignedIntermediateCertificate,
 			CreateSignedEndEntityCertificate:    nativex509utils.CreateSignedEndEntityCertificate,
 			GeneratePrivateKey:                  nativex509utils.GeneratePrivateKey,
+			GeneratePrivateKeyWithAlgorithm:     nativex509utils.GeneratePrivateKeyWithAlgorithm,
+
+			CreateCertificateSigningRequestAndPrivateKey: nativex509utils.CreateCertificateSigningRequestAndPrivateKey,
+			SignCertificateSigningRequest:                nativex509utils.SignCertificateSigningRequest,
+			ReadCertificateSigningRequestFromFile:        nativex509utils.ReadCertificateSigningRequestFromFile,
+			WriteCertificateSigningRequestToFile:         nativex509utils.WriteCertificateSigningRequestToFile,
+
+			WritePkcs12ToFile:         nativex509utils.WritePkcs12ToFile,
+			ReadPkcs12FromFile:        nativex509utils.ReadPkcs12FromFile,
+			WriteJksTrustStoreToFile:  nativex509utils.WriteJksTrustStoreToFile,
+			ReadJksTrustStoreFromFile: nativex509utils.ReadJksTrustStoreFromFile,
+
+			ReadRevocationListFromFile:                 nativex509utils.ReadRevocationListFromFile,
+			WriteRevocationListToFile:                  nativex509utils.WriteRevocationListToFile,
+			GetRevocationStatusUsingRevocationListFile: nativex509utils.GetRevocationStatusUsingRevocationListFile,
+			GetRevocationStatusUsingOcsp:               nativex509utils.GetRevocationStatusUsingOcsp,
 		},
 		{
 			Name: "commandexecutorx509utils_exec",
@@ -83,6 +132,45 @@ func getX509Implementations() []x509Implementation {
 			GeneratePrivateKey: func(ctx context.Context) (crypto.PrivateKey, error) {
 				return commandexecutorx509utils.GeneratePrivateKey(ctx, commandexecutorexecoo.Exec())
 			},
+			GeneratePrivateKeyWithAlgorithm: func(ctx context.Context, keyAlgorithm string) (crypto.PrivateKey, error) {
+				return commandexecutorx509utils.GeneratePrivateKeyWithAlgorithm(ctx, commandexecutorexecoo.Exec(), keyAlgorithm)
+			},
+			CreateCertificateSigningRequestAndPrivateKey: func(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error) {
+				return commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, commandexecutorexecoo.Exec(), options)
+			},
+			SignCertificateSigningRequest: func(ctx context.Context, csr *x509.CertificateRequest, caCertAndKey *genericx509utils.X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error) {
+				return commandexecutorx509utils.SignCertificateSigningRequest(ctx, commandexecutorexecoo.Exec(), csr, caCertAndKey, options)
+			},
+			ReadCertificateSigningRequestFromFile: func(ctx context.Context, pathToRead string) (*x509.CertificateRequest, error) {
+				return commandexecutorx509utils.ReadCertificateSigningRequestFromFile(ctx, commandexecutorexecoo.Exec(), pathToRead)
+			},
+			WriteCertificateSigningRequestToFile: func(ctx context.Context, csr *x509.CertificateRequest, pathToWrite string) error {
+				return commandexecutorx509utils.WriteCertificateSigningRequestToFile(ctx, commandexecutorexecoo.Exec(), csr, pathToWrite)
+			},
+			WritePkcs12ToFile: func(ctx context.Context, certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string, pathToWrite string) error {
+				return commandexecutorx509utils.WritePkcs12ToFile(ctx, commandexecutorexecoo.Exec(), certKeyPair, chain, password, pathToWrite)
+			},
+			ReadPkcs12FromFile: func(ctx context.Context, pathToRead string, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error) {
+				return commandexecutorx509utils.ReadPkcs12FromFile(ctx, commandexecutorexecoo.Exec(), pathToRead, password)
+			},
+			WriteJksTrustStoreToFile: func(ctx context.Context, certs []*x509.Certificate, password string, pathToWrite string) error {
+				return commandexecutorx509utils.WriteJksTrustStoreToFile(ctx, commandexecutorexecoo.Exec(), certs, password, pathToWrite)
+			},
+			ReadJksTrustStoreFromFile: func(ctx context.Context, pathToRead string, password string) ([]*x509.Certificate, error) {
+				return commandexecutorx509utils.ReadJksTrustStoreFromFile(ctx, commandexecutorexecoo.Exec(), pathToRead, password)
+			},
+			ReadRevocationListFromFile: func(ctx context.Context, pathToRead string) (*x509.RevocationList, error) {
+				return commandexecutorx509utils.ReadRevocationListFromFile(ctx, commandexecutorexecoo.Exec(), pathToRead)
+			},
+			WriteRevocationListToFile: func(ctx context.Context, crl *x509.RevocationList, pathToWrite string) error {
+				return commandexecutorx509utils.WriteRevocationListToFile(ctx, commandexecutorexecoo.Exec(), crl, pathToWrite)
+			},
+			GetRevocationStatusUsingRevocationListFile: func(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crlPath string) (*genericx509utils.RevocationStatus, error) {
+				return commandexecutorx509utils.GetRevocationStatusUsingRevocationListFile(ctx, commandexecutorexecoo.Exec(), cert, issuerCert, crlPath)
+			},
+			GetRevocationStatusUsingOcsp: func(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, responderUrl string) (*genericx509utils.RevocationStatus, error) {
+				return commandexecutorx509utils.GetRevocationStatusUsingOcsp(ctx, commandexecutorexecoo.Exec(), cert, issuerCert, responderUrl)
+			},
 		},
 		{
 			Name: "commandexecutorx509utils_bash",
@@ -107,6 +195,45 @@ func getX509Implementations() []x509Implementation {
 			GeneratePrivateKey: func(ctx context.Context) (crypto.PrivateKey, error) {
 				return commandexecutorx509utils.GeneratePrivateKey(ctx, commandexecutorbashoo.Bash())
 			},
+			GeneratePrivateKeyWithAlgorithm: func(ctx context.Context, keyAlgorithm string) (crypto.PrivateKey, error) {
+				return commandexecutorx509utils.GeneratePrivateKeyWithAlgorithm(ctx, commandexecutorbashoo.Bash(), keyAlgorithm)
+			},
+			CreateCertificateSigningRequestAndPrivateKey: func(ctx context.Context, options *x509options.X509CreateCertificateSigningRequestOptions) (*x509.CertificateRequest, crypto.PrivateKey, error) {
+				return commandexecutorx509utils.CreateCertificateSigningRequestAndPrivateKey(ctx, commandexecutorbashoo.Bash(), options)
+			},
+			SignCertificateSigningRequest: func(ctx context.Context, csr *x509.CertificateRequest, caCertAndKey *genericx509utils.X509CertKeyPair, options *x509options.X509SignCertificateSigningRequestOptions) (*x509.Certificate, error) {
+				return commandexecutorx509utils.SignCertificateSigningRequest(ctx, commandexecutorbashoo.Bash(), csr, caCertAndKey, options)
+			},
+			ReadCertificateSigningRequestFromFile: func(ctx context.Context, pathToRead string) (*x509.CertificateRequest, error) {
+				return commandexecutorx509utils.ReadCertificateSigningRequestFromFile(ctx, commandexecutorbashoo.Bash(), pathToRead)
+			},
+			WriteCertificateSigningRequestToFile: func(ctx context.Context, csr *x509.CertificateRequest, pathToWrite string) error {
+				return commandexecutorx509utils.WriteCertificateSigningRequestToFile(ctx, commandexecutorbashoo.Bash(), csr, pathToWrite)
+			},
+			WritePkcs12ToFile: func(ctx context.Context, certKeyPair *genericx509utils.X509CertKeyPair, chain []*x509.Certificate, password string, pathToWrite string) error {
+				return commandexecutorx509utils.WritePkcs12ToFile(ctx, commandexecutorbashoo.Bash(), certKeyPair, chain, password, pathToWrite)
+			},
+			ReadPkcs12FromFile: func(ctx context.Context, pathToRead string, password string) (*genericx509utils.X509CertKeyPair, []*x509.Certificate, error) {
+				return commandexecutorx509utils.ReadPkcs12FromFile(ctx, commandexecutorbashoo.Bash(), pathToRead, password)
+			},
+			WriteJksTrustStoreToFile: func(ctx context.Context, certs []*x509.Certificate, password string, pathToWrite string) error {
+				return commandexecutorx509utils.WriteJksTrustStoreToFile(ctx, commandexecutorbashoo.Bash(), certs, password, pathToWrite)
+			},
+			ReadJksTrustStoreFromFile: func(ctx context.Context, pathToRead string, password string) ([]*x509.Certificate, error) {
+				return commandexecutorx509utils.ReadJksTrustStoreFromFile(ctx, commandexecutorbashoo.Bash(), pathToRead, password)
+			},
+			ReadRevocationListFromFile: func(ctx context.Context, pathToRead string) (*x509.RevocationList, error) {
+				return commandexecutorx509utils.ReadRevocationListFromFile(ctx, commandexecutorbashoo.Bash(), pathToRead)
+			},
+			WriteRevocationListToFile: func(ctx context.Context, crl *x509.RevocationList, pathToWrite string) error {
+				return commandexecutorx509utils.WriteRevocationListToFile(ctx, commandexecutorbashoo.Bash(), crl, pathToWrite)
+			},
+			GetRevocationStatusUsingRevocationListFile: func(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, crlPath string) (*genericx509utils.RevocationStatus, error) {
+				return commandexecutorx509utils.GetRevocationStatusUsingRevocationListFile(ctx, commandexecutorbashoo.Bash(), cert, issuerCert, crlPath)
+			},
+			GetRevocationStatusUsingOcsp: func(ctx context.Context, cert *x509.Certificate, issuerCert *x509.Certificate, responderUrl string) (*genericx509utils.RevocationStatus, error) {
+				return commandexecutorx509utils.GetRevocationStatusUsingOcsp(ctx, commandexecutorbashoo.Bash(), cert, issuerCert, responderUrl)
+			},
 		},
 	}
 }
@@ -1063,3 +1190,333 @@ func Test_FullChain_across_implementations(t *testing.T) {
 		})
 	}
 }
+
+// --- Certificate signing requests ---
+
+// Test_CertificateSigningRequest validates that all implementations create, write, read and sign
+// certificate signing requests identically.
+func Test_CertificateSigningRequest(t *testing.T) {
+	implementations := getX509Implementations()
+
+	for _, impl := range implementations {
+		impl := impl
+
+		t.Run(impl.Name+"_nil options returns error", func(t *testing.T) {
+			csr, key, err := impl.CreateCertificateSigningRequestAndPrivateKey(contextutils.ContextVerbose(), nil)
+			require.Error(t, err)
+			require.Nil(t, csr)
+			require.Nil(t, key)
+		})
+
+		t.Run(impl.Name+"_empty path returns error", func(t *testing.T) {
+			csr, err := impl.ReadCertificateSigningRequestFromFile(contextutils.ContextVerbose(), "")
+			require.Error(t, err)
+			require.Nil(t, csr)
+		})
+
+		t.Run(impl.Name+"_create write read and sign", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			csrPath := filepath.Join(t.TempDir(), "request.csr")
+
+			csr, key, err := impl.CreateCertificateSigningRequestAndPrivateKey(ctx, &x509options.X509CreateCertificateSigningRequestOptions{
+				CountryName:       "CH",
+				Locality:          "Basel",
+				Organization:      "TestCsrOrg",
+				CommonName:        "csr.example.com",
+				AdditionalSans:    []string{"www.csr.example.com", "192.168.10.1"},
+				KeyUsages:         []string{"digitalSignature"},
+				ExtendedKeyUsages: []string{"serverAuth"},
+				PrivateKeySize:    2048,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, key)
+
+			err = impl.WriteCertificateSigningRequestToFile(ctx, csr, csrPath)
+			require.NoError(t, err)
+
+			readCsr, err := impl.ReadCertificateSigningRequestFromFile(ctx, csrPath)
+			require.NoError(t, err)
+			require.EqualValues(t, "csr.example.com", readCsr.Subject.CommonName)
+			require.EqualValues(t, []string{"csr.example.com", "www.csr.example.com"}, readCsr.DNSNames)
+			require.Len(t, readCsr.IPAddresses, 1)
+			require.EqualValues(t, "192.168.10.1", readCsr.IPAddresses[0].String())
+
+			rootPair, err := impl.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+			require.NoError(t, err)
+
+			cert, err := impl.SignCertificateSigningRequest(ctx, readCsr, rootPair, nil)
+			require.NoError(t, err)
+			require.EqualValues(t, "csr.example.com", cert.Subject.CommonName)
+			require.EqualValues(t, []string{"csr.example.com", "www.csr.example.com"}, cert.DNSNames)
+			require.Len(t, cert.IPAddresses, 1)
+			require.EqualValues(t, x509.KeyUsageDigitalSignature, cert.KeyUsage)
+			require.EqualValues(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+
+			rootCert, err := rootPair.GetX509Certificate()
+			require.NoError(t, err)
+
+			isSigned, err := genericx509utils.IsSignedBy(ctx, cert, rootCert)
+			require.NoError(t, err)
+			require.True(t, isSigned)
+
+			isMatching, err := genericx509utils.IsCertificateMatchingPrivateKey(cert, key)
+			require.NoError(t, err)
+			require.True(t, isMatching)
+		})
+	}
+}
+
+// --- Key algorithms ---
+
+// Test_KeyAlgorithms validates that all implementations create certificate chains and private keys
+// using all supported key algorithms.
+func Test_KeyAlgorithms(t *testing.T) {
+	implementations := getX509Implementations()
+
+	for _, impl := range implementations {
+		impl := impl
+
+		t.Run(impl.Name+"_unsupported key algorithm returns error", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+
+			key, err := impl.GeneratePrivateKeyWithAlgorithm(ctx, "dsa-1024")
+			require.Error(t, err)
+			require.Nil(t, key)
+
+			options := getDefaultRootCaOptions()
+			options.KeyAlgorithm = "dsa-1024"
+			pair, err := impl.CreateRootCaCertificate(ctx, options)
+			require.Error(t, err)
+			require.Nil(t, pair)
+		})
+
+		for _, keyAlgorithm := range []string{cryptoutils.KEY_ALGORITHM_RSA_2048, cryptoutils.KEY_ALGORITHM_ECDSA_P256, cryptoutils.KEY_ALGORITHM_ECDSA_P384, cryptoutils.KEY_ALGORITHM_ED25519} {
+			t.Run(impl.Name+"_"+keyAlgorithm, func(t *testing.T) {
+				ctx := contextutils.ContextVerbose()
+
+				key, err := impl.GeneratePrivateKeyWithAlgorithm(ctx, keyAlgorithm)
+				require.NoError(t, err)
+				detectedAlgorithm, err := cryptoutils.GetKeyAlgorithm(key)
+				require.NoError(t, err)
+				require.EqualValues(t, keyAlgorithm, detectedAlgorithm)
+
+				rootOptions := getDefaultRootCaOptions()
+				rootOptions.KeyAlgorithm = keyAlgorithm
+				rootPair, err := impl.CreateRootCaCertificate(ctx, rootOptions)
+				require.NoError(t, err)
+
+				intOptions := getDefaultIntermediateOptions()
+				intOptions.KeyAlgorithm = keyAlgorithm
+				intPair, err := impl.CreateSignedIntermediateCertificate(ctx, intOptions, rootPair)
+				require.NoError(t, err)
+
+				eeOptions := getDefaultEndEntityOptions()
+				eeOptions.KeyAlgorithm = keyAlgorithm
+				eePair, err := impl.CreateSignedEndEntityCertificate(ctx, eeOptions, intPair)
+				require.NoError(t, err)
+
+				rootCert, err := rootPair.GetX509Certificate()
+				require.NoError(t, err)
+				intCert, err := intPair.GetX509Certificate()
+				require.NoError(t, err)
+				eeCert, err := eePair.GetX509Certificate()
+				require.NoError(t, err)
+
+				isValidChain, err := genericx509utils.IsRootCaToEndEntityChain(ctx, []*x509.Certificate{eeCert, intCert, rootCert})
+				require.NoError(t, err)
+				require.True(t, isValidChain)
+
+				for _, pair := range []*genericx509utils.X509CertKeyPair{rootPair, intPair, eePair} {
+					isMatching, err := genericx509utils.IsCertificateMatchingPrivateKey(pair.Cert, pair.Key)
+					require.NoError(t, err)
+					require.True(t, isMatching)
+
+					detectedAlgorithm, err := cryptoutils.GetKeyAlgorithm(pair.Key)
+					require.NoError(t, err)
+					require.EqualValues(t, keyAlgorithm, detectedAlgorithm)
+				}
+
+				// A key of another certificate must not match:
+				isMatching, err := genericx509utils.IsCertificateMatchingPrivateKey(eeCert, key)
+				require.NoError(t, err)
+				require.False(t, isMatching)
+
+				// Ensure the private keys can be PEM encoded and loaded again:
+				keyPem, err := cryptoutils.EncodePrivateKeyAsPEMString(eePair.Key)
+				require.NoError(t, err)
+				readKey, err := cryptoutils.LoadPrivateKeyFromPEMString(keyPem)
+				require.NoError(t, err)
+				isMatching, err = genericx509utils.IsCertificateMatchingPrivateKey(eeCert, readKey)
+				require.NoError(t, err)
+				require.True(t, isMatching)
+			})
+		}
+	}
+}
+
+// --- PKCS#12 and JKS ---
+
+// Test_Pkcs12AndJks validates that all implementations write and read PKCS#12 bundles and JKS truststores identically.
+func Test_Pkcs12AndJks(t *testing.T) {
+	implementations := getX509Implementations()
+
+	for _, impl := range implementations {
+		impl := impl
+
+		t.Run(impl.Name+"_empty path returns error", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+
+			pair, chain, err := impl.ReadPkcs12FromFile(ctx, "", "secret")
+			require.Error(t, err)
+			require.Nil(t, pair)
+			require.Nil(t, chain)
+
+			certs, err := impl.ReadJksTrustStoreFromFile(ctx, "", "changeit")
+			require.Error(t, err)
+			require.Nil(t, certs)
+		})
+
+		t.Run(impl.Name+"_pkcs12 round trip", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			p12Path := filepath.Join(t.TempDir(), "bundle.p12")
+
+			rootPair, err := impl.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+			require.NoError(t, err)
+			intPair, err := impl.CreateSignedIntermediateCertificate(ctx, getDefaultIntermediateOptions(), rootPair)
+			require.NoError(t, err)
+			eePair, err := impl.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), intPair)
+			require.NoError(t, err)
+
+			err = impl.WritePkcs12ToFile(ctx, eePair, []*x509.Certificate{intPair.Cert, rootPair.Cert}, "secret", p12Path)
+			require.NoError(t, err)
+
+			fileInfo, err := os.Stat(p12Path)
+			require.NoError(t, err)
+			require.EqualValues(t, 0o600, fileInfo.Mode().Perm())
+
+			readPair, chain, err := impl.ReadPkcs12FromFile(ctx, p12Path, "secret")
+			require.NoError(t, err)
+			require.True(t, readPair.Cert.Equal(eePair.Cert))
+			require.NoError(t, readPair.CheckKeyMatchingCertificate())
+			require.Len(t, chain, 2)
+
+			isChain, err := genericx509utils.IsRootCaToEndEntityChain(ctx, []*x509.Certificate{readPair.Cert, chain[0], chain[1]})
+			require.NoError(t, err)
+			require.True(t, isChain)
+
+			_, _, err = impl.ReadPkcs12FromFile(ctx, p12Path, "wrong")
+			require.Error(t, err)
+		})
+
+		t.Run(impl.Name+"_jks truststore round trip", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			jksPath := filepath.Join(t.TempDir(), "truststore.jks")
+
+			rootPair, err := impl.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+			require.NoError(t, err)
+			intPair, err := impl.CreateSignedIntermediateCertificate(ctx, getDefaultIntermediateOptions(), rootPair)
+			require.NoError(t, err)
+
+			err = impl.WriteJksTrustStoreToFile(ctx, []*x509.Certificate{rootPair.Cert, intPair.Cert}, "changeit", jksPath)
+			require.NoError(t, err)
+
+			certs, err := impl.ReadJksTrustStoreFromFile(ctx, jksPath, "changeit")
+			require.NoError(t, err)
+			require.Len(t, certs, 2)
+			require.True(t, certs[0].Equal(rootPair.Cert))
+			require.True(t, certs[1].Equal(intPair.Cert))
+		})
+	}
+}
+
+// --- Revocation ---
+
+// Test_Revocation validates that all implementations check the revocation status using CRL files and OCSP responders identically.
+func Test_Revocation(t *testing.T) {
+	implementations := getX509Implementations()
+
+	for _, impl := range implementations {
+		impl := impl
+
+		t.Run(impl.Name+"_empty path returns error", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+
+			crl, err := impl.ReadRevocationListFromFile(ctx, "")
+			require.Error(t, err)
+			require.Nil(t, crl)
+		})
+
+		t.Run(impl.Name+"_revocation list file", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			crlPath := filepath.Join(t.TempDir(), "ca.crl")
+
+			rootPair, err := impl.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+			require.NoError(t, err)
+			eePair, err := impl.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), rootPair)
+			require.NoError(t, err)
+
+			crl, err := x509utils.CreateRevocationList(ctx, rootPair, nil, nil)
+			require.NoError(t, err)
+			err = impl.WriteRevocationListToFile(ctx, crl, crlPath)
+			require.NoError(t, err)
+
+			status, err := impl.GetRevocationStatusUsingRevocationListFile(ctx, eePair.Cert, rootPair.Cert, crlPath)
+			require.NoError(t, err)
+			require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+
+			crl, err = x509utils.RevokeCertificate(ctx, crl, rootPair, eePair.Cert.SerialNumber.String(), "keyCompromise", nil)
+			require.NoError(t, err)
+			err = impl.WriteRevocationListToFile(ctx, crl, crlPath)
+			require.NoError(t, err)
+
+			readCrl, err := impl.ReadRevocationListFromFile(ctx, crlPath)
+			require.NoError(t, err)
+			require.Len(t, readCrl.RevokedCertificateEntries, 1)
+			require.EqualValues(t, crl.Number, readCrl.Number)
+
+			status, err = impl.GetRevocationStatusUsingRevocationListFile(ctx, eePair.Cert, rootPair.Cert, crlPath)
+			require.NoError(t, err)
+			require.True(t, status.IsRevoked())
+			require.EqualValues(t, "keyCompromise", status.Reason)
+		})
+
+		t.Run(impl.Name+"_ocsp responder", func(t *testing.T) {
+			ctx := contextutils.ContextVerbose()
+			const port = 9126
+
+			rootPair, err := impl.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+			require.NoError(t, err)
+			eePair, err := impl.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), rootPair)
+			require.NoError(t, err)
+
+			responder, err := testocspresponder.GetRunningTestOcspResponder(ctx, port, rootPair)
+			require.NoError(t, err)
+			defer responder.Stop(ctx)
+
+			responderUrl, err := responder.GetUrl()
+			require.NoError(t, err)
+
+			status, err := impl.GetRevocationStatusUsingOcsp(ctx, eePair.Cert, rootPair.Cert, responderUrl)
+			require.NoError(t, err)
+			require.EqualValues(t, genericx509utils.REVOCATION_STATUS_GOOD, status.Status)
+
+			err = responder.RevokeCertificate(ctx, eePair.Cert.SerialNumber.String(), "superseded")
+			require.NoError(t, err)
+
+			status, err = impl.GetRevocationStatusUsingOcsp(ctx, eePair.Cert, rootPair.Cert, responderUrl)
+			require.NoError(t, err)
+			require.True(t, status.IsRevoked())
+			require.EqualValues(t, "superseded", status.Reason)
+
+			// Certificates of another CA are not answered by the responder:
+			otherRootPair, err := impl.CreateRootCaCertificate(ctx, getDefaultRootCaOptions())
+			require.NoError(t, err)
+			otherEePair, err := impl.CreateSignedEndEntityCertificate(ctx, getDefaultEndEntityOptions(), otherRootPair)
+			require.NoError(t, err)
+
+			status, err = impl.GetRevocationStatusUsingOcsp(ctx, otherEePair.Cert, otherRootPair.Cert, responderUrl)
+			require.Error(t, err)
+			require.Nil(t, status)
+		})
+	}
+}